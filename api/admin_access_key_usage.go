@@ -0,0 +1,270 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	userApi "github.com/minio/console/api/operations/user"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/logsearch"
+	"github.com/minio/console/pkg/store"
+	"github.com/minio/madmin-go/v3"
+)
+
+const (
+	accessKeyUsageCollection = "access-key-usage"
+	accessKeyUsageDocument   = "usage"
+	// accessKeyUsageSaveInterval is how often the tracked usage is written to the console store
+	accessKeyUsageSaveInterval = time.Minute
+)
+
+// globalAccessKeyUsage tracks the usage of the access keys, nil unless enabled
+var globalAccessKeyUsage *logsearch.UsageTracker
+
+func registerAccessKeyUsageHandlers(api *operations.ConsoleAPI) {
+	// Disable or delete inactive access keys
+	api.UserCleanupInactiveAccessKeysHandler = userApi.CleanupInactiveAccessKeysHandlerFunc(func(params userApi.CleanupInactiveAccessKeysParams, session *models.Principal) middleware.Responder {
+		resp, err := getCleanupInactiveAccessKeysResponse(session, params)
+		if err != nil {
+			return userApi.NewCleanupInactiveAccessKeysDefault(err.Code).WithPayload(err.APIError)
+		}
+		return userApi.NewCleanupInactiveAccessKeysOK().WithPayload(resp)
+	})
+}
+
+// startAccessKeyUsage resumes tracking the usage of the access keys when enabled, the MinIO
+// entries of the local log search index newer than the saved usage are replayed first
+func startAccessKeyUsage() {
+	if !getAccessKeyUsageEnabled() {
+		return
+	}
+	s := getConsoleStore()
+	var state logsearch.UsageState
+	if err := s.Get(accessKeyUsageCollection, accessKeyUsageDocument, &state); err != nil && !errors.Is(err, store.ErrNotFound) {
+		logError("unable to load the access key usage: %v", err)
+	}
+	tracker := logsearch.NewUsageTracker(state, time.Now().UTC())
+	if globalLogSearchIndex != nil {
+		query := logsearch.Query{Source: logsearch.SourceMinIO}
+		if until := tracker.Until(); !until.IsZero() {
+			query.Start = until.Add(time.Nanosecond)
+		}
+		if err := globalLogSearchIndex.Each(query, func(record logsearch.Record) { tracker.Add(record) }); err != nil {
+			logError("unable to replay the log search index into the access key usage: %v", err)
+		}
+	}
+	globalAccessKeyUsage = tracker
+	go func() {
+		ticker := time.NewTicker(accessKeyUsageSaveInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := saveAccessKeyUsage(s, tracker); err != nil {
+				logError("unable to save the access key usage: %v", err)
+			}
+		}
+	}()
+}
+
+// saveAccessKeyUsage writes the tracked usage to the store when it changed since it was last saved
+func saveAccessKeyUsage(s *store.Store, tracker *logsearch.UsageTracker) error {
+	state, changed := tracker.Snapshot()
+	if !changed {
+		return nil
+	}
+	return s.Put(accessKeyUsageCollection, accessKeyUsageDocument, state)
+}
+
+// accessKeyUsage returns the tracked usage of the access key, nil when usage isn't tracked or
+// the access key wasn't used since tracking started. The access key is recorded as seen since
+// it's only called for listed access keys.
+func accessKeyUsage(tracker *logsearch.UsageTracker, accessKey string) *models.AccessKeyUsage {
+	if tracker == nil {
+		return nil
+	}
+	tracker.Seen(accessKey, time.Now().UTC())
+	usage, ok := tracker.Get(accessKey)
+	if !ok {
+		return nil
+	}
+	return &models.AccessKeyUsage{
+		LastUsed:  usage.LastUsed.Format(time.RFC3339),
+		SourceIPs: usage.SourceIPs,
+		Requests:  usage.Requests,
+		APICalls:  usage.APICalls,
+	}
+}
+
+// usageTrackedSince returns the start of the tracked period, empty when usage isn't tracked
+func usageTrackedSince(tracker *logsearch.UsageTracker) string {
+	if tracker == nil {
+		return ""
+	}
+	return tracker.Since().Format(time.RFC3339)
+}
+
+// cleanupInactiveAccessKeys disables or deletes the users and service accounts that weren't used
+// for the requested number of days, a user is still in use while any of its service accounts or
+// temporary credentials is. Access keys first seen within the period are skipped as they may be
+// new, the requester's own access key is never included and nothing is changed on a dry run.
+func cleanupInactiveAccessKeys(ctx context.Context, client MinioAdmin, tracker *logsearch.UsageTracker, req *models.InactiveAccessKeysCleanupRequest, requester string, now time.Time) (*models.InactiveAccessKeysCleanupResponse, error) {
+	if tracker == nil {
+		return nil, ErrAccessKeyUsageDisabled
+	}
+	cutoff := now.AddDate(0, 0, -int(*req.InactiveDays))
+	since := tracker.Since()
+	// access keys never seen may have been used right before tracking started
+	if since.After(cutoff) {
+		return nil, fmt.Errorf("%w, usage is tracked since %s", ErrAccessKeyUsageTooRecent, since.Format(time.RFC3339))
+	}
+	action := *req.Action
+	credentialType := models.InactiveAccessKeysCleanupRequestCredentialTypeAll
+	if req.CredentialType != nil {
+		credentialType = *req.CredentialType
+	}
+	lastUsed := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var userNames []string
+	for user := range users {
+		userNames = append(userNames, user)
+	}
+	// a user listed for the first time may have just been created
+	firstSeen := map[string]time.Time{}
+	for _, user := range userNames {
+		firstSeen[user] = tracker.Seen(user, now)
+	}
+	sort.Strings(userNames)
+
+	var inactive []*models.InactiveAccessKey
+	// a service account in use keeps its parent in use even if older audit entries miss the parent
+	parentsInUse := map[string]bool{}
+	accounts, err := listAllServiceAccounts(ctx, client, userNames)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range accounts {
+		firstSeen := tracker.Seen(info.AccessKey, now)
+		usage, _ := tracker.Get(info.AccessKey)
+		if !usage.LastUsed.Before(cutoff) {
+			parentsInUse[info.ParentUser] = true
+			continue
+		}
+		if firstSeen.After(cutoff) || credentialType == models.InactiveAccessKeysCleanupRequestCredentialTypeUser || info.AccessKey == requester ||
+			(action == models.InactiveAccessKeysCleanupRequestActionDisable && info.AccountStatus == "off") {
+			continue
+		}
+		inactive = append(inactive, &models.InactiveAccessKey{
+			AccessKey:  info.AccessKey,
+			Type:       models.InactiveAccessKeyTypeServiceAccount,
+			ParentUser: info.ParentUser,
+			Status:     info.AccountStatus,
+			LastUsed:   lastUsed(usage.LastUsed),
+		})
+	}
+	if credentialType != models.InactiveAccessKeysCleanupRequestCredentialTypeServiceAccount {
+		for _, user := range userNames {
+			status := users[user].Status
+			if user == requester || parentsInUse[user] || firstSeen[user].After(cutoff) || (action == models.InactiveAccessKeysCleanupRequestActionDisable && status == madmin.AccountDisabled) {
+				continue
+			}
+			if used := tracker.LastUsedBy(user); used.Before(cutoff) {
+				usage, _ := tracker.Get(user)
+				inactive = append(inactive, &models.InactiveAccessKey{
+					AccessKey: user,
+					Type:      models.InactiveAccessKeyTypeUser,
+					Status:    string(status),
+					LastUsed:  lastUsed(usage.LastUsed),
+				})
+			}
+		}
+	}
+
+	resp := &models.InactiveAccessKeysCleanupResponse{
+		Action:            action,
+		DryRun:            req.DryRun,
+		Cutoff:            cutoff.Format(time.RFC3339),
+		UsageTrackedSince: since.Format(time.RFC3339),
+		AccessKeys:        []*models.InactiveAccessKey{},
+	}
+	for _, key := range inactive {
+		resp.AccessKeys = append(resp.AccessKeys, key)
+		if req.DryRun {
+			continue
+		}
+		// service accounts come first so deleting their parent doesn't fail them
+		var err error
+		switch {
+		case key.Type == models.InactiveAccessKeyTypeUser && action == models.InactiveAccessKeysCleanupRequestActionDelete:
+			err = client.removeUser(ctx, key.AccessKey)
+		case key.Type == models.InactiveAccessKeyTypeUser:
+			err = client.setUserStatus(ctx, key.AccessKey, madmin.AccountDisabled)
+		case action == models.InactiveAccessKeysCleanupRequestActionDelete:
+			err = client.deleteServiceAccount(ctx, key.AccessKey)
+		default:
+			err = client.updateServiceAccount(ctx, key.AccessKey, madmin.UpdateServiceAccountReq{NewStatus: "off"})
+		}
+		if err != nil {
+			key.Error = err.Error()
+			resp.Failed++
+			continue
+		}
+		if action == models.InactiveAccessKeysCleanupRequestActionDelete {
+			tracker.Forget(key.AccessKey)
+		}
+	}
+	return resp, nil
+}
+
+func getCleanupInactiveAccessKeysResponse(session *models.Principal, params userApi.CleanupInactiveAccessKeysParams) (*models.InactiveAccessKeysCleanupResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	resp, err := cleanupInactiveAccessKeys(ctx, adminClient, globalAccessKeyUsage, params.Body, session.AccountAccessKey, time.Now().UTC())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	var changed []string
+	for _, key := range resp.AccessKeys {
+		if !resp.DryRun && key.Error == "" {
+			changed = append(changed, key.AccessKey)
+		}
+	}
+	if len(changed) > 0 {
+		auditEvent(params.HTTPRequest, session, auditTargetUser, strings.Join(changed, ","), nil, params.Body)
+	}
+	return resp, nil
+}
@@ -0,0 +1,253 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/logsearch"
+	"github.com/minio/console/pkg/store"
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_accessKeyUsage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	start := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tracker := logsearch.NewUsageTracker(logsearch.UsageState{}, start)
+	tracker.Add(
+		logsearch.Record{Time: start.Add(time.Hour), Source: logsearch.SourceMinIO, APIName: "GetObject", AccessKey: "alice", RemoteHost: "10.0.0.1"},
+		logsearch.Record{Time: start.Add(2 * time.Hour), Source: logsearch.SourceMinIO, APIName: "PutObject", AccessKey: "sa-1", ParentUser: "alice", RemoteHost: "10.0.0.2"},
+	)
+
+	assert.Nil(t, accessKeyUsage(nil, "alice"))
+	assert.Empty(t, usageTrackedSince(nil))
+	assert.Nil(t, accessKeyUsage(tracker, "bob"))
+	assert.Equal(t, &models.AccessKeyUsage{
+		LastUsed:  "2024-03-10T13:00:00Z",
+		SourceIPs: []string{"10.0.0.1"},
+		Requests:  1,
+		APICalls:  map[string]int64{"GetObject": 1},
+	}, accessKeyUsage(tracker, "alice"))
+	assert.Equal(t, "2024-03-10T12:00:00Z", usageTrackedSince(tracker))
+
+	// listings show the usage once tracked
+	globalAccessKeyUsage = tracker
+	defer func() { globalAccessKeyUsage = nil }()
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"alice": {Status: madmin.AccountEnabled}}, nil
+	}
+	users, err := listUsers(ctx, adminClient)
+	assert.NoError(t, err)
+	if assert.Len(t, users, 1) {
+		assert.Equal(t, int64(1), users[0].Usage.Requests)
+	}
+	minioListServiceAccountsMock = func(_ context.Context, _ string) (madmin.ListServiceAccountsResp, error) {
+		return madmin.ListServiceAccountsResp{Accounts: []madmin.ServiceAccountInfo{{AccessKey: "sa-1"}, {AccessKey: "sa-2"}}}, nil
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "on"}, nil
+	}
+	accounts, err := getUserServiceAccounts(ctx, adminClient, "alice")
+	assert.NoError(t, err)
+	if assert.Len(t, accounts, 2) {
+		assert.Equal(t, "2024-03-10T14:00:00Z", accounts[0].Usage.LastUsed)
+		assert.Nil(t, accounts[1].Usage)
+	}
+	// listed access keys are remembered even if unused
+	state, _ := tracker.Snapshot()
+	assert.Contains(t, state.FirstSeen, "sa-2")
+}
+
+func Test_saveAccessKeyUsage(t *testing.T) {
+	s := store.New(t.TempDir())
+	start := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tracker := logsearch.NewUsageTracker(logsearch.UsageState{}, start)
+	tracker.Add(logsearch.Record{Time: start.Add(time.Hour), Source: logsearch.SourceMinIO, APIName: "GetObject", AccessKey: "alice"})
+	assert.NoError(t, saveAccessKeyUsage(s, tracker))
+
+	var state logsearch.UsageState
+	assert.NoError(t, s.Get(accessKeyUsageCollection, accessKeyUsageDocument, &state))
+	assert.Equal(t, start, state.Since)
+	assert.Equal(t, int64(1), state.Keys["alice"].Requests)
+
+	// unchanged usage isn't written again
+	assert.NoError(t, s.Delete(accessKeyUsageCollection, accessKeyUsageDocument))
+	assert.NoError(t, saveAccessKeyUsage(s, tracker))
+	assert.ErrorIs(t, s.Get(accessKeyUsageCollection, accessKeyUsageDocument, &state), store.ErrNotFound)
+}
+
+func Test_serveLogSearchWebhookAccessKeyUsage(t *testing.T) {
+	tracker := logsearch.NewUsageTracker(logsearch.UsageState{}, time.Now().UTC())
+	globalAccessKeyUsage = tracker
+	defer func() { globalAccessKeyUsage = nil }()
+	t.Setenv(ConsoleLogSearchWebhookToken, "webhook-token")
+
+	// usage is tracked without the local log search index
+	req := httptest.NewRequest(http.MethodPost, logSearchWebhookPath, strings.NewReader(`{"time":"2024-03-10T12:00:00Z","api":{"name":"PutObject","bucket":"photos","statusCode":200},"accessKey":"alice","remotehost":"10.0.0.1:52812"}`))
+	req.Header.Set("Authorization", "Bearer webhook-token")
+	rec := httptest.NewRecorder()
+	serveLogSearchWebhook(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	usage, ok := tracker.Get("alice")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"10.0.0.1"}, usage.SourceIPs)
+	assert.Equal(t, map[string]int64{"PutObject": 1}, usage.APICalls)
+}
+
+// cleanupKeys returns the type, access key and error of every cleaned up access key
+func cleanupKeys(resp *models.InactiveAccessKeysCleanupResponse) []string {
+	var keys []string
+	for _, key := range resp.AccessKeys {
+		keys = append(keys, strings.TrimSuffix(key.Type+" "+key.AccessKey+" "+key.Error, " "))
+	}
+	return keys
+}
+
+func Test_cleanupInactiveAccessKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tracker := logsearch.NewUsageTracker(logsearch.UsageState{}, now.AddDate(0, 0, -120))
+	tracker.Add(
+		// alice only signs in with temporary credentials
+		logsearch.Record{Time: now.AddDate(0, 0, -2), Source: logsearch.SourceMinIO, AccessKey: "STS1", ParentUser: "alice"},
+		logsearch.Record{Time: now.AddDate(0, 0, -100), Source: logsearch.SourceMinIO, AccessKey: "bob"},
+		// carol's service account is in use
+		logsearch.Record{Time: now.AddDate(0, 0, -1), Source: logsearch.SourceMinIO, AccessKey: "sa-carol"},
+		logsearch.Record{Time: now.AddDate(0, 0, -95), Source: logsearch.SourceMinIO, AccessKey: "sa-bob", ParentUser: "bob"},
+	)
+	// listed when tracking started, erin and sa-carol-2 are listed for the first time by the cleanup
+	for _, accessKey := range []string{"alice", "bob", "carol", "dave", "admin", "sa-dave"} {
+		tracker.Seen(accessKey, now.AddDate(0, 0, -120))
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {Status: madmin.AccountEnabled},
+			"bob":   {Status: madmin.AccountEnabled},
+			"carol": {Status: madmin.AccountEnabled},
+			"dave":  {Status: madmin.AccountDisabled},
+			"admin": {Status: madmin.AccountEnabled},
+			"erin":  {Status: madmin.AccountEnabled},
+		}, nil
+	}
+	accounts := map[string][]string{"bob": {"sa-bob"}, "carol": {"sa-carol", "sa-carol-2"}, "dave": {"sa-dave"}, "": {"sa-bob"}}
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		var resp madmin.ListServiceAccountsResp
+		for _, accessKey := range accounts[user] {
+			resp.Accounts = append(resp.Accounts, madmin.ServiceAccountInfo{AccessKey: accessKey})
+		}
+		return resp, nil
+	}
+	infos := map[string]madmin.InfoServiceAccountResp{
+		"sa-bob":     {ParentUser: "bob", AccountStatus: "on"},
+		"sa-carol":   {ParentUser: "carol", AccountStatus: "on"},
+		"sa-carol-2": {ParentUser: "carol", AccountStatus: "on"},
+		"sa-dave":    {ParentUser: "dave", AccountStatus: "off"},
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, accessKey string) (madmin.InfoServiceAccountResp, error) {
+		return infos[accessKey], nil
+	}
+	var changes []string
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		changes = append(changes, "status "+accessKey+" "+string(status))
+		return nil
+	}
+	minioRemoveUserMock = func(accessKey string) error {
+		changes = append(changes, "remove "+accessKey)
+		return nil
+	}
+	minioUpdateServiceAccountMock = func(_ context.Context, serviceAccount string, opts madmin.UpdateServiceAccountReq) error {
+		changes = append(changes, "update "+serviceAccount+" "+opts.NewStatus)
+		return nil
+	}
+	minioDeleteServiceAccountMock = func(_ context.Context, serviceAccount string) error {
+		if serviceAccount == "sa-dave" {
+			return errors.New("access denied")
+		}
+		changes = append(changes, "delete "+serviceAccount)
+		return nil
+	}
+	request := func(action string, dryRun bool, credentialType string) *models.InactiveAccessKeysCleanupRequest {
+		return &models.InactiveAccessKeysCleanupRequest{InactiveDays: swag.Int32(90), Action: swag.String(action), DryRun: dryRun, CredentialType: swag.String(credentialType)}
+	}
+
+	// a dry run changes nothing
+	resp, err := cleanupInactiveAccessKeys(ctx, adminClient, tracker, request(models.InactiveAccessKeysCleanupRequestActionDisable, true, models.InactiveAccessKeysCleanupRequestCredentialTypeAll), "admin", now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"serviceAccount sa-bob", "user bob"}, cleanupKeys(resp))
+	assert.Equal(t, "2024-03-03T12:00:00Z", resp.Cutoff)
+	assert.Equal(t, "2024-02-27T12:00:00Z", resp.AccessKeys[0].LastUsed)
+	assert.True(t, resp.DryRun)
+	assert.Empty(t, changes)
+
+	resp, err = cleanupInactiveAccessKeys(ctx, adminClient, tracker, request(models.InactiveAccessKeysCleanupRequestActionDisable, false, models.InactiveAccessKeysCleanupRequestCredentialTypeAll), "admin", now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"update sa-bob off", "status bob disabled"}, changes)
+	assert.Zero(t, resp.Failed)
+
+	// disabled credentials are deleted as well, service accounts before their parent
+	changes = nil
+	resp, err = cleanupInactiveAccessKeys(ctx, adminClient, tracker, request(models.InactiveAccessKeysCleanupRequestActionDelete, false, models.InactiveAccessKeysCleanupRequestCredentialTypeAll), "admin", now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"serviceAccount sa-bob", "serviceAccount sa-dave access denied", "user bob", "user dave"}, cleanupKeys(resp))
+	assert.Equal(t, []string{"delete sa-bob", "remove bob", "remove dave"}, changes)
+	assert.Equal(t, int64(1), resp.Failed)
+	_, ok := tracker.Get("bob")
+	assert.False(t, ok)
+
+	// deleted access keys listed again were created anew
+	resp, err = cleanupInactiveAccessKeys(ctx, adminClient, tracker, request(models.InactiveAccessKeysCleanupRequestActionDelete, true, models.InactiveAccessKeysCleanupRequestCredentialTypeUser), "admin", now)
+	assert.NoError(t, err)
+	assert.Empty(t, cleanupKeys(resp))
+
+	// keys first seen within the period may be new
+	firstSeen := now.AddDate(0, 0, -80)
+	tracker.Seen("frank", firstSeen)
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"frank": {Status: madmin.AccountEnabled}}, nil
+	}
+	resp, err = cleanupInactiveAccessKeys(ctx, adminClient, tracker, request(models.InactiveAccessKeysCleanupRequestActionDelete, true, models.InactiveAccessKeysCleanupRequestCredentialTypeUser), "admin", now)
+	assert.NoError(t, err)
+	assert.Empty(t, cleanupKeys(resp))
+	resp, err = cleanupInactiveAccessKeys(ctx, adminClient, tracker, request(models.InactiveAccessKeysCleanupRequestActionDelete, true, models.InactiveAccessKeysCleanupRequestCredentialTypeUser), "admin", firstSeen.AddDate(0, 0, 91))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user frank"}, cleanupKeys(resp))
+
+	// keys never seen can't be judged before tracking covers the whole period
+	_, err = cleanupInactiveAccessKeys(ctx, adminClient, logsearch.NewUsageTracker(logsearch.UsageState{}, now.AddDate(0, 0, -10)), request(models.InactiveAccessKeysCleanupRequestActionDisable, true, models.InactiveAccessKeysCleanupRequestCredentialTypeAll), "admin", now)
+	assert.ErrorIs(t, err, ErrAccessKeyUsageTooRecent)
+	_, err = cleanupInactiveAccessKeys(ctx, adminClient, nil, request(models.InactiveAccessKeysCleanupRequestActionDisable, true, models.InactiveAccessKeysCleanupRequestCredentialTypeAll), "admin", now)
+	assert.ErrorIs(t, err, ErrAccessKeyUsageDisabled)
+
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return nil, errors.New("access denied")
+	}
+	_, err = cleanupInactiveAccessKeys(ctx, adminClient, tracker, request(models.InactiveAccessKeysCleanupRequestActionDisable, true, models.InactiveAccessKeysCleanupRequestCredentialTypeAll), "admin", now)
+	assert.EqualError(t, err, "access denied")
+}
@@ -0,0 +1,1118 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	alertsApi "github.com/minio/console/api/operations/alerts"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/store"
+	"github.com/minio/madmin-go/v3"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
+)
+
+const (
+	alertRulesCollection    = "alert-rules"
+	alertChannelsCollection = "alert-channels"
+	alertSilencesCollection = "alert-silences"
+	alertStateCollection    = "alert-state"
+	alertHistoryCollection  = "alert-history"
+
+	// alertHistoryMaxEvents is the number of events kept in the alert history
+	alertHistoryMaxEvents = 1000
+	// alertDefaultRepeatInterval is used for rules without a repeat interval
+	alertDefaultRepeatInterval = 4 * time.Hour
+)
+
+// errAlertChannelInUse is returned when deleting a channel referenced by a rule
+var errAlertChannelInUse = errors.New("notification channel is in use")
+
+// alertsEvaluationMu serializes rule evaluations started by the API and by the background evaluator
+var alertsEvaluationMu sync.Mutex
+
+func registerAlertsHandlers(api *operations.ConsoleAPI) {
+	// list alert rules
+	api.AlertsListAlertRulesHandler = alertsApi.ListAlertRulesHandlerFunc(func(params alertsApi.ListAlertRulesParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewListAlertRulesDefault(err.Code).WithPayload(err.APIError)
+		}
+		rules, err := getListAlertRulesResponse(params)
+		if err != nil {
+			return alertsApi.NewListAlertRulesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewListAlertRulesOK().WithPayload(rules)
+	})
+	// create an alert rule
+	api.AlertsCreateAlertRuleHandler = alertsApi.CreateAlertRuleHandlerFunc(func(params alertsApi.CreateAlertRuleParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewCreateAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		rule, err := getCreateAlertRuleResponse(params)
+		if err != nil {
+			return alertsApi.NewCreateAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewCreateAlertRuleCreated().WithPayload(rule)
+	})
+	// get an alert rule
+	api.AlertsGetAlertRuleHandler = alertsApi.GetAlertRuleHandlerFunc(func(params alertsApi.GetAlertRuleParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewGetAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		rule, err := getGetAlertRuleResponse(params)
+		if err != nil {
+			return alertsApi.NewGetAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewGetAlertRuleOK().WithPayload(rule)
+	})
+	// update an alert rule
+	api.AlertsUpdateAlertRuleHandler = alertsApi.UpdateAlertRuleHandlerFunc(func(params alertsApi.UpdateAlertRuleParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewUpdateAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		rule, err := getUpdateAlertRuleResponse(params)
+		if err != nil {
+			return alertsApi.NewUpdateAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewUpdateAlertRuleOK().WithPayload(rule)
+	})
+	// delete an alert rule
+	api.AlertsDeleteAlertRuleHandler = alertsApi.DeleteAlertRuleHandlerFunc(func(params alertsApi.DeleteAlertRuleParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewDeleteAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		if err := getDeleteAlertRuleResponse(params); err != nil {
+			return alertsApi.NewDeleteAlertRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewDeleteAlertRuleNoContent()
+	})
+	// list notification channels
+	api.AlertsListAlertChannelsHandler = alertsApi.ListAlertChannelsHandlerFunc(func(params alertsApi.ListAlertChannelsParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewListAlertChannelsDefault(err.Code).WithPayload(err.APIError)
+		}
+		channels, err := getListAlertChannelsResponse(params)
+		if err != nil {
+			return alertsApi.NewListAlertChannelsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewListAlertChannelsOK().WithPayload(channels)
+	})
+	// create a notification channel
+	api.AlertsCreateAlertChannelHandler = alertsApi.CreateAlertChannelHandlerFunc(func(params alertsApi.CreateAlertChannelParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewCreateAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		channel, err := getCreateAlertChannelResponse(params)
+		if err != nil {
+			return alertsApi.NewCreateAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewCreateAlertChannelCreated().WithPayload(channel)
+	})
+	// update a notification channel
+	api.AlertsUpdateAlertChannelHandler = alertsApi.UpdateAlertChannelHandlerFunc(func(params alertsApi.UpdateAlertChannelParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewUpdateAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		channel, err := getUpdateAlertChannelResponse(params)
+		if err != nil {
+			return alertsApi.NewUpdateAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewUpdateAlertChannelOK().WithPayload(channel)
+	})
+	// delete a notification channel
+	api.AlertsDeleteAlertChannelHandler = alertsApi.DeleteAlertChannelHandlerFunc(func(params alertsApi.DeleteAlertChannelParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewDeleteAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		if err := getDeleteAlertChannelResponse(params); err != nil {
+			return alertsApi.NewDeleteAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewDeleteAlertChannelNoContent()
+	})
+	// send a test notification
+	api.AlertsTestAlertChannelHandler = alertsApi.TestAlertChannelHandlerFunc(func(params alertsApi.TestAlertChannelParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewTestAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		if err := getTestAlertChannelResponse(params); err != nil {
+			return alertsApi.NewTestAlertChannelDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewTestAlertChannelNoContent()
+	})
+	// list active silences
+	api.AlertsListAlertSilencesHandler = alertsApi.ListAlertSilencesHandlerFunc(func(params alertsApi.ListAlertSilencesParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewListAlertSilencesDefault(err.Code).WithPayload(err.APIError)
+		}
+		silences, err := getListAlertSilencesResponse(params)
+		if err != nil {
+			return alertsApi.NewListAlertSilencesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewListAlertSilencesOK().WithPayload(silences)
+	})
+	// create a silence
+	api.AlertsCreateAlertSilenceHandler = alertsApi.CreateAlertSilenceHandlerFunc(func(params alertsApi.CreateAlertSilenceParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewCreateAlertSilenceDefault(err.Code).WithPayload(err.APIError)
+		}
+		silence, err := getCreateAlertSilenceResponse(session, params)
+		if err != nil {
+			return alertsApi.NewCreateAlertSilenceDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewCreateAlertSilenceCreated().WithPayload(silence)
+	})
+	// delete a silence
+	api.AlertsDeleteAlertSilenceHandler = alertsApi.DeleteAlertSilenceHandlerFunc(func(params alertsApi.DeleteAlertSilenceParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewDeleteAlertSilenceDefault(err.Code).WithPayload(err.APIError)
+		}
+		if err := getDeleteAlertSilenceResponse(params); err != nil {
+			return alertsApi.NewDeleteAlertSilenceDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewDeleteAlertSilenceNoContent()
+	})
+	// alert history
+	api.AlertsListAlertHistoryHandler = alertsApi.ListAlertHistoryHandlerFunc(func(params alertsApi.ListAlertHistoryParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewListAlertHistoryDefault(err.Code).WithPayload(err.APIError)
+		}
+		history, err := getListAlertHistoryResponse(params)
+		if err != nil {
+			return alertsApi.NewListAlertHistoryDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewListAlertHistoryOK().WithPayload(history)
+	})
+	// evaluate alert rules using the current session
+	api.AlertsEvaluateAlertRulesHandler = alertsApi.EvaluateAlertRulesHandlerFunc(func(params alertsApi.EvaluateAlertRulesParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ConfigUpdateAdminAction); err != nil {
+			return alertsApi.NewEvaluateAlertRulesDefault(err.Code).WithPayload(err.APIError)
+		}
+		events, err := getEvaluateAlertRulesResponse(session, params)
+		if err != nil {
+			return alertsApi.NewEvaluateAlertRulesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return alertsApi.NewEvaluateAlertRulesOK().WithPayload(events)
+	})
+}
+
+// alertState keeps track of an alert that is currently firing
+type alertState struct {
+	RuleID       string    `json:"ruleId"`
+	Subject      string    `json:"subject"`
+	Message      string    `json:"message"`
+	FiringSince  time.Time `json:"firingSince"`
+	LastNotified time.Time `json:"lastNotified"`
+}
+
+// alertCondition is a problem detected while checking a rule
+type alertCondition struct {
+	Subject string
+	Message string
+}
+
+// alertFingerprint identifies an alert of a rule, used to deduplicate notifications
+func alertFingerprint(ruleID, subject string) string {
+	sum := sha256.Sum256([]byte(ruleID + "\x00" + subject))
+	return hex.EncodeToString(sum[:16])
+}
+
+// storeGet wraps store.Get translating missing documents to ErrNotFound
+func storeGet(s *store.Store, collection, id string, v interface{}) error {
+	if err := s.Get(collection, id, v); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func getAlertRule(s *store.Store, id string) (*models.AlertRule, error) {
+	var rule models.AlertRule
+	if err := storeGet(s, alertRulesCollection, id, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// listAlertRules returns all the alert rules sorted by name
+func listAlertRules(s *store.Store) ([]*models.AlertRule, error) {
+	ids, err := s.List(alertRulesCollection)
+	if err != nil {
+		return nil, err
+	}
+	rules := []*models.AlertRule{}
+	for _, id := range ids {
+		rule, err := getAlertRule(s, id)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return *rules[i].Name < *rules[j].Name
+	})
+	return rules, nil
+}
+
+// validateAlertRule checks the rule settings and that every referenced channel exists
+func validateAlertRule(s *store.Store, rule *models.AlertRule) error {
+	if rule == nil || rule.Name == nil || strings.TrimSpace(*rule.Name) == "" {
+		return errors.New("rule name cannot be empty")
+	}
+	if rule.Type == nil {
+		return errors.New("rule type cannot be empty")
+	}
+	if rule.Threshold < 0 {
+		return errors.New("threshold cannot be negative")
+	}
+	if *rule.Type == models.AlertRuleTypeBucketUsage && rule.Threshold > 100 {
+		return errors.New("bucket usage threshold is a percentage and cannot be over 100")
+	}
+	if rule.RepeatInterval < 0 {
+		return errors.New("repeat interval cannot be negative")
+	}
+	for _, channelID := range rule.Channels {
+		if _, err := getAlertChannel(s, channelID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("notification channel %s does not exist", channelID)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// saveAlertRule stores the rule under the provided id
+func saveAlertRule(s *store.Store, id string, rule *models.AlertRule) (*models.AlertRule, error) {
+	name := strings.TrimSpace(*rule.Name)
+	rule.ID = id
+	rule.Name = &name
+	if rule.Severity == nil {
+		severity := models.AlertRuleSeverityWarning
+		rule.Severity = &severity
+	}
+	if err := s.Put(alertRulesCollection, id, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// deleteAlertRule removes the rule along with the state of its firing alerts
+func deleteAlertRule(s *store.Store, id string) error {
+	if _, err := getAlertRule(s, id); err != nil {
+		return err
+	}
+	states, err := listAlertStates(s)
+	if err != nil {
+		return err
+	}
+	for fingerprint, state := range states {
+		if state.RuleID == id {
+			if err := s.Delete(alertStateCollection, fingerprint); err != nil {
+				return err
+			}
+		}
+	}
+	return s.Delete(alertRulesCollection, id)
+}
+
+func getAlertChannel(s *store.Store, id string) (*models.AlertChannel, error) {
+	var channel models.AlertChannel
+	if err := storeGet(s, alertChannelsCollection, id, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// redactAlertChannel returns a copy of the channel without credentials
+func redactAlertChannel(channel *models.AlertChannel) *models.AlertChannel {
+	redacted := *channel
+	redacted.AuthToken = ""
+	redacted.SMTPPassword = ""
+	return &redacted
+}
+
+// listAlertChannels returns all the notification channels sorted by name, credentials are not returned
+func listAlertChannels(s *store.Store) ([]*models.AlertChannel, error) {
+	ids, err := s.List(alertChannelsCollection)
+	if err != nil {
+		return nil, err
+	}
+	channels := []*models.AlertChannel{}
+	for _, id := range ids {
+		channel, err := getAlertChannel(s, id)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, redactAlertChannel(channel))
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return *channels[i].Name < *channels[j].Name
+	})
+	return channels, nil
+}
+
+// validateAlertChannel checks the channel has the settings required by its type
+func validateAlertChannel(channel *models.AlertChannel) error {
+	if channel == nil || channel.Name == nil || strings.TrimSpace(*channel.Name) == "" {
+		return errors.New("channel name cannot be empty")
+	}
+	if channel.Type == nil {
+		return errors.New("channel type cannot be empty")
+	}
+	switch *channel.Type {
+	case models.AlertChannelTypeWebhook, models.AlertChannelTypeSlack:
+		u, err := url.Parse(channel.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("a valid http or https url is required")
+		}
+		if ip := net.ParseIP(u.Hostname()); !getAlertsAllowPrivateWebhooks() && (strings.EqualFold(u.Hostname(), "localhost") || (ip != nil && isPrivateAlertAddress(ip))) {
+			return errAlertPrivateAddress
+		}
+	case models.AlertChannelTypeSMTP:
+		if channel.SMTPServer == "" || channel.SMTPFrom == "" || len(channel.SMTPTo) == 0 {
+			return errors.New("smtp server, sender and at least one recipient are required")
+		}
+	}
+	return nil
+}
+
+// saveAlertChannel stores the channel under the provided id, credentials left empty keep the previously stored ones
+func saveAlertChannel(s *store.Store, id string, channel *models.AlertChannel) (*models.AlertChannel, error) {
+	if previous, err := getAlertChannel(s, id); err == nil {
+		if channel.AuthToken == "" {
+			channel.AuthToken = previous.AuthToken
+		}
+		if channel.SMTPPassword == "" {
+			channel.SMTPPassword = previous.SMTPPassword
+		}
+	} else if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	name := strings.TrimSpace(*channel.Name)
+	channel.ID = id
+	channel.Name = &name
+	if err := s.Put(alertChannelsCollection, id, channel); err != nil {
+		return nil, err
+	}
+	return redactAlertChannel(channel), nil
+}
+
+// deleteAlertChannel removes a channel that is not used by any rule
+func deleteAlertChannel(s *store.Store, id string) error {
+	if _, err := getAlertChannel(s, id); err != nil {
+		return err
+	}
+	rules, err := listAlertRules(s)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		for _, channelID := range rule.Channels {
+			if channelID == id {
+				return fmt.Errorf("%w by rule %s", errAlertChannelInUse, *rule.Name)
+			}
+		}
+	}
+	return s.Delete(alertChannelsCollection, id)
+}
+
+// listAlertSilences returns the silences that are still active, expired ones are removed
+func listAlertSilences(s *store.Store, now time.Time) ([]*models.AlertSilence, error) {
+	ids, err := s.List(alertSilencesCollection)
+	if err != nil {
+		return nil, err
+	}
+	silences := []*models.AlertSilence{}
+	for _, id := range ids {
+		var silence models.AlertSilence
+		if err := s.Get(alertSilencesCollection, id, &silence); err != nil {
+			return nil, err
+		}
+		expiresAt, err := time.Parse(time.RFC3339, silence.ExpiresAt)
+		if err != nil || !expiresAt.After(now) {
+			if err := s.Delete(alertSilencesCollection, id); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		silences = append(silences, &silence)
+	}
+	sort.Slice(silences, func(i, j int) bool {
+		return silences[i].ExpiresAt < silences[j].ExpiresAt
+	})
+	return silences, nil
+}
+
+// createAlertSilence silences the alerts matching the rule and subject, empty values match everything
+func createAlertSilence(s *store.Store, user string, req *models.AlertSilenceRequest, now time.Time) (*models.AlertSilence, error) {
+	if req.RuleID != "" {
+		if _, err := getAlertRule(s, req.RuleID); err != nil {
+			return nil, err
+		}
+	}
+	silence := &models.AlertSilence{
+		ID:        uuid.NewString(),
+		RuleID:    req.RuleID,
+		Subject:   req.Subject,
+		Comment:   req.Comment,
+		CreatedBy: user,
+		CreatedAt: now.UTC().Format(time.RFC3339),
+		ExpiresAt: now.Add(time.Duration(*req.Duration) * time.Second).UTC().Format(time.RFC3339),
+	}
+	if err := s.Put(alertSilencesCollection, silence.ID, silence); err != nil {
+		return nil, err
+	}
+	return silence, nil
+}
+
+func deleteAlertSilence(s *store.Store, id string) error {
+	var silence models.AlertSilence
+	if err := storeGet(s, alertSilencesCollection, id, &silence); err != nil {
+		return err
+	}
+	return s.Delete(alertSilencesCollection, id)
+}
+
+// isAlertSilenced returns whether any of the silences matches the alert
+func isAlertSilenced(silences []*models.AlertSilence, ruleID, subject string) bool {
+	for _, silence := range silences {
+		if (silence.RuleID == "" || silence.RuleID == ruleID) && (silence.Subject == "" || silence.Subject == subject) {
+			return true
+		}
+	}
+	return false
+}
+
+func listAlertStates(s *store.Store) (map[string]*alertState, error) {
+	ids, err := s.List(alertStateCollection)
+	if err != nil {
+		return nil, err
+	}
+	states := map[string]*alertState{}
+	for _, id := range ids {
+		var state alertState
+		if err := s.Get(alertStateCollection, id, &state); err != nil {
+			return nil, err
+		}
+		states[id] = &state
+	}
+	return states, nil
+}
+
+// recordAlertEvent appends the event to the history dropping the oldest events over alertHistoryMaxEvents
+func recordAlertEvent(s *store.Store, event *models.AlertEvent, now time.Time) error {
+	// ids sort in chronological order
+	event.ID = fmt.Sprintf("%020d-%s", now.UnixNano(), uuid.NewString()[:8])
+	event.Timestamp = now.UTC().Format(time.RFC3339)
+	if err := s.Put(alertHistoryCollection, event.ID, event); err != nil {
+		return err
+	}
+	ids, err := s.List(alertHistoryCollection)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(ids)-alertHistoryMaxEvents; i++ {
+		if err := s.Delete(alertHistoryCollection, ids[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listAlertHistory returns the newest events first, optionally filtered by rule and status
+func listAlertHistory(s *store.Store, ruleID, status string, limit int) ([]*models.AlertEvent, error) {
+	ids, err := s.List(alertHistoryCollection)
+	if err != nil {
+		return nil, err
+	}
+	events := []*models.AlertEvent{}
+	for i := len(ids) - 1; i >= 0 && (limit <= 0 || len(events) < limit); i-- {
+		var event models.AlertEvent
+		if err := s.Get(alertHistoryCollection, ids[i], &event); err != nil {
+			return nil, err
+		}
+		if (ruleID != "" && event.RuleID != ruleID) || (status != "" && event.Status != status) {
+			continue
+		}
+		events = append(events, &event)
+	}
+	return events, nil
+}
+
+// checkAlertRule returns the conditions currently matching the rule
+func checkAlertRule(ctx context.Context, client MinioAdmin, rule *models.AlertRule, now time.Time) ([]alertCondition, error) {
+	switch *rule.Type {
+	case models.AlertRuleTypeDrivesOffline:
+		return checkDrivesOffline(ctx, client, rule.Threshold)
+	case models.AlertRuleTypeBucketUsage:
+		threshold := rule.Threshold
+		if threshold == 0 {
+			threshold = 90
+		}
+		return checkBucketUsage(ctx, client, rule.Bucket, threshold)
+	case models.AlertRuleTypeReplicationBacklog:
+		threshold := rule.Threshold
+		if threshold == 0 {
+			threshold = 1000
+		}
+		return checkReplicationBacklog(ctx, client, threshold)
+	case models.AlertRuleTypeKmsStatus:
+		return checkKMSStatus(ctx, client)
+	case models.AlertRuleTypeTierVerification:
+		return checkTierVerification(ctx, client)
+	case models.AlertRuleTypeCertificateExpiry:
+		threshold := rule.Threshold
+		if threshold == 0 {
+			threshold = 30
+		}
+		return checkCertificateExpiry(ctx, now, threshold)
+	}
+	return nil, fmt.Errorf("unknown rule type %s", *rule.Type)
+}
+
+// checkDrivesOffline reports the servers that are offline or have more offline drives than allowed
+func checkDrivesOffline(ctx context.Context, client MinioAdmin, allowed float64) ([]alertCondition, error) {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var conditions []alertCondition
+	for _, server := range info.Servers {
+		if server.State != string(madmin.ItemOnline) {
+			conditions = append(conditions, alertCondition{
+				Subject: server.Endpoint,
+				Message: fmt.Sprintf("server %s is %s", server.Endpoint, server.State),
+			})
+			continue
+		}
+		offline := 0
+		for _, disk := range server.Disks {
+			if disk.State != madmin.DriveStateOk {
+				offline++
+			}
+		}
+		if float64(offline) > allowed {
+			conditions = append(conditions, alertCondition{
+				Subject: server.Endpoint,
+				Message: fmt.Sprintf("%d of %d drives are offline on %s", offline, len(server.Disks), server.Endpoint),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+// checkBucketUsage reports the buckets using more than the threshold percentage of their quota
+func checkBucketUsage(ctx context.Context, client MinioAdmin, bucket string, threshold float64) ([]alertCondition, error) {
+	info, err := client.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var conditions []alertCondition
+	for _, b := range info.Buckets {
+		if bucket != "" && b.Name != bucket {
+			continue
+		}
+		quota, err := client.getBucketQuota(ctx, b.Name)
+		if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchQuotaConfiguration" {
+			// buckets without a quota can't run out of it
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		limit := quota.Size
+		if limit == 0 {
+			limit = quota.Quota
+		}
+		if limit == 0 {
+			continue
+		}
+		usage := float64(b.Size) * 100 / float64(limit)
+		if usage >= threshold {
+			conditions = append(conditions, alertCondition{
+				Subject: b.Name,
+				Message: fmt.Sprintf("bucket %s is using %.1f%% of its quota", b.Name, usage),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+// checkReplicationBacklog reports when the site replication queue has more objects than the threshold
+func checkReplicationBacklog(ctx context.Context, client MinioAdmin, threshold float64) ([]alertCondition, error) {
+	status, err := client.getSiteReplicationStatus(ctx, madmin.SRStatusOptions{Metrics: true})
+	if err != nil {
+		return nil, err
+	}
+	if status == nil || !status.Enabled {
+		return nil, nil
+	}
+	queued := status.Metrics.Queued.Curr
+	if queued.Count < threshold {
+		return nil, nil
+	}
+	return []alertCondition{{
+		Subject: "site-replication",
+		Message: fmt.Sprintf("%.0f objects (%.0f bytes) are waiting to be replicated", queued.Count, queued.Bytes),
+	}}, nil
+}
+
+// checkKMSStatus reports an unreachable KMS or offline KMS endpoints, nothing is reported when MinIO
+// has no KMS configured
+func checkKMSStatus(ctx context.Context, client MinioAdmin) ([]alertCondition, error) {
+	status, err := client.kmsStatus(ctx)
+	if isKMSNotConfigured(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return []alertCondition{{Subject: "kms", Message: fmt.Sprintf("unable to get KMS status: %v", err)}}, nil
+	}
+	var conditions []alertCondition
+	for endpoint, state := range status.Endpoints {
+		if state != madmin.ItemOnline {
+			conditions = append(conditions, alertCondition{
+				Subject: endpoint,
+				Message: fmt.Sprintf("KMS endpoint %s is %s", endpoint, state),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+// isKMSNotConfigured tells whether the error is MinIO refusing the request because no KMS is configured
+func isKMSNotConfigured(err error) bool {
+	resp := madmin.ToErrorResponse(err)
+	return resp.Code == "NotImplemented" || (resp.Code != "" && strings.Contains(resp.Message, "KMS is not configured"))
+}
+
+// checkTierVerification reports the remote tiers that fail verification
+func checkTierVerification(ctx context.Context, client MinioAdmin) ([]alertCondition, error) {
+	tiers, err := client.listTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var conditions []alertCondition
+	for _, tier := range tiers {
+		if err := client.verifyTierStatus(ctx, tier.Name); err != nil {
+			conditions = append(conditions, alertCondition{
+				Subject: tier.Name,
+				Message: fmt.Sprintf("tier %s failed verification: %v", tier.Name, err),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+// checkCertificateExpiry reports the certificates known to Console expiring within the threshold days
+func checkCertificateExpiry(ctx context.Context, now time.Time, days float64) ([]alertCondition, error) {
+	certificates, err := listCertificates(ctx, now, int64(math.Ceil(days)))
+	if err != nil {
+		return nil, err
+	}
+	var conditions []alertCondition
+	for _, info := range certificates.Certificates {
+		subject := fmt.Sprintf("%s/%s", info.Source, info.SerialNumber)
+		switch info.Status {
+		case models.CertificateInfoStatusExpired:
+			conditions = append(conditions, alertCondition{
+				Subject: subject,
+				Message: fmt.Sprintf("%s certificate %s expired on %s", info.Source, info.Subject, info.NotAfter),
+			})
+		case models.CertificateInfoStatusExpiring:
+			conditions = append(conditions, alertCondition{
+				Subject: subject,
+				Message: fmt.Sprintf("%s certificate %s expires in %d days", info.Source, info.Subject, info.DaysRemaining),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+// evaluateAlertRules checks every enabled rule, records the alerts that started firing or got resolved and
+// notifies the rule channels. Alerts still firing are notified again once the rule repeat interval elapses
+// since they were last delivered, silenced alerts as soon as the silence ends.
+func evaluateAlertRules(ctx context.Context, client MinioAdmin, s *store.Store, now time.Time) ([]*models.AlertEvent, error) {
+	alertsEvaluationMu.Lock()
+	defer alertsEvaluationMu.Unlock()
+
+	rules, err := listAlertRules(s)
+	if err != nil {
+		return nil, err
+	}
+	silences, err := listAlertSilences(s, now)
+	if err != nil {
+		return nil, err
+	}
+	states, err := listAlertStates(s)
+	if err != nil {
+		return nil, err
+	}
+	ruleIDs := map[string]bool{}
+	events := []*models.AlertEvent{}
+	for _, rule := range rules {
+		ruleIDs[rule.ID] = true
+		if !rule.Enabled {
+			// disabled rules don't keep firing alerts
+			delete(ruleIDs, rule.ID)
+			continue
+		}
+		conditions, err := checkAlertRule(ctx, client, rule, now)
+		if err != nil {
+			// keep the current state of the rule alerts until the rule can be checked again
+			logError("unable to evaluate alert rule %s: %v", *rule.Name, err)
+			for fingerprint, state := range states {
+				if state.RuleID == rule.ID {
+					delete(states, fingerprint)
+				}
+			}
+			continue
+		}
+		repeatInterval := alertDefaultRepeatInterval
+		if rule.RepeatInterval > 0 {
+			repeatInterval = time.Duration(rule.RepeatInterval) * time.Second
+		}
+		for _, condition := range conditions {
+			fingerprint := alertFingerprint(rule.ID, condition.Subject)
+			state, firing := states[fingerprint]
+			delete(states, fingerprint)
+			if firing && now.Sub(state.LastNotified) < repeatInterval {
+				continue
+			}
+			// a silenced alert was recorded when it started firing, it's notified once the silence ends
+			if firing && isAlertSilenced(silences, rule.ID, condition.Subject) {
+				continue
+			}
+			if !firing {
+				state = &alertState{RuleID: rule.ID, Subject: condition.Subject, FiringSince: now}
+			}
+			state.Message = condition.Message
+			event := newAlertEvent(rule, condition, models.AlertEventStatusFiring)
+			if err := dispatchAlertEvent(ctx, s, rule, event, silences, now); err != nil {
+				return events, err
+			}
+			events = append(events, event)
+			if !event.Silenced && (len(event.Notified) > 0 || len(rule.Channels) == 0) {
+				state.LastNotified = now
+			}
+			if err := s.Put(alertStateCollection, fingerprint, state); err != nil {
+				return events, err
+			}
+		}
+		// whatever is left for this rule is no longer firing
+		for fingerprint, state := range states {
+			if state.RuleID != rule.ID {
+				continue
+			}
+			delete(states, fingerprint)
+			event := newAlertEvent(rule, alertCondition{Subject: state.Subject, Message: state.Message}, models.AlertEventStatusResolved)
+			if err := dispatchAlertEvent(ctx, s, rule, event, silences, now); err != nil {
+				return events, err
+			}
+			events = append(events, event)
+			if err := s.Delete(alertStateCollection, fingerprint); err != nil {
+				return events, err
+			}
+		}
+	}
+	// drop state left behind by rules that no longer exist or were disabled
+	for fingerprint, state := range states {
+		if !ruleIDs[state.RuleID] {
+			if err := s.Delete(alertStateCollection, fingerprint); err != nil {
+				return events, err
+			}
+		}
+	}
+	return events, nil
+}
+
+func newAlertEvent(rule *models.AlertRule, condition alertCondition, status string) *models.AlertEvent {
+	event := &models.AlertEvent{
+		RuleID:   rule.ID,
+		RuleName: *rule.Name,
+		Type:     *rule.Type,
+		Subject:  condition.Subject,
+		Message:  condition.Message,
+		Status:   status,
+	}
+	if rule.Severity != nil {
+		event.Severity = *rule.Severity
+	}
+	return event
+}
+
+// dispatchAlertEvent notifies the rule channels unless the alert is silenced and records the event
+func dispatchAlertEvent(ctx context.Context, s *store.Store, rule *models.AlertRule, event *models.AlertEvent, silences []*models.AlertSilence, now time.Time) error {
+	event.Silenced = isAlertSilenced(silences, rule.ID, event.Subject)
+	if !event.Silenced {
+		for _, channelID := range rule.Channels {
+			channel, err := getAlertChannel(s, channelID)
+			if err != nil {
+				event.NotificationErrors = append(event.NotificationErrors, fmt.Sprintf("%s: %v", channelID, err))
+				continue
+			}
+			if err := sendAlertNotification(ctx, channel, event); err != nil {
+				event.NotificationErrors = append(event.NotificationErrors, fmt.Sprintf("%s: %v", *channel.Name, err))
+				continue
+			}
+			event.Notified = append(event.Notified, *channel.Name)
+		}
+	}
+	return recordAlertEvent(s, event, now)
+}
+
+// startAlertsEvaluator evaluates the alert rules periodically when background credentials are configured
+func startAlertsEvaluator() {
+	accessKey, secretKey := getAlertsCredentials()
+	if accessKey == "" || secretKey == "" {
+		return
+	}
+	interval := getAlertsInterval()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+			if err != nil {
+				logError("unable to create admin client to evaluate alert rules: %v", err)
+				continue
+			}
+			mAdmin.SetCustomTransport(GetConsoleHTTPClient(getMinIOServer(), LocalAddress).Transport)
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			if _, err := evaluateAlertRules(ctx, AdminClient{Client: mAdmin}, getConsoleStore(), time.Now()); err != nil {
+				logError("unable to evaluate alert rules: %v", err)
+			}
+			cancel()
+		}
+	}()
+}
+
+func getListAlertRulesResponse(params alertsApi.ListAlertRulesParams) (*models.AlertRuleListResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	rules, err := listAlertRules(getConsoleStore())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.AlertRuleListResponse{Rules: rules}, nil
+}
+
+func getCreateAlertRuleResponse(params alertsApi.CreateAlertRuleParams) (*models.AlertRule, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	s := getConsoleStore()
+	if err := validateAlertRule(s, params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	rule, err := saveAlertRule(s, uuid.NewString(), params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getGetAlertRuleResponse(params alertsApi.GetAlertRuleParams) (*models.AlertRule, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	rule, err := getAlertRule(getConsoleStore(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getUpdateAlertRuleResponse(params alertsApi.UpdateAlertRuleParams) (*models.AlertRule, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	s := getConsoleStore()
+	if _, err := getAlertRule(s, params.ID); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err := validateAlertRule(s, params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	rule, err := saveAlertRule(s, params.ID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getDeleteAlertRuleResponse(params alertsApi.DeleteAlertRuleParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := deleteAlertRule(getConsoleStore(), params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getListAlertChannelsResponse(params alertsApi.ListAlertChannelsParams) (*models.AlertChannelListResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	channels, err := listAlertChannels(getConsoleStore())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.AlertChannelListResponse{Channels: channels}, nil
+}
+
+func getCreateAlertChannelResponse(params alertsApi.CreateAlertChannelParams) (*models.AlertChannel, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateAlertChannel(params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	channel, err := saveAlertChannel(getConsoleStore(), uuid.NewString(), params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return channel, nil
+}
+
+func getUpdateAlertChannelResponse(params alertsApi.UpdateAlertChannelParams) (*models.AlertChannel, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	s := getConsoleStore()
+	if _, err := getAlertChannel(s, params.ID); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err := validateAlertChannel(params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	channel, err := saveAlertChannel(s, params.ID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return channel, nil
+}
+
+func getDeleteAlertChannelResponse(params alertsApi.DeleteAlertChannelParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := deleteAlertChannel(getConsoleStore(), params.ID); err != nil {
+		if errors.Is(err, errAlertChannelInUse) {
+			return ErrorWithContext(ctx, ErrBadRequest, err)
+		}
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getTestAlertChannelResponse(params alertsApi.TestAlertChannelParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	channel, err := getAlertChannel(getConsoleStore(), params.ID)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	event := &models.AlertEvent{
+		RuleName:  "Test notification",
+		Severity:  models.AlertRuleSeverityInfo,
+		Subject:   *channel.Name,
+		Message:   "This is a test notification sent from MinIO Console",
+		Status:    models.AlertEventStatusFiring,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := sendAlertNotification(ctx, channel, event); err != nil {
+		return ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	return nil
+}
+
+func getListAlertSilencesResponse(params alertsApi.ListAlertSilencesParams) (*models.AlertSilenceListResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	silences, err := listAlertSilences(getConsoleStore(), time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.AlertSilenceListResponse{Silences: silences}, nil
+}
+
+func getCreateAlertSilenceResponse(session *models.Principal, params alertsApi.CreateAlertSilenceParams) (*models.AlertSilence, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body.Duration == nil || *params.Body.Duration <= 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("silence duration must be greater than zero"))
+	}
+	silence, err := createAlertSilence(getConsoleStore(), session.AccountAccessKey, params.Body, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return silence, nil
+}
+
+func getDeleteAlertSilenceResponse(params alertsApi.DeleteAlertSilenceParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := deleteAlertSilence(getConsoleStore(), params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getListAlertHistoryResponse(params alertsApi.ListAlertHistoryParams) (*models.AlertHistoryResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	var ruleID, status string
+	if params.RuleID != nil {
+		ruleID = *params.RuleID
+	}
+	if params.Status != nil {
+		status = *params.Status
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	events, err := listAlertHistory(getConsoleStore(), ruleID, status, limit)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.AlertHistoryResponse{Events: events}, nil
+}
+
+func getEvaluateAlertRulesResponse(session *models.Principal, params alertsApi.EvaluateAlertRulesParams) (*models.AlertHistoryResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	events, err := evaluateAlertRules(ctx, adminClient, getConsoleStore(), time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.AlertHistoryResponse{Events: events}, nil
+}
@@ -0,0 +1,147 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/minio/console/models"
+)
+
+// alertNotificationTimeout bounds the time spent delivering a single notification
+const alertNotificationTimeout = 10 * time.Second
+
+// smtpSendMail is replaced in tests
+var smtpSendMail = smtp.SendMail
+
+// errAlertPrivateAddress is returned when a notification would be sent to an internal address
+var errAlertPrivateAddress = errors.New("notifications to loopback, link-local or private addresses are not allowed")
+
+// isPrivateAlertAddress tells whether the address can't receive notifications unless the operator allows it
+func isPrivateAlertAddress(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsPrivate() || ip.IsUnspecified()
+}
+
+// alertHTTPClient returns the client delivering notifications, unless the operator allows private webhooks
+// the address is checked when connecting, after the name is resolved and on every redirect, and proxies
+// aren't used
+func alertHTTPClient() *http.Client {
+	client := GetConsoleHTTPClient("", LocalAddress)
+	if getAlertsAllowPrivateWebhooks() {
+		return client
+	}
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 15 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateAlertAddress(ip) {
+				return errAlertPrivateAddress
+			}
+			return nil
+		},
+	}
+	if transport, ok := client.Transport.(*ConsoleTransport); ok {
+		transport.Transport.DialContext = dialer.DialContext
+		// through a proxy only the address of the proxy would be checked
+		transport.Transport.Proxy = nil
+	}
+	return client
+}
+
+// alertEventTitle returns a one line summary of the event
+func alertEventTitle(event *models.AlertEvent) string {
+	return fmt.Sprintf("[%s] %s (%s): %s", strings.ToUpper(event.Status), event.RuleName, event.Severity, event.Subject)
+}
+
+// sendAlertNotification delivers the event through the channel
+func sendAlertNotification(ctx context.Context, channel *models.AlertChannel, event *models.AlertEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, alertNotificationTimeout)
+	defer cancel()
+	switch *channel.Type {
+	case models.AlertChannelTypeWebhook:
+		return postAlertNotification(ctx, channel.URL, channel.AuthToken, event)
+	case models.AlertChannelTypeSlack:
+		payload := map[string]string{
+			"text": fmt.Sprintf("*%s*\n%s", alertEventTitle(event), event.Message),
+		}
+		return postAlertNotification(ctx, channel.URL, channel.AuthToken, payload)
+	case models.AlertChannelTypeSMTP:
+		return mailAlertNotification(channel, event)
+	}
+	return fmt.Errorf("unknown channel type %s", *channel.Type)
+}
+
+// postAlertNotification sends the payload as JSON to the url
+func postAlertNotification(ctx context.Context, url, authToken string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authToken != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
+	}
+	resp, err := alertHTTPClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
+
+// mailAlertNotification sends the event as a plain text email
+func mailAlertNotification(channel *models.AlertChannel, event *models.AlertEvent) error {
+	var auth smtp.Auth
+	if channel.SMTPUsername != "" {
+		host, _, err := net.SplitHostPort(channel.SMTPServer)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", channel.SMTPUsername, channel.SMTPPassword, host)
+	}
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", channel.SMTPFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(channel.SMTPTo, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", alertEventTitle(event))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n\r\nRule: %s\r\nSeverity: %s\r\nStatus: %s\r\nTime: %s\r\n",
+		event.Message, event.RuleName, event.Severity, event.Status, event.Timestamp)
+	return smtpSendMail(channel.SMTPServer, auth, channel.SMTPFrom, channel.SMTPTo, msg.Bytes())
+}
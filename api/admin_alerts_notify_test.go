@@ -0,0 +1,122 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAlertEvent() *models.AlertEvent {
+	return &models.AlertEvent{
+		RuleName: "Drives offline",
+		Severity: "critical",
+		Subject:  "node1:9000",
+		Message:  "1 of 4 drives are offline on node1:9000",
+		Status:   models.AlertEventStatusFiring,
+	}
+}
+
+func TestSendSlackNotification(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleAlertsAllowPrivateWebhooks, "on")
+	var payload map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer server.Close()
+
+	channel := &models.AlertChannel{Name: swag.String("slack"), Type: swag.String(models.AlertChannelTypeSlack), URL: server.URL}
+	assert.Nil(sendAlertNotification(context.Background(), channel, testAlertEvent()))
+	assert.Contains(payload["text"], "[FIRING] Drives offline (critical): node1:9000")
+}
+
+func TestSendWebhookNotificationError(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleAlertsAllowPrivateWebhooks, "on")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	channel := &models.AlertChannel{Name: swag.String("hook"), Type: swag.String(models.AlertChannelTypeWebhook), URL: server.URL}
+	assert.NotNil(sendAlertNotification(context.Background(), channel, testAlertEvent()))
+}
+
+func TestSendNotificationPrivateAddress(t *testing.T) {
+	assert := assert.New(t)
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	channel := &models.AlertChannel{Name: swag.String("hook"), Type: swag.String(models.AlertChannelTypeWebhook), URL: server.URL}
+	err := sendAlertNotification(context.Background(), channel, testAlertEvent())
+	assert.ErrorIs(err, errAlertPrivateAddress)
+	assert.False(called)
+
+	// notifications are sent directly so the proxy can't reach internal addresses for console
+	if transport, ok := alertHTTPClient().Transport.(*ConsoleTransport); assert.True(ok) {
+		assert.Nil(transport.Transport.Proxy)
+	}
+
+	// operators can allow a receiver running next to console
+	t.Setenv(ConsoleAlertsAllowPrivateWebhooks, "on")
+	assert.Nil(sendAlertNotification(context.Background(), channel, testAlertEvent()))
+	assert.True(called)
+	if transport, ok := alertHTTPClient().Transport.(*ConsoleTransport); assert.True(ok) {
+		assert.NotNil(transport.Transport.Proxy)
+	}
+}
+
+func TestSendSMTPNotification(t *testing.T) {
+	assert := assert.New(t)
+	previous := smtpSendMail
+	defer func() { smtpSendMail = previous }()
+
+	var sentTo []string
+	var sentMsg string
+	smtpSendMail = func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+		assert.Equal("mail.example.com:587", addr)
+		assert.NotNil(auth)
+		assert.Equal("console@example.com", from)
+		sentTo = to
+		sentMsg = string(msg)
+		return nil
+	}
+	channel := &models.AlertChannel{
+		Name:         swag.String("mail"),
+		Type:         swag.String(models.AlertChannelTypeSMTP),
+		SMTPServer:   "mail.example.com:587",
+		SMTPUsername: "console",
+		SMTPPassword: "secret",
+		SMTPFrom:     "console@example.com",
+		SMTPTo:       []string{"ops@example.com"},
+	}
+	assert.Nil(sendAlertNotification(context.Background(), channel, testAlertEvent()))
+	assert.Equal([]string{"ops@example.com"}, sentTo)
+	assert.Contains(sentMsg, "Subject: [FIRING] Drives offline (critical): node1:9000")
+}
@@ -0,0 +1,338 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/store"
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func serverInfoWithOfflineDrives(offline int) madmin.InfoMessage {
+	disks := []madmin.Disk{{State: madmin.DriveStateOk}, {State: madmin.DriveStateOk}}
+	for i := 0; i < offline; i++ {
+		disks[i].State = madmin.DriveStateOffline
+	}
+	return madmin.InfoMessage{
+		Servers: []madmin.ServerProperties{
+			{Endpoint: "node1:9000", State: string(madmin.ItemOnline), Disks: disks},
+		},
+	}
+}
+
+func TestValidateAlertRule(t *testing.T) {
+	assert := assert.New(t)
+	s := store.New(t.TempDir())
+
+	rule := &models.AlertRule{Name: swag.String("drives"), Type: swag.String(models.AlertRuleTypeDrivesOffline)}
+	assert.Nil(validateAlertRule(s, rule))
+
+	rule.Channels = []string{"missing"}
+	assert.NotNil(validateAlertRule(s, rule))
+
+	rule = &models.AlertRule{Name: swag.String("usage"), Type: swag.String(models.AlertRuleTypeBucketUsage), Threshold: 120}
+	assert.NotNil(validateAlertRule(s, rule))
+
+	assert.NotNil(validateAlertRule(s, &models.AlertRule{Name: swag.String(" "), Type: swag.String(models.AlertRuleTypeKmsStatus)}))
+}
+
+func TestValidateAlertChannel(t *testing.T) {
+	assert := assert.New(t)
+	webhook := swag.String(models.AlertChannelTypeWebhook)
+	smtpType := swag.String(models.AlertChannelTypeSMTP)
+
+	assert.Nil(validateAlertChannel(&models.AlertChannel{Name: swag.String("hook"), Type: webhook, URL: "https://example.com/alerts"}))
+	assert.NotNil(validateAlertChannel(&models.AlertChannel{Name: swag.String("hook"), Type: webhook, URL: "ftp://example.com"}))
+	for _, internal := range []string{"http://127.0.0.1:8080/hook", "http://localhost/hook", "http://169.254.169.254/latest", "http://10.0.0.5/hook", "http://[::1]/hook"} {
+		assert.ErrorIs(validateAlertChannel(&models.AlertChannel{Name: swag.String("hook"), Type: webhook, URL: internal}), errAlertPrivateAddress, internal)
+	}
+	assert.NotNil(validateAlertChannel(&models.AlertChannel{Name: swag.String("mail"), Type: smtpType, SMTPServer: "mail:25"}))
+	assert.Nil(validateAlertChannel(&models.AlertChannel{
+		Name: swag.String("mail"), Type: smtpType, SMTPServer: "mail:25", SMTPFrom: "console@example.com", SMTPTo: []string{"ops@example.com"},
+	}))
+}
+
+func TestAlertChannelCredentials(t *testing.T) {
+	assert := assert.New(t)
+	s := store.New(t.TempDir())
+
+	channel, err := saveAlertChannel(s, "hook", &models.AlertChannel{
+		Name: swag.String("hook"), Type: swag.String(models.AlertChannelTypeWebhook), URL: "https://example.com", AuthToken: "secret",
+	})
+	assert.Nil(err)
+	assert.Empty(channel.AuthToken)
+
+	// updating without a token keeps the stored one
+	_, err = saveAlertChannel(s, "hook", &models.AlertChannel{
+		Name: swag.String("renamed"), Type: swag.String(models.AlertChannelTypeWebhook), URL: "https://example.com",
+	})
+	assert.Nil(err)
+	stored, err := getAlertChannel(s, "hook")
+	assert.Nil(err)
+	assert.Equal("secret", stored.AuthToken)
+	assert.Equal("renamed", *stored.Name)
+
+	channels, err := listAlertChannels(s)
+	assert.Nil(err)
+	assert.Len(channels, 1)
+	assert.Empty(channels[0].AuthToken)
+
+	// channels used by a rule can't be deleted
+	_, err = saveAlertRule(s, "rule", &models.AlertRule{
+		Name: swag.String("drives"), Type: swag.String(models.AlertRuleTypeDrivesOffline), Channels: []string{"hook"},
+	})
+	assert.Nil(err)
+	assert.ErrorIs(deleteAlertChannel(s, "hook"), errAlertChannelInUse)
+	assert.Nil(deleteAlertRule(s, "rule"))
+	assert.Nil(deleteAlertChannel(s, "hook"))
+	assert.ErrorIs(deleteAlertChannel(s, "hook"), ErrNotFound)
+}
+
+func TestEvaluateAlertRules(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleAlertsAllowPrivateWebhooks, "on")
+	s := store.New(t.TempDir())
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	var mu sync.Mutex
+	var received []models.AlertEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event models.AlertEvent
+		assert.Nil(json.NewDecoder(r.Body).Decode(&event))
+		assert.Equal("Bearer token", r.Header.Get("Authorization"))
+		mu.Lock()
+		received = append(received, event)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	_, err := saveAlertChannel(s, "hook", &models.AlertChannel{
+		Name: swag.String("hook"), Type: swag.String(models.AlertChannelTypeWebhook), URL: server.URL, AuthToken: "token",
+	})
+	assert.Nil(err)
+	_, err = saveAlertRule(s, "drives", &models.AlertRule{
+		Name:           swag.String("Drives offline"),
+		Type:           swag.String(models.AlertRuleTypeDrivesOffline),
+		Enabled:        true,
+		Channels:       []string{"hook"},
+		RepeatInterval: 3600,
+	})
+	assert.Nil(err)
+
+	offline := 1
+	MinioServerInfoMock = func(_ context.Context) (madmin.InfoMessage, error) {
+		return serverInfoWithOfflineDrives(offline), nil
+	}
+
+	now := time.Now()
+	events, err := evaluateAlertRules(ctx, adminClient, s, now)
+	assert.Nil(err)
+	assert.Len(events, 1)
+	assert.Equal(models.AlertEventStatusFiring, events[0].Status)
+	assert.Equal("node1:9000", events[0].Subject)
+	assert.Equal("warning", events[0].Severity)
+	assert.Equal([]string{"hook"}, events[0].Notified)
+	assert.Len(received, 1)
+
+	// still firing within the repeat interval, nothing new is sent
+	events, err = evaluateAlertRules(ctx, adminClient, s, now.Add(time.Minute))
+	assert.Nil(err)
+	assert.Empty(events)
+	assert.Len(received, 1)
+
+	// once the repeat interval elapses the alert is notified again
+	events, err = evaluateAlertRules(ctx, adminClient, s, now.Add(2*time.Hour))
+	assert.Nil(err)
+	assert.Len(events, 1)
+	assert.Len(received, 2)
+
+	// silenced alerts are recorded but not notified
+	_, err = createAlertSilence(s, "admin", &models.AlertSilenceRequest{RuleID: "drives", Duration: swag.Int64(7200)}, now.Add(2*time.Hour))
+	assert.Nil(err)
+	offline = 0
+	events, err = evaluateAlertRules(ctx, adminClient, s, now.Add(3*time.Hour))
+	assert.Nil(err)
+	assert.Len(events, 1)
+	assert.Equal(models.AlertEventStatusResolved, events[0].Status)
+	assert.True(events[0].Silenced)
+	assert.Empty(events[0].Notified)
+	assert.Len(received, 2)
+
+	history, err := listAlertHistory(s, "", "", 0)
+	assert.Nil(err)
+	assert.Len(history, 3)
+	assert.Equal(models.AlertEventStatusResolved, history[0].Status)
+	history, err = listAlertHistory(s, "drives", models.AlertEventStatusFiring, 1)
+	assert.Nil(err)
+	assert.Len(history, 1)
+
+	// expired silences are dropped
+	silences, err := listAlertSilences(s, now.Add(4*time.Hour))
+	assert.Nil(err)
+	assert.Empty(silences)
+}
+
+func TestEvaluateAlertRulesSilenceEnds(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleAlertsAllowPrivateWebhooks, "on")
+	s := store.New(t.TempDir())
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	var mu sync.Mutex
+	received := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		received++
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	_, err := saveAlertChannel(s, "hook", &models.AlertChannel{
+		Name: swag.String("hook"), Type: swag.String(models.AlertChannelTypeWebhook), URL: server.URL,
+	})
+	assert.Nil(err)
+	_, err = saveAlertRule(s, "drives", &models.AlertRule{
+		Name:           swag.String("Drives offline"),
+		Type:           swag.String(models.AlertRuleTypeDrivesOffline),
+		Enabled:        true,
+		Channels:       []string{"hook"},
+		RepeatInterval: 86400,
+	})
+	assert.Nil(err)
+	MinioServerInfoMock = func(_ context.Context) (madmin.InfoMessage, error) {
+		return serverInfoWithOfflineDrives(1), nil
+	}
+
+	now := time.Now()
+	_, err = createAlertSilence(s, "admin", &models.AlertSilenceRequest{RuleID: "drives", Duration: swag.Int64(3600)}, now)
+	assert.Nil(err)
+	events, err := evaluateAlertRules(ctx, adminClient, s, now)
+	assert.Nil(err)
+	if assert.Len(events, 1) {
+		assert.True(events[0].Silenced)
+	}
+
+	// while silenced the firing alert isn't recorded again
+	events, err = evaluateAlertRules(ctx, adminClient, s, now.Add(30*time.Minute))
+	assert.Nil(err)
+	assert.Empty(events)
+
+	// the alert is notified when the silence ends rather than after the repeat interval
+	events, err = evaluateAlertRules(ctx, adminClient, s, now.Add(2*time.Hour))
+	assert.Nil(err)
+	if assert.Len(events, 1) {
+		assert.False(events[0].Silenced)
+		assert.Equal([]string{"hook"}, events[0].Notified)
+	}
+	events, err = evaluateAlertRules(ctx, adminClient, s, now.Add(3*time.Hour))
+	assert.Nil(err)
+	assert.Empty(events)
+	assert.Equal(1, received)
+}
+
+func TestCheckBucketUsage(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+			{Name: "full", Size: 95},
+			{Name: "empty", Size: 5},
+			{Name: "unlimited", Size: 1000},
+			{Name: "noquota", Size: 1000},
+		}}, nil
+	}
+	minioGetBucketQuotaMock = func(_ context.Context, bucket string) (madmin.BucketQuota, error) {
+		switch bucket {
+		case "unlimited":
+			return madmin.BucketQuota{}, nil
+		case "noquota":
+			return madmin.BucketQuota{}, madmin.ErrorResponse{Code: "XMinioAdminNoSuchQuotaConfiguration", Message: "The quota configuration does not exist"}
+		}
+		return madmin.BucketQuota{Size: 100, Type: madmin.HardQuota}, nil
+	}
+
+	conditions, err := checkBucketUsage(ctx, adminClient, "", 90)
+	assert.Nil(err)
+	assert.Len(conditions, 1)
+	assert.Equal("full", conditions[0].Subject)
+
+	conditions, err = checkBucketUsage(ctx, adminClient, "empty", 90)
+	assert.Nil(err)
+	assert.Empty(conditions)
+
+	// other errors still fail the rule
+	minioGetBucketQuotaMock = func(_ context.Context, _ string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{}, errors.New("connection refused")
+	}
+	_, err = checkBucketUsage(ctx, adminClient, "", 90)
+	assert.NotNil(err)
+}
+
+// kmsStatusClient returns the provided KMS status
+type kmsStatusClient struct {
+	AdminClientMock
+	err error
+}
+
+func (ac kmsStatusClient) kmsStatus(_ context.Context) (madmin.KMSStatus, error) {
+	return madmin.KMSStatus{}, ac.err
+}
+
+func TestCheckKMSStatus(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	conditions, err := checkKMSStatus(ctx, kmsStatusClient{err: madmin.ErrorResponse{Code: "NotImplemented", Message: "Server side encryption specified but KMS is not configured"}})
+	assert.Nil(err)
+	assert.Empty(conditions)
+
+	conditions, err = checkKMSStatus(ctx, kmsStatusClient{err: errors.New("connection refused")})
+	assert.Nil(err)
+	assert.Len(conditions, 1)
+	assert.Equal("kms", conditions[0].Subject)
+}
+
+func TestCheckCertificateExpiry(t *testing.T) {
+	assert := assert.New(t)
+	dir := setTestCertsDir(t)
+	now := time.Now()
+	writeTestCertificate(t, dir, "soon.local", now.Add(24*time.Hour))
+	writeTestCertificate(t, filepath.Join(dir, "later.local"), "later.local", now.Add(90*24*time.Hour))
+
+	conditions, err := checkCertificateExpiry(context.Background(), now, 30)
+	assert.Nil(err)
+	assert.Len(conditions, 1)
+	assert.Contains(conditions[0].Message, "CN=soon.local")
+}
@@ -0,0 +1,226 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	systemApi "github.com/minio/console/api/operations/system"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/certs"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
+)
+
+const (
+	// certificateExpiryCheckInterval is how often certificates are checked for expiration
+	certificateExpiryCheckInterval = 24 * time.Hour
+	// minioEndpointDialTimeout bounds the TLS handshake used to fetch the MinIO certificate chain
+	minioEndpointDialTimeout = 5 * time.Second
+)
+
+func registerCertificatesHandlers(api *operations.ConsoleAPI) {
+	// list known certificates
+	api.SystemListCertificatesHandler = systemApi.ListCertificatesHandlerFunc(func(params systemApi.ListCertificatesParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ServerInfoAdminAction); err != nil {
+			return systemApi.NewListCertificatesDefault(err.Code).WithPayload(err.APIError)
+		}
+		certificatesResp, err := getListCertificatesResponse(params)
+		if err != nil {
+			return systemApi.NewListCertificatesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewListCertificatesOK().WithPayload(certificatesResp)
+	})
+}
+
+// newCertificateInfo describes the certificate and how long it remains valid
+func newCertificateInfo(source, path string, cert *x509.Certificate, now time.Time, warningDays int64) *models.CertificateInfo {
+	sans := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, email := range cert.EmailAddresses {
+		sans = append(sans, email)
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	daysRemaining := int64(math.Floor(cert.NotAfter.Sub(now).Hours() / 24))
+	status := models.CertificateInfoStatusValid
+	switch {
+	case !cert.NotAfter.After(now):
+		status = models.CertificateInfoStatusExpired
+	case daysRemaining < warningDays:
+		status = models.CertificateInfoStatusExpiring
+	}
+	return &models.CertificateInfo{
+		Source:        source,
+		Path:          path,
+		Subject:       cert.Subject.String(),
+		Issuer:        cert.Issuer.String(),
+		SerialNumber:  cert.SerialNumber.String(),
+		Sans:          sans,
+		IsCA:          cert.IsCA,
+		NotBefore:     cert.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:      cert.NotAfter.UTC().Format(time.RFC3339),
+		DaysRemaining: daysRemaining,
+		Status:        status,
+	}
+}
+
+// listLocalCertificates returns the certificates Console serves and the CAs it trusts, files are read from
+// disk on every call so certificates reloaded by the TLS certificates manager are reported as well
+func listLocalCertificates(now time.Time, warningDays int64) ([]*models.CertificateInfo, error) {
+	var infos []*models.CertificateInfo
+	publicCertFiles, err := certs.GetPublicCertFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range publicCertFiles {
+		chain, err := certs.ParsePublicCertFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range chain {
+			infos = append(infos, newCertificateInfo(models.CertificateInfoSourceConsole, file, cert, now, warningDays))
+		}
+	}
+	caFiles, err := certs.GetCACertFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range caFiles {
+		cas, err := certs.ParsePublicCertFile(file)
+		if err != nil {
+			// the CAs directory may contain files other than PEM certificates
+			continue
+		}
+		for _, cert := range cas {
+			infos = append(infos, newCertificateInfo(models.CertificateInfoSourceCa, file, cert, now, warningDays))
+		}
+	}
+	return infos, nil
+}
+
+// getMinIOEndpointCertificates returns the certificate chain served by the MinIO endpoint, the chain is
+// fetched even if it can't be verified, verification errors are returned along with the chain
+func getMinIOEndpointCertificates(ctx context.Context) ([]*x509.Certificate, error) {
+	if !getMinIOEndpointIsSecure() {
+		return nil, nil
+	}
+	address := getMinIOEndpoint()
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+		address = net.JoinHostPort(address, "443")
+	}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: minioEndpointDialTimeout},
+		Config: &tls.Config{
+			ServerName: host,
+			// nolint:gosec // the chain is verified below, we want to report it even when it isn't trusted
+			InsecureSkipVerify: true,
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	chain := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("%s didn't present any certificate", address)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Roots:         GlobalRootCAs,
+		Intermediates: intermediates,
+	})
+	return chain, err
+}
+
+// listCertificates returns every certificate Console knows about, failing to fetch the MinIO chain
+// doesn't prevent the local certificates from being listed
+func listCertificates(ctx context.Context, now time.Time, warningDays int64) (*models.CertificatesResponse, error) {
+	infos, err := listLocalCertificates(now, warningDays)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.CertificatesResponse{WarningDays: warningDays}
+	chain, err := getMinIOEndpointCertificates(ctx)
+	if err != nil {
+		resp.MinioEndpointError = err.Error()
+	}
+	for _, cert := range chain {
+		infos = append(infos, newCertificateInfo(models.CertificateInfoSourceMinio, getMinIOServer(), cert, now, warningDays))
+	}
+	resp.Certificates = infos
+	return resp, nil
+}
+
+// warnExpiringCertificates logs a warning for every certificate expiring within the warning days
+func warnExpiringCertificates(ctx context.Context, now time.Time) {
+	resp, err := listCertificates(ctx, now, getCertsExpiryWarningDays())
+	if err != nil {
+		LogError("unable to check certificates expiration: %v", err)
+		return
+	}
+	if resp.MinioEndpointError != "" {
+		LogError("unable to verify MinIO endpoint certificates: %s", resp.MinioEndpointError)
+	}
+	for _, info := range resp.Certificates {
+		switch info.Status {
+		case models.CertificateInfoStatusExpired:
+			LogError("WARNING: %s certificate %s (%s) expired on %s", info.Source, info.Subject, info.Path, info.NotAfter)
+		case models.CertificateInfoStatusExpiring:
+			LogError("WARNING: %s certificate %s (%s) expires in %d days", info.Source, info.Subject, info.Path, info.DaysRemaining)
+		}
+	}
+}
+
+// startCertificateExpiryWarnings checks the certificates at startup and then once a day
+func startCertificateExpiryWarnings() {
+	go func() {
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), minioEndpointDialTimeout*2)
+			warnExpiringCertificates(ctx, time.Now())
+			cancel()
+			time.Sleep(certificateExpiryCheckInterval)
+		}
+	}()
+}
+
+func getListCertificatesResponse(params systemApi.ListCertificatesParams) (*models.CertificatesResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	certificatesResp, err := listCertificates(ctx, time.Now(), getCertsExpiryWarningDays())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return certificatesResp, nil
+}
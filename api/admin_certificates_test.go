@@ -0,0 +1,168 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/certs"
+	"github.com/minio/madmin-go/v3"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestCertificate writes a self signed certificate and its key to dir
+func writeTestCertificate(t *testing.T, dir, commonName string, notAfter time.Time) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err = os.WriteFile(filepath.Join(dir, certs.PrivateKeyFile), keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, certs.PublicCertFile), certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// setTestCertsDir points the certs directories to an empty temporary directory
+func setTestCertsDir(t *testing.T) string {
+	dir := t.TempDir()
+	previousDir, previousCADir := certs.GlobalCertsDir, certs.GlobalCertsCADir
+	certs.GlobalCertsDir = &certs.ConfigDir{Path: dir}
+	certs.GlobalCertsCADir = &certs.ConfigDir{Path: filepath.Join(dir, certs.CertsCADir)}
+	t.Cleanup(func() {
+		certs.GlobalCertsDir, certs.GlobalCertsCADir = previousDir, previousCADir
+	})
+	return dir
+}
+
+func TestListLocalCertificates(t *testing.T) {
+	assert := assert.New(t)
+	dir := setTestCertsDir(t)
+	now := time.Now()
+
+	writeTestCertificate(t, dir, "console.local", now.Add(10*24*time.Hour+time.Hour))
+	writeTestCertificate(t, filepath.Join(dir, certs.CertsCADir), "ca.local", now.Add(-time.Minute))
+	// files that are not certificates are ignored
+	assert.Nil(os.WriteFile(filepath.Join(dir, certs.CertsCADir, "README"), []byte("not a certificate"), 0o600))
+
+	infos, err := listLocalCertificates(now, 30)
+	assert.Nil(err)
+	assert.Len(infos, 2)
+
+	assert.Equal(models.CertificateInfoSourceConsole, infos[0].Source)
+	assert.Equal("CN=console.local", infos[0].Subject)
+	assert.Equal([]string{"console.local", "127.0.0.1"}, infos[0].Sans)
+	assert.Equal(int64(10), infos[0].DaysRemaining)
+	assert.Equal(models.CertificateInfoStatusExpiring, infos[0].Status)
+
+	assert.Equal(models.CertificateInfoSourceCa, infos[1].Source)
+	assert.Equal(models.CertificateInfoStatusExpired, infos[1].Status)
+
+	infos, err = listLocalCertificates(now, 5)
+	assert.Nil(err)
+	assert.Equal(models.CertificateInfoStatusValid, infos[0].Status)
+
+	// certificates replaced on disk are reported without restarting
+	writeTestCertificate(t, dir, "renewed.local", now.Add(90*24*time.Hour))
+	infos, err = listLocalCertificates(now, 30)
+	assert.Nil(err)
+	assert.Equal("CN=renewed.local", infos[0].Subject)
+	assert.Equal(models.CertificateInfoStatusValid, infos[0].Status)
+}
+
+func TestGetMinIOEndpointCertificates(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+
+	t.Setenv(ConsoleMinIOServer, server.URL)
+	previousRootCAs := GlobalRootCAs
+	defer func() { GlobalRootCAs = previousRootCAs }()
+
+	// the chain is returned even when it isn't trusted
+	GlobalRootCAs = x509.NewCertPool()
+	chain, err := getMinIOEndpointCertificates(context.Background())
+	assert.NotNil(err)
+	assert.Len(chain, 1)
+
+	GlobalRootCAs.AddCert(server.Certificate())
+	chain, err = getMinIOEndpointCertificates(context.Background())
+	assert.Nil(err)
+	assert.Len(chain, 1)
+
+	t.Setenv(ConsoleMinIOServer, "http://localhost:9000")
+	chain, err = getMinIOEndpointCertificates(context.Background())
+	assert.Nil(err)
+	assert.Empty(chain)
+}
+
+func TestListCertificatesPermission(t *testing.T) {
+	ctx := context.Background()
+	session := &models.Principal{AccountAccessKey: "auditor"}
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::*"]}]}`
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{AccountName: "auditor", Policy: []byte(policy)}, nil
+	}
+	allowed, err := hasAdminPermission(ctx, AdminClientMock{}, session, minioIAMPolicy.ServerInfoAdminAction)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	// certificates are listed along with the server information
+	policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:ServerInfo"]}]}`
+	allowed, err = hasAdminPermission(ctx, AdminClientMock{}, session, minioIAMPolicy.ServerInfoAdminAction)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+}
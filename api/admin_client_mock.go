@@ -28,8 +28,9 @@ import (
 type AdminClientMock struct{}
 
 var (
-	MinioServerInfoMock     func(ctx context.Context) (madmin.InfoMessage, error)
-	minioChangePasswordMock func(ctx context.Context, accessKey, secretKey string) error
+	MinioServerInfoMock      func(ctx context.Context) (madmin.InfoMessage, error)
+	minioRealtimeMetricsMock func(ctx context.Context, opts madmin.MetricsOptions, out func(madmin.RealtimeMetrics)) error
+	minioChangePasswordMock  func(ctx context.Context, accessKey, secretKey string) error
 
 	minioHelpConfigKVMock       func(subSys, key string, envOnly bool) (madmin.Help, error)
 	minioGetConfigKVMock        func(key string) ([]byte, error)
@@ -66,10 +67,20 @@ var (
 	deleteSiteReplicationInfoMock func(ctx context.Context, removeReq madmin.SRRemoveReq) (*madmin.ReplicateRemoveStatus, error)
 	getSiteReplicationStatus      func(ctx context.Context, params madmin.SRStatusOptions) (*madmin.SRStatusInfo, error)
 
-	minioListTiersMock func(ctx context.Context) ([]*madmin.TierConfig, error)
-	minioTierStatsMock func(ctx context.Context) ([]madmin.TierInfo, error)
-	minioAddTiersMock  func(ctx context.Context, tier *madmin.TierConfig) error
-	minioEditTiersMock func(ctx context.Context, tierName string, creds madmin.TierCreds) error
+	minioSiteReplicationResyncOpMock func(ctx context.Context, site madmin.PeerInfo, op madmin.SiteResyncOp) (madmin.SRResyncOpStatus, error)
+
+	minioDriveSpeedtestMock      func(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error)
+	minioNetperfMock             func(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error)
+	minioSiteReplicationPerfMock func(ctx context.Context, duration time.Duration) (madmin.SiteNetPerfResult, error)
+
+	minioBucketReplicationDiffMock func(ctx context.Context, bucketName string, opts madmin.ReplDiffOpts) <-chan madmin.DiffInfo
+
+	minioListTiersMock  func(ctx context.Context) ([]*madmin.TierConfig, error)
+	minioTierStatsMock  func(ctx context.Context) ([]madmin.TierInfo, error)
+	minioAddTiersMock   func(ctx context.Context, tier *madmin.TierConfig) error
+	minioEditTiersMock  func(ctx context.Context, tierName string, creds madmin.TierCreds) error
+	minioRemoveTierMock func(ctx context.Context, tierName string) error
+	minioVerifyTierMock func(ctx context.Context, tierName string) error
 
 	minioServiceTraceMock func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo
 
@@ -80,12 +91,14 @@ var (
 	minioSetUserStatusMock func(accessKey string, status madmin.AccountStatus) error
 
 	minioAccountInfoMock           func(ctx context.Context) (madmin.AccountInfo, error)
+	minioGetBucketQuotaMock        func(ctx context.Context, bucket string) (madmin.BucketQuota, error)
 	minioAddServiceAccountMock     func(ctx context.Context, policy string, user string, accessKey string, secretKey string, description string, name string, expiry *time.Time, status string) (madmin.Credentials, error)
 	minioListServiceAccountsMock   func(ctx context.Context, user string) (madmin.ListServiceAccountsResp, error)
 	minioDeleteServiceAccountMock  func(ctx context.Context, serviceAccount string) error
 	minioInfoServiceAccountMock    func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error)
 	minioUpdateServiceAccountMock  func(ctx context.Context, serviceAccount string, opts madmin.UpdateServiceAccountReq) error
 	minioGetLDAPPolicyEntitiesMock func(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error)
+	minioAttachPolicyLDAPMock      func(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
 
 	minioListRemoteBucketsMock func(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error)
 	minioGetRemoteBucketMock   func(ctx context.Context, bucket, arnType string) (targets *madmin.BucketTarget, err error)
@@ -96,6 +109,10 @@ func (ac AdminClientMock) serverInfo(ctx context.Context) (madmin.InfoMessage, e
 	return MinioServerInfoMock(ctx)
 }
 
+func (ac AdminClientMock) realtimeMetrics(ctx context.Context, opts madmin.MetricsOptions, out func(madmin.RealtimeMetrics)) error {
+	return minioRealtimeMetricsMock(ctx, opts, out)
+}
+
 func (ac AdminClientMock) listRemoteBuckets(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error) {
 	return minioListRemoteBucketsMock(ctx, bucket, arnType)
 }
@@ -120,8 +137,24 @@ func (ac AdminClientMock) speedtest(_ context.Context, _ madmin.SpeedtestOpts) (
 	return nil, nil
 }
 
-func (ac AdminClientMock) verifyTierStatus(_ context.Context, _ string) error {
-	return nil
+func (ac AdminClientMock) driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+	return minioDriveSpeedtestMock(ctx, opts)
+}
+
+func (ac AdminClientMock) netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+	return minioNetperfMock(ctx, duration)
+}
+
+func (ac AdminClientMock) siteReplicationPerf(ctx context.Context, duration time.Duration) (madmin.SiteNetPerfResult, error) {
+	return minioSiteReplicationPerfMock(ctx, duration)
+}
+
+func (ac AdminClientMock) verifyTierStatus(ctx context.Context, tierName string) error {
+	// tiers are reported online unless a test overrides the verification
+	if minioVerifyTierMock == nil {
+		return nil
+	}
+	return minioVerifyTierMock(ctx, tierName)
 }
 
 // mock function helpConfigKV()
@@ -329,10 +362,18 @@ func (ac AdminClientMock) deleteSiteReplicationInfo(ctx context.Context, removeR
 	return deleteSiteReplicationInfoMock(ctx, removeReq)
 }
 
+func (ac AdminClientMock) siteReplicationResyncOp(ctx context.Context, site madmin.PeerInfo, op madmin.SiteResyncOp) (madmin.SRResyncOpStatus, error) {
+	return minioSiteReplicationResyncOpMock(ctx, site, op)
+}
+
 func (ac AdminClientMock) getSiteReplicationStatus(ctx context.Context, params madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
 	return getSiteReplicationStatus(ctx, params)
 }
 
+func (ac AdminClientMock) bucketReplicationDiff(ctx context.Context, bucketName string, opts madmin.ReplDiffOpts) <-chan madmin.DiffInfo {
+	return minioBucketReplicationDiffMock(ctx, bucketName, opts)
+}
+
 func (ac AdminClientMock) listTiers(ctx context.Context) ([]*madmin.TierConfig, error) {
 	return minioListTiersMock(ctx)
 }
@@ -349,6 +390,10 @@ func (ac AdminClientMock) editTierCreds(ctx context.Context, tierName string, cr
 	return minioEditTiersMock(ctx, tierName, creds)
 }
 
+func (ac AdminClientMock) removeTier(ctx context.Context, tierName string) error {
+	return minioRemoveTierMock(ctx, tierName)
+}
+
 func (ac AdminClientMock) serviceTrace(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
 	return minioServiceTraceMock(ctx, threshold, s3, internal, storage, os, errTrace)
 }
@@ -377,6 +422,10 @@ func (ac AdminClientMock) AccountInfo(ctx context.Context) (madmin.AccountInfo,
 	return minioAccountInfoMock(ctx)
 }
 
+func (ac AdminClientMock) getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+	return minioGetBucketQuotaMock(ctx, bucket)
+}
+
 func (ac AdminClientMock) addServiceAccount(ctx context.Context, policy string, user string, accessKey string, secretKey string, description string, name string, expiry *time.Time, status string) (madmin.Credentials, error) {
 	return minioAddServiceAccountMock(ctx, policy, user, accessKey, secretKey, description, name, expiry, status)
 }
@@ -400,3 +449,7 @@ func (ac AdminClientMock) updateServiceAccount(ctx context.Context, serviceAccou
 func (ac AdminClientMock) getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
 	return minioGetLDAPPolicyEntitiesMock(ctx, query)
 }
+
+func (ac AdminClientMock) attachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	return minioAttachPolicyLDAPMock(ctx, req)
+}
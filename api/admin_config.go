@@ -165,6 +165,23 @@ func getConfig(ctx context.Context, client MinioAdmin, name string) ([]*models.C
 	return configSubSysList, nil
 }
 
+// auditConfigState returns the key values of the configuration by sub-system as recorded in audit events
+func auditConfigState(ctx context.Context, client MinioAdmin, name string) map[string]map[string]string {
+	configurations, err := getConfig(ctx, client, name)
+	if err != nil {
+		return nil
+	}
+	state := map[string]map[string]string{}
+	for _, configuration := range configurations {
+		kvs := map[string]string{}
+		for _, kv := range configuration.KeyValues {
+			kvs[kv.Key] = kv.Value
+		}
+		state[configuration.Name] = kvs
+	}
+	return state
+}
+
 // getConfigResponse performs getConfig() and serializes it to the handler's output
 func getConfigResponse(session *models.Principal, params cfgApi.ConfigInfoParams) ([]*models.Configuration, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
@@ -242,11 +259,18 @@ func setConfigResponse(session *models.Principal, params cfgApi.SetConfigParams)
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 	configName := params.Name
+	auditName := configName
+	if params.Body.ArnResourceID != "" {
+		auditName = fmt.Sprintf("%s:%s", configName, params.Body.ArnResourceID)
+	}
+	before := auditConfigState(ctx, adminClient, auditName)
 
 	needsRestart, err := setConfigWithARNAccountID(ctx, adminClient, &configName, params.Body.KeyValues, params.Body.ArnResourceID)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	after := auditConfigState(ctx, adminClient, auditName)
+	auditEvent(params.HTTPRequest, session, auditTargetConfig, auditName, before, after)
 	return &models.SetConfigResponse{Restart: needsRestart}, nil
 }
 
@@ -268,10 +292,13 @@ func resetConfigResponse(session *models.Principal, params cfgApi.ResetConfigPar
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
+	before := auditConfigState(ctx, adminClient, params.Name)
 	err = resetConfig(ctx, adminClient, &params.Name)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	after := auditConfigState(ctx, adminClient, params.Name)
+	auditEvent(params.HTTPRequest, session, auditTargetConfig, params.Name, before, after)
 
 	return &models.SetConfigResponse{Restart: true}, nil
 }
@@ -312,5 +339,7 @@ func importConfigResponse(session *models.Principal, params cfgApi.PostConfigsIm
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	// the imported file may hold any secret of the configuration so only the import itself is recorded
+	auditEvent(params.HTTPRequest, session, auditTargetConfig, "", nil, nil)
 	return &cfgApi.PostConfigsImportDefault{}, nil
 }
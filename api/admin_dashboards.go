@@ -0,0 +1,461 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	systemApi "github.com/minio/console/api/operations/system"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/store"
+	"github.com/minio/console/pkg/utils"
+)
+
+// dashboardsCollection is the store collection holding user defined dashboards
+const dashboardsCollection = "dashboards"
+
+func registerDashboardsHandlers(api *operations.ConsoleAPI) {
+	// list dashboards available to the current user
+	api.SystemListDashboardsHandler = systemApi.ListDashboardsHandlerFunc(func(params systemApi.ListDashboardsParams, session *models.Principal) middleware.Responder {
+		dashboardsResp, err := getListDashboardsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListDashboardsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewListDashboardsOK().WithPayload(dashboardsResp)
+	})
+	// create a dashboard
+	api.SystemCreateDashboardHandler = systemApi.CreateDashboardHandlerFunc(func(params systemApi.CreateDashboardParams, session *models.Principal) middleware.Responder {
+		dashboard, err := getCreateDashboardResponse(session, params)
+		if err != nil {
+			return systemApi.NewCreateDashboardDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewCreateDashboardCreated().WithPayload(dashboard)
+	})
+	// import a dashboard
+	api.SystemImportDashboardHandler = systemApi.ImportDashboardHandlerFunc(func(params systemApi.ImportDashboardParams, session *models.Principal) middleware.Responder {
+		dashboard, err := getImportDashboardResponse(session, params)
+		if err != nil {
+			return systemApi.NewImportDashboardDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewImportDashboardCreated().WithPayload(dashboard)
+	})
+	// get a dashboard
+	api.SystemGetDashboardHandler = systemApi.GetDashboardHandlerFunc(func(params systemApi.GetDashboardParams, session *models.Principal) middleware.Responder {
+		dashboard, err := getGetDashboardResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetDashboardDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewGetDashboardOK().WithPayload(dashboard)
+	})
+	// update a dashboard
+	api.SystemUpdateDashboardHandler = systemApi.UpdateDashboardHandlerFunc(func(params systemApi.UpdateDashboardParams, session *models.Principal) middleware.Responder {
+		dashboard, err := getUpdateDashboardResponse(session, params)
+		if err != nil {
+			return systemApi.NewUpdateDashboardDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewUpdateDashboardOK().WithPayload(dashboard)
+	})
+	// delete a dashboard
+	api.SystemDeleteDashboardHandler = systemApi.DeleteDashboardHandlerFunc(func(params systemApi.DeleteDashboardParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteDashboardResponse(session, params); err != nil {
+			return systemApi.NewDeleteDashboardDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewDeleteDashboardNoContent()
+	})
+	// export a dashboard
+	api.SystemExportDashboardHandler = systemApi.ExportDashboardHandlerFunc(func(params systemApi.ExportDashboardParams, session *models.Principal) middleware.Responder {
+		dashboard, err := getExportDashboardResponse(session, params)
+		if err != nil {
+			return systemApi.NewExportDashboardDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewExportDashboardOK().WithPayload(dashboard)
+	})
+	// return the results of a dashboard widget
+	api.SystemDashboardCustomWidgetDetailsHandler = systemApi.DashboardCustomWidgetDetailsHandlerFunc(func(params systemApi.DashboardCustomWidgetDetailsParams, session *models.Principal) middleware.Responder {
+		widgetResp, err := getDashboardCustomWidgetResponse(session, params)
+		if err != nil {
+			return systemApi.NewDashboardCustomWidgetDetailsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewDashboardCustomWidgetDetailsOK().WithPayload(widgetResp)
+	})
+}
+
+// validateDashboardWidgets checks every widget has at least one target and assigns ids to the ones missing it
+func validateDashboardWidgets(dashboardWidgets []*models.DashboardWidget) error {
+	usedIDs := map[int32]bool{}
+	for _, w := range dashboardWidgets {
+		if w == nil {
+			return errors.New("widget cannot be empty")
+		}
+		if w.ID != 0 {
+			if usedIDs[w.ID] {
+				return fmt.Errorf("duplicated widget id: %d", w.ID)
+			}
+			usedIDs[w.ID] = true
+		}
+	}
+	var nextID int32 = 1
+	for _, w := range dashboardWidgets {
+		if w.Title == nil || strings.TrimSpace(*w.Title) == "" {
+			return errors.New("widget title cannot be empty")
+		}
+		if len(w.Targets) == 0 {
+			return fmt.Errorf("widget %s must have at least one target", *w.Title)
+		}
+		for _, t := range w.Targets {
+			if t == nil || t.Expr == nil || strings.TrimSpace(*t.Expr) == "" {
+				return fmt.Errorf("widget %s has a target without expression", *w.Title)
+			}
+		}
+		if w.ID == 0 {
+			for usedIDs[nextID] {
+				nextID++
+			}
+			w.ID = nextID
+			usedIDs[nextID] = true
+		}
+	}
+	return nil
+}
+
+// validateDashboardRequest checks the dashboard has a name and valid widgets
+func validateDashboardRequest(req *models.DashboardRequest) error {
+	if req == nil || req.Name == nil || strings.TrimSpace(*req.Name) == "" {
+		return errors.New("dashboard name cannot be empty")
+	}
+	return validateDashboardWidgets(req.Widgets)
+}
+
+// isDashboardOwner returns whether the user owns the dashboard, dashboards stored without an owner
+// belong to nobody
+func isDashboardOwner(dashboard *models.Dashboard, user string) bool {
+	return user != "" && dashboard.Owner == user
+}
+
+// canReadDashboard returns whether the dashboard is visible to the user
+func canReadDashboard(dashboard *models.Dashboard, user string) bool {
+	return isDashboardOwner(dashboard, user) || dashboard.Shared
+}
+
+func getDashboard(s *store.Store, id string) (*models.Dashboard, error) {
+	var dashboard models.Dashboard
+	if err := s.Get(dashboardsCollection, id, &dashboard); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &dashboard, nil
+}
+
+// listDashboards returns all the dashboards owned by or shared with the user sorted by name
+func listDashboards(s *store.Store, user string) (*models.DashboardListResponse, error) {
+	ids, err := s.List(dashboardsCollection)
+	if err != nil {
+		return nil, err
+	}
+	dashboards := []*models.Dashboard{}
+	for _, id := range ids {
+		dashboard, err := getDashboard(s, id)
+		if err != nil {
+			return nil, err
+		}
+		if canReadDashboard(dashboard, user) {
+			dashboards = append(dashboards, dashboard)
+		}
+	}
+	sort.Slice(dashboards, func(i, j int) bool {
+		return dashboards[i].Name < dashboards[j].Name
+	})
+	return &models.DashboardListResponse{Dashboards: dashboards}, nil
+}
+
+// createDashboard stores a new dashboard owned by the user
+func createDashboard(s *store.Store, user string, req *models.DashboardRequest) (*models.Dashboard, error) {
+	if user == "" {
+		return nil, ErrForbidden
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	dashboard := &models.Dashboard{
+		ID:          uuid.NewString(),
+		Name:        strings.TrimSpace(*req.Name),
+		Description: req.Description,
+		Owner:       user,
+		Shared:      req.Shared,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Widgets:     req.Widgets,
+	}
+	if err := s.Put(dashboardsCollection, dashboard.ID, dashboard); err != nil {
+		return nil, err
+	}
+	return dashboard, nil
+}
+
+// updateDashboard replaces the contents of a dashboard, only the owner can update it
+func updateDashboard(s *store.Store, user, id string, req *models.DashboardRequest) (*models.Dashboard, error) {
+	dashboard, err := getDashboard(s, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canReadDashboard(dashboard, user) {
+		return nil, ErrNotFound
+	}
+	if !isDashboardOwner(dashboard, user) {
+		return nil, ErrForbidden
+	}
+	dashboard.Name = strings.TrimSpace(*req.Name)
+	dashboard.Description = req.Description
+	dashboard.Shared = req.Shared
+	dashboard.Widgets = req.Widgets
+	dashboard.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err = s.Put(dashboardsCollection, dashboard.ID, dashboard); err != nil {
+		return nil, err
+	}
+	return dashboard, nil
+}
+
+// deleteDashboard removes a dashboard, only the owner can delete it
+func deleteDashboard(s *store.Store, user, id string) error {
+	dashboard, err := getDashboard(s, id)
+	if err != nil {
+		return err
+	}
+	if !canReadDashboard(dashboard, user) {
+		return ErrNotFound
+	}
+	if !isDashboardOwner(dashboard, user) {
+		return ErrForbidden
+	}
+	return s.Delete(dashboardsCollection, id)
+}
+
+// exportDashboard returns a copy of the dashboard without any deployment specific information
+func exportDashboard(s *store.Store, user, id string) (*models.Dashboard, error) {
+	dashboard, err := getDashboard(s, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canReadDashboard(dashboard, user) {
+		return nil, ErrNotFound
+	}
+	return &models.Dashboard{
+		Name:        dashboard.Name,
+		Description: dashboard.Description,
+		Widgets:     dashboard.Widgets,
+	}, nil
+}
+
+// dashboardWidgetToMetric converts a saved widget to the Metric definition used for built-in widgets
+func dashboardWidgetToMetric(w *models.DashboardWidget) Metric {
+	m := Metric{
+		ID:            w.ID,
+		MaxDataPoints: w.MaxDataPoints,
+	}
+	if w.Title != nil {
+		m.Title = *w.Title
+	}
+	if w.Type != nil {
+		m.Type = *w.Type
+	}
+	if w.Options != nil && w.Options.ReduceOptions != nil {
+		m.Options.ReduceOptions.Calcs = w.Options.ReduceOptions.Calcs
+	}
+	if w.GridPos != nil {
+		m.GridPos = GridPos{H: w.GridPos.H, W: w.GridPos.W, X: w.GridPos.X, Y: w.GridPos.Y}
+	}
+	for _, t := range w.Targets {
+		target := Target{
+			LegendFormat: t.LegendFormat,
+			Step:         t.Step,
+			InitialTime:  t.InitialTime,
+		}
+		if t.Expr != nil {
+			target.Expr = *t.Expr
+		}
+		m.Targets = append(m.Targets, target)
+	}
+	return m
+}
+
+// getDashboardWidget returns the widget of a dashboard visible to the user
+func getDashboardWidget(s *store.Store, user, id string, widgetID int32) (*Metric, error) {
+	dashboard, err := getDashboard(s, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canReadDashboard(dashboard, user) {
+		return nil, ErrNotFound
+	}
+	for _, w := range dashboard.Widgets {
+		if w.ID == widgetID {
+			m := dashboardWidgetToMetric(w)
+			return &m, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// getListDashboardsResponse returns the dashboards available to the current user
+func getListDashboardsResponse(session *models.Principal, params systemApi.ListDashboardsParams) (*models.DashboardListResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	dashboards, err := listDashboards(getConsoleStore(), owner)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return dashboards, nil
+}
+
+// getCreateDashboardResponse creates a dashboard for the current user
+func getCreateDashboardResponse(session *models.Principal, params systemApi.CreateDashboardParams) (*models.Dashboard, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err := validateDashboardRequest(params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	dashboard, err := createDashboard(getConsoleStore(), owner, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return dashboard, nil
+}
+
+// getImportDashboardResponse stores an exported dashboard as a new dashboard owned by the current user
+func getImportDashboardResponse(session *models.Principal, params systemApi.ImportDashboardParams) (*models.Dashboard, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if params.Body == nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest)
+	}
+	name := params.Body.Name
+	req := &models.DashboardRequest{
+		Name:        &name,
+		Description: params.Body.Description,
+		Widgets:     params.Body.Widgets,
+	}
+	if err := validateDashboardRequest(req); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	dashboard, err := createDashboard(getConsoleStore(), owner, req)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return dashboard, nil
+}
+
+// getGetDashboardResponse returns a dashboard visible to the current user
+func getGetDashboardResponse(session *models.Principal, params systemApi.GetDashboardParams) (*models.Dashboard, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	dashboard, err := getDashboard(getConsoleStore(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if !canReadDashboard(dashboard, owner) {
+		return nil, ErrorWithContext(ctx, ErrNotFound)
+	}
+	return dashboard, nil
+}
+
+// getUpdateDashboardResponse updates a dashboard owned by the current user
+func getUpdateDashboardResponse(session *models.Principal, params systemApi.UpdateDashboardParams) (*models.Dashboard, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err := validateDashboardRequest(params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	dashboard, err := updateDashboard(getConsoleStore(), owner, params.ID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return dashboard, nil
+}
+
+// getDeleteDashboardResponse deletes a dashboard owned by the current user
+func getDeleteDashboardResponse(session *models.Principal, params systemApi.DeleteDashboardParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err := deleteDashboard(getConsoleStore(), owner, params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// getExportDashboardResponse returns a dashboard ready to be imported somewhere else
+func getExportDashboardResponse(session *models.Principal, params systemApi.ExportDashboardParams) (*models.Dashboard, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	dashboard, err := exportDashboard(getConsoleStore(), owner, params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return dashboard, nil
+}
+
+// getDashboardCustomWidgetResponse executes a saved widget against prometheus
+func getDashboardCustomWidgetResponse(session *models.Principal, params systemApi.DashboardCustomWidgetDetailsParams) (*models.WidgetDetails, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	m, err := getDashboardWidget(getConsoleStore(), owner, params.ID, params.WidgetID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	clientIP := getClientIP(params.HTTPRequest)
+	ctx = context.WithValue(ctx, utils.ContextClientIP, clientIP)
+	return executeWidget(ctx, getPrometheusURL(), getPrometheusSelector(), *m, params.Step, params.Start, params.End)
+}
@@ -0,0 +1,136 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDashboardRequest(name string, shared bool) *models.DashboardRequest {
+	return &models.DashboardRequest{
+		Name:   swag.String(name),
+		Shared: shared,
+		Widgets: []*models.DashboardWidget{
+			{
+				Title: swag.String("Requests"),
+				Type:  swag.String("graph"),
+				Targets: []*models.DashboardWidgetTarget{
+					{Expr: swag.String(`sum(rate(minio_s3_requests_total{$__query}[$__rate_interval]))`)},
+				},
+			},
+			{
+				ID:    7,
+				Title: swag.String("Errors"),
+				Type:  swag.String("stat"),
+				Targets: []*models.DashboardWidgetTarget{
+					{Expr: swag.String(`sum(minio_s3_requests_errors_total{$__query})`)},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateDashboardRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	req := newTestDashboardRequest("ops", false)
+	assert.Nil(validateDashboardRequest(req))
+	// widgets without id get the first free one
+	assert.Equal(int32(1), req.Widgets[0].ID)
+	assert.Equal(int32(7), req.Widgets[1].ID)
+
+	assert.NotNil(validateDashboardRequest(&models.DashboardRequest{Name: swag.String(" ")}))
+
+	req = newTestDashboardRequest("ops", false)
+	req.Widgets[0].Targets = nil
+	assert.NotNil(validateDashboardRequest(req))
+
+	req = newTestDashboardRequest("ops", false)
+	req.Widgets[0].ID = 7
+	assert.NotNil(validateDashboardRequest(req))
+}
+
+func TestDashboardsLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	s := store.New(t.TempDir())
+
+	req := newTestDashboardRequest("private", false)
+	assert.Nil(validateDashboardRequest(req))
+	private, err := createDashboard(s, "alice", req)
+	assert.Nil(err)
+	assert.Equal("alice", private.Owner)
+
+	req = newTestDashboardRequest("shared", true)
+	assert.Nil(validateDashboardRequest(req))
+	shared, err := createDashboard(s, "alice", req)
+	assert.Nil(err)
+
+	// owner sees both, other users only the shared one
+	list, err := listDashboards(s, "alice")
+	assert.Nil(err)
+	assert.Len(list.Dashboards, 2)
+	list, err = listDashboards(s, "bob")
+	assert.Nil(err)
+	assert.Len(list.Dashboards, 1)
+	assert.Equal(shared.ID, list.Dashboards[0].ID)
+
+	// only the owner can modify a dashboard
+	_, err = updateDashboard(s, "bob", shared.ID, newTestDashboardRequest("mine", true))
+	assert.ErrorIs(err, ErrForbidden)
+	_, err = updateDashboard(s, "bob", private.ID, newTestDashboardRequest("mine", true))
+	assert.ErrorIs(err, ErrNotFound)
+	updated, err := updateDashboard(s, "alice", private.ID, newTestDashboardRequest("renamed", false))
+	assert.Nil(err)
+	assert.Equal("renamed", updated.Name)
+
+	// widgets are executed through the same definition as built-in widgets
+	m, err := getDashboardWidget(s, "bob", shared.ID, 7)
+	assert.Nil(err)
+	assert.Equal("Errors", m.Title)
+	assert.Equal(`sum(minio_s3_requests_errors_total{$__query})`, m.Targets[0].Expr)
+	_, err = getDashboardWidget(s, "bob", private.ID, 7)
+	assert.ErrorIs(err, ErrNotFound)
+
+	// exported dashboards don't carry ownership information
+	exported, err := exportDashboard(s, "bob", shared.ID)
+	assert.Nil(err)
+	assert.Empty(exported.ID)
+	assert.Empty(exported.Owner)
+	assert.Len(exported.Widgets, 2)
+
+	// sessions without an identity own nothing
+	_, err = createDashboard(s, "", newTestDashboardRequest("anonymous", false))
+	assert.ErrorIs(err, ErrForbidden)
+	assert.Nil(s.Put(dashboardsCollection, "ownerless", &models.Dashboard{ID: "ownerless"}))
+	list, err = listDashboards(s, "")
+	assert.Nil(err)
+	assert.Len(list.Dashboards, 1)
+	assert.Equal(shared.ID, list.Dashboards[0].ID)
+	_, err = updateDashboard(s, "", "ownerless", newTestDashboardRequest("mine", false))
+	assert.ErrorIs(err, ErrNotFound)
+	assert.ErrorIs(deleteDashboard(s, "", shared.ID), ErrForbidden)
+
+	assert.ErrorIs(deleteDashboard(s, "bob", shared.ID), ErrForbidden)
+	assert.Nil(deleteDashboard(s, "alice", shared.ID))
+	_, err = getDashboard(s, shared.ID)
+	assert.ErrorIs(err, ErrNotFound)
+}
@@ -180,6 +180,7 @@ func getAddGroupResponse(session *models.Principal, params groupApi.AddGroupPara
 	if err := addGroup(ctx, adminClient, *groupRequest.Group, groupRequest.Members); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetGroup, *groupRequest.Group, nil, groupRequest)
 	return nil
 }
 
@@ -217,6 +218,7 @@ func getRemoveGroupResponse(session *models.Principal, params groupApi.RemoveGro
 		return ErrorWithContext(ctx, err)
 	}
 
+	before := auditGroupState(ctx, adminClient, groupName)
 	if err := removeGroup(ctx, adminClient, groupName); err != nil {
 		minioError := madmin.ToErrorResponse(err)
 		err2 := ErrorWithContext(ctx, err)
@@ -225,9 +227,24 @@ func getRemoveGroupResponse(session *models.Principal, params groupApi.RemoveGro
 		}
 		return err2
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetGroup, groupName, before, nil)
 	return nil
 }
 
+// auditGroupState returns the group as recorded in audit events, nil if it can't be fetched
+func auditGroupState(ctx context.Context, client MinioAdmin, group string) *models.Group {
+	groupDesc, err := client.getGroupDescription(ctx, group)
+	if err != nil {
+		return nil
+	}
+	return &models.Group{
+		Name:    groupDesc.Name,
+		Members: groupDesc.Members,
+		Policy:  groupDesc.Policy,
+		Status:  groupDesc.Status,
+	}
+}
+
 // updateGroup updates a group by adding/removing members and setting the status to the desired one
 //
 // isRemove: whether remove members or not
@@ -250,17 +267,22 @@ func addOrDeleteMembers(ctx context.Context, client MinioAdmin, group *madmin.Gr
 	membersToDelete := DifferenceArrays(group.Members, expectedMembers)
 	membersToAdd := DifferenceArrays(expectedMembers, group.Members)
 	// delete members if any to be deleted
+	var undo iamUndoLog
 	if len(membersToDelete) > 0 {
 		err := updateGroupMembers(ctx, client, group.Name, membersToDelete, true)
 		if err != nil {
 			return err
 		}
+		// deleted members are added back if adding the new ones fails
+		undo.add(func(ctx context.Context) error {
+			return updateGroupMembers(ctx, client, group.Name, membersToDelete, false)
+		})
 	}
 	// add members if any to be added
 	if len(membersToAdd) > 0 {
 		err := updateGroupMembers(ctx, client, group.Name, membersToAdd, false)
 		if err != nil {
-			return err
+			return undo.rollbackOnError(ctx, err)
 		}
 	}
 	return nil
@@ -306,6 +328,7 @@ func getUpdateGroupResponse(session *models.Principal, params groupApi.UpdateGro
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
+	before := auditGroupState(ctx, adminClient, groupName)
 	groupUpdated, err := groupUpdate(ctx, adminClient, groupName, expectedGroupUpdate)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
@@ -316,6 +339,7 @@ func getUpdateGroupResponse(session *models.Principal, params groupApi.UpdateGro
 		Policy:  groupUpdated.Policy,
 		Status:  groupUpdated.Status,
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetGroup, groupName, before, groupResponse)
 	return groupResponse, nil
 }
 
@@ -36,6 +36,28 @@ import (
 	"github.com/minio/websocket"
 )
 
+// healthInfoDataTypes are the health data types uploaded to SUBNET
+var healthInfoDataTypes = []madmin.HealthDataType{
+	madmin.HealthDataTypeMinioInfo,
+	madmin.HealthDataTypeMinioConfig,
+	madmin.HealthDataTypeSysCPU,
+	madmin.HealthDataTypeSysDriveHw,
+	madmin.HealthDataTypeSysDocker,
+	madmin.HealthDataTypeSysOsInfo,
+	madmin.HealthDataTypeSysLoad,
+	madmin.HealthDataTypeSysMem,
+	madmin.HealthDataTypeSysNet,
+	madmin.HealthDataTypeSysProcess,
+}
+
+// healthInfoMessage is the message sent on the websocket connection once diagnostics complete
+type healthInfoMessage struct {
+	Encoded          string        `json:"encoded"`
+	ServerHealthInfo interface{}   `json:"serverHealthInfo"`
+	SubnetResponse   string        `json:"subnetResponse"`
+	Report           *healthReport `json:"report,omitempty"`
+}
+
 // startHealthInfo starts fetching mc.ServerHealthInfo and
 // sends messages with the corresponding data on the websocket connection
 func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadline *time.Duration) error {
@@ -43,22 +65,9 @@ func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadli
 		return errors.New("duration can't be nil on startHealthInfo")
 	}
 
-	// Fetch info of all servers (cluster or single server)
-	healthDataTypes := []madmin.HealthDataType{
-		madmin.HealthDataTypeMinioInfo,
-		madmin.HealthDataTypeMinioConfig,
-		madmin.HealthDataTypeSysCPU,
-		madmin.HealthDataTypeSysDriveHw,
-		madmin.HealthDataTypeSysDocker,
-		madmin.HealthDataTypeSysOsInfo,
-		madmin.HealthDataTypeSysLoad,
-		madmin.HealthDataTypeSysMem,
-		madmin.HealthDataTypeSysNet,
-		madmin.HealthDataTypeSysProcess,
-	}
 	var err error
 	// Fetch info of all servers (cluster or single server)
-	healthInfo, version, err := client.serverHealthInfo(ctx, healthDataTypes, *deadline)
+	healthInfo, version, err := client.serverHealthInfo(ctx, healthInfoDataTypes, *deadline)
 	if err != nil {
 		return err
 	}
@@ -68,15 +77,10 @@ func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadli
 		return err
 	}
 	encodedDiag := b64.StdEncoding.EncodeToString(compressedDiag)
-	type messageReport struct {
-		Encoded          string      `json:"encoded"`
-		ServerHealthInfo interface{} `json:"serverHealthInfo"`
-		SubnetResponse   string      `json:"subnetResponse"`
-	}
 
 	ctx = context.WithValue(ctx, utils.ContextClientIP, conn.remoteAddress())
 	subnetResp, err := sendHealthInfoToSubnet(ctx, healthInfo, client)
-	report := messageReport{
+	report := healthInfoMessage{
 		Encoded:          encodedDiag,
 		ServerHealthInfo: healthInfo,
 		SubnetResponse:   subnetResp,
@@ -94,6 +98,48 @@ func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadli
 	return conn.writeMessage(websocket.TextMessage, message)
 }
 
+// startOfflineHealthInfo fetches the health diagnostics and analyzes them locally instead of uploading
+// them to SUBNET, the report is sent along with the compressed diagnostics so they can still be
+// downloaded and shared manually
+func startOfflineHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadline *time.Duration) error {
+	if deadline == nil {
+		return errors.New("duration can't be nil on startOfflineHealthInfo")
+	}
+
+	// system configuration and errors are only needed by the local checks
+	healthDataTypes := append([]madmin.HealthDataType{
+		madmin.HealthDataTypeSysConfig,
+		madmin.HealthDataTypeSysErrors,
+	}, healthInfoDataTypes...)
+	healthInfo, version, err := client.serverHealthInfo(ctx, healthDataTypes, *deadline)
+	if err != nil {
+		return err
+	}
+
+	compressedDiag, err := tarGZ(healthInfo, version)
+	if err != nil {
+		return err
+	}
+
+	info, ok := healthInfo.(madmin.HealthInfo)
+	if !ok {
+		return ErrHealthReportFail
+	}
+	report := healthInfoMessage{
+		Encoded:          b64.StdEncoding.EncodeToString(compressedDiag),
+		ServerHealthInfo: healthInfo,
+		Report:           analyzeHealthInfo(info),
+	}
+
+	message, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	// Send Message through websocket connection
+	return conn.writeMessage(websocket.TextMessage, message)
+}
+
 // compress and tar MinIO diagnostics output
 func tarGZ(healthInfo interface{}, version string) ([]byte, error) {
 	buffer := bytes.NewBuffer(nil)
@@ -129,6 +175,12 @@ func getHealthInfoOptionsFromReq(req *http.Request) (*time.Duration, error) {
 	return &deadlineDuration, nil
 }
 
+// getHealthInfoOfflineFromReq returns whether diagnostics are analyzed locally instead of being uploaded
+// to SUBNET, either requested as `/health-info?deadline=2h&offline=true` or enforced for the deployment
+func getHealthInfoOfflineFromReq(req *http.Request) bool {
+	return getHealthInfoOffline() || req.FormValue("offline") == "true"
+}
+
 func sendHealthInfoToSubnet(ctx context.Context, healthInfo interface{}, client MinioAdmin) (string, error) {
 	filename := fmt.Sprintf("health_%d.json", time.Now().Unix())
 
@@ -0,0 +1,313 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+)
+
+const (
+	healthSeverityCritical = "critical"
+	healthSeverityWarning  = "warning"
+	healthSeverityInfo     = "info"
+
+	// healthMinOpenFiles is the minimum open files limit recommended for MinIO
+	healthMinOpenFiles = 65536
+	// healthClockSkewWarning is the clock difference between nodes reported as a warning, nodes are
+	// queried concurrently so smaller differences are expected
+	healthClockSkewWarning = 5 * time.Second
+	// healthClockSkewCritical is the clock difference beyond which S3 signatures are rejected
+	healthClockSkewCritical = 15 * time.Minute
+)
+
+// healthFinding is the result of a check that didn't pass
+type healthFinding struct {
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Node     string `json:"node,omitempty"`
+	Message  string `json:"message"`
+}
+
+// healthReport is the outcome of analyzing the health diagnostics locally
+type healthReport struct {
+	Critical int             `json:"critical"`
+	Warning  int             `json:"warning"`
+	Info     int             `json:"info"`
+	Findings []healthFinding `json:"findings"`
+}
+
+func (r *healthReport) add(check, severity, node, format string, args ...interface{}) {
+	r.Findings = append(r.Findings, healthFinding{
+		Check:    check,
+		Severity: severity,
+		Node:     node,
+		Message:  fmt.Sprintf(format, args...),
+	})
+	switch severity {
+	case healthSeverityCritical:
+		r.Critical++
+	case healthSeverityWarning:
+		r.Warning++
+	default:
+		r.Info++
+	}
+}
+
+// checkMismatch reports a finding when nodes don't agree on value, listing the nodes per value
+func (r *healthReport) checkMismatch(check, what string, valuesByNode map[string]string) {
+	nodesByValue := map[string][]string{}
+	for node, value := range valuesByNode {
+		if value == "" {
+			continue
+		}
+		nodesByValue[value] = append(nodesByValue[value], node)
+	}
+	if len(nodesByValue) < 2 {
+		return
+	}
+	values := make([]string, 0, len(nodesByValue))
+	for value, nodes := range nodesByValue {
+		sort.Strings(nodes)
+		values = append(values, fmt.Sprintf("%s (%s)", value, strings.Join(nodes, ", ")))
+	}
+	sort.Strings(values)
+	r.add(check, healthSeverityWarning, "", "nodes run different %s: %s", what, strings.Join(values, "; "))
+}
+
+func checkHealthVersions(r *healthReport, info madmin.HealthInfo) {
+	minioVersions := map[string]string{}
+	for _, server := range info.Minio.Info.Servers {
+		minioVersions[server.Endpoint] = server.Version
+	}
+	r.checkMismatch("minio-version", "MinIO versions", minioVersions)
+
+	kernelVersions := map[string]string{}
+	for _, osInfo := range info.Sys.OSInfo {
+		kernelVersions[osInfo.Addr] = osInfo.Info.KernelVersion
+	}
+	r.checkMismatch("kernel-version", "kernel versions", kernelVersions)
+}
+
+func checkHealthSwap(r *healthReport, info madmin.HealthInfo) {
+	for _, memInfo := range info.Sys.MemInfo {
+		if memInfo.SwapSpaceTotal > 0 {
+			r.add("swap", healthSeverityWarning, memInfo.Addr, "swap is enabled (%d bytes), MinIO performs best with swap disabled", memInfo.SwapSpaceTotal)
+		}
+	}
+}
+
+// checkHealthDriveFilesystems reports MinIO drives not formatted with XFS, drives are matched to the
+// partition with the longest mount point containing the drive path
+func checkHealthDriveFilesystems(r *healthReport, info madmin.HealthInfo) {
+	partitionsByNode := map[string][]madmin.Partition{}
+	for _, partitions := range info.Sys.Partitions {
+		partitionsByNode[partitions.Addr] = partitions.Partitions
+	}
+	for _, server := range info.Minio.Info.Servers {
+		partitions, ok := partitionsByNode[server.Endpoint]
+		if !ok {
+			continue
+		}
+		for _, drive := range server.Drives {
+			var match *madmin.Partition
+			for i := range partitions {
+				mountpoint := partitions[i].Mountpoint
+				if mountpoint == "" || !strings.HasPrefix(drive.DrivePath, mountpoint) {
+					continue
+				}
+				if mountpoint != "/" && len(drive.DrivePath) > len(mountpoint) && drive.DrivePath[len(mountpoint)] != '/' {
+					continue
+				}
+				if match == nil || len(mountpoint) > len(match.Mountpoint) {
+					match = &partitions[i]
+				}
+			}
+			if match == nil {
+				continue
+			}
+			fsType := match.FSType
+			if fsType == "" {
+				fsType = match.MountFSType
+			}
+			if fsType != "" && fsType != "xfs" {
+				r.add("drive-filesystem", healthSeverityWarning, server.Endpoint, "drive %s is on a %s filesystem (%s), XFS is recommended", drive.DrivePath, fsType, match.Device)
+			}
+		}
+	}
+}
+
+// sysConfigTime returns the time reported by the node, values went through JSON so they are decoded again
+func sysConfigTime(config map[string]interface{}) (time.Time, bool) {
+	raw, ok := config["time-info"]
+	if !ok {
+		return time.Time{}, false
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+	var timeInfo madmin.TimeInfo
+	if err = json.Unmarshal(data, &timeInfo); err != nil || timeInfo.CurrentTime.IsZero() {
+		return time.Time{}, false
+	}
+	return timeInfo.CurrentTime, true
+}
+
+// sysConfigOpenFiles returns the open files limit of the MinIO process
+func sysConfigOpenFiles(config map[string]interface{}) (uint64, bool) {
+	switch limit := config["rlimit-max"].(type) {
+	case float64:
+		return uint64(limit), true
+	case uint64:
+		return limit, true
+	case int:
+		return uint64(limit), true
+	}
+	return 0, false
+}
+
+func checkHealthSysConfig(r *healthReport, info madmin.HealthInfo) {
+	var earliest, latest time.Time
+	var earliestNode, latestNode string
+	for _, sysConfig := range info.Sys.SysConfig {
+		if limit, ok := sysConfigOpenFiles(sysConfig.Config); ok && limit < healthMinOpenFiles {
+			r.add("open-files-limit", healthSeverityWarning, sysConfig.Addr, "open files limit is %d, at least %d is recommended", limit, healthMinOpenFiles)
+		}
+		nodeTime, ok := sysConfigTime(sysConfig.Config)
+		if !ok {
+			continue
+		}
+		if earliest.IsZero() || nodeTime.Before(earliest) {
+			earliest, earliestNode = nodeTime, sysConfig.Addr
+		}
+		if latest.IsZero() || nodeTime.After(latest) {
+			latest, latestNode = nodeTime, sysConfig.Addr
+		}
+	}
+	skew := latest.Sub(earliest)
+	switch {
+	case skew > healthClockSkewCritical:
+		r.add("clock-skew", healthSeverityCritical, "", "clocks of %s and %s differ by %s, requests will fail signature validation", earliestNode, latestNode, skew.Round(time.Second))
+	case skew > healthClockSkewWarning:
+		r.add("clock-skew", healthSeverityWarning, "", "clocks of %s and %s differ by %s, make sure NTP is configured", earliestNode, latestNode, skew.Round(time.Second))
+	}
+}
+
+// checkHealthNUMA reports nodes whose CPU sockets have a different number of cores, and nodes
+// with a different number of cores than the rest of the cluster
+func checkHealthNUMA(r *healthReport, info madmin.HealthInfo) {
+	totalCores := map[string]string{}
+	for _, cpus := range info.Sys.CPUInfo {
+		if len(cpus.CPUs) == 0 {
+			continue
+		}
+		cores := 0
+		socketCores := map[int]bool{}
+		for _, cpu := range cpus.CPUs {
+			cores += cpu.Cores
+			socketCores[cpu.Cores] = true
+		}
+		totalCores[cpus.Addr] = fmt.Sprintf("%d cores", cores)
+		if len(socketCores) > 1 {
+			r.add("numa", healthSeverityWarning, cpus.Addr, "CPU sockets have a different number of cores, NUMA nodes are imbalanced")
+		}
+	}
+	r.checkMismatch("numa", "CPU core counts", totalCores)
+}
+
+func checkHealthDrives(r *healthReport, info madmin.HealthInfo) {
+	for _, server := range info.Minio.Info.Servers {
+		for _, drive := range server.Drives {
+			if drive.State != "" && drive.State != madmin.DriveStateOk {
+				r.add("drive-errors", healthSeverityCritical, server.Endpoint, "drive %s is %s", drive.DrivePath, drive.State)
+			}
+			if drive.Metrics != nil && (drive.Metrics.TotalErrorsAvailability > 0 || drive.Metrics.TotalErrorsTimeout > 0) {
+				r.add("drive-errors", healthSeverityWarning, server.Endpoint, "drive %s reported %d availability errors and %d timeouts",
+					drive.DrivePath, drive.Metrics.TotalErrorsAvailability, drive.Metrics.TotalErrorsTimeout)
+			}
+		}
+	}
+	for _, partitions := range info.Sys.Partitions {
+		for _, partition := range partitions.Partitions {
+			if partition.Error != "" {
+				r.add("drive-errors", healthSeverityWarning, partitions.Addr, "partition %s: %s", partition.Device, partition.Error)
+			}
+		}
+	}
+	for _, sysErrs := range info.Sys.SysErrs {
+		for _, sysErr := range sysErrs.Errors {
+			r.add("system-errors", healthSeverityWarning, sysErrs.Addr, "%s", sysErr)
+		}
+	}
+}
+
+// checkHealthCollection reports the nodes some health data couldn't be collected from, the checks
+// relying on that data may be incomplete
+func checkHealthCollection(r *healthReport, info madmin.HealthInfo) {
+	if info.Error != "" {
+		r.add("health-data", healthSeverityWarning, "", "%s", info.Error)
+	}
+	if info.Minio.Error != "" {
+		r.add("health-data", healthSeverityInfo, "", "MinIO info: %s", info.Minio.Error)
+	}
+	var nodeErrors []madmin.NodeCommon
+	for _, v := range info.Sys.CPUInfo {
+		nodeErrors = append(nodeErrors, v.NodeCommon)
+	}
+	for _, v := range info.Sys.Partitions {
+		nodeErrors = append(nodeErrors, v.NodeCommon)
+	}
+	for _, v := range info.Sys.OSInfo {
+		nodeErrors = append(nodeErrors, v.NodeCommon)
+	}
+	for _, v := range info.Sys.MemInfo {
+		nodeErrors = append(nodeErrors, v.NodeCommon)
+	}
+	for _, v := range info.Sys.SysConfig {
+		nodeErrors = append(nodeErrors, v.NodeCommon)
+	}
+	for _, nodeErr := range nodeErrors {
+		if nodeErr.Error != "" {
+			r.add("health-data", healthSeverityInfo, nodeErr.Addr, "%s", nodeErr.Error)
+		}
+	}
+}
+
+// analyzeHealthInfo runs the local checks over the health diagnostics, findings are sorted
+// by severity, most severe first
+func analyzeHealthInfo(info madmin.HealthInfo) *healthReport {
+	r := &healthReport{Findings: []healthFinding{}}
+	checkHealthVersions(r, info)
+	checkHealthSwap(r, info)
+	checkHealthDriveFilesystems(r, info)
+	checkHealthSysConfig(r, info)
+	checkHealthNUMA(r, info)
+	checkHealthDrives(r, info)
+	checkHealthCollection(r, info)
+
+	severityRank := map[string]int{healthSeverityCritical: 0, healthSeverityWarning: 1, healthSeverityInfo: 2}
+	sort.SliceStable(r.Findings, func(i, j int) bool {
+		return severityRank[r.Findings[i].Severity] < severityRank[r.Findings[j].Severity]
+	})
+	return r
+}
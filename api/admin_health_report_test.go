@@ -0,0 +1,148 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func findingsByCheck(report *healthReport) map[string][]healthFinding {
+	findings := map[string][]healthFinding{}
+	for _, f := range report.Findings {
+		findings[f.Check] = append(findings[f.Check], f)
+	}
+	return findings
+}
+
+func TestAnalyzeHealthInfo(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+
+	var info madmin.HealthInfo
+	info.Minio.Info.Servers = []madmin.ServerInfo{
+		{Endpoint: "node1:9000", Version: "2024-01-01", Drives: []madmin.Disk{
+			{DrivePath: "/mnt/data1", State: madmin.DriveStateOk},
+			{DrivePath: "/mnt/data10", State: madmin.DriveStateOffline},
+		}},
+		{Endpoint: "node2:9000", Version: "2024-02-01", Drives: []madmin.Disk{
+			{DrivePath: "/mnt/data1", State: madmin.DriveStateOk, Metrics: &madmin.DiskMetrics{TotalErrorsTimeout: 3}},
+		}},
+	}
+	info.Sys.Partitions = []madmin.Partitions{
+		{NodeCommon: madmin.NodeCommon{Addr: "node1:9000"}, Partitions: []madmin.Partition{
+			{Device: "/dev/sda1", Mountpoint: "/", FSType: "ext4"},
+			{Device: "/dev/sdb", Mountpoint: "/mnt/data1", FSType: "xfs"},
+		}},
+		{NodeCommon: madmin.NodeCommon{Addr: "node2:9000"}, Partitions: []madmin.Partition{
+			{Device: "/dev/sdb", Mountpoint: "/mnt", MountFSType: "ext4"},
+		}},
+	}
+	info.Sys.MemInfo = []madmin.MemInfo{
+		{NodeCommon: madmin.NodeCommon{Addr: "node1:9000"}, SwapSpaceTotal: 1024},
+		{NodeCommon: madmin.NodeCommon{Addr: "node2:9000", Error: "permission denied"}},
+	}
+	info.Sys.SysConfig = []madmin.SysConfig{
+		{NodeCommon: madmin.NodeCommon{Addr: "node1:9000"}, Config: map[string]interface{}{
+			"time-info":  madmin.TimeInfo{CurrentTime: now},
+			"rlimit-max": uint64(1024),
+		}},
+		{NodeCommon: madmin.NodeCommon{Addr: "node2:9000"}, Config: map[string]interface{}{
+			"time-info":  madmin.TimeInfo{CurrentTime: now.Add(time.Minute)},
+			"rlimit-max": uint64(1048576),
+		}},
+	}
+	info.Sys.CPUInfo = []madmin.CPUs{
+		{NodeCommon: madmin.NodeCommon{Addr: "node1:9000"}, CPUs: []madmin.CPU{{Cores: 8}, {Cores: 4}}},
+		{NodeCommon: madmin.NodeCommon{Addr: "node2:9000"}, CPUs: []madmin.CPU{{Cores: 8}, {Cores: 8}}},
+	}
+	info.Sys.SysErrs = []madmin.SysErrors{
+		{NodeCommon: madmin.NodeCommon{Addr: "node2:9000"}, Errors: []string{"xfs: metadata I/O error"}},
+	}
+
+	// diagnostics are analyzed after going through JSON, the same as the ones downloaded by users
+	data, err := json.Marshal(info)
+	assert.Nil(err)
+	var decoded madmin.HealthInfo
+	assert.Nil(json.Unmarshal(data, &decoded))
+
+	report := analyzeHealthInfo(decoded)
+	findings := findingsByCheck(report)
+
+	assert.Len(findings["minio-version"], 1)
+	assert.Contains(findings["minio-version"][0].Message, "2024-01-01 (node1:9000)")
+	assert.Len(findings["swap"], 1)
+	assert.Equal("node1:9000", findings["swap"][0].Node)
+	// /mnt/data10 doesn't belong to the /mnt/data1 mount point
+	assert.Len(findings["drive-filesystem"], 2)
+	assert.Contains(findings["drive-filesystem"][0].Message, "/mnt/data10 is on a ext4 filesystem")
+	assert.Equal("node2:9000", findings["drive-filesystem"][1].Node)
+	assert.Len(findings["open-files-limit"], 1)
+	assert.Equal("node1:9000", findings["open-files-limit"][0].Node)
+	assert.Len(findings["clock-skew"], 1)
+	assert.Equal(healthSeverityWarning, findings["clock-skew"][0].Severity)
+	assert.Len(findings["numa"], 2)
+	assert.Len(findings["drive-errors"], 2)
+	assert.Len(findings["system-errors"], 1)
+	assert.Len(findings["health-data"], 1)
+	assert.Equal(healthSeverityInfo, findings["health-data"][0].Severity)
+
+	assert.Equal(1, report.Critical)
+	assert.Equal(1, report.Info)
+	assert.Equal(len(report.Findings)-2, report.Warning)
+	// most severe findings come first
+	assert.Equal("drive-errors", report.Findings[0].Check)
+	assert.Equal(healthSeverityCritical, report.Findings[0].Severity)
+	assert.Equal(healthSeverityInfo, report.Findings[len(report.Findings)-1].Severity)
+
+	report = analyzeHealthInfo(madmin.HealthInfo{})
+	assert.Empty(report.Findings)
+}
+
+func TestStartOfflineHealthInfo(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+	mockWSConn := mockConn{}
+	deadline := time.Minute
+
+	var requested []madmin.HealthDataType
+	minioServerHealthInfoMock = func(_ context.Context, healthDataTypes []madmin.HealthDataType, _ time.Duration) (interface{}, string, error) {
+		requested = healthDataTypes
+		var info madmin.HealthInfo
+		info.Sys.MemInfo = []madmin.MemInfo{{NodeCommon: madmin.NodeCommon{Addr: "node1:9000"}, SwapSpaceTotal: 1}}
+		return info, madmin.HealthInfoVersion, nil
+	}
+	var message healthInfoMessage
+	connWriteMessageMock = func(_ int, data []byte) error {
+		return json.Unmarshal(data, &message)
+	}
+
+	assert.Nil(startOfflineHealthInfo(ctx, mockWSConn, client, &deadline))
+	assert.Contains(requested, madmin.HealthDataTypeSysConfig)
+	assert.NotEmpty(message.Encoded)
+	assert.Empty(message.SubnetResponse)
+	assert.NotNil(message.Report)
+	assert.Equal(1, message.Report.Warning)
+
+	assert.NotNil(startOfflineHealthInfo(ctx, mockWSConn, client, nil))
+}
@@ -0,0 +1,317 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	userApi "github.com/minio/console/api/operations/user"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+)
+
+func registerIAMChangeSetHandlers(api *operations.ConsoleAPI) {
+	// Apply IAM change set
+	api.UserApplyIAMChangeSetHandler = userApi.ApplyIAMChangeSetHandlerFunc(func(params userApi.ApplyIAMChangeSetParams, session *models.Principal) middleware.Responder {
+		resp, err := getApplyIAMChangeSetResponse(session, params)
+		if err != nil {
+			return userApi.NewApplyIAMChangeSetDefault(err.Code).WithPayload(err.APIError)
+		}
+		return userApi.NewApplyIAMChangeSetOK().WithPayload(resp)
+	})
+}
+
+// iamUndo reverts an applied IAM change
+type iamUndo func(ctx context.Context) error
+
+// iamUndoLog keeps the compensating actions of the IAM changes applied so far
+type iamUndoLog []iamUndo
+
+func (l *iamUndoLog) add(undo iamUndo) {
+	*l = append(*l, undo)
+}
+
+// rollback reverts the applied changes newest first, the rollback isn't canceled with the request
+// that failed and keeps going after a failure so as much as possible is reverted
+func (l *iamUndoLog) rollback(ctx context.Context) error {
+	ctx = context.WithoutCancel(ctx)
+	var errs []error
+	for i := len(*l) - 1; i >= 0; i-- {
+		if err := (*l)[i](ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	*l = nil
+	return errors.Join(errs...)
+}
+
+// rollbackOnError reverts the applied changes when err is set, rollback failures are logged
+// and the original error is returned
+func (l *iamUndoLog) rollbackOnError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if rbErr := l.rollback(ctx); rbErr != nil {
+		LogError("unable to roll back IAM changes: %v", rbErr)
+	}
+	return err
+}
+
+// validateIAMChangeSet checks every operation has what it needs before anything is applied
+func validateIAMChangeSet(ops []*models.IamChangeSetOperation) error {
+	for i, op := range ops {
+		if op == nil || op.Type == nil {
+			return fmt.Errorf("operation %d: type is required", i)
+		}
+		var err error
+		switch *op.Type {
+		case models.IamChangeSetOperationTypeCreateUser:
+			if op.User == "" || op.SecretKey == "" {
+				err = errors.New("user and secretKey are required")
+			}
+		case models.IamChangeSetOperationTypeSetUserGroups:
+			if op.User == "" {
+				err = errors.New("user is required")
+			}
+		case models.IamChangeSetOperationTypeSetGroupMembers:
+			if op.Group == "" {
+				err = errors.New("group is required")
+			}
+		case models.IamChangeSetOperationTypeAttachPolicies:
+			if (op.User == "") == (op.Group == "") {
+				err = errors.New("either user or group is required")
+			} else if len(op.Policies) == 0 {
+				err = errors.New("policies are required")
+			}
+		case models.IamChangeSetOperationTypeCreateServiceAccount:
+			if op.User == "" {
+				err = errors.New("user is required")
+			} else if op.ServiceAccount != nil && op.ServiceAccount.Expiry != "" {
+				if _, errExpiry := time.Parse(time.RFC3339, op.ServiceAccount.Expiry); errExpiry != nil {
+					err = fmt.Errorf("invalid expiry: %v", errExpiry)
+				}
+			}
+		default:
+			err = fmt.Errorf("unsupported type %s", *op.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("operation %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// iamChangeSetTarget returns the user or group an operation applies to
+func iamChangeSetTarget(op *models.IamChangeSetOperation) string {
+	if op.User != "" {
+		return op.User
+	}
+	return op.Group
+}
+
+// applyIAMOperation applies a single operation and returns how to revert it, created service
+// account credentials are returned since their secret can't be retrieved later
+func applyIAMOperation(ctx context.Context, client MinioAdmin, op *models.IamChangeSetOperation) (iamUndo, *models.ServiceAccountCreds, error) {
+	switch *op.Type {
+	case models.IamChangeSetOperationTypeCreateUser:
+		if _, err := client.getUserInfo(ctx, op.User); err == nil {
+			return nil, nil, ErrNonUniqueAccessKey
+		}
+		if err := client.addUser(ctx, op.User, op.SecretKey); err != nil {
+			return nil, nil, err
+		}
+		return func(ctx context.Context) error {
+			return removeUser(ctx, client, op.User)
+		}, nil, nil
+
+	case models.IamChangeSetOperationTypeSetUserGroups:
+		userInfo, err := getUserInfo(ctx, client, op.User)
+		if err != nil {
+			return nil, nil, err
+		}
+		previousGroups := userInfo.MemberOf
+		if _, err = updateUserGroups(ctx, client, op.User, op.Groups); err != nil {
+			return nil, nil, err
+		}
+		return func(ctx context.Context) error {
+			_, err := updateUserGroups(ctx, client, op.User, previousGroups)
+			return err
+		}, nil, nil
+
+	case models.IamChangeSetOperationTypeSetGroupMembers:
+		groups, err := client.listGroups(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !IsElementInArray(groups, op.Group) {
+			if err = addGroup(ctx, client, op.Group, op.Members); err != nil {
+				return nil, nil, err
+			}
+			return func(ctx context.Context) error {
+				// a group can only be removed once it's empty
+				if len(op.Members) > 0 {
+					if err := updateGroupMembers(ctx, client, op.Group, op.Members, true); err != nil {
+						return err
+					}
+				}
+				return removeGroup(ctx, client, op.Group)
+			}, nil, nil
+		}
+		group, err := groupInfo(ctx, client, op.Group)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = addOrDeleteMembers(ctx, client, group, op.Members); err != nil {
+			return nil, nil, err
+		}
+		return func(ctx context.Context) error {
+			return addOrDeleteMembers(ctx, client, &madmin.GroupDesc{Name: op.Group, Members: op.Members}, group.Members)
+		}, nil, nil
+
+	case models.IamChangeSetOperationTypeAttachPolicies:
+		isGroup := op.Group != ""
+		var previousPolicy string
+		if isGroup {
+			group, err := groupInfo(ctx, client, op.Group)
+			if err != nil {
+				return nil, nil, err
+			}
+			previousPolicy = group.Policy
+		} else {
+			userInfo, err := getUserInfo(ctx, client, op.User)
+			if err != nil {
+				return nil, nil, err
+			}
+			previousPolicy = userInfo.PolicyName
+		}
+		policies := op.Policies
+		if previousPolicy != "" {
+			policies = UniqueKeys(append(strings.Split(previousPolicy, ","), op.Policies...))
+		}
+		entity := iamChangeSetTarget(op)
+		if err := client.setPolicy(ctx, strings.Join(policies, ","), entity, isGroup); err != nil {
+			return nil, nil, err
+		}
+		return func(ctx context.Context) error {
+			return client.setPolicy(ctx, previousPolicy, entity, isGroup)
+		}, nil, nil
+
+	case models.IamChangeSetOperationTypeCreateServiceAccount:
+		sa := op.ServiceAccount
+		if sa == nil {
+			sa = &models.ServiceAccountRequestCreds{}
+		}
+		var expiry time.Time
+		if sa.Expiry != "" {
+			expiry, _ = time.Parse(time.RFC3339, sa.Expiry)
+		}
+		creds, err := createAUserServiceAccountCreds(ctx, client, sa.Policy, op.User, sa.AccessKey, sa.SecretKey, sa.Name, sa.Description, &expiry, sa.Comment)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(ctx context.Context) error {
+			return deleteServiceAccount(ctx, client, creds.AccessKey)
+		}, creds, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported type %s", *op.Type)
+}
+
+// applyIAMChangeSet applies the operations in order, when one fails the following ones are skipped
+// and the applied ones are rolled back newest first, every step is reported
+func applyIAMChangeSet(ctx context.Context, client MinioAdmin, ops []*models.IamChangeSetOperation) *models.IamChangeSetResponse {
+	resp := &models.IamChangeSetResponse{Applied: true}
+	var applied []*models.IamChangeSetStep
+	var undos []iamUndo
+	for i, op := range ops {
+		step := &models.IamChangeSetStep{
+			Index:  int64(i),
+			Type:   *op.Type,
+			Target: iamChangeSetTarget(op),
+			Status: models.IamChangeSetStepStatusSkipped,
+		}
+		resp.Steps = append(resp.Steps, step)
+		if !resp.Applied {
+			continue
+		}
+		undo, creds, err := applyIAMOperation(ctx, client, op)
+		if err != nil {
+			step.Status = models.IamChangeSetStepStatusFailed
+			step.Error = err.Error()
+			resp.Applied = false
+			continue
+		}
+		step.Status = models.IamChangeSetStepStatusApplied
+		step.ServiceAccount = creds
+		applied = append(applied, step)
+		undos = append(undos, undo)
+	}
+	if resp.Applied {
+		return resp
+	}
+
+	resp.RolledBack = true
+	rollbackCtx := context.WithoutCancel(ctx)
+	for i := len(undos) - 1; i >= 0; i-- {
+		step := applied[i]
+		if err := undos[i](rollbackCtx); err != nil {
+			step.Status = models.IamChangeSetStepStatusRollbackFailed
+			step.Error = err.Error()
+			resp.RolledBack = false
+			continue
+		}
+		step.Status = models.IamChangeSetStepStatusRolledBack
+		// the service account no longer exists
+		step.ServiceAccount = nil
+	}
+	return resp
+}
+
+func getApplyIAMChangeSetResponse(session *models.Principal, params userApi.ApplyIAMChangeSetParams) (*models.IamChangeSetResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateIAMChangeSet(params.Body.Operations); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	resp := applyIAMChangeSet(ctx, adminClient, params.Body.Operations)
+	if resp.Applied {
+		auditEvent(params.HTTPRequest, session, auditTargetUser, strings.Join(iamChangeSetTargets(params.Body.Operations), ","), nil, params.Body)
+	}
+	return resp, nil
+}
+
+// iamChangeSetTargets returns the users and groups changed by the operations
+func iamChangeSetTargets(ops []*models.IamChangeSetOperation) []string {
+	var targets []string
+	for _, op := range ops {
+		targets = append(targets, iamChangeSetTarget(op))
+	}
+	return UniqueKeys(targets)
+}
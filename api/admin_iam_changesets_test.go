@@ -0,0 +1,237 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// iamChangeSetMocks mocks an IAM with the users added through it and records the changes made
+type iamChangeSetMocks struct {
+	mu    sync.Mutex
+	users map[string]madmin.UserInfo
+	calls []string
+}
+
+func (m *iamChangeSetMocks) record(call string) {
+	m.mu.Lock()
+	m.calls = append(m.calls, call)
+	m.mu.Unlock()
+}
+
+func setIAMChangeSetMocks() *iamChangeSetMocks {
+	m := &iamChangeSetMocks{users: map[string]madmin.UserInfo{}}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		userInfo, ok := m.users[accessKey]
+		if !ok {
+			return madmin.UserInfo{}, errors.New("user does not exist")
+		}
+		return userInfo, nil
+	}
+	minioAddUserMock = func(accessKey, _ string) error {
+		m.mu.Lock()
+		m.users[accessKey] = madmin.UserInfo{Status: madmin.AccountEnabled}
+		m.mu.Unlock()
+		m.record("addUser " + accessKey)
+		return nil
+	}
+	minioRemoveUserMock = func(accessKey string) error {
+		m.record("removeUser " + accessKey)
+		return nil
+	}
+	minioUpdateGroupMembersMock = func(req madmin.GroupAddRemove) error {
+		m.record(fmt.Sprintf("updateGroupMembers %s %v remove=%t", req.Group, req.Members, req.IsRemove))
+		return nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{}, nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, _ bool) error {
+		m.record(fmt.Sprintf("setPolicy %s=%s", entityName, policyName))
+		return nil
+	}
+	minioAddServiceAccountMock = func(_ context.Context, _ string, user string, _ string, _ string, _ string, _ string, _ *time.Time, _ string) (madmin.Credentials, error) {
+		m.record("addServiceAccount " + user)
+		return madmin.Credentials{AccessKey: "sa-" + user, SecretKey: "sa-secret"}, nil
+	}
+	minioDeleteServiceAccountMock = func(_ context.Context, serviceAccount string) error {
+		m.record("deleteServiceAccount " + serviceAccount)
+		return nil
+	}
+	return m
+}
+
+func iamOp(opType string, op models.IamChangeSetOperation) *models.IamChangeSetOperation {
+	op.Type = swag.String(opType)
+	return &op
+}
+
+func stepStatuses(resp *models.IamChangeSetResponse) []string {
+	var statuses []string
+	for _, step := range resp.Steps {
+		statuses = append(statuses, step.Status)
+	}
+	return statuses
+}
+
+func Test_validateIAMChangeSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     []*models.IamChangeSetOperation
+		wantErr string
+	}{
+		{
+			name: "valid",
+			ops: []*models.IamChangeSetOperation{
+				iamOp(models.IamChangeSetOperationTypeCreateUser, models.IamChangeSetOperation{User: "alice", SecretKey: "alice-secret"}),
+				iamOp(models.IamChangeSetOperationTypeAttachPolicies, models.IamChangeSetOperation{Group: "devs", Policies: []string{"readwrite"}}),
+			},
+		},
+		{
+			name:    "create user without secret",
+			ops:     []*models.IamChangeSetOperation{iamOp(models.IamChangeSetOperationTypeCreateUser, models.IamChangeSetOperation{User: "alice"})},
+			wantErr: "operation 0: user and secretKey are required",
+		},
+		{
+			name:    "attach policies to user and group",
+			ops:     []*models.IamChangeSetOperation{iamOp(models.IamChangeSetOperationTypeAttachPolicies, models.IamChangeSetOperation{User: "alice", Group: "devs", Policies: []string{"readwrite"}})},
+			wantErr: "operation 0: either user or group is required",
+		},
+		{
+			name: "service account with invalid expiry",
+			ops: []*models.IamChangeSetOperation{iamOp(models.IamChangeSetOperationTypeCreateServiceAccount, models.IamChangeSetOperation{
+				User: "alice", ServiceAccount: &models.ServiceAccountRequestCreds{Expiry: "tomorrow"},
+			})},
+			wantErr: "operation 0: invalid expiry",
+		},
+		{
+			name:    "unknown type",
+			ops:     []*models.IamChangeSetOperation{iamOp("deleteEverything", models.IamChangeSetOperation{})},
+			wantErr: "operation 0: unsupported type deleteEverything",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIAMChangeSet(tt.ops)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_applyIAMChangeSet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	setIAMChangeSetMocks()
+
+	resp := applyIAMChangeSet(ctx, adminClient, []*models.IamChangeSetOperation{
+		iamOp(models.IamChangeSetOperationTypeCreateUser, models.IamChangeSetOperation{User: "alice", SecretKey: "alice-secret"}),
+		iamOp(models.IamChangeSetOperationTypeSetUserGroups, models.IamChangeSetOperation{User: "alice", Groups: []string{"devs"}}),
+		iamOp(models.IamChangeSetOperationTypeAttachPolicies, models.IamChangeSetOperation{User: "alice", Policies: []string{"readwrite"}}),
+		iamOp(models.IamChangeSetOperationTypeCreateServiceAccount, models.IamChangeSetOperation{User: "alice"}),
+	})
+	assert.True(t, resp.Applied)
+	assert.False(t, resp.RolledBack)
+	assert.Equal(t, []string{"applied", "applied", "applied", "applied"}, stepStatuses(resp))
+	if assert.NotNil(t, resp.Steps[3].ServiceAccount) {
+		assert.Equal(t, "sa-alice", resp.Steps[3].ServiceAccount.AccessKey)
+	}
+}
+
+func Test_applyIAMChangeSetRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	mocks := setIAMChangeSetMocks()
+	minioUpdateGroupMembersMock = func(_ madmin.GroupAddRemove) error {
+		return errors.New("group update failed")
+	}
+
+	resp := applyIAMChangeSet(ctx, adminClient, []*models.IamChangeSetOperation{
+		iamOp(models.IamChangeSetOperationTypeCreateUser, models.IamChangeSetOperation{User: "alice", SecretKey: "alice-secret"}),
+		iamOp(models.IamChangeSetOperationTypeAttachPolicies, models.IamChangeSetOperation{User: "alice", Policies: []string{"readwrite"}}),
+		iamOp(models.IamChangeSetOperationTypeCreateServiceAccount, models.IamChangeSetOperation{User: "alice"}),
+		iamOp(models.IamChangeSetOperationTypeSetGroupMembers, models.IamChangeSetOperation{Group: "devs", Members: []string{"alice"}}),
+		iamOp(models.IamChangeSetOperationTypeCreateUser, models.IamChangeSetOperation{User: "bob", SecretKey: "bob-secret"}),
+	})
+	assert.False(t, resp.Applied)
+	assert.True(t, resp.RolledBack)
+	assert.Equal(t, []string{"rolledBack", "rolledBack", "rolledBack", "failed", "skipped"}, stepStatuses(resp))
+	assert.Equal(t, "group update failed", resp.Steps[3].Error)
+	assert.Nil(t, resp.Steps[2].ServiceAccount)
+	// applied steps are reverted newest first
+	assert.Equal(t, []string{
+		"addUser alice",
+		"setPolicy alice=readwrite",
+		"addServiceAccount alice",
+		"deleteServiceAccount sa-alice",
+		"setPolicy alice=",
+		"removeUser alice",
+	}, mocks.calls)
+
+	// a step that can't be reverted is reported
+	mocks = setIAMChangeSetMocks()
+	minioSetPolicyMock = func(_, _ string, _ bool) error {
+		return errors.New("policy update failed")
+	}
+	minioRemoveUserMock = func(_ string) error {
+		return errors.New("remove failed")
+	}
+	resp = applyIAMChangeSet(ctx, adminClient, []*models.IamChangeSetOperation{
+		iamOp(models.IamChangeSetOperationTypeCreateUser, models.IamChangeSetOperation{User: "alice", SecretKey: "alice-secret"}),
+		iamOp(models.IamChangeSetOperationTypeAttachPolicies, models.IamChangeSetOperation{User: "alice", Policies: []string{"readwrite"}}),
+	})
+	assert.False(t, resp.Applied)
+	assert.False(t, resp.RolledBack)
+	assert.Equal(t, []string{"rollbackFailed", "failed"}, stepStatuses(resp))
+	assert.Equal(t, "remove failed", resp.Steps[0].Error)
+	assert.Equal(t, []string{"addUser alice"}, mocks.calls)
+}
+
+func TestAddUserRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	mocks := setIAMChangeSetMocks()
+	minioSetPolicyMock = func(_, _ string, _ bool) error {
+		return errors.New("policy does not exist")
+	}
+
+	accessKey, secretKey := "alice", "alice-secret"
+	_, err := addUser(ctx, adminClient, &accessKey, &secretKey, nil, []string{"missing"})
+	assert.EqualError(t, err, "policy does not exist")
+	// the user isn't left behind without its policies
+	assert.Equal(t, []string{"addUser alice", "removeUser alice"}, mocks.calls)
+}
@@ -83,10 +83,12 @@ func createIDPConfigurationResponse(session *models.Principal, params idp.Create
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
-	restart, err := createOrUpdateIDPConfig(ctx, params.Type, params.Body.Name, params.Body.Input, false, AdminClient{Client: mAdmin})
+	adminClient := AdminClient{Client: mAdmin}
+	restart, err := createOrUpdateIDPConfig(ctx, params.Type, params.Body.Name, params.Body.Input, false, adminClient)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetIDP, params.Type+":"+params.Body.Name, nil, auditIDPState(ctx, params.Type, params.Body.Name, adminClient))
 	return &models.SetIDPResponse{Restart: restart}, nil
 }
 
@@ -97,10 +99,13 @@ func updateIDPConfigurationResponse(session *models.Principal, params idp.Update
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
-	restart, err := createOrUpdateIDPConfig(ctx, params.Type, params.Name, params.Body.Input, true, AdminClient{Client: mAdmin})
+	adminClient := AdminClient{Client: mAdmin}
+	before := auditIDPState(ctx, params.Type, params.Name, adminClient)
+	restart, err := createOrUpdateIDPConfig(ctx, params.Type, params.Name, params.Body.Input, true, adminClient)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetIDP, params.Type+":"+params.Name, before, auditIDPState(ctx, params.Type, params.Name, adminClient))
 	return &models.SetIDPResponse{Restart: restart}, nil
 }
 
@@ -158,13 +163,31 @@ func deleteIDPConfigurationResponse(session *models.Principal, params idp.Delete
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
-	restart, err := deleteIDPConfig(ctx, params.Type, params.Name, AdminClient{Client: mAdmin})
+	adminClient := AdminClient{Client: mAdmin}
+	before := auditIDPState(ctx, params.Type, params.Name, adminClient)
+	restart, err := deleteIDPConfig(ctx, params.Type, params.Name, adminClient)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetIDP, params.Type+":"+params.Name, before, nil)
 	return &models.SetIDPResponse{Restart: restart}, nil
 }
 
+// auditIDPState returns the configured keys of the identity provider as recorded in audit events
+func auditIDPState(ctx context.Context, idpType, name string, client MinioAdmin) map[string]string {
+	config, err := getIDPConfiguration(ctx, idpType, name, client)
+	if err != nil {
+		return nil
+	}
+	state := map[string]string{}
+	for _, info := range config.Info {
+		if info.IsCfg {
+			state[info.Key] = info.Value
+		}
+	}
+	return state
+}
+
 func deleteIDPConfig(ctx context.Context, idpType, name string, client MinioAdmin) (bool, error) {
 	if !madmin.ValidIDPConfigTypes.Contains(idpType) {
 		return false, errInvalidIDPType
@@ -34,6 +34,7 @@ import (
 	"github.com/minio/console/api/operations"
 	systemApi "github.com/minio/console/api/operations/system"
 	"github.com/minio/console/models"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
 )
 
 func registerAdminInfoHandlers(api *operations.ConsoleAPI) {
@@ -46,8 +47,8 @@ func registerAdminInfoHandlers(api *operations.ConsoleAPI) {
 		return systemApi.NewAdminInfoOK().WithPayload(infoResp)
 	})
 	// return single widget results
-	api.SystemDashboardWidgetDetailsHandler = systemApi.DashboardWidgetDetailsHandlerFunc(func(params systemApi.DashboardWidgetDetailsParams, _ *models.Principal) middleware.Responder {
-		infoResp, err := getAdminInfoWidgetResponse(params)
+	api.SystemDashboardWidgetDetailsHandler = systemApi.DashboardWidgetDetailsHandlerFunc(func(params systemApi.DashboardWidgetDetailsParams, session *models.Principal) middleware.Responder {
+		infoResp, err := getAdminInfoWidgetResponse(params, session)
 		if err != nil {
 			return systemApi.NewDashboardWidgetDetailsDefault(err.Code).WithPayload(err.APIError)
 		}
@@ -879,12 +880,15 @@ func getAdminInfoResponse(session *models.Principal, params systemApi.AdminInfoP
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
 	prometheusURL := ""
+	realtimeMetrics := false
 
 	if !*params.DefaultOnly {
 		promURL := getPrometheusURL()
 		if promURL != "" {
 			prometheusURL = promURL
 		}
+		// without prometheus we fall back to the metrics collected by console
+		realtimeMetrics = prometheusURL == "" && getRealtimeMetricsEnabled()
 	}
 
 	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
@@ -892,7 +896,7 @@ func getAdminInfoResponse(session *models.Principal, params systemApi.AdminInfoP
 		return nil, ErrorWithContext(ctx, err)
 	}
 
-	sessionResp, err2 := getUsageWidgetsForDeployment(ctx, prometheusURL, AdminClient{Client: mAdmin})
+	sessionResp, err2 := getUsageWidgetsForDeployment(ctx, prometheusURL, realtimeMetrics, AdminClient{Client: mAdmin})
 	if err2 != nil {
 		return nil, ErrorWithContext(ctx, err2)
 	}
@@ -900,10 +904,13 @@ func getAdminInfoResponse(session *models.Principal, params systemApi.AdminInfoP
 	return sessionResp, nil
 }
 
-func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, adminClient MinioAdmin) (*models.AdminInfoResponse, error) {
+func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, realtimeMetrics bool, adminClient MinioAdmin) (*models.AdminInfoResponse, error) {
 	prometheusStatus := models.AdminInfoResponseAdvancedMetricsStatusAvailable
 	if prometheusURL == "" {
 		prometheusStatus = models.AdminInfoResponseAdvancedMetricsStatusNotConfigured
+		if realtimeMetrics {
+			prometheusStatus = models.AdminInfoResponseAdvancedMetricsStatusRealtime
+		}
 	}
 	if prometheusURL != "" && !testPrometheusURL(ctx, prometheusURL) {
 		prometheusStatus = models.AdminInfoResponseAdvancedMetricsStatusUnavailable
@@ -949,6 +956,9 @@ func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, adm
 		}
 		sessionResp.Widgets = wdgts
 	}
+	if prometheusStatus == models.AdminInfoResponseAdvancedMetricsStatusRealtime {
+		sessionResp.Widgets = getRealtimeWidgets()
+	}
 
 	// wait for mc admin info
 	err := <-doneCh
@@ -956,6 +966,11 @@ func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, adm
 		return nil, err
 	}
 
+	if prometheusStatus == models.AdminInfoResponseAdvancedMetricsStatusRealtime {
+		// keep collecting realtime metrics while the dashboard is in use
+		globalRealtimeMetrics.EnsureRunning()
+	}
+
 	return sessionResp, nil
 }
 
@@ -1028,10 +1043,27 @@ func testPrometheusURL(ctx context.Context, url string) bool {
 	return response.StatusCode == http.StatusOK
 }
 
-func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams) (*models.WidgetDetails, *CodedAPIError) {
+func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams, session *models.Principal) (*models.WidgetDetails, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
 	prometheusURL := getPrometheusURL()
+	if prometheusURL == "" && getRealtimeMetricsEnabled() {
+		// realtime metrics are collected with the console credentials, only serve them
+		// to users that could read them from MinIO themselves
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.ServerInfoAdminAction); err != nil {
+			return nil, err
+		}
+		return globalRealtimeMetrics.WidgetDetails(params.WidgetID, params.Step, params.Start, params.End)
+	}
+	selector := getPrometheusSelector()
+	clientIP := getClientIP(params.HTTPRequest)
+	ctx = context.WithValue(ctx, utils.ContextClientIP, clientIP)
+	return getWidgetDetails(ctx, prometheusURL, selector, params.WidgetID, params.Step, params.Start, params.End)
+}
+
+// getPrometheusSelector returns the label selector that replaces `$__query` in widget expressions,
+// it's built from the configured prometheus job id and extra labels
+func getPrometheusSelector() string {
 	prometheusJobID := getPrometheusJobID()
 	prometheusExtraLabels := getPrometheusExtraLabels()
 
@@ -1039,12 +1071,22 @@ func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams) (
 	if strings.TrimSpace(prometheusExtraLabels) != "" {
 		selector = fmt.Sprintf(`job="%s",%s`, prometheusJobID, prometheusExtraLabels)
 	}
-	clientIP := getClientIP(params.HTTPRequest)
-	ctx = context.WithValue(ctx, utils.ContextClientIP, clientIP)
-	return getWidgetDetails(ctx, prometheusURL, selector, params.WidgetID, params.Step, params.Start, params.End)
+	return selector
 }
 
+// getWidgetDetails executes one of the built-in dashboard widgets
 func getWidgetDetails(ctx context.Context, prometheusURL string, selector string, widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *CodedAPIError) {
+	for _, m := range widgets {
+		if m.ID == widgetID {
+			return executeWidget(ctx, prometheusURL, selector, m, step, start, end)
+		}
+	}
+	return nil, &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Widget not found"}}
+}
+
+// executeWidget runs all the targets of a widget against prometheus, this works for built-in widgets as well
+// as user defined ones since labels (`$instance`, `$drive`...) and `$__query` are templated the same way
+func executeWidget(ctx context.Context, prometheusURL string, selector string, m Metric, step *int32, start *int64, end *int64) (*models.WidgetDetails, *CodedAPIError) {
 	// We test if prometheus URL is reachable. this is meant to avoid unuseful calls and application hang.
 	if !testPrometheusURL(ctx, prometheusURL) {
 		return nil, ErrorWithContext(ctx, errors.New("prometheus URL is unreachable"))
@@ -1083,110 +1125,100 @@ LabelsWaitLoop:
 		}
 	}
 
-	// launch a goroutines per widget
+	var (
+		wg            sync.WaitGroup
+		targetResults = make([]*models.ResultTarget, len(m.Targets))
+	)
 
-	for _, m := range widgets {
-		if m.ID != widgetID {
-			continue
-		}
-
-		var (
-			wg            sync.WaitGroup
-			targetResults = make([]*models.ResultTarget, len(m.Targets))
-		)
-
-		// for each target we will launch another goroutine to fetch the values
-		for idx, target := range m.Targets {
-			wg.Add(1)
-			go func(idx int, target Target, inStep *int32, inStart *int64, inEnd *int64) {
-				defer wg.Done()
+	// for each target we will launch another goroutine to fetch the values
+	for idx, target := range m.Targets {
+		wg.Add(1)
+		go func(idx int, target Target, inStep *int32, inStart *int64, inEnd *int64) {
+			defer wg.Done()
 
-				apiType := "query_range"
-				now := time.Now()
+			apiType := "query_range"
+			now := time.Now()
 
-				var initTime int64 = -15
+			var initTime int64 = -15
 
-				if target.InitialTime != 0 {
-					initTime = target.InitialTime
-				}
+			if target.InitialTime != 0 {
+				initTime = target.InitialTime
+			}
 
-				timeCalculated := time.Duration(initTime * int64(time.Minute))
+			timeCalculated := time.Duration(initTime * int64(time.Minute))
 
-				extraParamters := fmt.Sprintf("&start=%d&end=%d", now.Add(timeCalculated).Unix(), now.Unix())
+			extraParamters := fmt.Sprintf("&start=%d&end=%d", now.Add(timeCalculated).Unix(), now.Unix())
 
-				var step int32 = 60
-				if target.Step > 0 {
-					step = target.Step
-				}
-				if inStep != nil && *inStep > 0 {
-					step = *inStep
-				}
-				if step > 0 {
-					extraParamters = fmt.Sprintf("%s&step=%d", extraParamters, step)
-				}
+			var step int32 = 60
+			if target.Step > 0 {
+				step = target.Step
+			}
+			if inStep != nil && *inStep > 0 {
+				step = *inStep
+			}
+			if step > 0 {
+				extraParamters = fmt.Sprintf("%s&step=%d", extraParamters, step)
+			}
 
-				if inStart != nil && inEnd != nil {
-					extraParamters = fmt.Sprintf("&start=%d&end=%d&step=%d", *inStart, *inEnd, *inStep)
-				}
+			if inStart != nil && inEnd != nil {
+				extraParamters = fmt.Sprintf("&start=%d&end=%d&step=%d", *inStart, *inEnd, step)
+			}
 
-				// replace the `$__rate_interval` global for step with unit (s for seconds)
-				queryExpr := strings.ReplaceAll(target.Expr, "$__rate_interval", fmt.Sprintf("%ds", 240))
-				if strings.Contains(queryExpr, "$") {
-					re := regexp.MustCompile(`\$([a-z]+)`)
+			// replace the `$__rate_interval` global for step with unit (s for seconds)
+			queryExpr := strings.ReplaceAll(target.Expr, "$__rate_interval", fmt.Sprintf("%ds", 240))
+			if strings.Contains(queryExpr, "$") {
+				re := regexp.MustCompile(`\$([a-z]+)`)
 
-					for _, match := range re.FindAllStringSubmatch(queryExpr, -1) {
-						if val, ok := labelMap[match[1]]; ok {
-							queryExpr = strings.ReplaceAll(queryExpr, "$"+match[1], fmt.Sprintf("(%s)", strings.Join(val, "|")))
-						}
+				for _, match := range re.FindAllStringSubmatch(queryExpr, -1) {
+					if val, ok := labelMap[match[1]]; ok {
+						queryExpr = strings.ReplaceAll(queryExpr, "$"+match[1], fmt.Sprintf("(%s)", strings.Join(val, "|")))
 					}
 				}
+			}
 
-				queryExpr = strings.ReplaceAll(queryExpr, "$__query", selector)
-				endpoint := fmt.Sprintf("%s/api/v1/%s?query=%s%s", prometheusURL, apiType, url.QueryEscape(queryExpr), extraParamters)
+			queryExpr = strings.ReplaceAll(queryExpr, "$__query", selector)
+			endpoint := fmt.Sprintf("%s/api/v1/%s?query=%s%s", prometheusURL, apiType, url.QueryEscape(queryExpr), extraParamters)
 
-				var response PromResp
-				if unmarshalPrometheus(ctx, httpClnt, endpoint, &response) {
-					return
-				}
+			var response PromResp
+			if unmarshalPrometheus(ctx, httpClnt, endpoint, &response) {
+				return
+			}
 
-				targetResult := models.ResultTarget{
-					LegendFormat: target.LegendFormat,
-					ResultType:   response.Data.ResultType,
-				}
+			targetResult := models.ResultTarget{
+				LegendFormat: target.LegendFormat,
+				ResultType:   response.Data.ResultType,
+			}
 
-				for _, r := range response.Data.Result {
-					targetResult.Result = append(targetResult.Result, &models.WidgetResult{
-						Metric: r.Metric,
-						Values: r.Values,
-					})
-				}
+			for _, r := range response.Data.Result {
+				targetResult.Result = append(targetResult.Result, &models.WidgetResult{
+					Metric: r.Metric,
+					Values: r.Values,
+				})
+			}
 
-				targetResults[idx] = &targetResult
-			}(idx, target, step, start, end)
-		}
+			targetResults[idx] = &targetResult
+		}(idx, target, step, start, end)
+	}
 
-		wg.Wait()
+	wg.Wait()
 
-		wdgtResult := models.WidgetDetails{
-			ID:    m.ID,
-			Title: m.Title,
-			Type:  m.Type,
-		}
-		if len(m.Options.ReduceOptions.Calcs) > 0 {
-			wdgtResult.Options = &models.WidgetDetailsOptions{
-				ReduceOptions: &models.WidgetDetailsOptionsReduceOptions{
-					Calcs: m.Options.ReduceOptions.Calcs,
-				},
-			}
+	wdgtResult := models.WidgetDetails{
+		ID:    m.ID,
+		Title: m.Title,
+		Type:  m.Type,
+	}
+	if len(m.Options.ReduceOptions.Calcs) > 0 {
+		wdgtResult.Options = &models.WidgetDetailsOptions{
+			ReduceOptions: &models.WidgetDetailsOptionsReduceOptions{
+				Calcs: m.Options.ReduceOptions.Calcs,
+			},
 		}
+	}
 
-		for _, res := range targetResults {
-			if res != nil {
-				wdgtResult.Targets = append(wdgtResult.Targets, res)
-			}
+	for _, res := range targetResults {
+		if res != nil {
+			wdgtResult.Targets = append(wdgtResult.Targets, res)
 		}
-		return &wdgtResult, nil
 	}
-
-	return nil, &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Widget not found"}}
+	return &wdgtResult, nil
 }
@@ -129,7 +129,7 @@ func (suite *AdminInfoTestSuite) initSystemDashboardWidgetDetailsRequest() (para
 func (suite *AdminInfoTestSuite) TestGetUsageWidgetsForDeploymentWithoutError() {
 	ctx := context.WithValue(context.Background(), utils.ContextClientIP, "127.0.0.1")
 	suite.isPrometheusRequest = true
-	res, err := getUsageWidgetsForDeployment(ctx, suite.server.URL, suite.adminClient)
+	res, err := getUsageWidgetsForDeployment(ctx, suite.server.URL, false, suite.adminClient)
 	suite.assert.Nil(err)
 	suite.assert.NotNil(res)
 	suite.isPrometheusRequest = false
@@ -244,7 +244,11 @@ func GetKMSCreateKeyResponse(session *models.Principal, params kmsAPI.KMSCreateK
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
-	return createKey(ctx, *params.Body.Key, AdminClient{Client: mAdmin})
+	if err := createKey(ctx, *params.Body.Key, AdminClient{Client: mAdmin}); err != nil {
+		return err
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetKMSKey, *params.Body.Key, nil, nil)
+	return nil
 }
 
 func createKey(ctx context.Context, key string, minioClient MinioAdmin) *CodedAPIError {
@@ -265,7 +269,11 @@ func GetKMSImportKeyResponse(session *models.Principal, params kmsAPI.KMSImportK
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
-	return importKey(ctx, params.Name, bytes, AdminClient{Client: mAdmin})
+	if err := importKey(ctx, params.Name, bytes, AdminClient{Client: mAdmin}); err != nil {
+		return err
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetKMSKey, params.Name, nil, nil)
+	return nil
 }
 
 func importKey(ctx context.Context, key string, bytes []byte, minioClient MinioAdmin) *CodedAPIError {
@@ -337,7 +345,11 @@ func GetKMSDeleteKeyResponse(session *models.Principal, params kmsAPI.KMSDeleteK
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
-	return deleteKey(ctx, params.Name, AdminClient{Client: mAdmin})
+	if err := deleteKey(ctx, params.Name, AdminClient{Client: mAdmin}); err != nil {
+		return err
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetKMSKey, params.Name, nil, nil)
+	return nil
 }
 
 func deleteKey(ctx context.Context, key string, minioClient MinioAdmin) *CodedAPIError {
@@ -408,7 +420,11 @@ func GetKMSSetPolicyResponse(session *models.Principal, params kmsAPI.KMSSetPoli
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
-	return setPolicy(ctx, *params.Body.Policy, bytes, AdminClient{Client: mAdmin})
+	if err := setPolicy(ctx, *params.Body.Policy, bytes, AdminClient{Client: mAdmin}); err != nil {
+		return err
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetKMSPolicy, *params.Body.Policy, nil, params.Body)
+	return nil
 }
 
 func setPolicy(ctx context.Context, policy string, content []byte, minioClient MinioAdmin) *CodedAPIError {
@@ -429,7 +445,11 @@ func GetKMSAssignPolicyResponse(session *models.Principal, params kmsAPI.KMSAssi
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
-	return assignPolicy(ctx, params.Name, bytes, AdminClient{Client: mAdmin})
+	if err := assignPolicy(ctx, params.Name, bytes, AdminClient{Client: mAdmin}); err != nil {
+		return err
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetKMSPolicy, params.Name, nil, params.Body)
+	return nil
 }
 
 func assignPolicy(ctx context.Context, policy string, content []byte, minioClient MinioAdmin) *CodedAPIError {
@@ -522,7 +542,11 @@ func GetKMSDeletePolicyResponse(session *models.Principal, params kmsAPI.KMSDele
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
-	return deletePolicy(ctx, params.Name, AdminClient{Client: mAdmin})
+	if err := deletePolicy(ctx, params.Name, AdminClient{Client: mAdmin}); err != nil {
+		return err
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetKMSPolicy, params.Name, nil, nil)
+	return nil
 }
 
 func deletePolicy(ctx context.Context, policy string, minioClient MinioAdmin) *CodedAPIError {
@@ -658,7 +682,11 @@ func GetKMSDeleteIdentityResponse(session *models.Principal, params kmsAPI.KMSDe
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
-	return deleteIdentity(ctx, params.Name, AdminClient{Client: mAdmin})
+	if err := deleteIdentity(ctx, params.Name, AdminClient{Client: mAdmin}); err != nil {
+		return err
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetKMSIdentity, params.Name, nil, nil)
+	return nil
 }
 
 func deleteIdentity(ctx context.Context, identity string, minioClient MinioAdmin) *CodedAPIError {
@@ -0,0 +1,517 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	"github.com/minio/console/api/operations/idp"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+)
+
+const (
+	// ldapSearchTimeLimit is the time limit in seconds of a directory search
+	ldapSearchTimeLimit = 10
+	// maxLDAPSearchLimit caps the entries returned by a directory search
+	maxLDAPSearchLimit = 1000
+	// ldapGroupClasses matches the object classes of groups in common directory schemas
+	ldapGroupClasses = "(|(objectClass=group)(objectClass=groupOfNames)(objectClass=groupOfUniqueNames)(objectClass=posixGroup))"
+)
+
+// ldapLoginAttributeRegexp finds the attribute users log in with in MinIO's user search filter, like
+// uid in (&(objectClass=inetOrgPerson)(uid=%s))
+var ldapLoginAttributeRegexp = regexp.MustCompile(`\(([^()=&|!]+)=%s\)`)
+
+// ldapDirectoryConfig is what the directory browser needs of MinIO's LDAP configuration
+type ldapDirectoryConfig struct {
+	serverAddr         string
+	lookupBindDN       string
+	lookupBindPassword string
+	userBaseDNs        []string
+	userFilter         string
+	groupBaseDNs       []string
+	groupFilter        string
+	tlsSkipVerify      bool
+	serverInsecure     bool
+	startTLS           bool
+}
+
+// newLDAPDirectoryConfig reads MinIO's LDAP configuration, the lookup bind password is taken from
+// CONSOLE_LDAP_LOOKUP_BIND_PASSWORD when set
+func newLDAPDirectoryConfig(info []madmin.IDPCfgInfo) (*ldapDirectoryConfig, error) {
+	values := map[string]string{}
+	for _, i := range info {
+		if i.Value != "" {
+			values[i.Key] = i.Value
+		}
+	}
+	if values["server_addr"] == "" || values["lookup_bind_dn"] == "" {
+		return nil, ErrLDAPNotConfigured
+	}
+	config := &ldapDirectoryConfig{
+		serverAddr:         values["server_addr"],
+		lookupBindDN:       values["lookup_bind_dn"],
+		lookupBindPassword: values["lookup_bind_password"],
+		userBaseDNs:        splitLDAPBaseDNs(values["user_dn_search_base_dn"]),
+		userFilter:         values["user_dn_search_filter"],
+		groupBaseDNs:       splitLDAPBaseDNs(values["group_search_base_dn"]),
+		groupFilter:        values["group_search_filter"],
+		tlsSkipVerify:      values["tls_skip_verify"] == "on",
+		serverInsecure:     values["server_insecure"] == "on",
+		startTLS:           values["server_starttls"] == "on",
+	}
+	if password := getLDAPLookupBindPassword(); password != "" {
+		config.lookupBindPassword = password
+	}
+	if len(config.userBaseDNs) == 0 || config.userFilter == "" {
+		return nil, ErrLDAPNotConfigured
+	}
+	return config, nil
+}
+
+// splitLDAPBaseDNs splits the base DNs of a search, MinIO accepts several separated by semicolons
+func splitLDAPBaseDNs(value string) []string {
+	var baseDNs []string
+	for _, dn := range strings.Split(value, ";") {
+		if dn = strings.TrimSpace(dn); dn != "" {
+			baseDNs = append(baseDNs, dn)
+		}
+	}
+	return baseDNs
+}
+
+// loginAttribute returns the attribute users log in with, empty when the filter doesn't tell
+func (c *ldapDirectoryConfig) loginAttribute() string {
+	if match := ldapLoginAttributeRegexp.FindStringSubmatch(c.userFilter); match != nil {
+		return strings.TrimSpace(match[1])
+	}
+	return ""
+}
+
+// dial connects to the directory and binds as MinIO's lookup user
+func (c *ldapDirectoryConfig) dial() (*ldap.Conn, error) {
+	host, _, err := net.SplitHostPort(c.serverAddr)
+	if err != nil {
+		host = c.serverAddr
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: c.tlsSkipVerify,
+	}
+	var conn *ldap.Conn
+	if c.serverInsecure || c.startTLS {
+		conn, err = ldap.DialURL("ldap://" + c.serverAddr)
+	} else {
+		conn, err = ldap.DialURL("ldaps://"+c.serverAddr, ldap.DialWithTLSConfig(tlsConfig))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.startTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if err = conn.Bind(c.lookupBindDN, c.lookupBindPassword); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// ldapSearcher is the part of an LDAP connection the directory browser uses
+type ldapSearcher interface {
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+// ldapDirectory searches the directory MinIO authenticates LDAP users against
+type ldapDirectory struct {
+	conn   ldapSearcher
+	config *ldapDirectoryConfig
+}
+
+// openLDAPDirectory connects to the directory configured in MinIO
+func openLDAPDirectory(ctx context.Context, client MinioAdmin) (*ldapDirectory, error) {
+	cfg, err := client.getIDPConfig(ctx, madmin.LDAPIDPCfg, madmin.Default)
+	if err != nil {
+		return nil, err
+	}
+	config, err := newLDAPDirectoryConfig(cfg.Info)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := config.dial()
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the LDAP server: %v", err)
+	}
+	return &ldapDirectory{conn: conn, config: config}, nil
+}
+
+func (d *ldapDirectory) close() {
+	d.conn.Close()
+}
+
+// search returns up to limit entries matching filter under every base DN, and whether more match.
+// Without a limit every entry is returned.
+func (d *ldapDirectory) search(baseDNs []string, filter string, attributes []string, limit int) ([]*ldap.Entry, bool, error) {
+	var entries []*ldap.Entry
+	for _, baseDN := range baseDNs {
+		sizeLimit := 0
+		if limit > 0 {
+			if len(entries) >= limit {
+				return entries, true, nil
+			}
+			sizeLimit = limit - len(entries)
+		}
+		req := ldap.NewSearchRequest(baseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, sizeLimit, ldapSearchTimeLimit, false, filter, attributes, nil)
+		result, err := d.conn.Search(req)
+		if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+			return nil, false, err
+		}
+		if result != nil {
+			entries = append(entries, result.Entries...)
+		}
+		if err != nil {
+			return entries, true, nil
+		}
+	}
+	return entries, false, nil
+}
+
+// ldapSearchPattern matches values containing query
+func ldapSearchPattern(query string) string {
+	if query == "" {
+		return "*"
+	}
+	return "*" + ldap.EscapeFilter(query) + "*"
+}
+
+// searchUsers looks for users whose login name contains query with MinIO's user search filter
+func (d *ldapDirectory) searchUsers(query string, limit int) (*models.LdapDirectorySearchResponse, error) {
+	filter := strings.ReplaceAll(d.config.userFilter, "%s", ldapSearchPattern(query))
+	loginAttribute := d.config.loginAttribute()
+	attributes := []string{"cn", "mail"}
+	if loginAttribute != "" {
+		attributes = append(attributes, loginAttribute)
+	}
+	entries, truncated, err := d.search(d.config.userBaseDNs, filter, attributes, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.LdapDirectorySearchResponse{Entries: []*models.LdapDirectoryEntry{}, Truncated: truncated}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &models.LdapDirectoryEntry{
+			Dn:       entry.DN,
+			Name:     entry.GetAttributeValue("cn"),
+			Username: entry.GetAttributeValue(loginAttribute),
+			Email:    entry.GetAttributeValue("mail"),
+		})
+	}
+	return resp, nil
+}
+
+// searchGroups looks for groups whose name contains query
+func (d *ldapDirectory) searchGroups(query string, limit int) (*models.LdapDirectorySearchResponse, error) {
+	resp := &models.LdapDirectorySearchResponse{Entries: []*models.LdapDirectoryEntry{}}
+	if len(d.config.groupBaseDNs) == 0 {
+		return resp, nil
+	}
+	filter := fmt.Sprintf("(&%s(cn=%s))", ldapGroupClasses, ldapSearchPattern(query))
+	entries, truncated, err := d.search(d.config.groupBaseDNs, filter, []string{"cn", "mail"}, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp.Truncated = truncated
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &models.LdapDirectoryEntry{
+			Dn:    entry.DN,
+			Name:  entry.GetAttributeValue("cn"),
+			Email: entry.GetAttributeValue("mail"),
+		})
+	}
+	return resp, nil
+}
+
+// userGroups returns the login name of a user and the DNs of the groups MinIO resolves for it with
+// its group search filter
+func (d *ldapDirectory) userGroups(dn string) (string, []string, error) {
+	loginAttribute := d.config.loginAttribute()
+	var attributes []string
+	if loginAttribute != "" {
+		attributes = []string{loginAttribute}
+	}
+	req := ldap.NewSearchRequest(dn, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 1, ldapSearchTimeLimit, false, "(objectClass=*)", attributes, nil)
+	result, err := d.conn.Search(req)
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) || (err == nil && len(result.Entries) == 0) {
+		return "", nil, ErrLDAPEntryNotFound
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	var username string
+	if loginAttribute != "" {
+		username = result.Entries[0].GetAttributeValue(loginAttribute)
+	}
+	// like uid=alice,ou=people,dc=example,dc=org
+	if username == "" {
+		if parsed, err := ldap.ParseDN(dn); err == nil && len(parsed.RDNs) > 0 && len(parsed.RDNs[0].Attributes) > 0 {
+			username = parsed.RDNs[0].Attributes[0].Value
+		}
+	}
+
+	groups := []string{}
+	if len(d.config.groupBaseDNs) == 0 || d.config.groupFilter == "" {
+		return username, groups, nil
+	}
+	filter := strings.ReplaceAll(d.config.groupFilter, "%d", ldap.EscapeFilter(dn))
+	filter = strings.ReplaceAll(filter, "%s", ldap.EscapeFilter(username))
+	entries, _, err := d.search(d.config.groupBaseDNs, filter, []string{"cn"}, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, entry := range entries {
+		groups = append(groups, entry.DN)
+	}
+	sort.Strings(groups)
+	return username, groups, nil
+}
+
+// getLDAPUserGroups returns the groups of an LDAP user with the policies mapped to the user and to
+// each group
+func getLDAPUserGroups(ctx context.Context, client MinioAdmin, directory *ldapDirectory, dn string) (*models.LdapUserGroupsResponse, error) {
+	username, groups, err := directory.userGroups(dn)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.LdapUserGroupsResponse{
+		Dn:       dn,
+		Username: username,
+		Policies: []string{},
+		Groups:   []*models.LdapGroupPolicyEntity{},
+	}
+	entities, err := client.getLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{Users: []string{dn}, Groups: groups})
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range entities.UserMappings {
+		if strings.EqualFold(mapping.User, dn) {
+			resp.Policies = append(resp.Policies, mapping.Policies...)
+		}
+	}
+	for _, group := range groups {
+		entity := &models.LdapGroupPolicyEntity{Group: group, Policies: []string{}}
+		for _, mapping := range entities.GroupMappings {
+			if strings.EqualFold(mapping.Group, group) {
+				entity.Policies = append(entity.Policies, mapping.Policies...)
+			}
+		}
+		resp.Groups = append(resp.Groups, entity)
+	}
+	return resp, nil
+}
+
+// ldapMappedPolicies returns the policies mapped to an LDAP user or group as recorded in audit events
+func ldapMappedPolicies(ctx context.Context, client MinioAdmin, entityType, dn string) []string {
+	query := madmin.PolicyEntitiesQuery{Users: []string{dn}}
+	if entityType == models.LdapPolicyMappingRequestTypeGroup {
+		query = madmin.PolicyEntitiesQuery{Groups: []string{dn}}
+	}
+	entities, err := client.getLDAPPolicyEntities(ctx, query)
+	if err != nil {
+		return nil
+	}
+	var policies []string
+	for _, mapping := range entities.UserMappings {
+		policies = append(policies, mapping.Policies...)
+	}
+	for _, mapping := range entities.GroupMappings {
+		policies = append(policies, mapping.Policies...)
+	}
+	return policies
+}
+
+// attachLDAPPolicies maps policies to an LDAP user or group, MinIO checks the DN exists in the directory
+func attachLDAPPolicies(ctx context.Context, client MinioAdmin, entityType, dn string, policies []string) (*models.LdapPolicyMappingResponse, error) {
+	req := madmin.PolicyAssociationReq{Policies: policies}
+	switch entityType {
+	case models.LdapPolicyMappingRequestTypeUser:
+		req.User = dn
+	case models.LdapPolicyMappingRequestTypeGroup:
+		req.Group = dn
+	default:
+		return nil, fmt.Errorf("invalid entity type %s", entityType)
+	}
+	resp, err := client.attachPolicyLDAP(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	attached := resp.PoliciesAttached
+	if attached == nil {
+		attached = []string{}
+	}
+	return &models.LdapPolicyMappingResponse{
+		PoliciesAttached: attached,
+		UpdatedAt:        resp.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func registerLDAPDirectoryHandlers(api *operations.ConsoleAPI) {
+	// Search LDAP users
+	api.IdpSearchLDAPUsersHandler = idp.SearchLDAPUsersHandlerFunc(func(params idp.SearchLDAPUsersParams, session *models.Principal) middleware.Responder {
+		resp, err := getSearchLDAPUsersResponse(session, params)
+		if err != nil {
+			return idp.NewSearchLDAPUsersDefault(err.Code).WithPayload(err.APIError)
+		}
+		return idp.NewSearchLDAPUsersOK().WithPayload(resp)
+	})
+	// Search LDAP groups
+	api.IdpSearchLDAPGroupsHandler = idp.SearchLDAPGroupsHandlerFunc(func(params idp.SearchLDAPGroupsParams, session *models.Principal) middleware.Responder {
+		resp, err := getSearchLDAPGroupsResponse(session, params)
+		if err != nil {
+			return idp.NewSearchLDAPGroupsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return idp.NewSearchLDAPGroupsOK().WithPayload(resp)
+	})
+	// Get the groups of an LDAP user
+	api.IdpGetLDAPUserGroupsHandler = idp.GetLDAPUserGroupsHandlerFunc(func(params idp.GetLDAPUserGroupsParams, session *models.Principal) middleware.Responder {
+		resp, err := getLDAPUserGroupsResponse(session, params)
+		if err != nil {
+			return idp.NewGetLDAPUserGroupsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return idp.NewGetLDAPUserGroupsOK().WithPayload(resp)
+	})
+	// Attach policies to an LDAP user or group
+	api.IdpAttachLDAPPoliciesHandler = idp.AttachLDAPPoliciesHandlerFunc(func(params idp.AttachLDAPPoliciesParams, session *models.Principal) middleware.Responder {
+		resp, err := getAttachLDAPPoliciesResponse(session, params)
+		if err != nil {
+			return idp.NewAttachLDAPPoliciesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return idp.NewAttachLDAPPoliciesOK().WithPayload(resp)
+	})
+}
+
+// ldapSearchLimit returns the requested number of entries within bounds
+func ldapSearchLimit(limit *int32) int {
+	if limit == nil || *limit <= 0 {
+		return 50
+	}
+	if *limit > maxLDAPSearchLimit {
+		return maxLDAPSearchLimit
+	}
+	return int(*limit)
+}
+
+func getSearchLDAPUsersResponse(session *models.Principal, params idp.SearchLDAPUsersParams) (*models.LdapDirectorySearchResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	directory, err := openLDAPDirectory(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	defer directory.close()
+	var query string
+	if params.Query != nil {
+		query = *params.Query
+	}
+	resp, err := directory.searchUsers(query, ldapSearchLimit(params.Limit))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+func getSearchLDAPGroupsResponse(session *models.Principal, params idp.SearchLDAPGroupsParams) (*models.LdapDirectorySearchResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	directory, err := openLDAPDirectory(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	defer directory.close()
+	var query string
+	if params.Query != nil {
+		query = *params.Query
+	}
+	resp, err := directory.searchGroups(query, ldapSearchLimit(params.Limit))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+func getLDAPUserGroupsResponse(session *models.Principal, params idp.GetLDAPUserGroupsParams) (*models.LdapUserGroupsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	directory, err := openLDAPDirectory(ctx, adminClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	defer directory.close()
+	resp, err := getLDAPUserGroups(ctx, adminClient, directory, params.Dn)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+func getAttachLDAPPoliciesResponse(session *models.Principal, params idp.AttachLDAPPoliciesParams) (*models.LdapPolicyMappingResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	entityType, dn := *params.Body.Type, *params.Body.Dn
+	before := ldapMappedPolicies(ctx, adminClient, entityType, dn)
+	resp, err := attachLDAPPolicies(ctx, adminClient, entityType, dn, params.Body.Policies)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	target := auditTargetUser
+	if entityType == models.LdapPolicyMappingRequestTypeGroup {
+		target = auditTargetGroup
+	}
+	auditEvent(params.HTTPRequest, session, target, dn, before, ldapMappedPolicies(ctx, adminClient, entityType, dn))
+	return resp, nil
+}
@@ -0,0 +1,194 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// ldapSearcherMock records the searches and answers them with search
+type ldapSearcherMock struct {
+	requests []*ldap.SearchRequest
+	search   func(req *ldap.SearchRequest) (*ldap.SearchResult, error)
+}
+
+func (m *ldapSearcherMock) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	m.requests = append(m.requests, req)
+	return m.search(req)
+}
+
+func (m *ldapSearcherMock) Close() error {
+	return nil
+}
+
+var testLDAPDirectoryConfig = &ldapDirectoryConfig{
+	userBaseDNs:  []string{"ou=people,dc=example,dc=org", "ou=contractors,dc=example,dc=org"},
+	userFilter:   "(&(objectClass=inetOrgPerson)(uid=%s))",
+	groupBaseDNs: []string{"ou=groups,dc=example,dc=org"},
+	groupFilter:  "(&(objectClass=groupOfNames)(member=%d))",
+}
+
+func Test_newLDAPDirectoryConfig(t *testing.T) {
+	info := []madmin.IDPCfgInfo{
+		{Key: "server_addr", Value: "ldap.example.org:636", IsCfg: true},
+		{Key: "lookup_bind_dn", Value: "cn=admin,dc=example,dc=org", IsCfg: true},
+		{Key: "lookup_bind_password", Value: "", IsCfg: true},
+		{Key: "user_dn_search_base_dn", Value: "ou=people,dc=example,dc=org; ou=contractors,dc=example,dc=org", IsCfg: true},
+		{Key: "user_dn_search_filter", Value: "(uid=%s)", IsCfg: true},
+		{Key: "server_starttls", Value: "on", IsCfg: true},
+	}
+	t.Setenv(ConsoleLDAPLookupBindPassword, "secret")
+	config, err := newLDAPDirectoryConfig(info)
+	assert.NoError(t, err)
+	assert.Equal(t, "ldap.example.org:636", config.serverAddr)
+	assert.Equal(t, "secret", config.lookupBindPassword)
+	assert.Equal(t, []string{"ou=people,dc=example,dc=org", "ou=contractors,dc=example,dc=org"}, config.userBaseDNs)
+	assert.Empty(t, config.groupBaseDNs)
+	assert.True(t, config.startTLS)
+	assert.False(t, config.serverInsecure)
+	assert.Equal(t, "uid", config.loginAttribute())
+
+	_, err = newLDAPDirectoryConfig(info[1:])
+	assert.ErrorIs(t, err, ErrLDAPNotConfigured)
+}
+
+func Test_ldapDirectorySearch(t *testing.T) {
+	conn := &ldapSearcherMock{}
+	directory := &ldapDirectory{conn: conn, config: testLDAPDirectoryConfig}
+
+	// the first base DN has more users than the limit
+	conn.search = func(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+		return &ldap.SearchResult{Entries: []*ldap.Entry{
+			ldap.NewEntry("uid=alice,ou=people,dc=example,dc=org", map[string][]string{"cn": {"Alice"}, "uid": {"alice"}, "mail": {"alice@example.org"}}),
+		}}, ldap.NewError(ldap.LDAPResultSizeLimitExceeded, errors.New("size limit exceeded"))
+	}
+	resp, err := directory.searchUsers("al*", 1)
+	assert.NoError(t, err)
+	assert.True(t, resp.Truncated)
+	assert.Equal(t, []*models.LdapDirectoryEntry{{Dn: "uid=alice,ou=people,dc=example,dc=org", Name: "Alice", Username: "alice", Email: "alice@example.org"}}, resp.Entries)
+	if assert.Len(t, conn.requests, 1) {
+		assert.Equal(t, `(&(objectClass=inetOrgPerson)(uid=*al\2a*))`, conn.requests[0].Filter)
+		assert.Equal(t, 1, conn.requests[0].SizeLimit)
+		assert.Equal(t, []string{"cn", "mail", "uid"}, conn.requests[0].Attributes)
+	}
+
+	// every base DN is searched
+	conn.requests = nil
+	conn.search = func(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+		return &ldap.SearchResult{Entries: []*ldap.Entry{ldap.NewEntry("uid=x,"+req.BaseDN, nil)}}, nil
+	}
+	resp, err = directory.searchUsers("", 50)
+	assert.NoError(t, err)
+	assert.False(t, resp.Truncated)
+	assert.Len(t, resp.Entries, 2)
+	assert.Equal(t, "(&(objectClass=inetOrgPerson)(uid=*))", conn.requests[1].Filter)
+	assert.Equal(t, 49, conn.requests[1].SizeLimit)
+
+	conn.requests = nil
+	resp, err = directory.searchGroups("eng", 10)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Entries, 1)
+	assert.Equal(t, "(&"+ldapGroupClasses+"(cn=*eng*))", conn.requests[0].Filter)
+
+	conn.search = func(_ *ldap.SearchRequest) (*ldap.SearchResult, error) {
+		return nil, ldap.NewError(ldap.LDAPResultInsufficientAccessRights, errors.New("insufficient access"))
+	}
+	_, err = directory.searchUsers("alice", 10)
+	assert.Error(t, err)
+}
+
+func Test_getLDAPUserGroups(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	conn := &ldapSearcherMock{}
+	directory := &ldapDirectory{conn: conn, config: testLDAPDirectoryConfig}
+	dn := "uid=alice,ou=people,dc=example,dc=org"
+
+	conn.search = func(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+		if req.Scope == ldap.ScopeBaseObject {
+			return &ldap.SearchResult{Entries: []*ldap.Entry{ldap.NewEntry(dn, map[string][]string{"uid": {"alice"}})}}, nil
+		}
+		return &ldap.SearchResult{Entries: []*ldap.Entry{
+			ldap.NewEntry("cn=photographers,ou=groups,dc=example,dc=org", nil),
+			ldap.NewEntry("cn=editors,ou=groups,dc=example,dc=org", nil),
+		}}, nil
+	}
+	var query madmin.PolicyEntitiesQuery
+	minioGetLDAPPolicyEntitiesMock = func(_ context.Context, q madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
+		query = q
+		return madmin.PolicyEntitiesResult{
+			UserMappings:  []madmin.UserPolicyEntities{{User: dn, Policies: []string{"readonly"}}},
+			GroupMappings: []madmin.GroupPolicyEntities{{Group: "cn=photographers,ou=groups,dc=example,dc=org", Policies: []string{"photos-read"}}},
+		}, nil
+	}
+
+	resp, err := getLDAPUserGroups(ctx, adminClient, directory, dn)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", resp.Username)
+	assert.Equal(t, []string{"readonly"}, resp.Policies)
+	assert.Equal(t, []*models.LdapGroupPolicyEntity{
+		{Group: "cn=editors,ou=groups,dc=example,dc=org", Policies: []string{}},
+		{Group: "cn=photographers,ou=groups,dc=example,dc=org", Policies: []string{"photos-read"}},
+	}, resp.Groups)
+	assert.Equal(t, `(&(objectClass=groupOfNames)(member=uid=alice,ou=people,dc=example,dc=org))`, conn.requests[1].Filter)
+	assert.Equal(t, []string{dn}, query.Users)
+	assert.Len(t, query.Groups, 2)
+
+	conn.search = func(_ *ldap.SearchRequest) (*ldap.SearchResult, error) {
+		return nil, ldap.NewError(ldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+	_, err = getLDAPUserGroups(ctx, adminClient, directory, "uid=nobody,ou=people,dc=example,dc=org")
+	assert.ErrorIs(t, err, ErrLDAPEntryNotFound)
+}
+
+func Test_attachLDAPPolicies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	updatedAt := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	var req madmin.PolicyAssociationReq
+	minioAttachPolicyLDAPMock = func(_ context.Context, r madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+		req = r
+		return madmin.PolicyAssociationResp{PoliciesAttached: r.Policies, UpdatedAt: updatedAt}, nil
+	}
+	resp, err := attachLDAPPolicies(ctx, adminClient, models.LdapPolicyMappingRequestTypeGroup, "cn=photographers,ou=groups,dc=example,dc=org", []string{"photos-read"})
+	assert.NoError(t, err)
+	assert.Equal(t, madmin.PolicyAssociationReq{Policies: []string{"photos-read"}, Group: "cn=photographers,ou=groups,dc=example,dc=org"}, req)
+	assert.Equal(t, []string{"photos-read"}, resp.PoliciesAttached)
+	assert.Equal(t, "2024-03-10T12:00:00Z", resp.UpdatedAt)
+
+	_, err = attachLDAPPolicies(ctx, adminClient, models.LdapPolicyMappingRequestTypeUser, "uid=alice,ou=people,dc=example,dc=org", []string{"readonly"})
+	assert.NoError(t, err)
+	assert.Equal(t, "uid=alice,ou=people,dc=example,dc=org", req.User)
+	assert.Empty(t, req.Group)
+
+	minioAttachPolicyLDAPMock = func(_ context.Context, _ madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+		return madmin.PolicyAssociationResp{}, errors.New("LDAP user does not exist")
+	}
+	_, err = attachLDAPPolicies(ctx, adminClient, models.LdapPolicyMappingRequestTypeUser, "uid=nobody,ou=people,dc=example,dc=org", []string{"readonly"})
+	assert.EqualError(t, err, "LDAP user does not exist")
+}
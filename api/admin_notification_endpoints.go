@@ -19,6 +19,7 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/api/operations"
@@ -158,5 +159,6 @@ func getAddNotificationEndpointResponse(session *models.Principal, params config
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetConfig, fmt.Sprintf("notify_%s:%s", *params.Body.Service, *params.Body.AccountID), nil, params.Body)
 	return notfEndpointResp, nil
 }
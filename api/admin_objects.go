@@ -37,6 +37,12 @@ type ObjectsRequest struct {
 	Prefix     string `json:"prefix"`
 	Date       string `json:"date"`
 	RequestID  int64  `json:"request_id"`
+	// Search holds the filters of a `search` request
+	Search *ObjectsSearchRequest `json:"search,omitempty"`
+	// Edit holds the changes of a `bulk-edit` request, DryRun only counts the objects to be edited
+	// or previews the actions of a `restore` request
+	Edit   *ObjectsBulkEditRequest `json:"edit,omitempty"`
+	DryRun bool                    `json:"dry_run,omitempty"`
 }
 
 type WSResponse struct {
@@ -46,6 +52,13 @@ type WSResponse struct {
 	Prefix     string           `json:"prefix,omitempty"`
 	BucketName string           `json:"bucketName,omitempty"`
 	Data       []ObjectResponse `json:"data,omitempty"`
+	// bulk-edit results, Matched and Failed are sent along with the request end
+	BulkResults []ObjectBulkEditResult `json:"bulk_results,omitempty"`
+	Matched     int64                  `json:"matched,omitempty"`
+	Failed      int64                  `json:"failed,omitempty"`
+	// restore actions, the report is sent along with the request end
+	RestoreResults []ObjectRestoreResult `json:"restore_results,omitempty"`
+	RestoreReport  *ObjectsRestoreReport `json:"restore_report,omitempty"`
 }
 
 type ObjectResponse struct {
@@ -55,6 +68,10 @@ type ObjectResponse struct {
 	VersionID    string `json:"version_id,omitempty"`
 	DeleteMarker bool   `json:"delete_flag,omitempty"`
 	IsLatest     bool   `json:"is_latest,omitempty"`
+	// set for search matches when the search filters on content type, metadata or tags
+	ContentType  string            `json:"content_type,omitempty"`
+	UserMetadata map[string]string `json:"user_metadata,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
 }
 
 func getObjectsOptionsFromReq(request ObjectsRequest) (*objectsListOpts, error) {
@@ -76,7 +93,7 @@ func getObjectsOptionsFromReq(request ObjectsRequest) (*objectsListOpts, error)
 		pOptions.Prefix = string(decodedPrefix)
 	}
 
-	if request.Mode == "rewind" {
+	if request.Mode == "rewind" || request.Mode == "restore" {
 		parsedDate, errDate := time.Parse(time.RFC3339, request.Date)
 
 		if errDate != nil {
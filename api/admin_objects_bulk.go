@@ -0,0 +1,273 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	bulkTagsAdd     = "add"
+	bulkTagsRemove  = "remove"
+	bulkTagsReplace = "replace"
+)
+
+// bulkEditResultsPerBatch is the number of per object results sent on each websocket message
+const bulkEditResultsPerBatch = 100
+
+// bulkCopiedHeaders are the standard headers kept when the metadata of an object is replaced
+var bulkCopiedHeaders = []string{"Content-Type", "Cache-Control", "Content-Encoding", "Content-Disposition", "Content-Language", "Expires"}
+
+// ObjectsBulkTagsEdit describes how tags are changed, `remove` only uses the keys of Tags
+type ObjectsBulkTagsEdit struct {
+	Mode string            `json:"mode"`
+	Tags map[string]string `json:"tags"`
+}
+
+// ObjectsBulkEditRequest holds the changes of a `bulk-edit` request, only the fields set are changed
+type ObjectsBulkEditRequest struct {
+	Tags         *ObjectsBulkTagsEdit `json:"tags,omitempty"`
+	ContentType  *string              `json:"content_type,omitempty"`
+	CacheControl *string              `json:"cache_control,omitempty"`
+	// Metadata sets user metadata, keys with an empty value are removed
+	Metadata  map[string]string                 `json:"metadata,omitempty"`
+	Retention *models.PutObjectRetentionRequest `json:"retention,omitempty"`
+	LegalHold models.ObjectLegalHoldStatus      `json:"legal_hold,omitempty"`
+}
+
+// ObjectBulkEditResult is the outcome of a bulk edit for a single object
+type ObjectBulkEditResult struct {
+	Name      string `json:"name"`
+	VersionID string `json:"version_id,omitempty"`
+	// NewVersionID is the version created when the metadata was replaced
+	NewVersionID string `json:"new_version_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+func (e *ObjectsBulkEditRequest) replacesMetadata() bool {
+	return e.ContentType != nil || e.CacheControl != nil || len(e.Metadata) > 0
+}
+
+func validateObjectsBulkEdit(edit *ObjectsBulkEditRequest) error {
+	if edit == nil {
+		return errors.New("bulk edit changes are required")
+	}
+	if edit.Tags == nil && !edit.replacesMetadata() && edit.Retention == nil && edit.LegalHold == "" {
+		return errors.New("no changes requested")
+	}
+	if edit.Tags != nil {
+		switch edit.Tags.Mode {
+		case bulkTagsAdd, bulkTagsRemove, bulkTagsReplace:
+		default:
+			return fmt.Errorf("invalid tags mode %q", edit.Tags.Mode)
+		}
+	}
+	if edit.Retention != nil {
+		if err := edit.Retention.Validate(nil); err != nil {
+			return err
+		}
+		if _, err := time.Parse(time.RFC3339, *edit.Retention.Expires); err != nil {
+			return err
+		}
+	}
+	if edit.LegalHold != "" {
+		if err := edit.LegalHold.Validate(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getObjectsBulkEditOptionsFromReq returns the objects selection of a bulk edit, objects under the
+// prefix are all edited unless search filters are given. Only the latest version of the objects is
+// edited, replacing the metadata of an older version would make it the latest one.
+func getObjectsBulkEditOptionsFromReq(request ObjectsRequest) (*objectsSearchOpts, error) {
+	if err := validateObjectsBulkEdit(request.Edit); err != nil {
+		return nil, err
+	}
+	if request.Search == nil {
+		request.Search = &ObjectsSearchRequest{}
+	}
+	if request.Search.Versions {
+		return nil, errors.New("bulk edits only apply to the latest version of the objects")
+	}
+	return getObjectsSearchOptionsFromReq(request)
+}
+
+// editedTags returns the object tags once the edit is applied
+func editedTags(current map[string]string, edit *ObjectsBulkTagsEdit) map[string]string {
+	result := map[string]string{}
+	if edit.Mode != bulkTagsReplace {
+		for k, v := range current {
+			result[k] = v
+		}
+	}
+	for k, v := range edit.Tags {
+		if edit.Mode == bulkTagsRemove {
+			delete(result, k)
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// editedMetadata returns the metadata of the object once the edit is applied, including the standard
+// headers that would otherwise be lost when replacing the metadata
+func editedMetadata(stat minio.ObjectInfo, edit *ObjectsBulkEditRequest) map[string]string {
+	metadata := map[string]string{}
+	for _, header := range bulkCopiedHeaders {
+		if v := stat.Metadata.Get(header); v != "" {
+			metadata[header] = v
+		}
+	}
+	for k, v := range stat.UserMetadata {
+		metadata[k] = v
+	}
+	if edit.ContentType != nil {
+		metadata["Content-Type"] = *edit.ContentType
+	}
+	if edit.CacheControl != nil {
+		metadata["Cache-Control"] = *edit.CacheControl
+	}
+	for k, v := range edit.Metadata {
+		key := normalizeUserMetadataKey(k)
+		for existing := range metadata {
+			if strings.EqualFold(existing, key) {
+				delete(metadata, existing)
+			}
+		}
+		if v != "" {
+			metadata[key] = v
+		}
+	}
+	for k, v := range metadata {
+		if v == "" {
+			delete(metadata, k)
+		}
+	}
+	return metadata
+}
+
+// bulkEditObject applies the edit to a single object, metadata is replaced first through a copy so
+// tags, retention and legal hold are set on the resulting version
+func bulkEditObject(ctx context.Context, client MinioClient, bucketName string, obj minio.ObjectInfo, edit *ObjectsBulkEditRequest) ObjectBulkEditResult {
+	result := ObjectBulkEditResult{Name: obj.Key, VersionID: obj.VersionID}
+	versionID := obj.VersionID
+
+	if edit.replacesMetadata() {
+		stat, err := client.statObject(ctx, bucketName, obj.Key, minio.GetObjectOptions{VersionID: versionID})
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		info, err := client.copyObject(ctx, minio.CopyDestOptions{
+			Bucket:          bucketName,
+			Object:          obj.Key,
+			UserMetadata:    editedMetadata(stat, edit),
+			ReplaceMetadata: true,
+		}, minio.CopySrcOptions{
+			Bucket:    bucketName,
+			Object:    obj.Key,
+			VersionID: versionID,
+		})
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		versionID = info.VersionID
+		result.NewVersionID = info.VersionID
+	}
+
+	if edit.Tags != nil {
+		currentTags := map[string]string{}
+		if edit.Tags.Mode != bulkTagsReplace {
+			otags, err := client.getObjectTagging(ctx, bucketName, obj.Key, minio.GetObjectTaggingOptions{VersionID: versionID})
+			if err != nil {
+				result.Error = err.Error()
+				return result
+			}
+			currentTags = otags.ToMap()
+		}
+		if err := putObjectTags(ctx, client, bucketName, obj.Key, versionID, editedTags(currentTags, edit.Tags)); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+
+	if edit.Retention != nil {
+		if err := setObjectRetention(ctx, client, bucketName, versionID, obj.Key, edit.Retention); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+
+	if edit.LegalHold != "" {
+		if err := setObjectLegalHold(ctx, client, bucketName, obj.Key, versionID, edit.LegalHold); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+	return result
+}
+
+// startObjectsBulkEdit edits every object matching the selection and returns the per object results,
+// delete markers can't be edited and are skipped
+func startObjectsBulkEdit(ctx context.Context, client MinioClient, opts *objectsSearchOpts, edit *ObjectsBulkEditRequest) <-chan ObjectBulkEditResult {
+	resultCh := make(chan ObjectBulkEditResult)
+	go func() {
+		defer close(resultCh)
+		for obj := range startObjectsSearch(ctx, client, opts) {
+			if obj.IsDeleteMarker {
+				continue
+			}
+			var result ObjectBulkEditResult
+			if obj.Err != nil {
+				result = ObjectBulkEditResult{Error: obj.Err.Error()}
+			} else {
+				result = bulkEditObject(ctx, client, opts.BucketName, obj, edit)
+			}
+			select {
+			case resultCh <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return resultCh
+}
+
+// countObjectsBulkEdit returns the number of objects a bulk edit would change
+func countObjectsBulkEdit(ctx context.Context, client MinioClient, opts *objectsSearchOpts) (int64, error) {
+	var count int64
+	for obj := range startObjectsSearch(ctx, client, opts) {
+		if obj.Err != nil {
+			return count, obj.Err
+		}
+		if !obj.IsDeleteMarker {
+			count++
+		}
+	}
+	return count, nil
+}
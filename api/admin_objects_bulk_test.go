@@ -0,0 +1,160 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateObjectsBulkEdit(t *testing.T) {
+	assert := assert.New(t)
+	contentType := "text/plain"
+	mode := models.ObjectRetentionModeGovernance
+	expires := "2030-01-01T00:00:00Z"
+	badExpires := "tomorrow"
+
+	assert.Nil(validateObjectsBulkEdit(&ObjectsBulkEditRequest{ContentType: &contentType}))
+	assert.Nil(validateObjectsBulkEdit(&ObjectsBulkEditRequest{Retention: &models.PutObjectRetentionRequest{Mode: &mode, Expires: &expires}}))
+	assert.Nil(validateObjectsBulkEdit(&ObjectsBulkEditRequest{LegalHold: models.ObjectLegalHoldStatusEnabled}))
+
+	for _, edit := range []*ObjectsBulkEditRequest{
+		nil,
+		{},
+		{Tags: &ObjectsBulkTagsEdit{Mode: "merge"}},
+		{Retention: &models.PutObjectRetentionRequest{Mode: &mode}},
+		{Retention: &models.PutObjectRetentionRequest{Mode: &mode, Expires: &badExpires}},
+		{LegalHold: "maybe"},
+	} {
+		assert.NotNil(validateObjectsBulkEdit(edit))
+	}
+}
+
+func TestGetObjectsBulkEditOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+	contentType := "text/plain"
+	request := ObjectsRequest{BucketName: "bucket", Prefix: "bG9ncy8=", Edit: &ObjectsBulkEditRequest{ContentType: &contentType}}
+
+	opts, err := getObjectsBulkEditOptionsFromReq(request)
+	assert.Nil(err)
+	assert.Equal("bucket", opts.BucketName)
+	assert.Equal("logs/", opts.Prefix)
+	assert.False(opts.Versions)
+
+	// older versions can't be edited
+	request.Search = &ObjectsSearchRequest{Versions: true}
+	_, err = getObjectsBulkEditOptionsFromReq(request)
+	assert.NotNil(err)
+}
+
+func TestEditedTagsAndMetadata(t *testing.T) {
+	assert := assert.New(t)
+	current := map[string]string{"team": "a", "env": "dev"}
+
+	assert.Equal(map[string]string{"team": "b", "env": "dev", "new": "1"},
+		editedTags(current, &ObjectsBulkTagsEdit{Mode: bulkTagsAdd, Tags: map[string]string{"team": "b", "new": "1"}}))
+	assert.Equal(map[string]string{"env": "dev"},
+		editedTags(current, &ObjectsBulkTagsEdit{Mode: bulkTagsRemove, Tags: map[string]string{"team": ""}}))
+	assert.Equal(map[string]string{"new": "1"},
+		editedTags(current, &ObjectsBulkTagsEdit{Mode: bulkTagsReplace, Tags: map[string]string{"new": "1"}}))
+
+	stat := minio.ObjectInfo{
+		Metadata:     http.Header{"Content-Type": {"text/plain"}, "Content-Disposition": {"inline"}, "Etag": {"abc"}},
+		UserMetadata: map[string]string{"Camera": "x100", "Owner": "alice"},
+	}
+	cacheControl := "max-age=3600"
+	metadata := editedMetadata(stat, &ObjectsBulkEditRequest{
+		CacheControl: &cacheControl,
+		Metadata:     map[string]string{"X-Amz-Meta-Camera": "", "lens": "35mm"},
+	})
+	assert.Equal(map[string]string{
+		"Content-Type":        "text/plain",
+		"Content-Disposition": "inline",
+		"Cache-Control":       "max-age=3600",
+		"Owner":               "alice",
+		"lens":                "35mm",
+	}, metadata)
+}
+
+func TestStartObjectsBulkEdit(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	contentType := "image/png"
+
+	minioListObjectsMock = func(_ context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.True(opts.Recursive)
+		ch := make(chan minio.ObjectInfo, 3)
+		ch <- minio.ObjectInfo{Key: "img/a.png", VersionID: "v1"}
+		ch <- minio.ObjectInfo{Key: "img/b.png", IsDeleteMarker: true}
+		ch <- minio.ObjectInfo{Key: "img/c.png"}
+		close(ch)
+		return ch
+	}
+	minioStatObjectMock = func(_ context.Context, _, _ string, _ minio.GetObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{Metadata: http.Header{"Content-Type": {"binary/octet-stream"}}}, nil
+	}
+	var copied []minio.CopyDestOptions
+	minioCopyObjectMock = func(_ context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		if src.Object == "img/c.png" {
+			return minio.UploadInfo{}, errors.New("access denied")
+		}
+		copied = append(copied, dst)
+		return minio.UploadInfo{VersionID: "v2"}, nil
+	}
+	minioGetObjectTaggingMock = func(_ context.Context, _, _ string, _ minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		return tags.MapToObjectTags(map[string]string{"team": "a"})
+	}
+	var taggedVersion string
+	var tagged map[string]string
+	minioPutObjectTaggingMock = func(_ context.Context, _, _ string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error {
+		taggedVersion = opts.VersionID
+		tagged = otags.ToMap()
+		return nil
+	}
+
+	edit := &ObjectsBulkEditRequest{
+		ContentType: &contentType,
+		Tags:        &ObjectsBulkTagsEdit{Mode: bulkTagsAdd, Tags: map[string]string{"env": "prod"}},
+	}
+	opts := &objectsSearchOpts{BucketName: "media", Prefix: "img/"}
+	var results []ObjectBulkEditResult
+	for result := range startObjectsBulkEdit(ctx, client, opts, edit) {
+		results = append(results, result)
+	}
+
+	assert.Len(results, 2)
+	assert.Equal(ObjectBulkEditResult{Name: "img/a.png", VersionID: "v1", NewVersionID: "v2"}, results[0])
+	assert.Equal("access denied", results[1].Error)
+	assert.Len(copied, 1)
+	assert.True(copied[0].ReplaceMetadata)
+	assert.Equal("image/png", copied[0].UserMetadata["Content-Type"])
+	// tags are set on the version created by the copy
+	assert.Equal("v2", taggedVersion)
+	assert.Equal(map[string]string{"team": "a", "env": "prod"}, tagged)
+
+	count, err := countObjectsBulkEdit(ctx, client, opts)
+	assert.Nil(err)
+	assert.Equal(int64(2), count)
+}
@@ -0,0 +1,202 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// restoreActionRestore copies the version current at the restore date over the latest one
+	restoreActionRestore = "restore"
+	// restoreActionDelete adds a delete marker to objects created after the restore date
+	restoreActionDelete = "delete"
+	// restoreActionUnchanged is used for objects already matching the restore date
+	restoreActionUnchanged = "unchanged"
+)
+
+// errRestoreVersioningNotEnabled is returned when restoring a bucket without versioning enabled, older
+// versions aren't kept while versioning is suspended so a restore could lose data
+var errRestoreVersioningNotEnabled = errors.New("versioning must be enabled on the bucket to restore objects to a date")
+
+// restoreResultsPerBatch is the number of restore results sent on each websocket message
+const restoreResultsPerBatch = 100
+
+// ObjectRestoreResult is the action taken, or to be taken on a preview, for a single object
+type ObjectRestoreResult struct {
+	Name   string `json:"name"`
+	Action string `json:"action"`
+	// VersionID is the version being restored, empty for deletions
+	VersionID    string `json:"version_id,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	// NewVersionID is the version created by the restore, either a copy or a delete marker
+	NewVersionID string `json:"new_version_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// ObjectsRestoreReport summarizes a restore or its preview
+type ObjectsRestoreReport struct {
+	Date      string `json:"date"`
+	DryRun    bool   `json:"dry_run,omitempty"`
+	Restored  int64  `json:"restored"`
+	Deleted   int64  `json:"deleted"`
+	Unchanged int64  `json:"unchanged"`
+	Failed    int64  `json:"failed"`
+}
+
+func (r *ObjectsRestoreReport) add(result ObjectRestoreResult) {
+	switch {
+	case result.Error != "":
+		r.Failed++
+	case result.Action == restoreActionRestore:
+		r.Restored++
+	case result.Action == restoreActionDelete:
+		r.Deleted++
+	default:
+		r.Unchanged++
+	}
+}
+
+// planObjectRestore returns the action needed for the object to look like it did at date, versions are
+// all the versions of the object, newest first as returned by the listing
+func planObjectRestore(versions []minio.ObjectInfo, date time.Time) (string, minio.ObjectInfo) {
+	latest := versions[0]
+	for _, v := range versions {
+		if v.IsLatest {
+			latest = v
+			break
+		}
+	}
+	var target *minio.ObjectInfo
+	for i := range versions {
+		if versions[i].LastModified.After(date) {
+			continue
+		}
+		if target == nil || versions[i].LastModified.After(target.LastModified) {
+			target = &versions[i]
+		}
+	}
+
+	if target != nil && !target.IsDeleteMarker {
+		if target.VersionID == latest.VersionID {
+			return restoreActionUnchanged, *target
+		}
+		return restoreActionRestore, *target
+	}
+	// the object didn't exist at date
+	if !latest.IsDeleteMarker {
+		return restoreActionDelete, latest
+	}
+	return restoreActionUnchanged, latest
+}
+
+func restoreObjectToDate(ctx context.Context, client MinioClient, bucketName string, versions []minio.ObjectInfo, date time.Time, dryRun bool) ObjectRestoreResult {
+	action, version := planObjectRestore(versions, date)
+	result := ObjectRestoreResult{Name: version.Key, Action: action}
+	if action == restoreActionRestore {
+		result.VersionID = version.VersionID
+		result.LastModified = version.LastModified.Format(time.RFC3339)
+	}
+	if dryRun {
+		return result
+	}
+
+	switch action {
+	case restoreActionRestore:
+		info, err := client.copyObject(ctx, minio.CopyDestOptions{
+			Bucket: bucketName,
+			Object: version.Key,
+		}, minio.CopySrcOptions{
+			Bucket:    bucketName,
+			Object:    version.Key,
+			VersionID: version.VersionID,
+		})
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		result.NewVersionID = info.VersionID
+	case restoreActionDelete:
+		if err := client.removeObject(ctx, bucketName, version.Key, minio.RemoveObjectOptions{}); err != nil {
+			result.Error = err.Error()
+		}
+	}
+	return result
+}
+
+// startObjectsRestore restores every object under the prefix to the version current at opts.Date, objects
+// created afterwards are removed with a delete marker so the previous versions remain available. The
+// bucket must have versioning enabled.
+func startObjectsRestore(ctx context.Context, client MinioClient, opts *objectsListOpts, dryRun bool) (<-chan ObjectRestoreResult, error) {
+	versioning, err := client.getBucketVersioning(ctx, opts.BucketName)
+	if err != nil {
+		return nil, err
+	}
+	if !versioning.Enabled() {
+		status := versioning.Status
+		if status == "" {
+			status = "Unversioned"
+		}
+		return nil, fmt.Errorf("%w, bucket versioning is %s", errRestoreVersioningNotEnabled, status)
+	}
+
+	objCh := client.listObjects(ctx, opts.BucketName, minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    true,
+		WithVersions: true,
+	})
+
+	resultCh := make(chan ObjectRestoreResult)
+	go func() {
+		defer close(resultCh)
+		send := func(result ObjectRestoreResult) bool {
+			select {
+			case resultCh <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// versions of the same object are listed together
+		var versions []minio.ObjectInfo
+		for obj := range objCh {
+			if obj.Err != nil {
+				if !send(ObjectRestoreResult{Error: obj.Err.Error()}) {
+					return
+				}
+				continue
+			}
+			if len(versions) > 0 && versions[0].Key != obj.Key {
+				if !send(restoreObjectToDate(ctx, client, opts.BucketName, versions, opts.Date, dryRun)) {
+					return
+				}
+				versions = nil
+			}
+			versions = append(versions, obj)
+		}
+		if len(versions) > 0 {
+			send(restoreObjectToDate(ctx, client, opts.BucketName, versions, opts.Date, dryRun))
+		}
+	}()
+	return resultCh, nil
+}
@@ -0,0 +1,194 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+// assigning mock at runtime instead of compile time
+var minioRemoveObjectMock func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+
+// mock function of removeObject()
+func (ac minioClientMock) removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+	return minioRemoveObjectMock(ctx, bucketName, objectName, opts)
+}
+
+// assigning mock at runtime instead of compile time
+var minioGetBucketVersioningMock func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
+
+// mock function of getBucketVersioning()
+func (ac minioClientMock) getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+	return minioGetBucketVersioningMock(ctx, bucketName)
+}
+
+func TestPlanObjectRestore(t *testing.T) {
+	assert := assert.New(t)
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	before := date.Add(-time.Hour)
+	after := date.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		versions  []minio.ObjectInfo
+		action    string
+		versionID string
+	}{
+		{
+			name:      "overwritten after date",
+			versions:  []minio.ObjectInfo{{Key: "a", VersionID: "v2", IsLatest: true, LastModified: after}, {Key: "a", VersionID: "v1", LastModified: before}},
+			action:    restoreActionRestore,
+			versionID: "v1",
+		},
+		{
+			name:      "deleted after date",
+			versions:  []minio.ObjectInfo{{Key: "a", VersionID: "dm", IsLatest: true, IsDeleteMarker: true, LastModified: after}, {Key: "a", VersionID: "v1", LastModified: before}},
+			action:    restoreActionRestore,
+			versionID: "v1",
+		},
+		{
+			name:      "not modified since date",
+			versions:  []minio.ObjectInfo{{Key: "a", VersionID: "v1", IsLatest: true, LastModified: before}},
+			action:    restoreActionUnchanged,
+			versionID: "v1",
+		},
+		{
+			name:     "created after date",
+			versions: []minio.ObjectInfo{{Key: "a", VersionID: "v1", IsLatest: true, LastModified: after}},
+			action:   restoreActionDelete,
+		},
+		{
+			name:     "deleted before date and recreated",
+			versions: []minio.ObjectInfo{{Key: "a", VersionID: "v2", IsLatest: true, LastModified: after}, {Key: "a", VersionID: "dm", IsDeleteMarker: true, LastModified: before}},
+			action:   restoreActionDelete,
+		},
+		{
+			name:     "created and deleted after date",
+			versions: []minio.ObjectInfo{{Key: "a", VersionID: "dm", IsLatest: true, IsDeleteMarker: true, LastModified: after.Add(time.Hour)}, {Key: "a", VersionID: "v1", LastModified: after}},
+			action:   restoreActionUnchanged,
+		},
+	}
+	for _, tt := range tests {
+		action, version := planObjectRestore(tt.versions, date)
+		assert.Equal(tt.action, action, tt.name)
+		if tt.versionID != "" {
+			assert.Equal(tt.versionID, version.VersionID, tt.name)
+		}
+	}
+}
+
+func TestStartObjectsRestore(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	minioGetBucketVersioningMock = func(_ context.Context, _ string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{Status: "Enabled"}, nil
+	}
+	minioListObjectsMock = func(_ context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.True(opts.WithVersions)
+		assert.True(opts.Recursive)
+		ch := make(chan minio.ObjectInfo, 5)
+		ch <- minio.ObjectInfo{Key: "data/a.csv", VersionID: "a2", IsLatest: true, LastModified: date.Add(time.Hour)}
+		ch <- minio.ObjectInfo{Key: "data/a.csv", VersionID: "a1", LastModified: date.Add(-time.Hour)}
+		ch <- minio.ObjectInfo{Key: "data/b.csv", VersionID: "b1", IsLatest: true, LastModified: date.Add(-time.Hour)}
+		ch <- minio.ObjectInfo{Key: "data/c.csv", VersionID: "c1", IsLatest: true, LastModified: date.Add(time.Hour)}
+		ch <- minio.ObjectInfo{Err: errors.New("listing interrupted")}
+		close(ch)
+		return ch
+	}
+	var copied []string
+	minioCopyObjectMock = func(_ context.Context, _ minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		copied = append(copied, src.Object+"@"+src.VersionID)
+		return minio.UploadInfo{VersionID: "a3"}, nil
+	}
+	var removed []string
+	minioRemoveObjectMock = func(_ context.Context, _, objectName string, opts minio.RemoveObjectOptions) error {
+		assert.Empty(opts.VersionID)
+		removed = append(removed, objectName)
+		return nil
+	}
+
+	opts := &objectsListOpts{BucketName: "datasets", Prefix: "data/", Date: date}
+
+	// previews don't change anything
+	report := &ObjectsRestoreReport{}
+	restoreCh, err := startObjectsRestore(ctx, client, opts, true)
+	assert.Nil(err)
+	for result := range restoreCh {
+		report.add(result)
+	}
+	assert.Empty(copied)
+	assert.Empty(removed)
+	assert.Equal(ObjectsRestoreReport{Restored: 1, Deleted: 1, Unchanged: 1, Failed: 1}, *report)
+
+	var results []ObjectRestoreResult
+	restoreCh, err = startObjectsRestore(ctx, client, opts, false)
+	assert.Nil(err)
+	for result := range restoreCh {
+		results = append(results, result)
+	}
+	assert.Len(results, 4)
+	assert.Equal(ObjectRestoreResult{
+		Name:         "data/a.csv",
+		Action:       restoreActionRestore,
+		VersionID:    "a1",
+		LastModified: date.Add(-time.Hour).Format(time.RFC3339),
+		NewVersionID: "a3",
+	}, results[0])
+	assert.Equal([]string{"data/a.csv@a1"}, copied)
+	assert.Equal([]string{"data/c.csv"}, removed)
+	assert.Equal("listing interrupted", results[2].Error)
+	assert.Equal(restoreActionDelete, results[3].Action)
+}
+
+func TestStartObjectsRestoreWithoutVersioning(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	opts := &objectsListOpts{BucketName: "datasets", Prefix: "data/", Date: time.Now()}
+
+	minioListObjectsMock = func(_ context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		t.Error("objects listed without versioning enabled")
+		ch := make(chan minio.ObjectInfo)
+		close(ch)
+		return ch
+	}
+
+	// unversioned buckets report an empty status
+	for _, status := range []string{"", "Suspended"} {
+		minioGetBucketVersioningMock = func(_ context.Context, _ string) (minio.BucketVersioningConfiguration, error) {
+			return minio.BucketVersioningConfiguration{Status: status}, nil
+		}
+		restoreCh, err := startObjectsRestore(ctx, client, opts, true)
+		assert.ErrorIs(err, errRestoreVersioningNotEnabled, status)
+		assert.Nil(restoreCh)
+	}
+
+	minioGetBucketVersioningMock = func(_ context.Context, _ string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{}, errors.New("access denied")
+	}
+	_, err := startObjectsRestore(ctx, client, opts, true)
+	assert.EqualError(err, "access denied")
+}
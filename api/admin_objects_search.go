@@ -0,0 +1,235 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// ObjectsSearchRequest holds the filters of a `search` request, all filters set must match
+type ObjectsSearchRequest struct {
+	// Name is a glob matched against the object name, or against the full key when it contains a `/`
+	Name           string            `json:"name,omitempty"`
+	Regex          string            `json:"regex,omitempty"`
+	MinSize        *int64            `json:"min_size,omitempty"`
+	MaxSize        *int64            `json:"max_size,omitempty"`
+	ModifiedAfter  string            `json:"modified_after,omitempty"`
+	ModifiedBefore string            `json:"modified_before,omitempty"`
+	ContentType    string            `json:"content_type,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Versions       bool              `json:"versions,omitempty"`
+}
+
+type objectsSearchOpts struct {
+	BucketName     string
+	Prefix         string
+	Name           string
+	Regex          *regexp.Regexp
+	MinSize        *int64
+	MaxSize        *int64
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+	ContentType    string
+	Metadata       map[string]string
+	Tags           map[string]string
+	Versions       bool
+}
+
+func getObjectsSearchOptionsFromReq(request ObjectsRequest) (*objectsSearchOpts, error) {
+	listOpts, err := getObjectsOptionsFromReq(request)
+	if err != nil {
+		return nil, err
+	}
+	if request.Search == nil {
+		return nil, errors.New("search filters are required")
+	}
+	search := request.Search
+	opts := objectsSearchOpts{
+		BucketName:  listOpts.BucketName,
+		Prefix:      listOpts.Prefix,
+		Name:        search.Name,
+		MinSize:     search.MinSize,
+		MaxSize:     search.MaxSize,
+		ContentType: strings.ToLower(search.ContentType),
+		Tags:        search.Tags,
+		Versions:    search.Versions,
+	}
+	if opts.Name != "" {
+		// validate the pattern once instead of failing on every object
+		if _, err = path.Match(opts.Name, ""); err != nil {
+			return nil, fmt.Errorf("invalid name pattern: %w", err)
+		}
+	}
+	if search.Regex != "" {
+		if opts.Regex, err = regexp.Compile(search.Regex); err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+	}
+	if opts.MinSize != nil && opts.MaxSize != nil && *opts.MinSize > *opts.MaxSize {
+		return nil, errors.New("min_size can't be greater than max_size")
+	}
+	if search.ModifiedAfter != "" {
+		if opts.ModifiedAfter, err = time.Parse(time.RFC3339, search.ModifiedAfter); err != nil {
+			return nil, err
+		}
+	}
+	if search.ModifiedBefore != "" {
+		if opts.ModifiedBefore, err = time.Parse(time.RFC3339, search.ModifiedBefore); err != nil {
+			return nil, err
+		}
+	}
+	if len(search.Metadata) > 0 {
+		opts.Metadata = make(map[string]string, len(search.Metadata))
+		for k, v := range search.Metadata {
+			opts.Metadata[normalizeUserMetadataKey(k)] = v
+		}
+	}
+	return &opts, nil
+}
+
+// normalizeUserMetadataKey returns the metadata key without the `X-Amz-Meta-` prefix, lower cased
+func normalizeUserMetadataKey(key string) string {
+	key = strings.ToLower(key)
+	return strings.TrimPrefix(key, "x-amz-meta-")
+}
+
+// objectUserMetadata returns the user defined metadata of a listed object
+func objectUserMetadata(obj minio.ObjectInfo) map[string]string {
+	metadata := map[string]string{}
+	for k, v := range obj.UserMetadata {
+		if !strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+			continue
+		}
+		metadata[normalizeUserMetadataKey(k)] = v
+	}
+	return metadata
+}
+
+// objectContentType returns the content type of a listed object, MinIO sends it along with the
+// metadata rather than setting the content type of the listed object
+func objectContentType(obj minio.ObjectInfo) string {
+	for k, v := range obj.UserMetadata {
+		if strings.EqualFold(k, "Content-Type") {
+			return v
+		}
+	}
+	return obj.ContentType
+}
+
+// needsMetadata returns whether objects have to be listed along with their metadata and tags
+func (o *objectsSearchOpts) needsMetadata() bool {
+	return o.ContentType != "" || len(o.Metadata) > 0 || len(o.Tags) > 0
+}
+
+func (o *objectsSearchOpts) matches(obj minio.ObjectInfo) bool {
+	if o.Name != "" {
+		name := obj.Key
+		if !strings.Contains(o.Name, "/") {
+			name = path.Base(obj.Key)
+		}
+		if ok, _ := path.Match(o.Name, name); !ok {
+			return false
+		}
+	}
+	if o.Regex != nil && !o.Regex.MatchString(obj.Key) {
+		return false
+	}
+	if o.MinSize != nil && obj.Size < *o.MinSize {
+		return false
+	}
+	if o.MaxSize != nil && obj.Size > *o.MaxSize {
+		return false
+	}
+	if !o.ModifiedAfter.IsZero() && obj.LastModified.Before(o.ModifiedAfter) {
+		return false
+	}
+	if !o.ModifiedBefore.IsZero() && obj.LastModified.After(o.ModifiedBefore) {
+		return false
+	}
+	if o.ContentType != "" && !strings.HasPrefix(strings.ToLower(objectContentType(obj)), o.ContentType) {
+		return false
+	}
+	if len(o.Metadata) > 0 {
+		metadata := objectUserMetadata(obj)
+		for k, v := range o.Metadata {
+			if value, ok := metadata[k]; !ok || (v != "" && value != v) {
+				return false
+			}
+		}
+	}
+	for k, v := range o.Tags {
+		if value, ok := obj.UserTags[k]; !ok || (v != "" && value != v) {
+			return false
+		}
+	}
+	return true
+}
+
+// startObjectsSearch walks the bucket recursively and returns the objects matching the search
+// filters, listing errors are forwarded as well
+func startObjectsSearch(ctx context.Context, client MinioClient, opts *objectsSearchOpts) <-chan minio.ObjectInfo {
+	objCh := client.listObjects(ctx, opts.BucketName, minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    true,
+		WithVersions: opts.Versions,
+		WithMetadata: opts.needsMetadata(),
+	})
+
+	matchCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(matchCh)
+		for obj := range objCh {
+			if obj.Err == nil && !opts.matches(obj) {
+				continue
+			}
+			select {
+			case matchCh <- obj:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return matchCh
+}
+
+func newObjectSearchResponse(obj minio.ObjectInfo) ObjectResponse {
+	objItem := ObjectResponse{
+		Name:         obj.Key,
+		Size:         obj.Size,
+		LastModified: obj.LastModified.Format(time.RFC3339),
+		VersionID:    obj.VersionID,
+		IsLatest:     obj.IsLatest,
+		DeleteMarker: obj.IsDeleteMarker,
+		ContentType:  objectContentType(obj),
+	}
+	if metadata := objectUserMetadata(obj); len(metadata) > 0 {
+		objItem.UserMetadata = metadata
+	}
+	if len(obj.UserTags) > 0 {
+		objItem.Tags = obj.UserTags
+	}
+	return objItem
+}
@@ -0,0 +1,134 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectsSearchOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+	minSize, maxSize := int64(10), int64(5)
+
+	opts, err := getObjectsSearchOptionsFromReq(ObjectsRequest{
+		Mode:       "search",
+		BucketName: "photos",
+		Prefix:     base64.StdEncoding.EncodeToString([]byte("2024/")),
+		Search: &ObjectsSearchRequest{
+			Regex:         `\.jpe?g$`,
+			ModifiedAfter: "2024-01-01T00:00:00Z",
+			ContentType:   "Image/",
+			Metadata:      map[string]string{"X-Amz-Meta-Camera": "x100"},
+		},
+	})
+	assert.Nil(err)
+	assert.Equal("2024/", opts.Prefix)
+	assert.Equal("image/", opts.ContentType)
+	assert.Equal(map[string]string{"camera": "x100"}, opts.Metadata)
+	assert.True(opts.needsMetadata())
+
+	for _, search := range []*ObjectsSearchRequest{
+		nil,
+		{Name: "[a-"},
+		{Regex: "("},
+		{MinSize: &minSize, MaxSize: &maxSize},
+		{ModifiedBefore: "yesterday"},
+	} {
+		_, err = getObjectsSearchOptionsFromReq(ObjectsRequest{Mode: "search", BucketName: "photos", Search: search})
+		assert.NotNil(err)
+	}
+}
+
+func TestObjectsSearchMatches(t *testing.T) {
+	assert := assert.New(t)
+	minSize := int64(100)
+	obj := minio.ObjectInfo{
+		Key:          "2024/trip/img_001.JPG",
+		Size:         2048,
+		LastModified: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		// listings with metadata only carry the content type among the metadata
+		UserMetadata: map[string]string{"X-Amz-Meta-Camera": "x100", "content-type": "image/jpeg"},
+		UserTags:     map[string]string{"album": "trip"},
+	}
+
+	tests := []struct {
+		name  string
+		opts  objectsSearchOpts
+		match bool
+	}{
+		{name: "no filters", match: true},
+		{name: "glob on base name", opts: objectsSearchOpts{Name: "img_*.JPG"}, match: true},
+		{name: "glob on full key", opts: objectsSearchOpts{Name: "2024/*/*.JPG"}, match: true},
+		{name: "glob mismatch", opts: objectsSearchOpts{Name: "*.png"}},
+		{name: "size range", opts: objectsSearchOpts{MinSize: &minSize}, match: true},
+		{name: "modified before", opts: objectsSearchOpts{ModifiedBefore: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{name: "content type", opts: objectsSearchOpts{ContentType: "image/"}, match: true},
+		{name: "content type mismatch", opts: objectsSearchOpts{ContentType: "text/"}},
+		{name: "metadata value", opts: objectsSearchOpts{Metadata: map[string]string{"camera": "x100"}}, match: true},
+		{name: "metadata presence", opts: objectsSearchOpts{Metadata: map[string]string{"lens": ""}}},
+		// standard headers are not user metadata
+		{name: "metadata header", opts: objectsSearchOpts{Metadata: map[string]string{"content-type": ""}}},
+		{name: "tag value", opts: objectsSearchOpts{Tags: map[string]string{"album": "trip"}}, match: true},
+		{name: "tag mismatch", opts: objectsSearchOpts{Tags: map[string]string{"album": "home"}}},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.match, tt.opts.matches(obj), tt.name)
+	}
+
+	resp := newObjectSearchResponse(obj)
+	assert.Equal("image/jpeg", resp.ContentType)
+	assert.Equal(map[string]string{"camera": "x100"}, resp.UserMetadata)
+}
+
+func TestStartObjectsSearch(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+
+	minioListObjectsMock = func(_ context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.True(opts.Recursive)
+		assert.True(opts.WithVersions)
+		assert.False(opts.WithMetadata)
+		ch := make(chan minio.ObjectInfo, 4)
+		ch <- minio.ObjectInfo{Key: "a/report.pdf", Size: 10}
+		ch <- minio.ObjectInfo{Key: "a/photo.jpg", Size: 10}
+		ch <- minio.ObjectInfo{Err: errors.New("access denied")}
+		ch <- minio.ObjectInfo{Key: "b/report.pdf", Size: 10, VersionID: "v1"}
+		close(ch)
+		return ch
+	}
+
+	var matches []ObjectResponse
+	var errs int
+	for obj := range startObjectsSearch(context.Background(), client, &objectsSearchOpts{BucketName: "docs", Name: "*.pdf", Versions: true}) {
+		if obj.Err != nil {
+			errs++
+			continue
+		}
+		matches = append(matches, newObjectSearchResponse(obj))
+	}
+	assert.Equal(1, errs)
+	assert.Len(matches, 2)
+	assert.Equal("b/report.pdf", matches[1].Name)
+	assert.Equal("v1", matches[1].VersionID)
+}
@@ -0,0 +1,68 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	policies "github.com/minio/console/api/policy"
+	"github.com/minio/console/models"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
+)
+
+// hasAdminPermission tells whether the policy of the session allows the admin action, it's used by the
+// console features that don't call a MinIO admin API MinIO would check the permission of
+func hasAdminPermission(ctx context.Context, client MinioAdmin, session *models.Principal, action minioIAMPolicy.AdminAction) (bool, error) {
+	accountInfo, err := getAccountInfo(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	claims, _ := getClaimsFromToken(session.STSSessionToken)
+	policy, err := minioIAMPolicy.ParseConfig(bytes.NewReader(policies.ReplacePolicyVariables(claims, accountInfo)))
+	if err != nil {
+		return false, err
+	}
+	return policy.IsAllowed(minioIAMPolicy.Args{
+		AccountName:     session.AccountAccessKey,
+		Action:          minioIAMPolicy.Action(action),
+		ConditionValues: map[string][]string{},
+		Claims:          claims,
+	}), nil
+}
+
+// checkAdminPermission returns a forbidden error unless the session is allowed the admin action
+func checkAdminPermission(req *http.Request, session *models.Principal, action minioIAMPolicy.AdminAction) *CodedAPIError {
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	if session == nil {
+		return ErrorWithContext(ctx, ErrInvalidSession)
+	}
+	mAdmin, err := NewMinioAdminClient(ctx, session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	allowed, err := hasAdminPermission(ctx, AdminClient{Client: mAdmin}, session, action)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if !allowed {
+		return ErrorWithContext(ctx, ErrForbidden)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasAdminPermission(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	session := &models.Principal{AccountAccessKey: "user"}
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::*"]}]}`
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{AccountName: "user", Policy: []byte(policy)}, nil
+	}
+	allowed, err := hasAdminPermission(ctx, adminClient, session, minioIAMPolicy.ConfigUpdateAdminAction)
+	assert.Nil(err)
+	assert.False(allowed)
+
+	policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:*"]},{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`
+	allowed, err = hasAdminPermission(ctx, adminClient, session, minioIAMPolicy.ConfigUpdateAdminAction)
+	assert.Nil(err)
+	assert.True(allowed)
+
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{}, errors.New("access denied")
+	}
+	_, err = hasAdminPermission(ctx, adminClient, session, minioIAMPolicy.ConfigUpdateAdminAction)
+	assert.NotNil(err)
+}
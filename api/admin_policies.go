@@ -27,6 +27,7 @@ import (
 	bucketApi "github.com/minio/console/api/operations/bucket"
 	policyApi "github.com/minio/console/api/operations/policy"
 	"github.com/minio/console/pkg/utils"
+	"github.com/minio/madmin-go/v3"
 	s3 "github.com/minio/minio-go/v7"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -176,6 +177,7 @@ func getSetAccessRuleWithBucketResponse(session *models.Principal, params bucket
 		}
 		return false, returnError
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.Bucket, nil, prefixAccess)
 	return true, nil
 }
 
@@ -192,6 +194,7 @@ func getDeleteAccessRuleWithBucketResponse(session *models.Principal, params buc
 	if errorVal != nil {
 		return false, ErrorWithContext(ctx, errorVal.Cause)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, bucket, prefix, nil)
 	return true, nil
 }
 
@@ -336,7 +339,11 @@ func getListUsersForPolicyResponse(session *models.Principal, params policyApi.L
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	return usersWithPolicy(users, policy), nil
+}
 
+// usersWithPolicy returns the users a policy is attached to
+func usersWithPolicy(users []*models.User, policy string) []string {
 	var filteredUsers []string
 	for _, user := range users {
 		for _, upolicy := range user.Policy {
@@ -347,7 +354,7 @@ func getListUsersForPolicyResponse(session *models.Principal, params policyApi.L
 		}
 	}
 	sort.Strings(filteredUsers)
-	return filteredUsers, nil
+	return filteredUsers
 }
 
 func getUserPolicyResponse(ctx context.Context, session *models.Principal) (string, *CodedAPIError) {
@@ -486,17 +493,34 @@ func getListGroupsForPolicyResponse(session *models.Principal, params policyApi.
 		return nil, ErrorWithContext(ctx, ErrPolicyNotFound, fmt.Errorf("the policy %s does not exist", policy))
 	}
 
-	groups, err := adminClient.listGroups(ctx)
+	groups, err := listGroupDescriptions(ctx, adminClient)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	return groupsWithPolicy(groups, policy), nil
+}
 
-	var filteredGroups []string
+// listGroupDescriptions returns the description of every group by name
+func listGroupDescriptions(ctx context.Context, client MinioAdmin) (map[string]*madmin.GroupDesc, error) {
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	descriptions := make(map[string]*madmin.GroupDesc, len(groups))
 	for _, group := range groups {
-		info, err := groupInfo(ctx, adminClient, group)
+		info, err := groupInfo(ctx, client, group)
 		if err != nil {
-			return nil, ErrorWithContext(ctx, err)
+			return nil, err
 		}
+		descriptions[group] = info
+	}
+	return descriptions, nil
+}
+
+// groupsWithPolicy returns the groups a policy is attached to
+func groupsWithPolicy(groups map[string]*madmin.GroupDesc, policy string) []string {
+	var filteredGroups []string
+	for group, info := range groups {
 		groupPolicies := strings.Split(info.Policy, ",")
 		for _, groupPolicy := range groupPolicies {
 			if groupPolicy == policy {
@@ -505,7 +529,7 @@ func getListGroupsForPolicyResponse(session *models.Principal, params policyApi.
 		}
 	}
 	sort.Strings(filteredGroups)
-	return filteredGroups, nil
+	return filteredGroups
 }
 
 // removePolicy() calls MinIO server to remove a policy based on name.
@@ -536,9 +560,11 @@ func getRemovePolicyResponse(session *models.Principal, params policyApi.RemoveP
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
+	before, _ := adminClient.getPolicy(ctx, policyName)
 	if err := removePolicy(ctx, adminClient, policyName); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetPolicy, policyName, before, nil)
 	return nil
 }
 
@@ -578,10 +604,20 @@ func getAddPolicyResponse(session *models.Principal, params policyApi.AddPolicyP
 	// create a MinIO Admin Client interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
+	// the policy is replaced when it already exists
+	before, _ := adminClient.getPolicy(ctx, *params.Body.Name)
 	policy, err := addPolicy(ctx, adminClient, *params.Body.Name, *params.Body.Policy)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	after, _ := adminClient.getPolicy(ctx, *params.Body.Name)
+	keepPolicyRevision(ctx, session, params.HTTPRequest, *params.Body.Name, params.Body.Comment, before, after)
+	auditEvent(params.HTTPRequest, session, auditTargetPolicy, *params.Body.Name, before, after)
+	// the policy is saved already, linting it is best effort
+	if mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest)); err == nil {
+		lc := newPolicyLintContext(ctx, adminClient, minioClient{client: mClient}, false)
+		policy.Findings = lintPolicy(*params.Body.Name, []byte(*params.Body.Policy), lc, false)
+	}
 	return policy, nil
 }
 
@@ -656,9 +692,24 @@ func getSetPolicyResponse(session *models.Principal, params policyApi.SetPolicyP
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
-	if err := SetPolicy(ctx, adminClient, strings.Join(params.Body.Name, ","), *params.Body.EntityName, *params.Body.EntityType); err != nil {
+	entityName := *params.Body.EntityName
+	targetType := auditTargetUser
+	var before, after interface{}
+	if *params.Body.EntityType == models.PolicyEntityGroup {
+		targetType = auditTargetGroup
+		before = auditGroupState(ctx, adminClient, entityName)
+	} else {
+		before = auditUserState(ctx, adminClient, entityName)
+	}
+	if err := SetPolicy(ctx, adminClient, strings.Join(params.Body.Name, ","), entityName, *params.Body.EntityType); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	if targetType == auditTargetGroup {
+		after = auditGroupState(ctx, adminClient, entityName)
+	} else {
+		after = auditUserState(ctx, adminClient, entityName)
+	}
+	auditEvent(params.HTTPRequest, session, targetType, entityName, before, after)
 	return nil
 }
 
@@ -673,23 +724,36 @@ func getSetPolicyMultipleResponse(session *models.Principal, params policyApi.Se
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
-	if err := setPolicyMultipleEntities(ctx, adminClient, strings.Join(params.Body.Name, ","), params.Body.Users, params.Body.Groups); err != nil {
+	policyName := strings.Join(params.Body.Name, ",")
+	if err := setPolicyMultipleEntities(ctx, adminClient, policyName, params.Body.Users, params.Body.Groups); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetPolicy, policyName, nil, params.Body)
 	return nil
 }
 
-// setPolicyMultipleEntities sets a policy to multiple users/groups
+// setPolicyMultipleEntities sets a policy to multiple users/groups, on failure the previous policies
+// of the users and groups already updated are restored
 func setPolicyMultipleEntities(ctx context.Context, client MinioAdmin, policyName string, users, groups []models.IamEntity) error {
+	var undo iamUndoLog
 	for _, user := range users {
+		user := user
+		userInfo, err := client.getUserInfo(ctx, string(user))
+		if err != nil {
+			return undo.rollbackOnError(ctx, err)
+		}
 		if err := client.setPolicy(ctx, policyName, string(user), false); err != nil {
-			return err
+			return undo.rollbackOnError(ctx, err)
 		}
+		undo.add(func(ctx context.Context) error {
+			return client.setPolicy(ctx, userInfo.PolicyName, string(user), false)
+		})
 	}
 	for _, group := range groups {
+		group := group
 		groupDesc, err := groupInfo(ctx, client, string(group))
 		if err != nil {
-			return err
+			return undo.rollbackOnError(ctx, err)
 		}
 		allGroupPolicies := ""
 		if len(groups) > 1 {
@@ -700,8 +764,11 @@ func setPolicyMultipleEntities(ctx context.Context, client MinioAdmin, policyNam
 			allGroupPolicies = policyName
 		}
 		if err := client.setPolicy(ctx, allGroupPolicies, string(group), true); err != nil {
-			return err
+			return undo.rollbackOnError(ctx, err)
 		}
+		undo.add(func(ctx context.Context) error {
+			return client.setPolicy(ctx, groupDesc.Policy, string(group), true)
+		})
 	}
 	return nil
 }
@@ -0,0 +1,387 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	policyApi "github.com/minio/console/api/operations/policy"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	iampolicy "github.com/minio/pkg/v2/policy"
+	"github.com/minio/pkg/v2/policy/condition"
+	"github.com/minio/pkg/v2/wildcard"
+)
+
+// broadGroupMembers is the number of members from which a group is too broad to be granted admin actions
+const broadGroupMembers = 10
+
+// builtinPolicies are created by MinIO, they aren't reported when unused
+var builtinPolicies = []string{"consoleAdmin", "diagnostics", "readonly", "readwrite", "writeonly"}
+
+var policyLintRemediations = map[string]string{
+	models.PolicyLintFindingCheckInvalidPolicy:           "Fix the policy document, MinIO rejects it as it is.",
+	models.PolicyLintFindingCheckWildcardAccess:          "Grant only the actions needed on the buckets and prefixes they're needed for.",
+	models.PolicyLintFindingCheckAdminActionsBroadGroup:  "Move the admin actions to a separate policy attached to a small group of administrators.",
+	models.PolicyLintFindingCheckShadowedDeny:            "Deny statements always take precedence regardless of their position, narrow or remove the statements involved.",
+	models.PolicyLintFindingCheckNonExistentBucket:       "Fix the bucket name or remove the resource if the bucket was deleted.",
+	models.PolicyLintFindingCheckUnusedPolicy:            "Remove the policy if it's no longer needed, unless it's mapped to LDAP or OpenID identities.",
+	models.PolicyLintFindingCheckUnsupportedConditionKey: "Use one of the condition keys supported by MinIO, unsupported keys make the policy invalid.",
+}
+
+// policyLintContext is what the linter knows about the deployment, checks needing something missing are skipped
+type policyLintContext struct {
+	buckets map[string]bool
+	users   []*models.User
+	groups  map[string]*madmin.GroupDesc
+}
+
+// newPolicyLintContext gathers the buckets, groups and, when users is set, the users of the deployment.
+// What the session can't list is left out.
+func newPolicyLintContext(ctx context.Context, adminClient MinioAdmin, client MinioClient, users bool) *policyLintContext {
+	lc := &policyLintContext{}
+	if buckets, err := client.listBucketsWithContext(ctx); err == nil {
+		lc.buckets = map[string]bool{}
+		for _, bucket := range buckets {
+			lc.buckets[bucket.Name] = true
+		}
+	}
+	if groups, err := listGroupDescriptions(ctx, adminClient); err == nil {
+		lc.groups = groups
+	}
+	if users {
+		if list, err := listUsers(ctx, adminClient); err == nil {
+			// not nil even without users, nil means they couldn't be listed
+			lc.users = append([]*models.User{}, list...)
+		}
+	}
+	return lc
+}
+
+func policyLintFinding(policy, check, severity string, statement int, message string) *models.PolicyLintFinding {
+	return &models.PolicyLintFinding{
+		Policy:      policy,
+		Check:       check,
+		Severity:    severity,
+		Statement:   int32(statement),
+		Message:     message,
+		Remediation: policyLintRemediations[check],
+	}
+}
+
+// unsupportedConditionKeys looks for condition keys MinIO doesn't know in the raw document since
+// parsing it fails on the first one
+func unsupportedConditionKeys(name string, document []byte) []*models.PolicyLintFinding {
+	type rawStatement struct {
+		Condition map[string]map[string]json.RawMessage `json:"Condition"`
+	}
+	var doc struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return nil
+	}
+	var statements []rawStatement
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement rawStatement
+		if err = json.Unmarshal(doc.Statement, &statement); err != nil {
+			return nil
+		}
+		statements = []rawStatement{statement}
+	}
+
+	var findings []*models.PolicyLintFinding
+	for i, statement := range statements {
+		var keys []string
+		for _, conditions := range statement.Condition {
+			for key := range conditions {
+				var k condition.Key
+				if err := k.UnmarshalJSON([]byte(strconv.Quote(key))); err != nil {
+					keys = append(keys, key)
+				}
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range UniqueKeys(keys) {
+			findings = append(findings, policyLintFinding(name, models.PolicyLintFindingCheckUnsupportedConditionKey, models.PolicyLintFindingSeverityHigh, i+1,
+				fmt.Sprintf("condition key %s is not supported", key)))
+		}
+	}
+	return findings
+}
+
+// isAdminStatement tells whether a statement allows admin actions
+func isAdminStatement(statement iampolicy.Statement) bool {
+	if statement.Effect != iampolicy.Allow {
+		return false
+	}
+	for action := range statement.Actions {
+		if action == "*" || strings.HasPrefix(string(action), "admin:") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasWildcardAccess tells whether a statement allows every action of a service on every resource
+func hasWildcardAccess(statement iampolicy.Statement) bool {
+	if statement.Effect != iampolicy.Allow {
+		return false
+	}
+	wildcardAction := false
+	for action := range statement.Actions {
+		if action == "*" || strings.HasSuffix(string(action), ":*") {
+			wildcardAction = true
+		}
+	}
+	if !wildcardAction {
+		return false
+	}
+	for _, resource := range statementResources(statement) {
+		if resource == "" || resource == "*" || resource == "*/*" {
+			return true
+		}
+	}
+	return false
+}
+
+// statementCovers tells whether outer applies to every action and resource inner applies to, no
+// matter the conditions of inner
+func statementCovers(outer, inner iampolicy.Statement) bool {
+	if len(outer.Conditions) > 0 || len(outer.NotActions) > 0 || len(inner.NotActions) > 0 {
+		return false
+	}
+	for action := range inner.Actions {
+		covered := false
+		for pattern := range outer.Actions {
+			if wildcard.Match(string(pattern), string(action)) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	for _, resource := range statementResources(inner) {
+		covered := false
+		for _, pattern := range statementResources(outer) {
+			if wildcard.Match(pattern, resource) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// shadowedDenies reports Deny statements already covered by a Deny before them, and Deny statements
+// cancelling an Allow before them, which usually means the Deny was meant as an exception
+func shadowedDenies(name string, statements []iampolicy.Statement) []*models.PolicyLintFinding {
+	var findings []*models.PolicyLintFinding
+	for j, deny := range statements {
+		if deny.Effect != iampolicy.Deny {
+			continue
+		}
+		for i, previous := range statements[:j] {
+			var severity, message string
+			switch {
+			case previous.Effect == iampolicy.Deny && statementCovers(previous, deny):
+				severity = models.PolicyLintFindingSeverityLow
+				message = fmt.Sprintf("Deny statement %d has no effect, statement %d already denies everything it does", j+1, i+1)
+			case previous.Effect == iampolicy.Allow && statementCovers(deny, previous):
+				severity = models.PolicyLintFindingSeverityMedium
+				message = fmt.Sprintf("Deny statement %d cancels everything Allow statement %d before it grants", j+1, i+1)
+			default:
+				continue
+			}
+			findings = append(findings, policyLintFinding(name, models.PolicyLintFindingCheckShadowedDeny, severity, j+1, message))
+			break
+		}
+	}
+	return findings
+}
+
+// resourceBuckets returns the buckets a statement names without wildcards
+func resourceBuckets(statement iampolicy.Statement) []string {
+	var buckets []string
+	for _, resource := range statementResources(statement) {
+		bucket, _, _ := strings.Cut(resource, "/")
+		if bucket == "" || strings.ContainsAny(bucket, "*?$") {
+			continue
+		}
+		buckets = append(buckets, bucket)
+	}
+	return UniqueKeys(buckets)
+}
+
+// lintPolicy reports security issues of a policy document, a policy is only reported as unused
+// when checkUnused is set as documents not saved yet aren't attached to anyone
+func lintPolicy(name string, document []byte, lc *policyLintContext, checkUnused bool) []*models.PolicyLintFinding {
+	findings := unsupportedConditionKeys(name, document)
+	policy, err := iampolicy.ParseConfig(bytes.NewReader(document))
+	if err != nil {
+		if len(findings) == 0 {
+			findings = append(findings, policyLintFinding(name, models.PolicyLintFindingCheckInvalidPolicy, models.PolicyLintFindingSeverityHigh, 0, err.Error()))
+		}
+		return findings
+	}
+
+	adminStatement := 0
+	for i, statement := range policy.Statements {
+		if hasWildcardAccess(statement) {
+			severity := models.PolicyLintFindingSeverityHigh
+			if len(statement.Conditions) > 0 {
+				severity = models.PolicyLintFindingSeverityMedium
+			}
+			findings = append(findings, policyLintFinding(name, models.PolicyLintFindingCheckWildcardAccess, severity, i+1,
+				"every action is allowed on every resource"))
+		}
+		if adminStatement == 0 && isAdminStatement(statement) {
+			adminStatement = i + 1
+		}
+		if lc.buckets == nil {
+			continue
+		}
+		for _, bucket := range resourceBuckets(statement) {
+			if !lc.buckets[bucket] {
+				findings = append(findings, policyLintFinding(name, models.PolicyLintFindingCheckNonExistentBucket, models.PolicyLintFindingSeverityMedium, i+1,
+					fmt.Sprintf("bucket %s does not exist", bucket)))
+			}
+		}
+	}
+	findings = append(findings, shadowedDenies(name, policy.Statements)...)
+
+	groups := groupsWithPolicy(lc.groups, name)
+	if adminStatement > 0 {
+		for _, group := range groups {
+			if members := len(lc.groups[group].Members); members >= broadGroupMembers {
+				findings = append(findings, policyLintFinding(name, models.PolicyLintFindingCheckAdminActionsBroadGroup, models.PolicyLintFindingSeverityHigh, adminStatement,
+					fmt.Sprintf("admin actions are granted to group %s with %d members", group, members)))
+			}
+		}
+	}
+	if checkUnused && lc.users != nil && lc.groups != nil && !IsElementInArray(builtinPolicies, name) {
+		if len(groups) == 0 && len(usersWithPolicy(lc.users, name)) == 0 {
+			findings = append(findings, policyLintFinding(name, models.PolicyLintFindingCheckUnusedPolicy, models.PolicyLintFindingSeverityLow, 0,
+				"the policy is not attached to any user or group"))
+		}
+	}
+	return findings
+}
+
+// lintPolicies lints every policy
+func lintPolicies(ctx context.Context, adminClient MinioAdmin, client MinioClient) (*models.PolicyLintReport, error) {
+	policies, err := adminClient.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lc := newPolicyLintContext(ctx, adminClient, client, true)
+	report := &models.PolicyLintReport{
+		Policies: int64(len(names)),
+		Findings: []*models.PolicyLintFinding{},
+	}
+	for _, name := range names {
+		document, err := json.Marshal(policies[name])
+		if err != nil {
+			return nil, err
+		}
+		report.Findings = append(report.Findings, lintPolicy(name, document, lc, true)...)
+	}
+	return report, nil
+}
+
+func registerPoliciesLintHandlers(api *operations.ConsoleAPI) {
+	// Lint all policies
+	api.PolicyLintPoliciesHandler = policyApi.LintPoliciesHandlerFunc(func(params policyApi.LintPoliciesParams, session *models.Principal) middleware.Responder {
+		resp, err := getLintPoliciesResponse(session, params)
+		if err != nil {
+			return policyApi.NewLintPoliciesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewLintPoliciesOK().WithPayload(resp)
+	})
+	// Lint a policy document
+	api.PolicyLintPolicyHandler = policyApi.LintPolicyHandlerFunc(func(params policyApi.LintPolicyParams, session *models.Principal) middleware.Responder {
+		resp, err := getLintPolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewLintPolicyDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewLintPolicyOK().WithPayload(resp)
+	})
+}
+
+// newPolicyLintClients returns the clients the linter needs to learn about the deployment
+func newPolicyLintClients(session *models.Principal, req *http.Request) (MinioAdmin, MinioClient, error) {
+	mAdmin, err := NewMinioAdminClient(req.Context(), session)
+	if err != nil {
+		return nil, nil, err
+	}
+	mClient, err := newMinioClient(session, getClientIP(req))
+	if err != nil {
+		return nil, nil, err
+	}
+	return AdminClient{Client: mAdmin}, minioClient{client: mClient}, nil
+}
+
+func getLintPoliciesResponse(session *models.Principal, params policyApi.LintPoliciesParams) (*models.PolicyLintReport, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	adminClient, minClient, err := newPolicyLintClients(session, params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	report, err := lintPolicies(ctx, adminClient, minClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return report, nil
+}
+
+func getLintPolicyResponse(session *models.Principal, params policyApi.LintPolicyParams) (*models.PolicyLintReport, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil {
+		return nil, ErrorWithContext(ctx, ErrPolicyBodyNotInRequest)
+	}
+	adminClient, minClient, err := newPolicyLintClients(session, params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	lc := newPolicyLintContext(ctx, adminClient, minClient, false)
+	return &models.PolicyLintReport{
+		Policies: 1,
+		Findings: lintPolicy(*params.Body.Name, []byte(*params.Body.Policy), lc, false),
+	}, nil
+}
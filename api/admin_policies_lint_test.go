@@ -0,0 +1,179 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	iampolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+// lintChecks returns the check, severity and statement of every finding
+func lintChecks(findings []*models.PolicyLintFinding) []string {
+	var checks []string
+	for _, finding := range findings {
+		checks = append(checks, fmt.Sprintf("%s/%s/%d", finding.Check, finding.Severity, finding.Statement))
+	}
+	return checks
+}
+
+func Test_lintPolicy(t *testing.T) {
+	manyMembers := make([]string, broadGroupMembers)
+	for i := range manyMembers {
+		manyMembers[i] = fmt.Sprintf("user-%d", i)
+	}
+	lc := &policyLintContext{
+		buckets: map[string]bool{"photos": true},
+		users:   []*models.User{{AccessKey: "alice", Policy: []string{"photos-read"}}},
+		groups: map[string]*madmin.GroupDesc{
+			"everyone": {Members: manyMembers, Policy: "ops"},
+			"admins":   {Members: []string{"alice"}, Policy: "photos-read,ops"},
+		},
+	}
+	tests := []struct {
+		name        string
+		policy      string
+		document    string
+		checkUnused bool
+		want        []string
+	}{
+		{
+			name:     "least privilege",
+			policy:   "photos-read",
+			document: readOnlyPhotosPolicy,
+		},
+		{
+			name:     "wildcard access",
+			policy:   "everything",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]},{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"],"Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8"]}}}]}`,
+			want:     []string{"wildcardAccess/high/1", "wildcardAccess/medium/2"},
+		},
+		{
+			name:     "unsupported condition key",
+			policy:   "conditions",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"],"Condition":{"StringEquals":{"aws:PrincipalTag/team":["media"],"s3:prefix":["2024"]}}}]}`,
+			want:     []string{"unsupportedConditionKey/high/1"},
+		},
+		{
+			name:     "invalid policy",
+			policy:   "invalid",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Maybe","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`,
+			want:     []string{"invalidPolicy/high/0"},
+		},
+		{
+			name:     "shadowed denies",
+			policy:   "shadowed",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/2024/*"]},{"Effect":"Deny","Action":["s3:Get*"],"Resource":["arn:aws:s3:::photos/*"]},{"Effect":"Deny","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/2023/*"]}]}`,
+			want:     []string{"shadowedDeny/medium/2", "shadowedDeny/low/3"},
+		},
+		{
+			name:     "deny as an exception",
+			policy:   "exception",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]},{"Effect":"Deny","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/private/*"]}]}`,
+		},
+		{
+			name:     "non existent bucket",
+			policy:   "videos",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::videos/*","arn:aws:s3:::photos/*","arn:aws:s3:::logs-*"]}]}`,
+			want:     []string{"nonExistentBucket/medium/1"},
+		},
+		{
+			name:     "admin actions for a broad group",
+			policy:   "ops",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]},{"Effect":"Allow","Action":["admin:ServerInfo"]}]}`,
+			want:     []string{"adminActionsBroadGroup/high/2"},
+		},
+		{
+			name:        "unused policy",
+			policy:      "unused",
+			document:    getPhotosPolicy,
+			checkUnused: true,
+			want:        []string{"unusedPolicy/low/0"},
+		},
+		{
+			name:        "unused built-in policy",
+			policy:      "diagnostics",
+			document:    getPhotosPolicy,
+			checkUnused: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := lintPolicy(tt.policy, []byte(tt.document), lc, tt.checkUnused)
+			assert.Equal(t, tt.want, lintChecks(findings))
+			for _, finding := range findings {
+				assert.Equal(t, tt.policy, finding.Policy)
+				assert.NotEmpty(t, finding.Remediation)
+			}
+		})
+	}
+}
+
+func Test_lintPolicies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	client := minioClientMock{}
+
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{
+			"photos-read": mustParsePolicy(t, readOnlyPhotosPolicy),
+			"photos-get":  mustParsePolicy(t, getPhotosPolicy),
+			"readwrite":   mustParsePolicy(t, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`),
+		}, nil
+	}
+	minioListBucketsWithContextMock = func(_ context.Context) ([]minio.BucketInfo, error) {
+		return []minio.BucketInfo{{Name: "photos"}}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"photographers"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: "photos-read"}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"bob": {PolicyName: "readwrite"}}, nil
+	}
+
+	report, err := lintPolicies(ctx, adminClient, client)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), report.Policies)
+	assert.Equal(t, []string{"unusedPolicy/low/0", "wildcardAccess/high/1"}, lintChecks(report.Findings))
+	assert.Equal(t, "photos-get", report.Findings[0].Policy)
+	assert.Equal(t, "readwrite", report.Findings[1].Policy)
+
+	// without users nothing is reported as unused
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return nil, errors.New("access denied")
+	}
+	report, err = lintPolicies(ctx, adminClient, client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"wildcardAccess/high/1"}, lintChecks(report.Findings))
+
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return nil, errors.New("access denied")
+	}
+	_, err = lintPolicies(ctx, adminClient, client)
+	assert.EqualError(t, err, "access denied")
+}
@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
 	iampolicy "github.com/minio/pkg/v2/policy"
 	"github.com/stretchr/testify/assert"
 )
@@ -251,6 +252,12 @@ func Test_SetPolicyMultiple(t *testing.T) {
 			errorExpected: nil,
 		},
 	}
+	minioGetUserInfoMock = func(_ string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "consoleAdmin"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: "diagnostics"}, nil
+	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(_ *testing.T) {
 			minioSetPolicyMock = tt.args.setPolicyFunc
@@ -380,3 +387,35 @@ func Test_policyMatchesBucket(t *testing.T) {
 		})
 	}
 }
+
+func Test_SetPolicyMultipleRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+
+	minioGetUserInfoMock = func(user string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: user + "-policy"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: "diagnostics"}, nil
+	}
+	var calls []string
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		calls = append(calls, fmt.Sprintf("%s=%s", entityName, policyName))
+		if isGroup && policyName == "readonly" {
+			return errors.New("error set")
+		}
+		return nil
+	}
+
+	err := setPolicyMultipleEntities(ctx, adminClient, "readonly", []models.IamEntity{"user1", "user2"}, []models.IamEntity{"group1"})
+	assert.Equal(t, errors.New("error set"), err)
+	// the users updated before the failure get their policies back, newest first
+	assert.Equal(t, []string{
+		"user1=readonly",
+		"user2=readonly",
+		"group1=readonly",
+		"user2=user2-policy",
+		"user1=user1-policy",
+	}, calls)
+}
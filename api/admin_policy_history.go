@@ -0,0 +1,449 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	policyApi "github.com/minio/console/api/operations/policy"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/minio-go/v7"
+	iampolicy "github.com/minio/pkg/v2/policy"
+)
+
+// policyRevisionLayout names revisions after the time they were written so they sort in order
+const policyRevisionLayout = "20060102T150405.000000000Z"
+
+func registerPolicyHistoryHandlers(api *operations.ConsoleAPI) {
+	// List policy revisions
+	api.PolicyListPolicyRevisionsHandler = policyApi.ListPolicyRevisionsHandlerFunc(func(params policyApi.ListPolicyRevisionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListPolicyRevisionsResponse(session, params)
+		if err != nil {
+			return policyApi.NewListPolicyRevisionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewListPolicyRevisionsOK().WithPayload(resp)
+	})
+	// Diff two policy revisions
+	api.PolicyDiffPolicyRevisionsHandler = policyApi.DiffPolicyRevisionsHandlerFunc(func(params policyApi.DiffPolicyRevisionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getDiffPolicyRevisionsResponse(session, params)
+		if err != nil {
+			return policyApi.NewDiffPolicyRevisionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewDiffPolicyRevisionsOK().WithPayload(resp)
+	})
+	// Roll back a policy to a revision
+	api.PolicyRollbackPolicyHandler = policyApi.RollbackPolicyHandlerFunc(func(params policyApi.RollbackPolicyParams, session *models.Principal) middleware.Responder {
+		resp, err := getRollbackPolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewRollbackPolicyDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewRollbackPolicyOK().WithPayload(resp)
+	})
+}
+
+// policyRevision is a policy document as console wrote it, stored as <policy>/<revision>.json
+type policyRevision struct {
+	Revision string          `json:"revision"`
+	Name     string          `json:"name"`
+	Author   string          `json:"author,omitempty"`
+	Time     time.Time       `json:"time"`
+	Comment  string          `json:"comment,omitempty"`
+	Policy   json.RawMessage `json:"policy"`
+}
+
+func (r *policyRevision) toModel() *models.PolicyRevision {
+	return &models.PolicyRevision{
+		Revision: r.Revision,
+		Name:     r.Name,
+		Author:   r.Author,
+		Time:     r.Time.Format(time.RFC3339),
+		Comment:  r.Comment,
+		Policy:   string(r.Policy),
+	}
+}
+
+func (r *policyRevision) parsePolicy() (*iampolicy.Policy, error) {
+	return iampolicy.ParseConfig(bytes.NewReader(r.Policy))
+}
+
+// policyHistory keeps the revisions of the canned policies in a bucket
+type policyHistory struct {
+	client MinioClient
+	bucket string
+}
+
+// newPolicyHistory returns the policy history accessed with the session credentials
+func newPolicyHistory(session *models.Principal, req *http.Request) (*policyHistory, error) {
+	bucket := getPolicyHistoryBucket()
+	if bucket == "" {
+		return nil, ErrPolicyHistoryDisabled
+	}
+	mClient, err := newMinioClient(session, getClientIP(req))
+	if err != nil {
+		return nil, err
+	}
+	return &policyHistory{client: minioClient{client: mClient}, bucket: bucket}, nil
+}
+
+func policyRevisionKey(name, revision string) string {
+	return fmt.Sprintf("%s/%s.json", name, revision)
+}
+
+// put stores a revision, the bucket is created the first time
+func (h *policyHistory) put(ctx context.Context, rev *policyRevision) error {
+	data, err := json.Marshal(rev)
+	if err != nil {
+		return err
+	}
+	put := func() error {
+		_, err := h.client.putObject(ctx, h.bucket, policyRevisionKey(rev.Name, rev.Revision), bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: "application/json"})
+		return err
+	}
+	err = put()
+	if minio.ToErrorResponse(err).Code != "NoSuchBucket" {
+		return err
+	}
+	if err = h.client.makeBucketWithContext(ctx, h.bucket, "", false); err != nil && minio.ToErrorResponse(err).Code != "BucketAlreadyOwnedByYou" {
+		return err
+	}
+	return put()
+}
+
+// record keeps a new revision of a policy, when the policy existed but has no history yet the
+// previous document is kept first so there is something to roll back to
+func (h *policyHistory) record(ctx context.Context, name, author, comment string, before, after *iampolicy.Policy, now time.Time) (*policyRevision, error) {
+	if before != nil {
+		ids, err := h.revisionIDs(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			// ordered right before the revision being recorded
+			baselineTime := now.Add(-time.Nanosecond)
+			if _, err = h.add(ctx, name, "", "revision before policy history was kept", before, baselineTime); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return h.add(ctx, name, author, comment, after, now)
+}
+
+func (h *policyHistory) add(ctx context.Context, name, author, comment string, policy *iampolicy.Policy, now time.Time) (*policyRevision, error) {
+	document, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	rev := &policyRevision{
+		Revision: now.UTC().Format(policyRevisionLayout),
+		Name:     name,
+		Author:   author,
+		Time:     now.UTC(),
+		Comment:  comment,
+		Policy:   document,
+	}
+	if err = h.put(ctx, rev); err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+// revisionIDs returns the revisions kept for a policy newest first
+func (h *policyHistory) revisionIDs(ctx context.Context, name string) ([]string, error) {
+	var ids []string
+	for obj := range h.client.listObjects(ctx, h.bucket, minio.ListObjectsOptions{Prefix: name + "/"}) {
+		if obj.Err != nil {
+			if minio.ToErrorResponse(obj.Err).Code == "NoSuchBucket" {
+				return nil, nil
+			}
+			return nil, obj.Err
+		}
+		// the history of policies nested under this name shows up as common prefixes
+		if id, ok := strings.CutSuffix(strings.TrimPrefix(obj.Key, name+"/"), ".json"); ok && !strings.Contains(id, "/") {
+			ids = append(ids, id)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+	return ids, nil
+}
+
+// list returns the revisions of a policy newest first
+func (h *policyHistory) list(ctx context.Context, name string) ([]*policyRevision, error) {
+	ids, err := h.revisionIDs(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*policyRevision, 0, len(ids))
+	for _, id := range ids {
+		rev, err := h.get(ctx, name, id)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, nil
+}
+
+// get returns a revision of a policy
+func (h *policyHistory) get(ctx context.Context, name, revision string) (*policyRevision, error) {
+	if _, err := time.Parse(policyRevisionLayout, revision); err != nil {
+		return nil, ErrPolicyRevisionNotFound
+	}
+	obj, err := h.client.getObject(ctx, h.bucket, policyRevisionKey(name, revision), minio.GetObjectOptions{})
+	if err == nil {
+		defer obj.Close()
+		var data []byte
+		if data, err = io.ReadAll(obj); err == nil {
+			var rev policyRevision
+			if err = json.Unmarshal(data, &rev); err != nil {
+				return nil, err
+			}
+			return &rev, nil
+		}
+	}
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey", "NoSuchBucket":
+		return nil, ErrPolicyRevisionNotFound
+	}
+	return nil, err
+}
+
+// keepPolicyRevision records a policy written by console when policy history is enabled, the
+// policy is already applied so failures are only logged
+func keepPolicyRevision(ctx context.Context, session *models.Principal, req *http.Request, name, comment string, before, after *iampolicy.Policy) {
+	history, err := newPolicyHistory(session, req)
+	if errors.Is(err, ErrPolicyHistoryDisabled) || after == nil {
+		return
+	}
+	if err == nil {
+		// identity provider sessions have no access key, they are recorded under their identity
+		author, _ := sessionIdentity(session)
+		_, err = history.record(ctx, name, author, comment, before, after, time.Now())
+	}
+	if err != nil {
+		LogError("unable to keep a revision of policy %s: %v", name, err)
+	}
+}
+
+// canonicalStatement renders a statement with sorted actions and resources so equal statements
+// render the same
+func canonicalStatement(statement iampolicy.Statement) string {
+	data, err := json.Marshal(statement)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	for _, key := range []string{"Action", "NotAction", "Resource"} {
+		if values, ok := fields[key].([]interface{}); ok {
+			sort.Slice(values, func(i, j int) bool {
+				return fmt.Sprint(values[i]) < fmt.Sprint(values[j])
+			})
+		}
+	}
+	data, _ = json.Marshal(fields)
+	return string(data)
+}
+
+// diffPolicyStatements compares two policies statement by statement, statements are matched by
+// Sid first and then by content. Changes follow the order of the newer policy with the removed
+// statements last.
+func diffPolicyStatements(from, to *iampolicy.Policy) []*models.PolicyStatementChange {
+	used := make([]bool, len(from.Statements))
+	match := func(statement iampolicy.Statement) int {
+		if statement.SID != "" {
+			for i, f := range from.Statements {
+				if !used[i] && f.SID == statement.SID {
+					return i
+				}
+			}
+		}
+		for i, f := range from.Statements {
+			if !used[i] && f.Equals(statement) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	changes := []*models.PolicyStatementChange{}
+	for _, statement := range to.Statements {
+		change := &models.PolicyStatementChange{
+			Sid:    string(statement.SID),
+			After:  canonicalStatement(statement),
+			Change: models.PolicyStatementChangeChangeAdded,
+		}
+		if i := match(statement); i >= 0 {
+			used[i] = true
+			change.Before = canonicalStatement(from.Statements[i])
+			change.Change = models.PolicyStatementChangeChangeModified
+			if from.Statements[i].SID == statement.SID && from.Statements[i].Equals(statement) {
+				change.Change = models.PolicyStatementChangeChangeUnchanged
+			}
+		}
+		changes = append(changes, change)
+	}
+	for i, statement := range from.Statements {
+		if used[i] {
+			continue
+		}
+		changes = append(changes, &models.PolicyStatementChange{
+			Sid:    string(statement.SID),
+			Before: canonicalStatement(statement),
+			Change: models.PolicyStatementChangeChangeRemoved,
+		})
+	}
+	return changes
+}
+
+func getListPolicyRevisionsResponse(session *models.Principal, params policyApi.ListPolicyRevisionsParams) (*models.ListPolicyRevisionsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	policyName, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	history, err := newPolicyHistory(session, params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	revisions, err := history.list(ctx, policyName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp := &models.ListPolicyRevisionsResponse{Revisions: []*models.PolicyRevision{}}
+	for _, rev := range revisions {
+		resp.Revisions = append(resp.Revisions, rev.toModel())
+	}
+	return resp, nil
+}
+
+// diffPolicyRevisions compares two revisions of a policy
+func diffPolicyRevisions(ctx context.Context, history *policyHistory, name, from, to string) (*models.PolicyRevisionDiff, error) {
+	fromRev, err := history.get(ctx, name, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := history.get(ctx, name, to)
+	if err != nil {
+		return nil, err
+	}
+	fromPolicy, err := fromRev.parsePolicy()
+	if err != nil {
+		return nil, err
+	}
+	toPolicy, err := toRev.parsePolicy()
+	if err != nil {
+		return nil, err
+	}
+	return &models.PolicyRevisionDiff{
+		Name:       name,
+		From:       fromRev.toModel(),
+		To:         toRev.toModel(),
+		Statements: diffPolicyStatements(fromPolicy, toPolicy),
+	}, nil
+}
+
+func getDiffPolicyRevisionsResponse(session *models.Principal, params policyApi.DiffPolicyRevisionsParams) (*models.PolicyRevisionDiff, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	policyName, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	history, err := newPolicyHistory(session, params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	diff, err := diffPolicyRevisions(ctx, history, policyName, params.From, params.To)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return diff, nil
+}
+
+// rollbackPolicy writes the document of a revision back as the policy and keeps it as a new revision
+func rollbackPolicy(ctx context.Context, client MinioAdmin, history *policyHistory, name, revision, author, comment string, now time.Time) (*models.Policy, error) {
+	rev, err := history.get(ctx, name, revision)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := rev.parsePolicy()
+	if err != nil {
+		return nil, err
+	}
+	if err = client.addPolicy(ctx, name, policy); err != nil {
+		return nil, err
+	}
+	if comment == "" {
+		comment = "rolled back to revision " + revision
+	}
+	if _, err = history.add(ctx, name, author, comment, policy, now); err != nil {
+		LogError("unable to keep a revision of policy %s: %v", name, err)
+	}
+	return policyInfo(ctx, client, name)
+}
+
+func getRollbackPolicyResponse(session *models.Principal, params policyApi.RollbackPolicyParams) (*models.Policy, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	policyName, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	author, err := sessionIdentity(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	history, err := newPolicyHistory(session, params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	comment := ""
+	if params.Body != nil {
+		comment = params.Body.Comment
+	}
+	before, _ := adminClient.getPolicy(ctx, policyName)
+	policy, err := rollbackPolicy(ctx, adminClient, history, policyName, params.Revision, author, comment, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	after, _ := adminClient.getPolicy(ctx, policyName)
+	auditEvent(params.HTTPRequest, session, auditTargetPolicy, policyName, before, after)
+	return policy, nil
+}
@@ -0,0 +1,186 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	iampolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+// setPolicyHistoryMocks mocks a bucket that doesn't exist until it's created
+func setPolicyHistoryMocks() map[string][]byte {
+	var created bool
+	objects := map[string][]byte{}
+	noSuchBucket := minio.ErrorResponse{Code: "NoSuchBucket"}
+	minioMakeBucketWithContextMock = func(_ context.Context, _, _ string, _ bool) error {
+		created = true
+		return nil
+	}
+	minioPutObjectMock = func(_ context.Context, _, objectName string, reader io.Reader, _ int64, _ minio.PutObjectOptions) (minio.UploadInfo, error) {
+		if !created {
+			return minio.UploadInfo{}, noSuchBucket
+		}
+		data, err := io.ReadAll(reader)
+		objects[objectName] = data
+		return minio.UploadInfo{Key: objectName}, err
+	}
+	minioGetObjectMock = func(_ context.Context, _, objectName string, _ minio.GetObjectOptions) (io.ReadCloser, error) {
+		data, ok := objects[objectName]
+		if !ok {
+			return nil, minio.ErrorResponse{Code: "NoSuchKey"}
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	minioListObjectsMock = func(_ context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		var keys []string
+		for key := range objects {
+			if strings.HasPrefix(key, opts.Prefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		ch := make(chan minio.ObjectInfo, len(keys)+1)
+		if !created {
+			ch <- minio.ObjectInfo{Err: noSuchBucket}
+		}
+		for _, key := range keys {
+			ch <- minio.ObjectInfo{Key: key}
+		}
+		close(ch)
+		return ch
+	}
+	return objects
+}
+
+const (
+	policyRevisionV1 = `{"Version":"2012-10-17","Statement":[{"Sid":"read","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]},{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::photos"]},{"Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`
+	policyRevisionV2 = `{"Version":"2012-10-17","Statement":[{"Sid":"read","Effect":"Allow","Action":["s3:GetObject","s3:GetObjectVersion"],"Resource":["arn:aws:s3:::photos/*"]},{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::photos"]},{"Effect":"Allow","Action":["s3:DeleteObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`
+)
+
+func TestPolicyHistory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	objects := setPolicyHistoryMocks()
+	history := &policyHistory{client: minioClientMock{}, bucket: "policy-history"}
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	// no history yet
+	revisions, err := history.list(ctx, "photos")
+	assert.NoError(t, err)
+	assert.Empty(t, revisions)
+
+	// the policy as it was before gets kept first
+	rev, err := history.record(ctx, "photos", "alice", "allow deletes", mustParsePolicy(t, policyRevisionV1), mustParsePolicy(t, policyRevisionV2), now)
+	assert.NoError(t, err)
+	assert.Equal(t, "20240310T120000.000000000Z", rev.Revision)
+	assert.Len(t, objects, 2)
+	_, err = history.record(ctx, "photos", "bob", "", mustParsePolicy(t, policyRevisionV2), mustParsePolicy(t, policyRevisionV1), now.Add(time.Minute))
+	assert.NoError(t, err)
+	// policies nested under the name have their own history
+	_, err = history.record(ctx, "photos/archive", "bob", "", nil, mustParsePolicy(t, policyRevisionV1), now)
+	assert.NoError(t, err)
+
+	revisions, err = history.list(ctx, "photos")
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 3) {
+		assert.Equal(t, "20240310T120100.000000000Z", revisions[0].Revision)
+		assert.Equal(t, "bob", revisions[0].Author)
+		assert.Equal(t, "alice", revisions[1].Author)
+		assert.Equal(t, "allow deletes", revisions[1].Comment)
+		assert.Equal(t, "20240310T115959.999999999Z", revisions[2].Revision)
+		assert.Equal(t, "", revisions[2].Author)
+	}
+
+	_, err = history.get(ctx, "photos", "20240101T000000.000000000Z")
+	assert.ErrorIs(t, err, ErrPolicyRevisionNotFound)
+	_, err = history.get(ctx, "photos", "../users")
+	assert.ErrorIs(t, err, ErrPolicyRevisionNotFound)
+}
+
+func Test_diffPolicyStatements(t *testing.T) {
+	changes := diffPolicyStatements(mustParsePolicy(t, policyRevisionV1), mustParsePolicy(t, policyRevisionV2))
+	var kinds []string
+	for _, change := range changes {
+		kinds = append(kinds, change.Change)
+	}
+	assert.Equal(t, []string{
+		models.PolicyStatementChangeChangeModified,
+		models.PolicyStatementChangeChangeUnchanged,
+		models.PolicyStatementChangeChangeAdded,
+		models.PolicyStatementChangeChangeRemoved,
+	}, kinds)
+	assert.Equal(t, "read", changes[0].Sid)
+	assert.Equal(t, `{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["arn:aws:s3:::photos/*"],"Sid":"read"}`, changes[0].Before)
+	assert.Equal(t, `{"Action":["s3:GetObject","s3:GetObjectVersion"],"Effect":"Allow","Resource":["arn:aws:s3:::photos/*"],"Sid":"read"}`, changes[0].After)
+	assert.Empty(t, changes[2].Before)
+	assert.Empty(t, changes[3].After)
+	assert.Contains(t, changes[3].Before, "s3:PutObject")
+}
+
+func Test_rollbackPolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	setPolicyHistoryMocks()
+	history := &policyHistory{client: minioClientMock{}, bucket: "policy-history"}
+	adminClient := AdminClientMock{}
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	v1, err := history.record(ctx, "photos", "alice", "", nil, mustParsePolicy(t, policyRevisionV1), now)
+	assert.NoError(t, err)
+	_, err = history.record(ctx, "photos", "alice", "", mustParsePolicy(t, policyRevisionV1), mustParsePolicy(t, policyRevisionV2), now.Add(time.Minute))
+	assert.NoError(t, err)
+
+	var current *iampolicy.Policy
+	minioAddPolicyMock = func(_ string, policy *iampolicy.Policy) error {
+		current = policy
+		return nil
+	}
+	minioGetPolicyMock = func(_ string) (*iampolicy.Policy, error) {
+		return current, nil
+	}
+	policy, err := rollbackPolicy(ctx, adminClient, history, "photos", v1.Revision, "bob", "", now.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, "photos", policy.Name)
+	assert.True(t, current.Equals(*mustParsePolicy(t, policyRevisionV1)))
+
+	revisions, err := history.list(ctx, "photos")
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 3) {
+		assert.Equal(t, "bob", revisions[0].Author)
+		assert.Equal(t, "rolled back to revision 20240310T120000.000000000Z", revisions[0].Comment)
+	}
+
+	_, err = rollbackPolicy(ctx, adminClient, history, "photos", "20240101T000000.000000000Z", "bob", "", now)
+	assert.ErrorIs(t, err, ErrPolicyRevisionNotFound)
+
+	diff, err := diffPolicyRevisions(ctx, history, "photos", revisions[1].Revision, revisions[0].Revision)
+	assert.NoError(t, err)
+	assert.Equal(t, revisions[1].Revision, diff.From.Revision)
+	assert.Equal(t, revisions[0].Revision, diff.To.Revision)
+	// the rollback undoes every change
+	assert.Len(t, diff.Statements, 4)
+}
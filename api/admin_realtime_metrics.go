@@ -0,0 +1,441 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+)
+
+// Series collected from madmin realtime metrics, values are kept per host and
+// aggregated (empty host)
+const (
+	seriesDrivesOnline      = "drives_online"
+	seriesDrivesOffline     = "drives_offline"
+	seriesDrivesHealing     = "drives_healing"
+	seriesDriveReadBytes    = "drive_read_bytes"
+	seriesDriveWriteBytes   = "drive_write_bytes"
+	seriesNetRxBytes        = "net_rx_bytes"
+	seriesNetTxBytes        = "net_tx_bytes"
+	seriesScannerObjects    = "scanner_objects"
+	seriesScannerCycle      = "scanner_cycle"
+	seriesOSOperations      = "os_operations"
+	seriesMemUsed           = "mem_used"
+	seriesCPULoad1          = "cpu_load1"
+	seriesResyncReplicated  = "resync_replicated_bytes"
+	seriesResyncFailedCount = "resync_failed_count"
+)
+
+// counters are cumulative values, they are reported as a per second rate
+var realtimeCounterSeries = map[string]bool{
+	seriesDriveReadBytes:  true,
+	seriesDriveWriteBytes: true,
+	seriesNetRxBytes:      true,
+	seriesNetTxBytes:      true,
+	seriesScannerObjects:  true,
+	seriesOSOperations:    true,
+}
+
+const (
+	realtimeMetricsInterval    = 10 * time.Second
+	realtimeMetricsIdleTimeout = 15 * time.Minute
+	// sector size used by the kernel to report disk io stats
+	diskSectorSize = 512
+)
+
+type RealtimeTarget struct {
+	Series       string
+	LegendFormat string
+	// PerHost returns one result per host instead of the aggregated value
+	PerHost bool
+}
+
+type RealtimeWidget struct {
+	ID      int32
+	Title   string
+	Type    string
+	Options MetricOptions
+	Targets []RealtimeTarget
+}
+
+// realtimeWidgets are the widgets served when prometheus is not configured,
+// ids don't overlap with the prometheus widgets
+var realtimeWidgets = []RealtimeWidget{
+	{
+		ID:    1001,
+		Title: "Drives",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesDrivesOnline, LegendFormat: "Online"},
+			{Series: seriesDrivesOffline, LegendFormat: "Offline"},
+			{Series: seriesDrivesHealing, LegendFormat: "Healing"},
+		},
+	},
+	{
+		ID:    1002,
+		Title: "Drive Throughput",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesDriveReadBytes, LegendFormat: "Read"},
+			{Series: seriesDriveWriteBytes, LegendFormat: "Write"},
+		},
+	},
+	{
+		ID:    1003,
+		Title: "Network Traffic",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesNetRxBytes, LegendFormat: "RX [{{server}}]", PerHost: true},
+			{Series: seriesNetTxBytes, LegendFormat: "TX [{{server}}]", PerHost: true},
+		},
+	},
+	{
+		ID:    1004,
+		Title: "Scanned Objects",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesScannerObjects, LegendFormat: "Objects/s"},
+		},
+	},
+	{
+		ID:    1005,
+		Title: "Scanner Cycle",
+		Type:  "stat",
+		Options: MetricOptions{
+			ReduceOptions: ReduceOptions{
+				Calcs: []string{
+					"last",
+				},
+			},
+		},
+		Targets: []RealtimeTarget{
+			{Series: seriesScannerCycle},
+		},
+	},
+	{
+		ID:    1006,
+		Title: "OS Operations",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesOSOperations, LegendFormat: "{{server}}", PerHost: true},
+		},
+	},
+	{
+		ID:    1007,
+		Title: "Memory Used",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesMemUsed, LegendFormat: "{{server}}", PerHost: true},
+		},
+	},
+	{
+		ID:    1008,
+		Title: "CPU Load (1m)",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesCPULoad1, LegendFormat: "{{server}}", PerHost: true},
+		},
+	},
+	{
+		ID:    1009,
+		Title: "Site Replication Resync",
+		Type:  "graph",
+		Targets: []RealtimeTarget{
+			{Series: seriesResyncReplicated, LegendFormat: "Replicated Bytes"},
+			{Series: seriesResyncFailedCount, LegendFormat: "Failed Objects"},
+		},
+	},
+}
+
+// realtimeSample holds the values collected at a point in time, indexed by series and host
+type realtimeSample struct {
+	Time   time.Time
+	Values map[string]map[string]float64
+}
+
+func (s *realtimeSample) set(series, host string, value float64) {
+	if s.Values[series] == nil {
+		s.Values[series] = map[string]float64{}
+	}
+	s.Values[series][host] = value
+}
+
+func (s realtimeSample) sampleTime() time.Time {
+	return s.Time
+}
+
+// RealtimeMetricsCollector polls MinIO realtime metrics and keeps a rolling
+// window of samples in memory
+type RealtimeMetricsCollector struct {
+	window *metricsWindow[realtimeSample]
+	// newClient returns the client used to poll, it doesn't belong to any user session
+	// so collection doesn't depend on the rights or the expiration of the user credentials
+	newClient func() (MinioAdmin, error)
+}
+
+var globalRealtimeMetrics = NewRealtimeMetricsCollector(getRealtimeMetricsRetention(), newRealtimeMetricsClient)
+
+// NewRealtimeMetricsCollector returns a collector keeping samples for the retention period
+func NewRealtimeMetricsCollector(retention time.Duration, newClient func() (MinioAdmin, error)) *RealtimeMetricsCollector {
+	return &RealtimeMetricsCollector{
+		window:    newMetricsWindow[realtimeSample](retention, realtimeMetricsIdleTimeout),
+		newClient: newClient,
+	}
+}
+
+// newRealtimeMetricsClient returns an admin client for the dedicated realtime metrics credentials
+func newRealtimeMetricsClient() (MinioAdmin, error) {
+	accessKey, secretKey := getRealtimeMetricsCredentials()
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New("realtime metrics credentials are not configured")
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		return nil, err
+	}
+	mAdmin.SetCustomTransport(GetConsoleHTTPClient(getMinIOServer(), LocalAddress).Transport)
+	return AdminClient{Client: mAdmin}, nil
+}
+
+// EnsureRunning starts polling unless it's already running, polling stops when the
+// client fails or nobody has read the data for a while, the next dashboard request
+// will start it again.
+func (c *RealtimeMetricsCollector) EnsureRunning() {
+	c.window.ensureRunning(func() {
+		client, err := c.newClient()
+		if err != nil {
+			LogError("unable to create admin client to collect realtime metrics: %v", err)
+			return
+		}
+		c.poll(client)
+	})
+}
+
+func (c *RealtimeMetricsCollector) poll(client MinioAdmin) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := madmin.MetricsOptions{
+		Type: madmin.MetricsDisk | madmin.MetricNet | madmin.MetricsScanner | madmin.MetricsOS |
+			madmin.MetricsMem | madmin.MetricsCPU | madmin.MetricsSiteResync,
+		Interval: realtimeMetricsInterval,
+		ByHost:   true,
+	}
+	err := client.realtimeMetrics(ctx, opts, func(m madmin.RealtimeMetrics) {
+		if c.window.idle() {
+			cancel()
+			return
+		}
+		c.Add(time.Now(), m)
+	})
+	if err != nil && ctx.Err() == nil {
+		LogError("realtime metrics stopped: %v", err)
+	}
+}
+
+// Add converts a realtime metrics packet into a sample and drops the samples out of retention
+func (c *RealtimeMetricsCollector) Add(now time.Time, m madmin.RealtimeMetrics) {
+	sample := realtimeSample{Time: now, Values: map[string]map[string]float64{}}
+	addRealtimeMetrics(&sample, "", m.Aggregated)
+	for host, hostMetrics := range m.ByHost {
+		addRealtimeMetrics(&sample, host, hostMetrics)
+	}
+	c.window.add(sample)
+}
+
+func addRealtimeMetrics(sample *realtimeSample, host string, m madmin.Metrics) {
+	if m.Disk != nil {
+		sample.set(seriesDrivesOnline, host, float64(m.Disk.NDisks-m.Disk.Offline))
+		sample.set(seriesDrivesOffline, host, float64(m.Disk.Offline))
+		sample.set(seriesDrivesHealing, host, float64(m.Disk.Healing))
+		sample.set(seriesDriveReadBytes, host, float64(m.Disk.IOStats.ReadSectors*diskSectorSize))
+		sample.set(seriesDriveWriteBytes, host, float64(m.Disk.IOStats.WriteSectors*diskSectorSize))
+	}
+	if m.Net != nil {
+		sample.set(seriesNetRxBytes, host, float64(m.Net.NetStats.RxBytes))
+		sample.set(seriesNetTxBytes, host, float64(m.Net.NetStats.TxBytes))
+	}
+	if m.Scanner != nil {
+		sample.set(seriesScannerObjects, host, float64(m.Scanner.LifeTimeOps["ScanObject"]))
+		sample.set(seriesScannerCycle, host, float64(m.Scanner.CurrentCycle))
+	}
+	if m.OS != nil {
+		var ops uint64
+		for _, v := range m.OS.LifeTimeOps {
+			ops += v
+		}
+		sample.set(seriesOSOperations, host, float64(ops))
+	}
+	if m.Mem != nil {
+		sample.set(seriesMemUsed, host, float64(m.Mem.Info.Total-m.Mem.Info.Available))
+	}
+	if m.CPU != nil && m.CPU.LoadStat != nil {
+		sample.set(seriesCPULoad1, host, m.CPU.LoadStat.Load1)
+	}
+	if m.SiteResync != nil {
+		sample.set(seriesResyncReplicated, host, float64(m.SiteResync.ReplicatedSize))
+		sample.set(seriesResyncFailedCount, host, float64(m.SiteResync.FailedCount))
+	}
+}
+
+// seriesPoint is a single value of a series for a host
+type seriesPoint struct {
+	time  time.Time
+	value float64
+}
+
+// series returns the points of a series between start and end grouped by host, counters
+// are converted to per second rates
+func (c *RealtimeMetricsCollector) series(name string, perHost bool, start, end time.Time) map[string][]seriesPoint {
+	result := map[string][]seriesPoint{}
+	previous := map[string]seriesPoint{}
+	c.window.each(func(sample realtimeSample) {
+		for host, value := range sample.Values[name] {
+			if (host != "") != perHost {
+				continue
+			}
+			point := seriesPoint{time: sample.Time, value: value}
+			if realtimeCounterSeries[name] {
+				prev, ok := previous[host]
+				previous[host] = point
+				elapsed := point.time.Sub(prev.time).Seconds()
+				// counters reset when a server restarts
+				if !ok || elapsed <= 0 || value < prev.value {
+					continue
+				}
+				point.value = (value - prev.value) / elapsed
+			}
+			if point.time.Before(start) || point.time.After(end) {
+				continue
+			}
+			result[host] = append(result[host], point)
+		}
+	})
+	return result
+}
+
+// downsample keeps the last point of every step sized bucket
+func downsample(points []seriesPoint, step time.Duration) []seriesPoint {
+	if step <= 0 || len(points) == 0 {
+		return points
+	}
+	var result []seriesPoint
+	var lastBucket int64 = -1
+	for _, p := range points {
+		bucket := p.time.Unix() / int64(step.Seconds())
+		if bucket == lastBucket {
+			result[len(result)-1] = p
+			continue
+		}
+		lastBucket = bucket
+		result = append(result, p)
+	}
+	return result
+}
+
+// WidgetDetails builds the widget results with the same shape prometheus range queries are served
+func (c *RealtimeMetricsCollector) WidgetDetails(widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *CodedAPIError) {
+	c.window.touch()
+	var widget *RealtimeWidget
+	for i := range realtimeWidgets {
+		if realtimeWidgets[i].ID == widgetID {
+			widget = &realtimeWidgets[i]
+			break
+		}
+	}
+	if widget == nil {
+		return nil, &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Widget not found"}}
+	}
+
+	rangeEnd := time.Now()
+	rangeStart := rangeEnd.Add(-15 * time.Minute)
+	if start != nil && end != nil {
+		rangeStart = time.Unix(*start, 0)
+		rangeEnd = time.Unix(*end, 0)
+	}
+	var rangeStep time.Duration
+	if step != nil && *step > 0 {
+		rangeStep = time.Duration(*step) * time.Second
+	}
+
+	wdgtResult := models.WidgetDetails{
+		ID:    widget.ID,
+		Title: widget.Title,
+		Type:  widget.Type,
+	}
+	if len(widget.Options.ReduceOptions.Calcs) > 0 {
+		wdgtResult.Options = &models.WidgetDetailsOptions{
+			ReduceOptions: &models.WidgetDetailsOptionsReduceOptions{
+				Calcs: widget.Options.ReduceOptions.Calcs,
+			},
+		}
+	}
+	for _, target := range widget.Targets {
+		targetResult := &models.ResultTarget{
+			LegendFormat: target.LegendFormat,
+			ResultType:   "matrix",
+		}
+		byHost := c.series(target.Series, target.PerHost, rangeStart, rangeEnd)
+		hosts := make([]string, 0, len(byHost))
+		for host := range byHost {
+			hosts = append(hosts, host)
+		}
+		sort.Strings(hosts)
+		for _, host := range hosts {
+			var values []interface{}
+			for _, p := range downsample(byHost[host], rangeStep) {
+				values = append(values, []interface{}{p.time.Unix(), strconv.FormatFloat(p.value, 'f', -1, 64)})
+			}
+			metric := map[string]string{}
+			if host != "" {
+				metric["server"] = host
+			}
+			targetResult.Result = append(targetResult.Result, &models.WidgetResult{
+				Metric: metric,
+				Values: values,
+			})
+		}
+		wdgtResult.Targets = append(wdgtResult.Targets, targetResult)
+	}
+	return &wdgtResult, nil
+}
+
+// getRealtimeWidgets returns the list of realtime widgets the dashboard can request
+func getRealtimeWidgets() []*models.Widget {
+	var wdgts []*models.Widget
+	for _, m := range realtimeWidgets {
+		wdgtResult := models.Widget{
+			ID:    m.ID,
+			Title: m.Title,
+			Type:  m.Type,
+		}
+		if len(m.Options.ReduceOptions.Calcs) > 0 {
+			wdgtResult.Options = &models.WidgetOptions{
+				ReduceOptions: &models.WidgetOptionsReduceOptions{
+					Calcs: m.Options.ReduceOptions.Calcs,
+				},
+			}
+		}
+		wdgts = append(wdgts, &wdgtResult)
+	}
+	return wdgts
+}
@@ -0,0 +1,147 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func realtimeMetricsPacket(rxBytes uint64, offline int) madmin.RealtimeMetrics {
+	netMetrics := &madmin.NetMetrics{}
+	netMetrics.NetStats.RxBytes = rxBytes
+	return madmin.RealtimeMetrics{
+		Aggregated: madmin.Metrics{
+			Disk: &madmin.DiskMetric{NDisks: 4, Offline: offline},
+		},
+		ByHost: map[string]madmin.Metrics{
+			"node1:9000": {
+				Net: netMetrics,
+			},
+		},
+	}
+}
+
+func TestRealtimeMetricsCollector(t *testing.T) {
+	assert := assert.New(t)
+	collector := NewRealtimeMetricsCollector(time.Hour, nil)
+
+	now := time.Now()
+	collector.Add(now.Add(-90*time.Minute), realtimeMetricsPacket(0, 0))
+	collector.Add(now.Add(-20*time.Second), realtimeMetricsPacket(1000, 0))
+	collector.Add(now.Add(-10*time.Second), realtimeMetricsPacket(3000, 1))
+
+	// samples out of retention are dropped
+	assert.Len(collector.window.samples, 2)
+
+	start := now.Add(-time.Minute).Unix()
+	end := now.Unix()
+	details, err := collector.WidgetDetails(1001, nil, &start, &end)
+	assert.Nil(err)
+	assert.Equal("Drives", details.Title)
+	assert.Len(details.Targets, 3)
+	online := details.Targets[0].Result[0].Values
+	assert.Len(online, 2)
+	assert.Equal("3", online[1].([]interface{})[1])
+
+	// counters are served as rates per host
+	details, err = collector.WidgetDetails(1003, nil, &start, &end)
+	assert.Nil(err)
+	rx := details.Targets[0].Result
+	assert.Len(rx, 1)
+	assert.Equal("node1:9000", rx[0].Metric["server"])
+	assert.Len(rx[0].Values, 1)
+	assert.Equal("200", rx[0].Values[0].([]interface{})[1])
+
+	_, err = collector.WidgetDetails(1, nil, &start, &end)
+	assert.NotNil(err)
+	assert.Equal(404, err.Code)
+}
+
+func TestRealtimeMetricsCollectorPolling(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	collector := NewRealtimeMetricsCollector(time.Hour, func() (MinioAdmin, error) {
+		return adminClient, nil
+	})
+
+	done := make(chan struct{})
+	minioRealtimeMetricsMock = func(_ context.Context, opts madmin.MetricsOptions, out func(madmin.RealtimeMetrics)) error {
+		defer close(done)
+		assert.True(opts.ByHost)
+		out(realtimeMetricsPacket(10, 0))
+		return nil
+	}
+	collector.EnsureRunning()
+	<-done
+
+	assert.Eventually(func() bool {
+		collector.window.mu.RLock()
+		defer collector.window.mu.RUnlock()
+		return !collector.window.running && len(collector.window.samples) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRealtimeMetricsCollectorWithoutClient(t *testing.T) {
+	assert := assert.New(t)
+	collector := NewRealtimeMetricsCollector(time.Hour, func() (MinioAdmin, error) {
+		return nil, errors.New("realtime metrics credentials are not configured")
+	})
+	minioRealtimeMetricsMock = func(_ context.Context, _ madmin.MetricsOptions, _ func(madmin.RealtimeMetrics)) error {
+		t.Error("realtime metrics polled without a client")
+		return nil
+	}
+	collector.EnsureRunning()
+
+	assert.Eventually(func() bool {
+		collector.window.mu.RLock()
+		defer collector.window.mu.RUnlock()
+		return !collector.window.running
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGetRealtimeMetricsEnabled(t *testing.T) {
+	t.Setenv(ConsoleRealtimeMetricsAccessKey, "")
+	t.Setenv(ConsoleRealtimeMetricsSecretKey, "")
+	assert.False(t, getRealtimeMetricsEnabled())
+
+	t.Setenv(ConsoleRealtimeMetricsAccessKey, "metrics")
+	t.Setenv(ConsoleRealtimeMetricsSecretKey, "metrics123")
+	assert.True(t, getRealtimeMetricsEnabled())
+
+	t.Setenv(ConsoleRealtimeMetrics, "off")
+	assert.False(t, getRealtimeMetricsEnabled())
+}
+
+func TestDownsample(t *testing.T) {
+	assert := assert.New(t)
+	base := time.Unix(1000, 0)
+	points := []seriesPoint{
+		{time: base, value: 1},
+		{time: base.Add(10 * time.Second), value: 2},
+		{time: base.Add(60 * time.Second), value: 3},
+	}
+	assert.Len(downsample(points, 0), 3)
+	sampled := downsample(points, time.Minute)
+	assert.Len(sampled, 2)
+	assert.Equal(float64(2), sampled[0].value)
+}
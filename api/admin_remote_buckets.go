@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/minio/console/pkg/utils"
@@ -178,6 +179,7 @@ func getDeleteRemoteBucketResponse(session *models.Principal, params bucketApi.D
 	if err != nil {
 		return ErrorWithContext(ctx, fmt.Errorf("error deleting remote bucket: %v", err))
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.SourceBucketName, map[string]string{"arn": params.Arn}, nil)
 	return nil
 }
 
@@ -193,6 +195,7 @@ func getAddRemoteBucketResponse(session *models.Principal, params bucketApi.AddR
 	if err != nil {
 		return ErrorWithContext(ctx, fmt.Errorf("error adding remote bucket: %v", err))
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, *params.Body.SourceBucket, nil, params.Body)
 	return nil
 }
 
@@ -541,6 +544,11 @@ func setMultiBucketReplicationResponse(session *models.Principal, params bucketA
 	resultsParsed := models.MultiBucketResponseState{
 		ReplicationState: resParsed,
 	}
+	originBuckets := make([]string, 0, len(params.Body.BucketsRelation))
+	for _, relation := range params.Body.BucketsRelation {
+		originBuckets = append(originBuckets, relation.OriginBucket)
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, strings.Join(originBuckets, ","), nil, params.Body)
 
 	return &resultsParsed, nil
 }
@@ -741,6 +749,7 @@ func deleteReplicationRuleResponse(session *models.Principal, params bucketApi.D
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, map[string]string{"ruleID": params.RuleID}, nil)
 	return nil
 }
 
@@ -752,6 +761,7 @@ func deleteBucketReplicationRulesResponse(session *models.Principal, params buck
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, nil, nil)
 	return nil
 }
 
@@ -765,6 +775,7 @@ func deleteSelectedReplicationRulesResponse(session *models.Principal, params bu
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, params.Rules, nil)
 	return nil
 }
 
@@ -799,6 +810,7 @@ func updateBucketReplicationResponse(session *models.Principal, params bucketApi
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, nil, params.Body)
 
 	return nil
 }
@@ -0,0 +1,132 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/websocket"
+)
+
+type replicationDiffOptions struct {
+	BucketName string
+	madmin.ReplDiffOpts
+}
+
+// replicationDiffTarget is the replication status of an object on a remote target
+type replicationDiffTarget struct {
+	Status       string `json:"status,omitempty"`
+	DeleteStatus string `json:"deleteStatus,omitempty"`
+}
+
+// replicationDiffEntry is an object version pending or failed to replicate, sent as a websocket message
+type replicationDiffEntry struct {
+	Object               string                           `json:"object"`
+	VersionID            string                           `json:"versionId,omitempty"`
+	IsDeleteMarker       bool                             `json:"isDeleteMarker"`
+	Status               string                           `json:"status,omitempty"`
+	DeleteStatus         string                           `json:"deleteStatus,omitempty"`
+	LastModified         string                           `json:"lastModified,omitempty"`
+	ReplicationTimestamp string                           `json:"replicationTimestamp,omitempty"`
+	Targets              map[string]replicationDiffTarget `json:"targets,omitempty"`
+}
+
+func newReplicationDiffEntry(diff madmin.DiffInfo) replicationDiffEntry {
+	entry := replicationDiffEntry{
+		Object:         diff.Object,
+		VersionID:      diff.VersionID,
+		IsDeleteMarker: diff.IsDeleteMarker,
+		Status:         diff.ReplicationStatus,
+		DeleteStatus:   diff.DeleteReplicationStatus,
+	}
+	if !diff.LastModified.IsZero() {
+		entry.LastModified = diff.LastModified.UTC().Format(time.RFC3339)
+	}
+	if !diff.ReplicationTimestamp.IsZero() {
+		entry.ReplicationTimestamp = diff.ReplicationTimestamp.UTC().Format(time.RFC3339)
+	}
+	if len(diff.Targets) > 0 {
+		entry.Targets = make(map[string]replicationDiffTarget, len(diff.Targets))
+		for arn, target := range diff.Targets {
+			entry.Targets[arn] = replicationDiffTarget{
+				Status:       target.ReplicationStatus,
+				DeleteStatus: target.DeleteReplicationStatus,
+			}
+		}
+	}
+	return entry
+}
+
+// startReplicationDiff streams the object versions of a bucket that are pending or failed to replicate
+func startReplicationDiff(ctx context.Context, conn WSConn, client MinioAdmin, options *replicationDiffOptions) error {
+	diffCh := client.bucketReplicationDiff(ctx, options.BucketName, options.ReplDiffOpts)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case diff, ok := <-diffCh:
+			// zero value returned because the channel is closed and empty
+			if !ok {
+				return nil
+			}
+			if diff.Err != nil {
+				LogError("error on replication diff: %v", diff.Err)
+				return diff.Err
+			}
+			// Serialize message to be sent
+			bytes, err := json.Marshal(newReplicationDiffEntry(diff))
+			if err != nil {
+				LogError("error on json.Marshal: %v", err)
+				return err
+			}
+			// Send Message through websocket connection
+			err = conn.writeMessage(websocket.TextMessage, bytes)
+			if err != nil {
+				LogError("error writeMessage: %v", err)
+				return err
+			}
+		}
+	}
+}
+
+// getReplicationDiffOptionsFromReq gets bucket name, arn and prefix from a websocket
+// replication diff path.
+// path come as : `/replication-diff/bucket1?arn=arn:minio:replication::id:dest&prefix=logs/`
+func getReplicationDiffOptionsFromReq(req *http.Request) (*replicationDiffOptions, error) {
+	re := regexp.MustCompile(`(/replication-diff/)(.*?$)`)
+	matches := re.FindAllSubmatch([]byte(req.URL.Path), -1)
+	if len(matches) == 0 || len(matches[0]) < 3 {
+		return nil, fmt.Errorf("invalid url: %s", req.URL.Path)
+	}
+	options := replicationDiffOptions{
+		BucketName: strings.TrimSpace(string(matches[0][2])),
+	}
+	if options.BucketName == "" {
+		return nil, fmt.Errorf("bucket name is required")
+	}
+	options.ARN = req.FormValue("arn")
+	options.Prefix = req.FormValue("prefix")
+	options.Verbose = req.FormValue("verbose") == "true"
+	return &options, nil
+}
@@ -0,0 +1,107 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReplicationDiffOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/ws/replication-diff/photos?arn=arn:dest&prefix=2024/", nil)
+	assert.Nil(err)
+	options, err := getReplicationDiffOptionsFromReq(req)
+	assert.Nil(err)
+	assert.Equal("photos", options.BucketName)
+	assert.Equal("arn:dest", options.ARN)
+	assert.Equal("2024/", options.Prefix)
+	assert.False(options.Verbose)
+
+	req, err = http.NewRequest(http.MethodGet, "http://localhost/ws/replication-diff/", nil)
+	assert.Nil(err)
+	_, err = getReplicationDiffOptionsFromReq(req)
+	assert.NotNil(err)
+}
+
+func TestStartReplicationDiff(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	mockWSConn := mockConn{}
+	ctx := context.Background()
+	lastModified := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	minioBucketReplicationDiffMock = func(_ context.Context, bucketName string, opts madmin.ReplDiffOpts) <-chan madmin.DiffInfo {
+		assert.Equal("photos", bucketName)
+		assert.Equal("arn:dest", opts.ARN)
+		ch := make(chan madmin.DiffInfo)
+		go func() {
+			defer close(ch)
+			ch <- madmin.DiffInfo{
+				Object: "a.jpg", VersionID: "v1", ReplicationStatus: "PENDING", LastModified: lastModified,
+				Targets: map[string]madmin.TgtDiffInfo{"arn:dest": {ReplicationStatus: "PENDING"}},
+			}
+			ch <- madmin.DiffInfo{Object: "b.jpg", ReplicationStatus: "FAILED"}
+		}()
+		return ch
+	}
+	var entries []replicationDiffEntry
+	connWriteMessageMock = func(_ int, data []byte) error {
+		var entry replicationDiffEntry
+		assert.Nil(json.Unmarshal(data, &entry))
+		entries = append(entries, entry)
+		return nil
+	}
+	options := &replicationDiffOptions{BucketName: "photos", ReplDiffOpts: madmin.ReplDiffOpts{ARN: "arn:dest"}}
+	assert.Nil(startReplicationDiff(ctx, mockWSConn, adminClient, options))
+	assert.Len(entries, 2)
+	assert.Equal("PENDING", entries[0].Status)
+	assert.Equal("2024-05-01T10:00:00Z", entries[0].LastModified)
+	assert.Equal("PENDING", entries[0].Targets["arn:dest"].Status)
+	assert.Equal("FAILED", entries[1].Status)
+	assert.Empty(entries[1].LastModified)
+
+	// errors listing the diff are returned to close the connection
+	minioBucketReplicationDiffMock = func(_ context.Context, _ string, _ madmin.ReplDiffOpts) <-chan madmin.DiffInfo {
+		ch := make(chan madmin.DiffInfo, 1)
+		ch <- madmin.DiffInfo{Err: errors.New("bucket not found")}
+		close(ch)
+		return ch
+	}
+	err := startReplicationDiff(ctx, mockWSConn, adminClient, options)
+	assert.EqualError(err, "bucket not found")
+
+	// errors writing to the connection stop the diff
+	minioBucketReplicationDiffMock = func(_ context.Context, _ string, _ madmin.ReplDiffOpts) <-chan madmin.DiffInfo {
+		ch := make(chan madmin.DiffInfo, 1)
+		ch <- madmin.DiffInfo{Object: "a.jpg"}
+		close(ch)
+		return ch
+	}
+	connWriteMessageMock = func(_ int, _ []byte) error {
+		return errors.New("error on write")
+	}
+	assert.EqualError(startReplicationDiff(ctx, mockWSConn, adminClient, options), "error on write")
+}
@@ -0,0 +1,288 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	replicationApi "github.com/minio/console/api/operations/replication"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+const (
+	// replicationMetricsInterval is how often replication metrics are sampled
+	replicationMetricsInterval = 30 * time.Second
+	// replicationMetricsIdleTimeout stops sampling once nobody has requested the metrics for a while
+	replicationMetricsIdleTimeout = 15 * time.Minute
+)
+
+func registerReplicationMetricsHandlers(api *operations.ConsoleAPI) {
+	// replication metrics per bucket remote target and site replication peer
+	api.ReplicationGetReplicationMetricsHandler = replicationApi.GetReplicationMetricsHandlerFunc(func(params replicationApi.GetReplicationMetricsParams, session *models.Principal) middleware.Responder {
+		metricsResp, err := getReplicationMetricsResponse(session, params)
+		if err != nil {
+			return replicationApi.NewGetReplicationMetricsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return replicationApi.NewGetReplicationMetricsOK().WithPayload(metricsResp)
+	})
+}
+
+// replicationTargetKey identifies a target across samples
+func replicationTargetKey(target *models.ReplicationTargetMetrics) string {
+	if target.Type == models.ReplicationTargetMetricsTypeSite {
+		return "site/" + target.DeploymentID
+	}
+	return "bucket/" + target.Bucket + "/" + target.Arn
+}
+
+func durationToMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// newBucketTargetMetrics returns the metrics of a bucket remote target, the queue is tracked per bucket
+// by MinIO so every target of the bucket reports the same queue
+func newBucketTargetMetrics(target madmin.BucketTarget, bucketMetrics replication.MetricsV2) *models.ReplicationTargetMetrics {
+	stats := bucketMetrics.CurrentStats.Stats[target.Arn]
+	bandwidthLimit := target.BandwidthLimit
+	if stats.BandWidthLimitInBytesPerSecond > 0 {
+		bandwidthLimit = stats.BandWidthLimitInBytesPerSecond
+	}
+	return &models.ReplicationTargetMetrics{
+		Type:                models.ReplicationTargetMetricsTypeBucket,
+		Bucket:              target.SourceBucket,
+		Arn:                 target.Arn,
+		TargetBucket:        target.TargetBucket,
+		DeploymentID:        target.DeploymentID,
+		Endpoint:            target.Endpoint,
+		Online:              target.Online,
+		ReplicatedSize:      int64(stats.ReplicatedSize),
+		ReplicatedCount:     int64(stats.ReplicatedCount),
+		FailedSize:          stats.Failed.Totals.Bytes,
+		FailedCount:         int64(stats.Failed.Totals.Count),
+		FailedLastHourCount: int64(stats.Failed.LastHour.Count),
+		QueuedCount:         int64(bucketMetrics.CurrentStats.QStats.Curr.Count),
+		QueuedSize:          int64(bucketMetrics.CurrentStats.QStats.Curr.Bytes),
+		LatencyCurrentMs:    durationToMs(target.Latency.Curr),
+		LatencyAverageMs:    durationToMs(target.Latency.Avg),
+		LatencyMaxMs:        durationToMs(target.Latency.Max),
+		BandwidthLimit:      bandwidthLimit,
+		CurrentBandwidth:    stats.CurrentBandwidthInBytesPerSecond,
+	}
+}
+
+// newSitePeerMetrics returns the metrics of a site replication peer, the queue is tracked per site
+func newSitePeerMetrics(deploymentID string, peer madmin.SRMetric, summary madmin.SRMetricsSummary) *models.ReplicationTargetMetrics {
+	return &models.ReplicationTargetMetrics{
+		Type:                models.ReplicationTargetMetricsTypeSite,
+		DeploymentID:        deploymentID,
+		Endpoint:            peer.Endpoint,
+		Online:              peer.Online,
+		ReplicatedSize:      peer.ReplicatedSize,
+		ReplicatedCount:     peer.ReplicatedCount,
+		FailedSize:          peer.Failed.Totals.Bytes,
+		FailedCount:         int64(peer.Failed.Totals.Count),
+		FailedLastHourCount: int64(peer.Failed.LastHour.Count),
+		QueuedCount:         int64(summary.Queued.Curr.Count),
+		QueuedSize:          int64(summary.Queued.Curr.Bytes),
+		LatencyCurrentMs:    durationToMs(peer.Latency.Curr),
+		LatencyAverageMs:    durationToMs(peer.Latency.Avg),
+		LatencyMaxMs:        durationToMs(peer.Latency.Max),
+		CurrentBandwidth:    peer.XferStats[replication.Total].CurrRate,
+	}
+}
+
+// collectReplicationMetrics returns the metrics of the remote targets of every bucket, or only of bucket
+// when set, and of the site replication peers. Site replication peers are only reported for all buckets,
+// failing to get them doesn't prevent the bucket targets from being reported.
+func collectReplicationMetrics(ctx context.Context, client MinioAdmin, minioClient MinioClient, bucket string) (*models.ReplicationMetricsResponse, error) {
+	remoteTargets, err := client.listRemoteBuckets(ctx, bucket, "replication")
+	if err != nil {
+		return nil, err
+	}
+	metricsResp := &models.ReplicationMetricsResponse{
+		SampleInterval: int64(replicationMetricsInterval / time.Second),
+		Targets:        []*models.ReplicationTargetMetrics{},
+	}
+	bucketsMetrics := map[string]replication.MetricsV2{}
+	for _, target := range remoteTargets {
+		bucketMetrics, ok := bucketsMetrics[target.SourceBucket]
+		if !ok {
+			bucketMetrics, err = minioClient.getBucketReplicationMetricsV2(ctx, target.SourceBucket)
+			if err != nil {
+				return nil, err
+			}
+			bucketsMetrics[target.SourceBucket] = bucketMetrics
+		}
+		metricsResp.Targets = append(metricsResp.Targets, newBucketTargetMetrics(target, bucketMetrics))
+	}
+	if bucket == "" {
+		srInfo, err := client.getSiteReplicationStatus(ctx, madmin.SRStatusOptions{Metrics: true})
+		if err != nil {
+			metricsResp.SiteReplicationError = err.Error()
+		} else if srInfo.Enabled {
+			for deploymentID, peer := range srInfo.Metrics.Metrics {
+				metricsResp.Targets = append(metricsResp.Targets, newSitePeerMetrics(deploymentID, peer, srInfo.Metrics))
+			}
+		}
+	}
+	sort.Slice(metricsResp.Targets, func(i, j int) bool {
+		return replicationTargetKey(metricsResp.Targets[i]) < replicationTargetKey(metricsResp.Targets[j])
+	})
+	return metricsResp, nil
+}
+
+// replicationSample holds the metrics of every target at a point in time, indexed by target key
+type replicationSample struct {
+	Time    time.Time
+	Targets map[string]*models.ReplicationTargetMetrics
+}
+
+func (s replicationSample) sampleTime() time.Time {
+	return s.Time
+}
+
+// ReplicationMetricsCollector samples replication metrics periodically and keeps a rolling
+// window of samples in memory
+type ReplicationMetricsCollector struct {
+	window *metricsWindow[replicationSample]
+}
+
+var globalReplicationMetrics = NewReplicationMetricsCollector(getReplicationMetricsRetention())
+
+// NewReplicationMetricsCollector returns a collector keeping samples for the retention period
+func NewReplicationMetricsCollector(retention time.Duration) *ReplicationMetricsCollector {
+	return &ReplicationMetricsCollector{window: newMetricsWindow[replicationSample](retention, replicationMetricsIdleTimeout)}
+}
+
+// EnsureRunning starts sampling with the provided clients unless it's already running, sampling
+// stops when the clients fail (i.e. credentials expired) or nobody has read the metrics for a while,
+// the next request will start it again.
+func (c *ReplicationMetricsCollector) EnsureRunning(client MinioAdmin, minioClient MinioClient) {
+	c.window.ensureRunning(func() { c.poll(client, minioClient) })
+}
+
+func (c *ReplicationMetricsCollector) poll(client MinioAdmin, minioClient MinioClient) {
+	ticker := time.NewTicker(replicationMetricsInterval)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), replicationMetricsInterval)
+		metricsResp, err := collectReplicationMetrics(ctx, client, minioClient, "")
+		cancel()
+		if err != nil {
+			LogError("replication metrics sampling stopped: %v", err)
+			return
+		}
+		c.Add(time.Now(), metricsResp.Targets)
+
+		<-ticker.C
+		if c.window.idle() {
+			return
+		}
+	}
+}
+
+// Add records the metrics of the targets and drops the samples out of retention
+func (c *ReplicationMetricsCollector) Add(now time.Time, targets []*models.ReplicationTargetMetrics) {
+	sample := replicationSample{Time: now, Targets: make(map[string]*models.ReplicationTargetMetrics, len(targets))}
+	for _, target := range targets {
+		sample.Targets[replicationTargetKey(target)] = target
+	}
+	c.window.add(sample)
+}
+
+// History returns the points sampled for the target, oldest first
+func (c *ReplicationMetricsCollector) History(target *models.ReplicationTargetMetrics) []*models.ReplicationMetricsPoint {
+	key := replicationTargetKey(target)
+	points := []*models.ReplicationMetricsPoint{}
+	c.window.each(func(sample replicationSample) {
+		sampled, ok := sample.Targets[key]
+		if !ok {
+			return
+		}
+		points = append(points, &models.ReplicationMetricsPoint{
+			Timestamp:        sample.Time.UTC().Format(time.RFC3339),
+			QueuedCount:      sampled.QueuedCount,
+			QueuedSize:       sampled.QueuedSize,
+			ReplicatedSize:   sampled.ReplicatedSize,
+			FailedCount:      sampled.FailedCount,
+			CurrentBandwidth: sampled.CurrentBandwidth,
+		})
+	})
+	return points
+}
+
+// replicationBacklogTrend compares the current queue with the oldest point of the history
+func replicationBacklogTrend(queuedCount int64, history []*models.ReplicationMetricsPoint) string {
+	if len(history) == 0 {
+		return models.ReplicationTargetMetricsBacklogTrendUnknown
+	}
+	switch oldest := history[0].QueuedCount; {
+	case queuedCount < oldest:
+		return models.ReplicationTargetMetricsBacklogTrendDraining
+	case queuedCount > oldest:
+		return models.ReplicationTargetMetricsBacklogTrendGrowing
+	default:
+		return models.ReplicationTargetMetricsBacklogTrendSteady
+	}
+}
+
+// getReplicationMetrics returns the current metrics of the targets visible with the provided clients
+// along with their history, samples of targets not visible to the caller are never returned
+func getReplicationMetrics(ctx context.Context, client MinioAdmin, minioClient MinioClient, collector *ReplicationMetricsCollector, bucket string) (*models.ReplicationMetricsResponse, error) {
+	metricsResp, err := collectReplicationMetrics(ctx, client, minioClient, bucket)
+	if err != nil {
+		return nil, err
+	}
+	collector.EnsureRunning(client, minioClient)
+	for _, target := range metricsResp.Targets {
+		target.History = collector.History(target)
+		target.BacklogTrend = replicationBacklogTrend(target.QueuedCount, target.History)
+	}
+	return metricsResp, nil
+}
+
+func getReplicationMetricsResponse(session *models.Principal, params replicationApi.GetReplicationMetricsParams) (*models.ReplicationMetricsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	minioClient := minioClient{client: mClient}
+	bucket := ""
+	if params.Bucket != nil {
+		bucket = *params.Bucket
+	}
+	metricsResp, err := getReplicationMetrics(ctx, adminClient, minioClient, globalReplicationMetrics, bucket)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return metricsResp, nil
+}
@@ -0,0 +1,137 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+// assigning mock at runtime instead of compile time
+var minioGetBucketReplicationMetricsV2Mock func(ctx context.Context, bucketName string) (replication.MetricsV2, error)
+
+// mock function of getBucketReplicationMetricsV2()
+func (ac minioClientMock) getBucketReplicationMetricsV2(ctx context.Context, bucketName string) (replication.MetricsV2, error) {
+	return minioGetBucketReplicationMetricsV2Mock(ctx, bucketName)
+}
+
+func TestCollectReplicationMetrics(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	client := minioClientMock{}
+
+	minioListRemoteBucketsMock = func(_ context.Context, bucket, _ string) ([]madmin.BucketTarget, error) {
+		targets := []madmin.BucketTarget{
+			{SourceBucket: "photos", Arn: "arn:b", TargetBucket: "photos-dr", Endpoint: "dr:9000", Online: true, BandwidthLimit: 100},
+			{SourceBucket: "photos", Arn: "arn:a", TargetBucket: "photos-backup", Latency: madmin.LatencyStat{Curr: 20 * time.Millisecond}},
+		}
+		if bucket != "" && bucket != "photos" {
+			return nil, nil
+		}
+		return targets, nil
+	}
+	calls := 0
+	minioGetBucketReplicationMetricsV2Mock = func(_ context.Context, _ string) (replication.MetricsV2, error) {
+		calls++
+		var metrics replication.MetricsV2
+		metrics.CurrentStats.QStats.Curr = replication.QStat{Count: 10, Bytes: 1024}
+		metrics.CurrentStats.Stats = map[string]replication.TargetMetrics{
+			"arn:b": {ReplicatedSize: 500, ReplicatedCount: 5, CurrentBandwidthInBytesPerSecond: 42},
+		}
+		return metrics, nil
+	}
+	getSiteReplicationStatus = func(_ context.Context, _ madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
+		return nil, errors.New("site replication unavailable")
+	}
+
+	metricsResp, err := collectReplicationMetrics(ctx, adminClient, client, "")
+	assert.Nil(err)
+	// bucket metrics are fetched once per bucket
+	assert.Equal(1, calls)
+	assert.Equal("site replication unavailable", metricsResp.SiteReplicationError)
+	assert.Len(metricsResp.Targets, 2)
+	assert.Equal("arn:a", metricsResp.Targets[0].Arn)
+	assert.Equal(float64(20), metricsResp.Targets[0].LatencyCurrentMs)
+	target := metricsResp.Targets[1]
+	assert.Equal(models.ReplicationTargetMetricsTypeBucket, target.Type)
+	assert.Equal("photos-dr", target.TargetBucket)
+	assert.Equal(int64(500), target.ReplicatedSize)
+	assert.Equal(int64(10), target.QueuedCount)
+	assert.Equal(int64(100), target.BandwidthLimit)
+	assert.Equal(float64(42), target.CurrentBandwidth)
+
+	getSiteReplicationStatus = func(_ context.Context, _ madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
+		info := &madmin.SRStatusInfo{Enabled: true}
+		info.Metrics.Queued.Curr = madmin.QStat{Count: 3}
+		info.Metrics.Metrics = map[string]madmin.SRMetric{
+			"dep-1": {DeploymentID: "dep-1", Endpoint: "https://site2:9000", Online: true, ReplicatedCount: 7},
+		}
+		return info, nil
+	}
+	metricsResp, err = collectReplicationMetrics(ctx, adminClient, client, "")
+	assert.Nil(err)
+	assert.Len(metricsResp.Targets, 3)
+	site := metricsResp.Targets[2]
+	assert.Equal(models.ReplicationTargetMetricsTypeSite, site.Type)
+	assert.Equal("dep-1", site.DeploymentID)
+	assert.Equal(int64(7), site.ReplicatedCount)
+	assert.Equal(int64(3), site.QueuedCount)
+
+	// site replication peers are not reported when filtering by bucket
+	metricsResp, err = collectReplicationMetrics(ctx, adminClient, client, "photos")
+	assert.Nil(err)
+	assert.Len(metricsResp.Targets, 2)
+
+	minioGetBucketReplicationMetricsV2Mock = func(_ context.Context, _ string) (replication.MetricsV2, error) {
+		return replication.MetricsV2{}, errors.New("access denied")
+	}
+	_, err = collectReplicationMetrics(ctx, adminClient, client, "")
+	assert.NotNil(err)
+}
+
+func TestReplicationMetricsCollector(t *testing.T) {
+	assert := assert.New(t)
+	collector := NewReplicationMetricsCollector(time.Hour)
+	target := &models.ReplicationTargetMetrics{Type: models.ReplicationTargetMetricsTypeBucket, Bucket: "photos", Arn: "arn:a"}
+	other := &models.ReplicationTargetMetrics{Type: models.ReplicationTargetMetricsTypeSite, DeploymentID: "dep-1"}
+
+	now := time.Now()
+	assert.Empty(collector.History(target))
+	assert.Equal(models.ReplicationTargetMetricsBacklogTrendUnknown, replicationBacklogTrend(5, nil))
+
+	collector.Add(now.Add(-2*time.Hour), []*models.ReplicationTargetMetrics{{Type: target.Type, Bucket: "photos", Arn: "arn:a", QueuedCount: 100}})
+	collector.Add(now.Add(-30*time.Minute), []*models.ReplicationTargetMetrics{{Type: target.Type, Bucket: "photos", Arn: "arn:a", QueuedCount: 20}, other})
+	collector.Add(now, []*models.ReplicationTargetMetrics{{Type: target.Type, Bucket: "photos", Arn: "arn:a", QueuedCount: 10}})
+
+	// samples out of retention are dropped
+	history := collector.History(target)
+	assert.Len(history, 2)
+	assert.Equal(int64(20), history[0].QueuedCount)
+	assert.Len(collector.History(other), 1)
+
+	assert.Equal(models.ReplicationTargetMetricsBacklogTrendDraining, replicationBacklogTrend(10, history))
+	assert.Equal(models.ReplicationTargetMetricsBacklogTrendGrowing, replicationBacklogTrend(30, history))
+	assert.Equal(models.ReplicationTargetMetricsBacklogTrendSteady, replicationBacklogTrend(20, history))
+}
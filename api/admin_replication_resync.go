@@ -0,0 +1,151 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+func registerBucketReplicationResyncHandlers(api *operations.ConsoleAPI) {
+	// start a replication resync to a remote target
+	api.BucketStartBucketReplicationResyncHandler = bucketApi.StartBucketReplicationResyncHandlerFunc(func(params bucketApi.StartBucketReplicationResyncParams, session *models.Principal) middleware.Responder {
+		resyncResp, err := getStartBucketReplicationResyncResponse(session, params)
+		if err != nil {
+			return bucketApi.NewStartBucketReplicationResyncDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewStartBucketReplicationResyncOK().WithPayload(resyncResp)
+	})
+	// replication resync progress per remote target
+	api.BucketGetBucketReplicationResyncStatusHandler = bucketApi.GetBucketReplicationResyncStatusHandlerFunc(func(params bucketApi.GetBucketReplicationResyncStatusParams, session *models.Principal) middleware.Responder {
+		resyncResp, err := getBucketReplicationResyncStatusResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketReplicationResyncStatusDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetBucketReplicationResyncStatusOK().WithPayload(resyncResp)
+	})
+}
+
+// newReplicationResyncResponse converts the resync information returned by MinIO
+func newReplicationResyncResponse(info replication.ResyncTargetsInfo) *models.ReplicationResyncResponse {
+	resyncResp := &models.ReplicationResyncResponse{Targets: []*models.ReplicationResyncTarget{}}
+	for _, target := range info.Targets {
+		resyncTarget := &models.ReplicationResyncTarget{
+			Arn:             target.Arn,
+			ResetID:         target.ResetID,
+			Status:          target.ResyncStatus,
+			ReplicatedSize:  target.ReplicatedSize,
+			ReplicatedCount: target.ReplicatedCount,
+			FailedSize:      target.FailedSize,
+			FailedCount:     target.FailedCount,
+		}
+		if !target.StartTime.IsZero() {
+			resyncTarget.StartTime = target.StartTime.UTC().Format(time.RFC3339)
+		}
+		if !target.EndTime.IsZero() {
+			resyncTarget.EndTime = target.EndTime.UTC().Format(time.RFC3339)
+		}
+		if target.Object != "" {
+			resyncTarget.LastObject = target.Bucket + "/" + target.Object
+		}
+		resyncResp.Targets = append(resyncResp.Targets, resyncTarget)
+	}
+	return resyncResp
+}
+
+// parseReplicationResyncRequest validates the remote target and the age of the objects to resync
+func parseReplicationResyncRequest(req *models.ReplicationResyncRequest) (string, time.Duration, error) {
+	if req == nil || req.Arn == nil || strings.TrimSpace(*req.Arn) == "" {
+		return "", 0, fmt.Errorf("remote target arn is required")
+	}
+	var olderThan time.Duration
+	if req.OlderThan != "" {
+		var err error
+		olderThan, err = time.ParseDuration(req.OlderThan)
+		if err != nil || olderThan < 0 {
+			return "", 0, fmt.Errorf("invalid older than duration: %s", req.OlderThan)
+		}
+	}
+	return strings.TrimSpace(*req.Arn), olderThan, nil
+}
+
+// startBucketReplicationResync replicates again the existing objects of a bucket to a remote target,
+// the replication rule must have existing object replication enabled. A started resync can't be
+// canceled, MinIO only has a cancel call for site replication resyncs.
+func startBucketReplicationResync(ctx context.Context, client MinioClient, bucketName, arn string, olderThan time.Duration) (*models.ReplicationResyncResponse, error) {
+	info, err := client.resetBucketReplicationOnTarget(ctx, bucketName, olderThan, arn)
+	if err != nil {
+		return nil, err
+	}
+	return newReplicationResyncResponse(info), nil
+}
+
+func getStartBucketReplicationResyncResponse(session *models.Principal, params bucketApi.StartBucketReplicationResyncParams) (*models.ReplicationResyncResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	arn, olderThan, err := parseReplicationResyncRequest(params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	resyncResp, err := startBucketReplicationResync(ctx, minioClient, params.BucketName, arn, olderThan)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resyncResp, nil
+}
+
+// getBucketReplicationResyncStatus returns the resync progress to every remote target of the bucket,
+// or only to the one matching arn
+func getBucketReplicationResyncStatus(ctx context.Context, client MinioClient, bucketName, arn string) (*models.ReplicationResyncResponse, error) {
+	info, err := client.getBucketReplicationResyncStatus(ctx, bucketName, arn)
+	if err != nil {
+		return nil, err
+	}
+	return newReplicationResyncResponse(info), nil
+}
+
+func getBucketReplicationResyncStatusResponse(session *models.Principal, params bucketApi.GetBucketReplicationResyncStatusParams) (*models.ReplicationResyncResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	arn := ""
+	if params.Arn != nil {
+		arn = *params.Arn
+	}
+	resyncResp, err := getBucketReplicationResyncStatus(ctx, minioClient, params.BucketName, arn)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resyncResp, nil
+}
@@ -0,0 +1,105 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+// assigning mock at runtime instead of compile time
+var (
+	minioResetBucketReplicationOnTargetMock   func(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error)
+	minioGetBucketReplicationResyncStatusMock func(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error)
+)
+
+// mock function of resetBucketReplicationOnTarget()
+func (ac minioClientMock) resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error) {
+	return minioResetBucketReplicationOnTargetMock(ctx, bucketName, olderThan, arn)
+}
+
+// mock function of getBucketReplicationResyncStatus()
+func (ac minioClientMock) getBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+	return minioGetBucketReplicationResyncStatusMock(ctx, bucketName, arn)
+}
+
+func TestParseReplicationResyncRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	arn, olderThan, err := parseReplicationResyncRequest(&models.ReplicationResyncRequest{Arn: swag.String(" arn:minio:replication::1:dest "), OlderThan: "72h"})
+	assert.Nil(err)
+	assert.Equal("arn:minio:replication::1:dest", arn)
+	assert.Equal(72*time.Hour, olderThan)
+
+	_, _, err = parseReplicationResyncRequest(&models.ReplicationResyncRequest{Arn: swag.String("")})
+	assert.NotNil(err)
+	_, _, err = parseReplicationResyncRequest(&models.ReplicationResyncRequest{Arn: swag.String("arn"), OlderThan: "3 days"})
+	assert.NotNil(err)
+	_, _, err = parseReplicationResyncRequest(nil)
+	assert.NotNil(err)
+}
+
+func TestBucketReplicationResync(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	ctx := context.Background()
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	minioResetBucketReplicationOnTargetMock = func(_ context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error) {
+		assert.Equal("photos", bucketName)
+		assert.Equal(time.Hour, olderThan)
+		return replication.ResyncTargetsInfo{Targets: []replication.ResyncTarget{{Arn: arn, ResetID: "reset-1"}}}, nil
+	}
+	resyncResp, err := startBucketReplicationResync(ctx, client, "photos", "arn:dest", time.Hour)
+	assert.Nil(err)
+	assert.Len(resyncResp.Targets, 1)
+	assert.Equal("reset-1", resyncResp.Targets[0].ResetID)
+	assert.Empty(resyncResp.Targets[0].StartTime)
+
+	minioGetBucketReplicationResyncStatusMock = func(_ context.Context, _, arn string) (replication.ResyncTargetsInfo, error) {
+		assert.Empty(arn)
+		return replication.ResyncTargetsInfo{Targets: []replication.ResyncTarget{
+			{
+				Arn: "arn:dest", ResetID: "reset-1", StartTime: start, ResyncStatus: "Ongoing",
+				ReplicatedSize: 2048, ReplicatedCount: 2, FailedCount: 1, FailedSize: 10, Bucket: "photos", Object: "a.jpg",
+			},
+			{Arn: "arn:other", ResyncStatus: "Completed", StartTime: start, EndTime: start.Add(time.Hour)},
+		}}, nil
+	}
+	resyncResp, err = getBucketReplicationResyncStatus(ctx, client, "photos", "")
+	assert.Nil(err)
+	assert.Len(resyncResp.Targets, 2)
+	assert.Equal("Ongoing", resyncResp.Targets[0].Status)
+	assert.Equal("2024-05-01T10:00:00Z", resyncResp.Targets[0].StartTime)
+	assert.Empty(resyncResp.Targets[0].EndTime)
+	assert.Equal("photos/a.jpg", resyncResp.Targets[0].LastObject)
+	assert.Equal(int64(1), resyncResp.Targets[0].FailedCount)
+	assert.Equal("2024-05-01T11:00:00Z", resyncResp.Targets[1].EndTime)
+
+	minioGetBucketReplicationResyncStatusMock = func(_ context.Context, _, _ string) (replication.ResyncTargetsInfo, error) {
+		return replication.ResyncTargetsInfo{}, errors.New("no resync in progress")
+	}
+	_, err = getBucketReplicationResyncStatus(ctx, client, "photos", "arn:dest")
+	assert.NotNil(err)
+}
@@ -18,6 +18,12 @@ package api
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/api/operations"
@@ -34,6 +40,22 @@ func registerSiteReplicationStatusHandler(api *operations.ConsoleAPI) {
 		}
 		return siteRepApi.NewGetSiteReplicationStatusOK().WithPayload(rInfo)
 	})
+
+	api.SiteReplicationGetSiteReplicationPeerHealthHandler = siteRepApi.GetSiteReplicationPeerHealthHandlerFunc(func(params siteRepApi.GetSiteReplicationPeerHealthParams, session *models.Principal) middleware.Responder {
+		healthRes, err := getSRPeerHealthResponse(session, params)
+		if err != nil {
+			return siteRepApi.NewGetSiteReplicationPeerHealthDefault(err.Code).WithPayload(err.APIError)
+		}
+		return siteRepApi.NewGetSiteReplicationPeerHealthOK().WithPayload(healthRes)
+	})
+
+	api.SiteReplicationGetSiteReplicationDiffHandler = siteRepApi.GetSiteReplicationDiffHandlerFunc(func(params siteRepApi.GetSiteReplicationDiffParams, session *models.Principal) middleware.Responder {
+		diffRes, err := getSRDiffResponse(session, params)
+		if err != nil {
+			return siteRepApi.NewGetSiteReplicationDiffDefault(err.Code).WithPayload(err.APIError)
+		}
+		return siteRepApi.NewGetSiteReplicationDiffOK().WithPayload(diffRes)
+	})
 }
 
 func getSRStatusResponse(session *models.Principal, params siteRepApi.GetSiteReplicationStatusParams) (*models.SiteReplicationStatusResponse, *CodedAPIError) {
@@ -84,3 +106,233 @@ func getSRStats(ctx context.Context, client MinioAdmin, params siteRepApi.GetSit
 	}
 	return &retInfo, nil
 }
+
+// srPeerCheckTimeout bounds the liveness check of each site replication peer
+const srPeerCheckTimeout = 5 * time.Second
+
+func getSRPeerHealthResponse(session *models.Principal, params siteRepApi.GetSiteReplicationPeerHealthParams) (*models.SiteReplicationPeerHealthResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	httpClient := GetConsoleHTTPClient("", getClientIP(params.HTTPRequest))
+	res, err := getSRPeerHealth(ctx, adminClient, httpClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return res, nil
+}
+
+// checkSRPeerLiveness requests the liveness endpoint of the peer and returns how long it took to answer
+func checkSRPeerLiveness(ctx context.Context, httpClient *http.Client, endpoint string) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, srPeerCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(endpoint, "/")+"/minio/health/live", nil)
+	if err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	elapsed := time.Since(start)
+	if resp.StatusCode != http.StatusOK {
+		return elapsed, errors.New(resp.Status)
+	}
+	return elapsed, nil
+}
+
+// getSRPeerHealth reports the state MinIO tracks for every peer along with a liveness check made from Console,
+// sites MinIO doesn't report metrics for (the local site) are only checked from Console
+func getSRPeerHealth(ctx context.Context, client MinioAdmin, httpClient *http.Client) (*models.SiteReplicationPeerHealthResponse, error) {
+	srInfo, err := client.getSiteReplicationStatus(ctx, madmin.SRStatusOptions{Metrics: true})
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]*models.SiteReplicationPeerHealth, 0, len(srInfo.Sites))
+	for deploymentID, site := range srInfo.Sites {
+		peer := &models.SiteReplicationPeerHealth{
+			DeploymentID: deploymentID,
+			Name:         site.Name,
+			Endpoint:     site.Endpoint,
+		}
+		if metric, ok := srInfo.Metrics.Metrics[deploymentID]; ok {
+			peer.Online = metric.Online
+			peer.TotalDowntime = int64(metric.TotalDowntime / time.Second)
+			peer.LatencyCurrentMs = durationToMs(metric.Latency.Curr)
+			peer.LatencyAverageMs = durationToMs(metric.Latency.Avg)
+			peer.LatencyMaxMs = durationToMs(metric.Latency.Max)
+			if !metric.LastOnline.IsZero() {
+				peer.LastOnline = metric.LastOnline.UTC().Format(time.RFC3339)
+			}
+		}
+		peers = append(peers, peer)
+	}
+
+	var wg sync.WaitGroup
+	for _, peer := range peers {
+		wg.Add(1)
+		go func(peer *models.SiteReplicationPeerHealth) {
+			defer wg.Done()
+			elapsed, err := checkSRPeerLiveness(ctx, httpClient, peer.Endpoint)
+			if err != nil {
+				peer.Error = err.Error()
+				return
+			}
+			peer.Reachable = true
+			peer.CheckLatencyMs = durationToMs(elapsed)
+			if _, ok := srInfo.Metrics.Metrics[peer.DeploymentID]; !ok {
+				peer.Online = true
+			}
+		}(peer)
+	}
+	wg.Wait()
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Name < peers[j].Name
+	})
+	return &models.SiteReplicationPeerHealthResponse{Peers: peers}, nil
+}
+
+func getSRDiffResponse(session *models.Principal, params siteRepApi.GetSiteReplicationDiffParams) (*models.SiteReplicationDiffResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	entityType, entityValue := "", ""
+	if params.EntityType != nil {
+		entityType = *params.EntityType
+	}
+	if params.EntityValue != nil {
+		entityValue = *params.EntityValue
+	}
+	if entityValue != "" && entityType == "" {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("entityType is required to look up an entity"))
+	}
+	res, err := getSRDiff(ctx, adminClient, entityType, entityValue)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return res, nil
+}
+
+// srDiffEntry accumulates the sites out of sync for an entity
+func srDiffEntry(entries map[string]*models.SiteReplicationDiffEntry, sites map[string]madmin.PeerInfo, entityType, name, deploymentID string, missing bool, fields []string) {
+	if !missing && len(fields) == 0 {
+		return
+	}
+	key := entityType + "/" + name
+	entry, ok := entries[key]
+	if !ok {
+		entry = &models.SiteReplicationDiffEntry{EntityType: entityType, Name: name}
+		entries[key] = entry
+	}
+	entry.Sites = append(entry.Sites, &models.SiteReplicationDiffSite{
+		DeploymentID: deploymentID,
+		Name:         sites[deploymentID].Name,
+		Missing:      missing,
+		Fields:       fields,
+	})
+}
+
+// srField is a setting of an entity and whether it differs from the other sites
+type srField struct {
+	name     string
+	mismatch bool
+}
+
+// mismatchedFields returns the names of the fields that differ from the other sites
+func mismatchedFields(fields ...srField) []string {
+	names := []string{}
+	for _, field := range fields {
+		if field.mismatch {
+			names = append(names, field.name)
+		}
+	}
+	return names
+}
+
+// getSRDiff lists the buckets, policies, users and groups that are out of sync and, for every site, whether
+// the entity is missing or which of its settings differ. entityType and entityValue narrow the report down
+// using the site replication status entity filters.
+func getSRDiff(ctx context.Context, client MinioAdmin, entityType, entityValue string) (*models.SiteReplicationDiffResponse, error) {
+	srParams := madmin.SRStatusOptions{}
+	switch {
+	case entityValue != "":
+		srParams.Entity = madmin.GetSREntityType(entityType)
+		srParams.EntityValue = entityValue
+	case entityType != "":
+		srParams.Buckets = entityType == models.SiteReplicationDiffEntryEntityTypeBucket
+		srParams.Policies = entityType == models.SiteReplicationDiffEntryEntityTypePolicy
+		srParams.Users = entityType == models.SiteReplicationDiffEntryEntityTypeUser
+		srParams.Groups = entityType == models.SiteReplicationDiffEntryEntityTypeGroup
+	default:
+		srParams.Buckets, srParams.Policies, srParams.Users, srParams.Groups = true, true, true, true
+	}
+	srInfo, err := client.getSiteReplicationStatus(ctx, srParams)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]*models.SiteReplicationDiffEntry{}
+	for bucket, stats := range srInfo.BucketStats {
+		for deploymentID, st := range stats {
+			srDiffEntry(entries, srInfo.Sites, models.SiteReplicationDiffEntryEntityTypeBucket, bucket, deploymentID, !st.HasBucket, mismatchedFields(
+				srField{"deleted", st.BucketMarkedDeleted},
+				srField{"tags", st.TagMismatch},
+				srField{"versioning", st.VersioningConfigMismatch},
+				srField{"objectLock", st.OLockConfigMismatch},
+				srField{"policy", st.PolicyMismatch},
+				srField{"encryption", st.SSEConfigMismatch},
+				srField{"replication", st.ReplicationCfgMismatch},
+				srField{"quota", st.QuotaCfgMismatch},
+			))
+		}
+	}
+	for policy, stats := range srInfo.PolicyStats {
+		for deploymentID, st := range stats {
+			srDiffEntry(entries, srInfo.Sites, models.SiteReplicationDiffEntryEntityTypePolicy, policy, deploymentID, !st.HasPolicy, mismatchedFields(
+				srField{"policy", st.PolicyMismatch},
+			))
+		}
+	}
+	for user, stats := range srInfo.UserStats {
+		for deploymentID, st := range stats {
+			srDiffEntry(entries, srInfo.Sites, models.SiteReplicationDiffEntryEntityTypeUser, user, deploymentID, !st.HasUser, mismatchedFields(
+				srField{"policyMapping", st.PolicyMismatch},
+				srField{"userInfo", st.UserInfoMismatch},
+			))
+		}
+	}
+	for group, stats := range srInfo.GroupStats {
+		for deploymentID, st := range stats {
+			srDiffEntry(entries, srInfo.Sites, models.SiteReplicationDiffEntryEntityTypeGroup, group, deploymentID, !st.HasGroup, mismatchedFields(
+				srField{"policyMapping", st.PolicyMismatch},
+				srField{"description", st.GroupDescMismatch},
+			))
+		}
+	}
+
+	res := &models.SiteReplicationDiffResponse{Entries: make([]*models.SiteReplicationDiffEntry, 0, len(entries))}
+	for _, entry := range entries {
+		sort.Slice(entry.Sites, func(i, j int) bool {
+			return entry.Sites[i].DeploymentID < entry.Sites[j].DeploymentID
+		})
+		res.Entries = append(res.Entries, entry)
+	}
+	sort.Slice(res.Entries, func(i, j int) bool {
+		if res.Entries[i].EntityType != res.Entries[j].EntityType {
+			return res.Entries[i].EntityType < res.Entries[j].EntityType
+		}
+		return res.Entries[i].Name < res.Entries[j].Name
+	})
+	return res, nil
+}
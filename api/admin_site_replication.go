@@ -59,6 +59,14 @@ func registerSiteReplicationHandler(api *operations.ConsoleAPI) {
 		}
 		return siteRepApi.NewSiteReplicationEditOK().WithPayload(eInfo)
 	})
+
+	api.SiteReplicationSiteReplicationResyncHandler = siteRepApi.SiteReplicationResyncHandlerFunc(func(params siteRepApi.SiteReplicationResyncParams, session *models.Principal) middleware.Responder {
+		rRes, err := getSRResyncResponse(session, params)
+		if err != nil {
+			return siteRepApi.NewSiteReplicationResyncDefault(err.Code).WithPayload(err.APIError)
+		}
+		return siteRepApi.NewSiteReplicationResyncOK().WithPayload(rRes)
+	})
 }
 
 func getSRInfoResponse(session *models.Principal, params siteRepApi.GetSiteReplicationInfoParams) (*models.SiteReplicationInfoResponse, *CodedAPIError) {
@@ -86,10 +94,13 @@ func getSRAddResponse(session *models.Principal, params siteRepApi.SiteReplicati
 	}
 	adminClient := AdminClient{Client: mAdmin}
 
+	before, _ := getSRConfig(ctx, adminClient)
 	res, err := addSiteReplication(ctx, adminClient, &params)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	after, _ := getSRConfig(ctx, adminClient)
+	auditEvent(params.HTTPRequest, session, auditTargetSiteReplication, "", before, after)
 	return res, nil
 }
 
@@ -101,10 +112,13 @@ func getSREditResponse(session *models.Principal, params siteRepApi.SiteReplicat
 		return nil, ErrorWithContext(ctx, err)
 	}
 	adminClient := AdminClient{Client: mAdmin}
+	before, _ := getSRConfig(ctx, adminClient)
 	eRes, err := editSiteReplication(ctx, adminClient, &params)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	after, _ := getSRConfig(ctx, adminClient)
+	auditEvent(params.HTTPRequest, session, auditTargetSiteReplication, params.Body.DeploymentID, before, after)
 	return eRes, nil
 }
 
@@ -116,10 +130,29 @@ func getSRRemoveResponse(session *models.Principal, params siteRepApi.SiteReplic
 		return nil, ErrorWithContext(ctx, err)
 	}
 	adminClient := AdminClient{Client: mAdmin}
+	before, _ := getSRConfig(ctx, adminClient)
 	rRes, err := removeSiteReplication(ctx, adminClient, &params)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	after, _ := getSRConfig(ctx, adminClient)
+	auditEvent(params.HTTPRequest, session, auditTargetSiteReplication, "", before, after)
+	return rRes, nil
+}
+
+func getSRResyncResponse(session *models.Principal, params siteRepApi.SiteReplicationResyncParams) (*models.SiteReplicationResyncResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	rRes, err := resyncSiteReplication(ctx, adminClient, *params.Body.DeploymentID, madmin.SiteResyncOp(*params.Body.Op))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetSiteReplication, *params.Body.DeploymentID, nil, params.Body)
 	return rRes, nil
 }
 
@@ -240,3 +273,40 @@ func removeSiteReplication(ctx context.Context, client MinioAdmin, params *siteR
 	}
 	return removeRes, nil
 }
+
+// resyncSiteReplication starts or cancels the resync of every bucket to the peer site with the deployment ID
+func resyncSiteReplication(ctx context.Context, client MinioAdmin, deploymentID string, op madmin.SiteResyncOp) (*models.SiteReplicationResyncResponse, error) {
+	srInfo, err := client.getSiteReplicationInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var peer *madmin.PeerInfo
+	for i := range srInfo.Sites {
+		if srInfo.Sites[i].DeploymentID == deploymentID {
+			peer = &srInfo.Sites[i]
+			break
+		}
+	}
+	if peer == nil {
+		return nil, ErrNotFound
+	}
+	rRes, err := client.siteReplicationResyncOp(ctx, *peer, op)
+	if err != nil {
+		return nil, err
+	}
+	resyncRes := &models.SiteReplicationResyncResponse{
+		Op:          rRes.OpType,
+		ResyncID:    rRes.ResyncID,
+		Status:      rRes.Status,
+		ErrorDetail: rRes.ErrDetail,
+		Buckets:     []*models.SiteReplicationResyncBucketStatus{},
+	}
+	for _, bucket := range rRes.Buckets {
+		resyncRes.Buckets = append(resyncRes.Buckets, &models.SiteReplicationResyncBucketStatus{
+			Bucket:      bucket.Bucket,
+			Status:      bucket.Status,
+			ErrorDetail: bucket.ErrDetail,
+		})
+	}
+	return resyncRes, nil
+}
@@ -21,8 +21,12 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/minio/console/models"
 	"github.com/minio/madmin-go/v3"
 	"github.com/stretchr/testify/assert"
 )
@@ -253,3 +257,143 @@ func TestSiteReplicationStatus(t *testing.T) {
 
 	assert.Equal(expValueMock, srInfo, fmt.Sprintf("Failed on %s: expected result is not same", function))
 }
+
+func TestResyncSiteReplication(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	getSiteReplicationInfo = func(_ context.Context) (*madmin.SiteReplicationInfo, error) {
+		return &madmin.SiteReplicationInfo{Enabled: true, Sites: []madmin.PeerInfo{
+			{Name: "site1", Endpoint: "https://site1:9000", DeploymentID: "dep-1"},
+			{Name: "site2", Endpoint: "https://site2:9000", DeploymentID: "dep-2"},
+		}}, nil
+	}
+	var requestedPeer madmin.PeerInfo
+	minioSiteReplicationResyncOpMock = func(_ context.Context, site madmin.PeerInfo, op madmin.SiteResyncOp) (madmin.SRResyncOpStatus, error) {
+		requestedPeer = site
+		return madmin.SRResyncOpStatus{
+			OpType:   string(op),
+			ResyncID: "resync-1",
+			Status:   "Success",
+			Buckets:  []madmin.ResyncBucketStatus{{Bucket: "photos", Status: "Started"}},
+		}, nil
+	}
+
+	res, err := resyncSiteReplication(ctx, adminClient, "dep-2", madmin.SiteResyncStart)
+	assert.Nil(err)
+	assert.Equal("https://site2:9000", requestedPeer.Endpoint)
+	assert.Equal("start", res.Op)
+	assert.Equal("resync-1", res.ResyncID)
+	assert.Len(res.Buckets, 1)
+	assert.Equal("photos", res.Buckets[0].Bucket)
+
+	_, err = resyncSiteReplication(ctx, adminClient, "dep-3", madmin.SiteResyncCancel)
+	assert.Equal(ErrNotFound, err)
+}
+
+func TestGetSRPeerHealth(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/minio/health/live", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer live.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	getSiteReplicationStatus = func(_ context.Context, opts madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
+		assert.True(opts.Metrics)
+		info := &madmin.SRStatusInfo{
+			Enabled: true,
+			Sites: map[string]madmin.PeerInfo{
+				"dep-1": {Name: "site1", Endpoint: live.URL, DeploymentID: "dep-1"},
+				"dep-2": {Name: "site2", Endpoint: down.URL, DeploymentID: "dep-2"},
+			},
+		}
+		info.Metrics.Metrics = map[string]madmin.SRMetric{
+			"dep-2": {Online: false, TotalDowntime: time.Minute, Latency: madmin.LatencyStat{Avg: 15 * time.Millisecond}},
+		}
+		return info, nil
+	}
+
+	res, err := getSRPeerHealth(ctx, adminClient, http.DefaultClient)
+	assert.Nil(err)
+	assert.Len(res.Peers, 2)
+	// sites without metrics are reported online when they are reachable
+	assert.Equal("site1", res.Peers[0].Name)
+	assert.True(res.Peers[0].Reachable)
+	assert.True(res.Peers[0].Online)
+	assert.Empty(res.Peers[0].Error)
+
+	assert.False(res.Peers[1].Reachable)
+	assert.False(res.Peers[1].Online)
+	assert.Equal("503 Service Unavailable", res.Peers[1].Error)
+	assert.Equal(int64(60), res.Peers[1].TotalDowntime)
+	assert.Equal(float64(15), res.Peers[1].LatencyAverageMs)
+}
+
+func TestGetSRDiff(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	var requested madmin.SRStatusOptions
+	getSiteReplicationStatus = func(_ context.Context, opts madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
+		requested = opts
+		return &madmin.SRStatusInfo{
+			Enabled: true,
+			Sites: map[string]madmin.PeerInfo{
+				"dep-1": {Name: "site1", DeploymentID: "dep-1"},
+				"dep-2": {Name: "site2", DeploymentID: "dep-2"},
+			},
+			BucketStats: map[string]map[string]madmin.SRBucketStatsSummary{
+				"photos": {
+					"dep-1": {DeploymentID: "dep-1", HasBucket: true, TagMismatch: true, QuotaCfgMismatch: true},
+					"dep-2": {DeploymentID: "dep-2", HasBucket: true},
+				},
+				"in-sync": {
+					"dep-1": {DeploymentID: "dep-1", HasBucket: true},
+				},
+			},
+			UserStats: map[string]map[string]madmin.SRUserStatsSummary{
+				"alice": {"dep-2": {DeploymentID: "dep-2"}},
+			},
+			GroupStats: map[string]map[string]madmin.SRGroupStatsSummary{
+				"devs": {"dep-1": {DeploymentID: "dep-1", HasGroup: true, GroupDescMismatch: true}},
+			},
+		}, nil
+	}
+
+	res, err := getSRDiff(ctx, adminClient, "", "")
+	assert.Nil(err)
+	assert.True(requested.Buckets && requested.Policies && requested.Users && requested.Groups)
+	assert.Len(res.Entries, 3)
+
+	assert.Equal(models.SiteReplicationDiffEntryEntityTypeBucket, res.Entries[0].EntityType)
+	assert.Equal("photos", res.Entries[0].Name)
+	assert.Len(res.Entries[0].Sites, 1)
+	assert.Equal("site1", res.Entries[0].Sites[0].Name)
+	assert.Equal([]string{"tags", "quota"}, res.Entries[0].Sites[0].Fields)
+
+	assert.Equal("devs", res.Entries[1].Name)
+	assert.Equal([]string{"description"}, res.Entries[1].Sites[0].Fields)
+
+	assert.Equal("alice", res.Entries[2].Name)
+	assert.True(res.Entries[2].Sites[0].Missing)
+
+	_, err = getSRDiff(ctx, adminClient, "user", "")
+	assert.Nil(err)
+	assert.True(requested.Users)
+	assert.False(requested.Buckets)
+
+	_, err = getSRDiff(ctx, adminClient, "bucket", "photos")
+	assert.Nil(err)
+	assert.Equal(madmin.SRBucketEntity, requested.Entity)
+	assert.Equal("photos", requested.EntityValue)
+}
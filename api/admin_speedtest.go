@@ -18,50 +18,144 @@ package api
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	speedtestApi "github.com/minio/console/api/operations/speedtest"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/store"
 	"github.com/minio/madmin-go/v3"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
 	"github.com/minio/websocket"
 )
 
-// getSpeedtesthOptionsFromReq gets duration, size & concurrent requests from a websocket
-// path come as : `/speedtest?duration=2h&size=12MiB&concurrent=10`
-func getSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error) {
-	optionsSet := madmin.SpeedtestOpts{}
+const (
+	speedtestResultsCollection = "speedtest-results"
+	// speedtestResultsMaxRuns is the number of runs kept in the speedtest history
+	speedtestResultsMaxRuns = 500
+)
 
-	queryPairs := req.URL.Query()
+// speedtestOptions holds the options of the speedtest type requested, Parameters keeps the
+// effective options so runs can be compared later on
+type speedtestOptions struct {
+	Type   string
+	Object madmin.SpeedtestOpts
+	Drive  madmin.DriveSpeedTestOpts
+	// Duration of the network and site replication speedtests
+	Duration   time.Duration
+	Parameters map[string]string
+}
+
+func registerSpeedtestHandlers(api *operations.ConsoleAPI) {
+	// list previous speedtest runs
+	api.SpeedtestListSpeedtestResultsHandler = speedtestApi.ListSpeedtestResultsHandlerFunc(func(params speedtestApi.ListSpeedtestResultsParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.HealthInfoAdminAction); err != nil {
+			return speedtestApi.NewListSpeedtestResultsDefault(err.Code).WithPayload(err.APIError)
+		}
+		resultsResp, err := getListSpeedtestResultsResponse(params)
+		if err != nil {
+			return speedtestApi.NewListSpeedtestResultsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return speedtestApi.NewListSpeedtestResultsOK().WithPayload(resultsResp)
+	})
+	// get a speedtest run
+	api.SpeedtestGetSpeedtestResultHandler = speedtestApi.GetSpeedtestResultHandlerFunc(func(params speedtestApi.GetSpeedtestResultParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.HealthInfoAdminAction); err != nil {
+			return speedtestApi.NewGetSpeedtestResultDefault(err.Code).WithPayload(err.APIError)
+		}
+		resultResp, err := getSpeedtestResultResponse(params)
+		if err != nil {
+			return speedtestApi.NewGetSpeedtestResultDefault(err.Code).WithPayload(err.APIError)
+		}
+		return speedtestApi.NewGetSpeedtestResultOK().WithPayload(resultResp)
+	})
+	// delete a speedtest run
+	api.SpeedtestDeleteSpeedtestResultHandler = speedtestApi.DeleteSpeedtestResultHandlerFunc(func(params speedtestApi.DeleteSpeedtestResultParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.HealthInfoAdminAction); err != nil {
+			return speedtestApi.NewDeleteSpeedtestResultDefault(err.Code).WithPayload(err.APIError)
+		}
+		if err := getDeleteSpeedtestResultResponse(params); err != nil {
+			return speedtestApi.NewDeleteSpeedtestResultDefault(err.Code).WithPayload(err.APIError)
+		}
+		return speedtestApi.NewDeleteSpeedtestResultNoContent()
+	})
+	// export the speedtest history
+	api.SpeedtestExportSpeedtestResultsHandler = speedtestApi.ExportSpeedtestResultsHandlerFunc(func(params speedtestApi.ExportSpeedtestResultsParams, session *models.Principal) middleware.Responder {
+		if err := checkAdminPermission(params.HTTPRequest, session, minioIAMPolicy.HealthInfoAdminAction); err != nil {
+			return speedtestApi.NewExportSpeedtestResultsDefault(err.Code).WithPayload(err.APIError)
+		}
+		runs, err := getExportSpeedtestResults(params)
+		if err != nil {
+			return speedtestApi.NewExportSpeedtestResultsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return middleware.ResponderFunc(processExportSpeedtestResponse(*params.Format, runs))
+	})
+}
 
+// parseSpeedtestDuration parses the duration query parameter, using defaultDuration when it's not set
+func parseSpeedtestDuration(queryPairs url.Values, defaultDuration string) (time.Duration, error) {
 	paramDuration := queryPairs.Get("duration")
 
 	if paramDuration == "" {
-		paramDuration = "10s"
+		paramDuration = defaultDuration
 	}
 
 	duration, err := time.ParseDuration(paramDuration)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse duration: %s", paramDuration)
+		return 0, fmt.Errorf("unable to parse duration: %s", paramDuration)
 	}
 
 	if duration <= 0 {
-		return nil, fmt.Errorf("duration cannot be 0 or negative")
+		return 0, fmt.Errorf("duration cannot be 0 or negative")
 	}
+	return duration, nil
+}
 
-	optionsSet.Duration = duration
-
-	paramSize := queryPairs.Get("size")
+// parseSpeedtestSize parses a size query parameter, using defaultSize when it's not set
+func parseSpeedtestSize(queryPairs url.Values, name, defaultSize string) (uint64, error) {
+	paramSize := queryPairs.Get(name)
 
 	if paramSize == "" {
-		paramSize = "64MiB"
+		paramSize = defaultSize
 	}
 
 	size, err := humanize.ParseBytes(paramSize)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse object size")
+		return 0, fmt.Errorf("unable to parse %s", name)
+	}
+	if size == 0 {
+		return 0, fmt.Errorf("%s cannot be 0", name)
+	}
+	return size, nil
+}
+
+// getObjectSpeedtestOptions gets duration, size & concurrent requests of the object speedtest
+func getObjectSpeedtestOptions(queryPairs url.Values) (madmin.SpeedtestOpts, error) {
+	optionsSet := madmin.SpeedtestOpts{}
+
+	duration, err := parseSpeedtestDuration(queryPairs, "10s")
+	if err != nil {
+		return optionsSet, err
+	}
+
+	optionsSet.Duration = duration
+
+	size, err := parseSpeedtestSize(queryPairs, "size", "64MiB")
+	if err != nil {
+		return optionsSet, err
 	}
 
 	optionsSet.Size = int(size)
@@ -74,11 +168,11 @@ func getSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error
 
 	concurrent, err := strconv.Atoi(paramConcurrent)
 	if err != nil {
-		return nil, fmt.Errorf("invalid concurrent value: %s", paramConcurrent)
+		return optionsSet, fmt.Errorf("invalid concurrent value: %s", paramConcurrent)
 	}
 
 	if concurrent <= 0 {
-		return nil, fmt.Errorf("concurrency cannot be '0' or negative")
+		return optionsSet, fmt.Errorf("concurrency cannot be '0' or negative")
 	}
 
 	optionsSet.Concurrency = concurrent
@@ -89,30 +183,414 @@ func getSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error
 		optionsSet.Autotune = true
 	}
 
-	return &optionsSet, nil
+	return optionsSet, nil
+}
+
+// getDriveSpeedtestOptions gets whether drives are tested one at a time, the block size and the file size
+// of the drive speedtest
+func getDriveSpeedtestOptions(queryPairs url.Values) (madmin.DriveSpeedTestOpts, error) {
+	optionsSet := madmin.DriveSpeedTestOpts{Serial: queryPairs.Get("serial") == "true"}
+
+	blockSize, err := parseSpeedtestSize(queryPairs, "blocksize", "4MiB")
+	if err != nil {
+		return optionsSet, err
+	}
+	fileSize, err := parseSpeedtestSize(queryPairs, "filesize", "1GiB")
+	if err != nil {
+		return optionsSet, err
+	}
+	if fileSize < blockSize {
+		return optionsSet, fmt.Errorf("filesize cannot be smaller than blocksize")
+	}
+	optionsSet.BlockSize = blockSize
+	optionsSet.FileSize = fileSize
+	return optionsSet, nil
+}
+
+// getSpeedtesthOptionsFromReq gets the speedtest type and its options from a websocket
+// path come as :
+// `/speedtest?duration=2h&size=12MiB&concurrent=10` (object speedtest, the default)
+// `/speedtest?type=drive&serial=true&blocksize=4MiB&filesize=1GiB`
+// `/speedtest?type=net&duration=10s`
+// `/speedtest?type=site&duration=10s`
+func getSpeedtestOptionsFromReq(req *http.Request) (*speedtestOptions, error) {
+	queryPairs := req.URL.Query()
+
+	opts := &speedtestOptions{Type: queryPairs.Get("type")}
+	if opts.Type == "" {
+		opts.Type = models.SpeedtestResultTypeObject
+	}
+
+	var err error
+	switch opts.Type {
+	case models.SpeedtestResultTypeObject:
+		opts.Object, err = getObjectSpeedtestOptions(queryPairs)
+		if err != nil {
+			return nil, err
+		}
+		opts.Parameters = map[string]string{
+			"duration":   opts.Object.Duration.String(),
+			"size":       humanize.IBytes(uint64(opts.Object.Size)),
+			"concurrent": strconv.Itoa(opts.Object.Concurrency),
+			"autotune":   strconv.FormatBool(opts.Object.Autotune),
+		}
+	case models.SpeedtestResultTypeDrive:
+		opts.Drive, err = getDriveSpeedtestOptions(queryPairs)
+		if err != nil {
+			return nil, err
+		}
+		opts.Parameters = map[string]string{
+			"serial":    strconv.FormatBool(opts.Drive.Serial),
+			"blocksize": humanize.IBytes(opts.Drive.BlockSize),
+			"filesize":  humanize.IBytes(opts.Drive.FileSize),
+		}
+	case models.SpeedtestResultTypeNet, models.SpeedtestResultTypeSite:
+		opts.Duration, err = parseSpeedtestDuration(queryPairs, "10s")
+		if err != nil {
+			return nil, err
+		}
+		opts.Parameters = map[string]string{
+			"duration": opts.Duration.String(),
+		}
+	default:
+		return nil, fmt.Errorf("invalid speedtest type: %s", opts.Type)
+	}
+	return opts, nil
+}
+
+// writeSpeedtestMessage sends a speedtest result through the websocket connection
+func writeSpeedtestMessage(conn WSConn, result interface{}) error {
+	// Serializing message
+	bytes, err := json.Marshal(result)
+	if err != nil {
+		LogError("error serializing json: %v", err)
+		return err
+	}
+	// Send Message through websocket connection
+	err = conn.writeMessage(websocket.TextMessage, bytes)
+	if err != nil {
+		LogError("error writing speedtest response: %v", err)
+		return err
+	}
+	return nil
+}
+
+func newSpeedtestMetric(node, drive, name string, value float64) *models.SpeedtestMetric {
+	return &models.SpeedtestMetric{Node: node, Drive: drive, Name: name, Value: value}
 }
 
-func startSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, speedtestOpts *madmin.SpeedtestOpts) error {
-	speedtestRes, err := client.speedtest(ctx, *speedtestOpts)
+// runObjectSpeedtest streams every intermediate result, only the final one is recorded in run
+func runObjectSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, opts madmin.SpeedtestOpts, run *models.SpeedtestResult) error {
+	speedtestRes, err := client.speedtest(ctx, opts)
 	if err != nil {
 		LogError("error initializing speedtest: %v", err)
 		return err
 	}
 
+	var last *madmin.SpeedTestResult
 	for result := range speedtestRes {
-		// Serializing message
-		bytes, err := json.Marshal(result)
-		if err != nil {
-			LogError("error serializing json: %v", err)
+		result := result
+		if err := writeSpeedtestMessage(conn, result); err != nil {
 			return err
 		}
-		// Send Message through websocket connection
-		err = conn.writeMessage(websocket.TextMessage, bytes)
-		if err != nil {
-			LogError("error writing speedtest response: %v", err)
+		last = &result
+	}
+	if last == nil {
+		return nil
+	}
+
+	run.Result = last
+	run.Metrics = append(run.Metrics,
+		newSpeedtestMetric("", "", "putThroughput", float64(last.PUTStats.ThroughputPerSec)),
+		newSpeedtestMetric("", "", "putObjectsPerSec", float64(last.PUTStats.ObjectsPerSec)),
+		newSpeedtestMetric("", "", "getThroughput", float64(last.GETStats.ThroughputPerSec)),
+		newSpeedtestMetric("", "", "getObjectsPerSec", float64(last.GETStats.ObjectsPerSec)),
+	)
+	for _, server := range last.PUTStats.Servers {
+		run.Metrics = append(run.Metrics, newSpeedtestMetric(server.Endpoint, "", "putThroughput", float64(server.ThroughputPerSec)))
+		if server.Err != "" {
+			run.Errors = append(run.Errors, fmt.Sprintf("%s: %s", server.Endpoint, server.Err))
+		}
+	}
+	for _, server := range last.GETStats.Servers {
+		run.Metrics = append(run.Metrics, newSpeedtestMetric(server.Endpoint, "", "getThroughput", float64(server.ThroughputPerSec)))
+		if server.Err != "" {
+			run.Errors = append(run.Errors, fmt.Sprintf("%s: %s", server.Endpoint, server.Err))
+		}
+	}
+	return nil
+}
+
+// runDriveSpeedtest streams the result of every node as it completes
+func runDriveSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, opts madmin.DriveSpeedTestOpts, run *models.SpeedtestResult) error {
+	speedtestRes, err := client.driveSpeedtest(ctx, opts)
+	if err != nil {
+		LogError("error initializing drive speedtest: %v", err)
+		return err
+	}
+
+	results := []madmin.DriveSpeedTestResult{}
+	for result := range speedtestRes {
+		if err := writeSpeedtestMessage(conn, result); err != nil {
 			return err
 		}
+		results = append(results, result)
+		if result.Error != "" {
+			run.Errors = append(run.Errors, fmt.Sprintf("%s: %s", result.Endpoint, result.Error))
+		}
+		for _, drive := range result.DrivePerf {
+			if drive.Error != "" {
+				run.Errors = append(run.Errors, fmt.Sprintf("%s%s: %s", result.Endpoint, drive.Path, drive.Error))
+				continue
+			}
+			run.Metrics = append(run.Metrics,
+				newSpeedtestMetric(result.Endpoint, drive.Path, "readThroughput", float64(drive.ReadThroughput)),
+				newSpeedtestMetric(result.Endpoint, drive.Path, "writeThroughput", float64(drive.WriteThroughput)),
+			)
+		}
 	}
+	run.Result = results
+	return nil
+}
 
+// runNetSpeedtest measures the network throughput between the nodes of the cluster
+func runNetSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, duration time.Duration, run *models.SpeedtestResult) error {
+	result, err := client.netperf(ctx, duration)
+	if err != nil {
+		LogError("error running network speedtest: %v", err)
+		return err
+	}
+	if err := writeSpeedtestMessage(conn, result); err != nil {
+		return err
+	}
+	run.Result = result
+	for _, node := range result.NodeResults {
+		if node.Error != "" {
+			run.Errors = append(run.Errors, fmt.Sprintf("%s: %s", node.Endpoint, node.Error))
+			continue
+		}
+		run.Metrics = append(run.Metrics,
+			newSpeedtestMetric(node.Endpoint, "", "tx", float64(node.TX)),
+			newSpeedtestMetric(node.Endpoint, "", "rx", float64(node.RX)),
+		)
+	}
+	return nil
+}
+
+// runSiteSpeedtest measures the throughput of the links to the site replication peers
+func runSiteSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, duration time.Duration, run *models.SpeedtestResult) error {
+	result, err := client.siteReplicationPerf(ctx, duration)
+	if err != nil {
+		LogError("error running site replication speedtest: %v", err)
+		return err
+	}
+	if err := writeSpeedtestMessage(conn, result); err != nil {
+		return err
+	}
+	run.Result = result
+	for _, node := range result.NodeResults {
+		if node.Error != "" {
+			run.Errors = append(run.Errors, fmt.Sprintf("%s: %s", node.Endpoint, node.Error))
+			continue
+		}
+		run.Metrics = append(run.Metrics,
+			newSpeedtestMetric(node.Endpoint, "", "tx", float64(node.TX)),
+			newSpeedtestMetric(node.Endpoint, "", "rx", float64(node.RX)),
+			newSpeedtestMetric(node.Endpoint, "", "totalConn", float64(node.TotalConn)),
+		)
+	}
+	return nil
+}
+
+// startSpeedtest runs the requested speedtest and records it in the speedtest history, runs
+// interrupted by the client closing the connection are not recorded
+func startSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, s *store.Store, opts *speedtestOptions) error {
+	run := &models.SpeedtestResult{
+		Type:       opts.Type,
+		Parameters: opts.Parameters,
+		Metrics:    []*models.SpeedtestMetric{},
+	}
+	start := time.Now()
+
+	var err error
+	switch opts.Type {
+	case models.SpeedtestResultTypeDrive:
+		err = runDriveSpeedtest(ctx, conn, client, opts.Drive, run)
+	case models.SpeedtestResultTypeNet:
+		err = runNetSpeedtest(ctx, conn, client, opts.Duration, run)
+	case models.SpeedtestResultTypeSite:
+		err = runSiteSpeedtest(ctx, conn, client, opts.Duration, run)
+	default:
+		err = runObjectSpeedtest(ctx, conn, client, opts.Object, run)
+	}
+	if ctx.Err() != nil {
+		return err
+	}
+	if err != nil {
+		run.Error = err.Error()
+	}
+	if recErr := recordSpeedtestResult(s, run, start, time.Now()); recErr != nil {
+		LogError("unable to record speedtest result: %v", recErr)
+	}
+	return err
+}
+
+// recordSpeedtestResult adds the run to the speedtest history dropping the oldest runs
+func recordSpeedtestResult(s *store.Store, run *models.SpeedtestResult, start, end time.Time) error {
+	// ids sort in chronological order
+	run.ID = fmt.Sprintf("%020d-%s", start.UnixNano(), uuid.NewString()[:8])
+	run.StartTime = start.UTC().Format(time.RFC3339)
+	run.EndTime = end.UTC().Format(time.RFC3339)
+	if err := s.Put(speedtestResultsCollection, run.ID, run); err != nil {
+		return err
+	}
+	ids, err := s.List(speedtestResultsCollection)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(ids)-speedtestResultsMaxRuns; i++ {
+		if err := s.Delete(speedtestResultsCollection, ids[i]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// listSpeedtestResults returns the newest runs first, optionally filtered by type, the raw
+// results are only included when withResult is set
+func listSpeedtestResults(s *store.Store, testType string, withResult bool) ([]*models.SpeedtestResult, error) {
+	ids, err := s.List(speedtestResultsCollection)
+	if err != nil {
+		return nil, err
+	}
+	runs := []*models.SpeedtestResult{}
+	for i := len(ids) - 1; i >= 0; i-- {
+		var run models.SpeedtestResult
+		if err := s.Get(speedtestResultsCollection, ids[i], &run); err != nil {
+			return nil, err
+		}
+		if testType != "" && run.Type != testType {
+			continue
+		}
+		if !withResult {
+			run.Result = nil
+		}
+		runs = append(runs, &run)
+	}
+	return runs, nil
+}
+
+func getSpeedtestResult(s *store.Store, id string) (*models.SpeedtestResult, error) {
+	var run models.SpeedtestResult
+	if err := storeGet(s, speedtestResultsCollection, id, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func deleteSpeedtestResult(s *store.Store, id string) error {
+	if _, err := getSpeedtestResult(s, id); err != nil {
+		return err
+	}
+	return s.Delete(speedtestResultsCollection, id)
+}
+
+// formatSpeedtestParameters returns the parameters as `name=value` pairs sorted by name
+func formatSpeedtestParameters(parameters map[string]string) string {
+	pairs := make([]string, 0, len(parameters))
+	for name, value := range parameters {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ";")
+}
+
+// writeSpeedtestResults writes the runs as a JSON array, or as CSV with one row per metric
+func writeSpeedtestResults(w io.Writer, format string, runs []*models.SpeedtestResult) error {
+	if format != "csv" {
+		return json.NewEncoder(w).Encode(runs)
+	}
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{"id", "type", "startTime", "endTime", "parameters", "node", "drive", "metric", "value", "error"}); err != nil {
+		return err
+	}
+	for _, run := range runs {
+		row := []string{run.ID, run.Type, run.StartTime, run.EndTime, formatSpeedtestParameters(run.Parameters)}
+		if len(run.Metrics) == 0 {
+			// keep failed runs in the export
+			if err := csvWriter.Write(append(row, "", "", "", "", run.Error)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, metric := range run.Metrics {
+			value := strconv.FormatFloat(metric.Value, 'f', -1, 64)
+			if err := csvWriter.Write(append(row, metric.Node, metric.Drive, metric.Name, value, run.Error)); err != nil {
+				return err
+			}
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func processExportSpeedtestResponse(format string, runs []*models.SpeedtestResult) func(w http.ResponseWriter, _ runtime.Producer) {
+	return func(w http.ResponseWriter, _ runtime.Producer) {
+		contentType := "application/json"
+		if format == "csv" {
+			contentType = "text/csv"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"speedtest-results.%s\"", format))
+		if err := writeSpeedtestResults(w, format, runs); err != nil {
+			LogError("unable to export speedtest results: %v", err)
+		}
+	}
+}
+
+func getListSpeedtestResultsResponse(params speedtestApi.ListSpeedtestResultsParams) (*models.SpeedtestResultsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	testType := ""
+	if params.Type != nil {
+		testType = *params.Type
+	}
+	runs, err := listSpeedtestResults(getConsoleStore(), testType, false)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.SpeedtestResultsResponse{Results: runs}, nil
+}
+
+func getSpeedtestResultResponse(params speedtestApi.GetSpeedtestResultParams) (*models.SpeedtestResult, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	run, err := getSpeedtestResult(getConsoleStore(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return run, nil
+}
+
+func getDeleteSpeedtestResultResponse(params speedtestApi.DeleteSpeedtestResultParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := deleteSpeedtestResult(getConsoleStore(), params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getExportSpeedtestResults(params speedtestApi.ExportSpeedtestResultsParams) ([]*models.SpeedtestResult, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	testType := ""
+	if params.Type != nil {
+		testType = *params.Type
+	}
+	runs, err := listSpeedtestResults(getConsoleStore(), testType, *params.Format == "json")
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return runs, nil
+}
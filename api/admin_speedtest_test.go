@@ -0,0 +1,207 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/store"
+	"github.com/minio/madmin-go/v3"
+	minioIAMPolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSpeedtestOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/ws/speedtest?duration=20s&size=1MiB&concurrent=4", nil)
+	opts, err := getSpeedtestOptionsFromReq(req)
+	assert.Nil(err)
+	assert.Equal(models.SpeedtestResultTypeObject, opts.Type)
+	assert.Equal(20*time.Second, opts.Object.Duration)
+	assert.Equal(1<<20, opts.Object.Size)
+	assert.Equal(4, opts.Object.Concurrency)
+	assert.Equal("1.0 MiB", opts.Parameters["size"])
+
+	req, _ = http.NewRequest(http.MethodGet, "/ws/speedtest?type=drive&serial=true&blocksize=1MiB", nil)
+	opts, err = getSpeedtestOptionsFromReq(req)
+	assert.Nil(err)
+	assert.True(opts.Drive.Serial)
+	assert.Equal(uint64(1<<20), opts.Drive.BlockSize)
+	assert.Equal(uint64(1<<30), opts.Drive.FileSize)
+
+	req, _ = http.NewRequest(http.MethodGet, "/ws/speedtest?type=net", nil)
+	opts, err = getSpeedtestOptionsFromReq(req)
+	assert.Nil(err)
+	assert.Equal(10*time.Second, opts.Duration)
+	assert.Equal(map[string]string{"duration": "10s"}, opts.Parameters)
+
+	for _, query := range []string{
+		"type=drive&blocksize=4MiB&filesize=1MiB",
+		"type=site&duration=-1s",
+		"concurrent=0",
+		"type=unknown",
+	} {
+		req, _ = http.NewRequest(http.MethodGet, "/ws/speedtest?"+query, nil)
+		_, err = getSpeedtestOptionsFromReq(req)
+		assert.NotNil(err, query)
+	}
+}
+
+func TestStartSpeedtest(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	s := store.New(t.TempDir())
+	adminClient := AdminClientMock{}
+	mockWSConn := mockConn{}
+
+	var messages [][]byte
+	connWriteMessageMock = func(_ int, data []byte) error {
+		messages = append(messages, data)
+		return nil
+	}
+	minioDriveSpeedtestMock = func(_ context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+		assert.True(opts.Serial)
+		ch := make(chan madmin.DriveSpeedTestResult, 2)
+		ch <- madmin.DriveSpeedTestResult{Endpoint: "node1:9000", DrivePerf: []madmin.DrivePerf{
+			{Path: "/data1", ReadThroughput: 300, WriteThroughput: 200},
+			{Path: "/data2", Error: "faulty drive"},
+		}}
+		ch <- madmin.DriveSpeedTestResult{Endpoint: "node2:9000", Error: "node offline"}
+		close(ch)
+		return ch, nil
+	}
+
+	opts := &speedtestOptions{
+		Type:       models.SpeedtestResultTypeDrive,
+		Drive:      madmin.DriveSpeedTestOpts{Serial: true},
+		Parameters: map[string]string{"serial": "true"},
+	}
+	assert.Nil(startSpeedtest(ctx, mockWSConn, adminClient, s, opts))
+	assert.Len(messages, 2)
+
+	runs, err := listSpeedtestResults(s, "", false)
+	assert.Nil(err)
+	assert.Len(runs, 1)
+	assert.Equal(models.SpeedtestResultTypeDrive, runs[0].Type)
+	assert.Nil(runs[0].Result)
+	assert.Len(runs[0].Metrics, 2)
+	assert.Equal("/data1", runs[0].Metrics[0].Drive)
+	assert.Equal(float64(300), runs[0].Metrics[0].Value)
+	assert.Equal([]string{"node1:9000/data2: faulty drive", "node2:9000: node offline"}, runs[0].Errors)
+
+	// failed runs are recorded as well
+	minioNetperfMock = func(_ context.Context, _ time.Duration) (madmin.NetperfResult, error) {
+		return madmin.NetperfResult{}, errors.New("netperf not supported")
+	}
+	opts = &speedtestOptions{Type: models.SpeedtestResultTypeNet, Duration: time.Second}
+	assert.NotNil(startSpeedtest(ctx, mockWSConn, adminClient, s, opts))
+	runs, err = listSpeedtestResults(s, models.SpeedtestResultTypeNet, false)
+	assert.Nil(err)
+	assert.Len(runs, 1)
+	assert.Equal("netperf not supported", runs[0].Error)
+
+	minioSiteReplicationPerfMock = func(_ context.Context, _ time.Duration) (madmin.SiteNetPerfResult, error) {
+		return madmin.SiteNetPerfResult{NodeResults: []madmin.SiteNetPerfNodeResult{{Endpoint: "site2:9000", TX: 100, RX: 50, TotalConn: 8}}}, nil
+	}
+	opts = &speedtestOptions{Type: models.SpeedtestResultTypeSite, Duration: time.Second}
+	assert.Nil(startSpeedtest(ctx, mockWSConn, adminClient, s, opts))
+
+	// runs cancelled by the client are not recorded
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Nil(startSpeedtest(cancelledCtx, mockWSConn, adminClient, s, opts))
+
+	runs, err = listSpeedtestResults(s, "", true)
+	assert.Nil(err)
+	assert.Len(runs, 3)
+	assert.Equal(models.SpeedtestResultTypeSite, runs[0].Type)
+	assert.NotNil(runs[0].Result)
+
+	run, err := getSpeedtestResult(s, runs[0].ID)
+	assert.Nil(err)
+	assert.Len(run.Metrics, 3)
+	assert.Nil(deleteSpeedtestResult(s, runs[0].ID))
+	assert.Equal(ErrNotFound, deleteSpeedtestResult(s, runs[0].ID))
+}
+
+func TestWriteSpeedtestResults(t *testing.T) {
+	assert := assert.New(t)
+	runs := []*models.SpeedtestResult{
+		{
+			ID:         "2",
+			Type:       models.SpeedtestResultTypeNet,
+			Parameters: map[string]string{"duration": "10s"},
+			Metrics: []*models.SpeedtestMetric{
+				{Node: "node1:9000", Name: "tx", Value: 1024},
+				{Node: "node1:9000", Name: "rx", Value: 512.5},
+			},
+		},
+		{ID: "1", Type: models.SpeedtestResultTypeObject, Parameters: map[string]string{"size": "64 MiB", "concurrent": "32"}, Error: "timeout"},
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(writeSpeedtestResults(&buf, "csv", runs))
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.Nil(err)
+	assert.Len(records, 4)
+	assert.Equal("metric", records[0][7])
+	assert.Equal([]string{"2", "net", "", "", "duration=10s", "node1:9000", "", "rx", "512.5", ""}, records[2])
+	assert.Equal("concurrent=32;size=64 MiB", records[3][4])
+	assert.Equal("timeout", records[3][9])
+
+	buf.Reset()
+	assert.Nil(writeSpeedtestResults(&buf, "json", runs))
+	var decoded []*models.SpeedtestResult
+	assert.Nil(json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Len(decoded, 2)
+	assert.Equal("2", decoded[0].ID)
+}
+
+func TestSpeedtestHistoryPermission(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	session := &models.Principal{AccountAccessKey: "monitor"}
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:ServerInfo"]}]}`
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{AccountName: "monitor", Policy: []byte(policy)}, nil
+	}
+	allowed, err := hasAdminPermission(ctx, AdminClientMock{}, session, minioIAMPolicy.HealthInfoAdminAction)
+	assert.Nil(err)
+	assert.False(allowed)
+
+	// the history is available to whoever may run a speedtest
+	policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:OBDInfo"]}]}`
+	allowed, err = hasAdminPermission(ctx, AdminClientMock{}, session, minioIAMPolicy.HealthInfoAdminAction)
+	assert.Nil(err)
+	assert.True(allowed)
+
+	// healing doesn't grant access to the speedtest results
+	policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:Heal"]}]}`
+	allowed, err = hasAdminPermission(ctx, AdminClientMock{}, session, minioIAMPolicy.HealthInfoAdminAction)
+	assert.Nil(err)
+	assert.False(allowed)
+}
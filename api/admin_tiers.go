@@ -19,7 +19,11 @@ package api
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/go-openapi/runtime/middleware"
@@ -27,8 +31,12 @@ import (
 	tieringApi "github.com/minio/console/api/operations/tiering"
 	"github.com/minio/console/models"
 	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
 )
 
+// errTierInUse is returned when removing a tier still targeted by bucket lifecycle rules
+var errTierInUse = errors.New("tier is in use")
+
 func registerAdminTiersHandlers(api *operations.ConsoleAPI) {
 	// return a list of notification endpoints
 	api.TieringTiersListHandler = tieringApi.TiersListHandlerFunc(func(params tieringApi.TiersListParams, session *models.Principal) middleware.Responder {
@@ -62,6 +70,30 @@ func registerAdminTiersHandlers(api *operations.ConsoleAPI) {
 		}
 		return tieringApi.NewEditTierCredentialsOK()
 	})
+	// remove a tier
+	api.TieringRemoveTierHandler = tieringApi.RemoveTierHandlerFunc(func(params tieringApi.RemoveTierParams, session *models.Principal) middleware.Responder {
+		err := getRemoveTierResponse(session, params)
+		if err != nil {
+			return tieringApi.NewRemoveTierDefault(err.Code).WithPayload(err.APIError)
+		}
+		return tieringApi.NewRemoveTierNoContent()
+	})
+	// verify a tier
+	api.TieringVerifyTierHandler = tieringApi.VerifyTierHandlerFunc(func(params tieringApi.VerifyTierParams, session *models.Principal) middleware.Responder {
+		verifyResp, err := getVerifyTierResponse(session, params)
+		if err != nil {
+			return tieringApi.NewVerifyTierDefault(err.Code).WithPayload(err.APIError)
+		}
+		return tieringApi.NewVerifyTierOK().WithPayload(verifyResp)
+	})
+	// data transitioned to each tier
+	api.TieringTiersUsageHandler = tieringApi.TiersUsageHandlerFunc(func(params tieringApi.TiersUsageParams, session *models.Principal) middleware.Responder {
+		usageResp, err := getTiersUsageResponse(session, params)
+		if err != nil {
+			return tieringApi.NewTiersUsageDefault(err.Code).WithPayload(err.APIError)
+		}
+		return tieringApi.NewTiersUsageOK().WithPayload(usageResp)
+	})
 }
 
 // getNotificationEndpoints invokes admin info and returns a list of notification endpoints
@@ -292,9 +324,33 @@ func getAddTierResponse(session *models.Principal, params tieringApi.AddTierPara
 	if errTier != nil {
 		return ErrorWithContext(ctx, errTier)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetTier, tierName(params.Body), nil, params.Body)
 	return nil
 }
 
+// tierName returns the name of the tier from the configuration of its type
+func tierName(tier *models.Tier) string {
+	switch tier.Type {
+	case models.TierTypeS3:
+		if tier.S3 != nil {
+			return tier.S3.Name
+		}
+	case models.TierTypeMinio:
+		if tier.Minio != nil {
+			return tier.Minio.Name
+		}
+	case models.TierTypeGcs:
+		if tier.Gcs != nil {
+			return tier.Gcs.Name
+		}
+	case models.TierTypeAzure:
+		if tier.Azure != nil {
+			return tier.Azure.Name
+		}
+	}
+	return ""
+}
+
 func getTier(ctx context.Context, client MinioAdmin, params *tieringApi.GetTierParams) (*models.Tier, error) {
 	tiers, err := client.listTiers(ctx)
 	if err != nil {
@@ -407,5 +463,229 @@ func getEditTierCredentialsResponse(session *models.Principal, params tieringApi
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetTier, params.Name, nil, params.Body)
+	return nil
+}
+
+// getTierTransitionRules returns, for every tier, the lifecycle rules of each bucket transitioning objects to it
+func getTierTransitionRules(ctx context.Context, client MinioClient) (map[string]map[string][]*models.TierLifecycleRule, error) {
+	buckets, err := client.listBucketsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tierRules := make(map[string]map[string][]*models.TierLifecycleRule)
+	addRule := func(tierName, bucket string, rule *models.TierLifecycleRule) {
+		if tierRules[tierName] == nil {
+			tierRules[tierName] = make(map[string][]*models.TierLifecycleRule)
+		}
+		tierRules[tierName][bucket] = append(tierRules[tierName][bucket], rule)
+	}
+	for _, bucket := range buckets {
+		lfcCfg, err := client.getLifecycleRules(ctx, bucket.Name)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+				continue
+			}
+			return nil, err
+		}
+		if lfcCfg == nil {
+			continue
+		}
+		for _, rule := range lfcCfg.Rules {
+			prefix := rule.RuleFilter.And.Prefix
+			if prefix == "" {
+				prefix = rule.RuleFilter.Prefix
+			}
+			if rule.Transition.StorageClass != "" {
+				addRule(rule.Transition.StorageClass, bucket.Name, &models.TierLifecycleRule{
+					ID:     rule.ID,
+					Prefix: prefix,
+					Status: rule.Status,
+				})
+			}
+			if rule.NoncurrentVersionTransition.StorageClass != "" {
+				addRule(rule.NoncurrentVersionTransition.StorageClass, bucket.Name, &models.TierLifecycleRule{
+					ID:         rule.ID,
+					Prefix:     prefix,
+					Status:     rule.Status,
+					Noncurrent: true,
+				})
+			}
+		}
+	}
+	return tierRules, nil
+}
+
+// sortedTierBuckets returns the buckets transitioning objects to a tier sorted by name
+func sortedTierBuckets(bucketRules map[string][]*models.TierLifecycleRule) []string {
+	buckets := make([]string, 0, len(bucketRules))
+	for bucket := range bucketRules {
+		buckets = append(buckets, bucket)
+	}
+	sort.Strings(buckets)
+	return buckets
+}
+
+// removeTier removes a tier, unless a bucket lifecycle rule still transitions objects to it, disabled
+// rules are considered as well since enabling them again would fail
+func removeTier(ctx context.Context, client MinioAdmin, minioClient MinioClient, name string) error {
+	tierRules, err := getTierTransitionRules(ctx, minioClient)
+	if err != nil {
+		return err
+	}
+	if bucketRules := tierRules[name]; len(bucketRules) > 0 {
+		var references []string
+		for _, bucket := range sortedTierBuckets(bucketRules) {
+			for _, rule := range bucketRules[bucket] {
+				references = append(references, fmt.Sprintf("%s (rule %s)", bucket, rule.ID))
+			}
+		}
+		return fmt.Errorf("%w by lifecycle rules of bucket %s", errTierInUse, strings.Join(references, ", "))
+	}
+	return client.removeTier(ctx, name)
+}
+
+// getRemoveTierResponse returns the result of removing a tier
+func getRemoveTierResponse(session *models.Principal, params tieringApi.RemoveTierParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	minioClient := minioClient{client: mClient}
+	if err = removeTier(ctx, adminClient, minioClient, params.Name); err != nil {
+		if errors.Is(err, errTierInUse) {
+			return ErrorWithContext(ctx, ErrBadRequest, err)
+		}
+		return ErrorWithContext(ctx, err)
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetTier, params.Name, nil, nil)
 	return nil
 }
+
+// verifyTier checks the tier is reachable with its current configuration and reports why it isn't
+func verifyTier(ctx context.Context, client MinioAdmin, name string) (*models.TierVerifyResponse, error) {
+	tiers, err := client.listTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, tier := range tiers {
+		if tier.Name == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrRemoteTierNotFound
+	}
+	verifyResp := &models.TierVerifyResponse{Name: name, Status: true}
+	if err = client.verifyTierStatus(ctx, name); err != nil {
+		verifyResp.Status = false
+		verifyResp.Error = err.Error()
+	}
+	return verifyResp, nil
+}
+
+// getVerifyTierResponse returns the verification status of a tier
+func getVerifyTierResponse(session *models.Principal, params tieringApi.VerifyTierParams) (*models.TierVerifyResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	verifyResp, err := verifyTier(ctx, adminClient, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return verifyResp, nil
+}
+
+// getTiersUsage reports the data transitioned to every tier along with the buckets and lifecycle rules
+// targeting it. MinIO only keeps statistics per tier, so usage is attributed to a bucket only when it's
+// the single bucket transitioning objects to that tier
+func getTiersUsage(ctx context.Context, client MinioAdmin, minioClient MinioClient) (*models.TierUsageResponse, error) {
+	tiers, err := client.listTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tiersInfo, err := client.tierStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tierRules, err := getTierTransitionRules(ctx, minioClient)
+	if err != nil {
+		return nil, err
+	}
+	statsByTier := make(map[string]madmin.TierInfo, len(tiersInfo))
+	for _, info := range tiersInfo {
+		statsByTier[info.Name] = info
+	}
+	usageResp := &models.TierUsageResponse{Tiers: []*models.TierUsage{}}
+	for _, tier := range tiers {
+		info := statsByTier[tier.Name]
+		usage := &models.TierUsage{
+			Name:     tier.Name,
+			Type:     tier.Type.String(),
+			Size:     int64(info.Stats.TotalSize),
+			Objects:  int64(info.Stats.NumObjects),
+			Versions: int64(info.Stats.NumVersions),
+			Buckets:  []*models.TierBucketUsage{},
+		}
+		for _, bin := range info.DailyStats.Bins {
+			usage.DailySize += int64(bin.TotalSize)
+			usage.DailyObjects += int64(bin.NumObjects)
+		}
+		bucketRules := tierRules[tier.Name]
+		for _, bucket := range sortedTierBuckets(bucketRules) {
+			bucketUsage := &models.TierBucketUsage{
+				Bucket: bucket,
+				Rules:  bucketRules[bucket],
+			}
+			if len(bucketRules) == 1 {
+				bucketUsage.Attributed = true
+				bucketUsage.Size = usage.Size
+				bucketUsage.Objects = usage.Objects
+				bucketUsage.Versions = usage.Versions
+			}
+			usage.Buckets = append(usage.Buckets, bucketUsage)
+		}
+		usageResp.Tiers = append(usageResp.Tiers, usage)
+	}
+	return usageResp, nil
+}
+
+// getTiersUsageResponse returns the data transitioned to every tier
+func getTiersUsageResponse(session *models.Principal, params tieringApi.TiersUsageParams) (*models.TierUsageResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	minioClient := minioClient{client: mClient}
+	usageResp, err := getTiersUsage(ctx, adminClient, minioClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return usageResp, nil
+}
@@ -25,6 +25,8 @@ import (
 	tieringApi "github.com/minio/console/api/operations/tiering"
 	"github.com/minio/console/models"
 	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -228,3 +230,130 @@ func TestUpdateTierCreds(t *testing.T) {
 
 	assert.Equal(errors.New("error message"), errT2, fmt.Sprintf("Failed on %s: Error returned", function))
 }
+
+// mockTierLifecycles mocks buckets with lifecycle rules transitioning objects to tiers
+func mockTierLifecycles() {
+	minioListBucketsWithContextMock = func(_ context.Context) ([]minio.BucketInfo, error) {
+		return []minio.BucketInfo{{Name: "logs"}, {Name: "archive"}, {Name: "plain"}}, nil
+	}
+	minioGetLifecycleRulesMock = func(_ context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		switch bucketName {
+		case "logs":
+			return &lifecycle.Configuration{Rules: []lifecycle.Rule{
+				{ID: "old-logs", Status: "Enabled", RuleFilter: lifecycle.Filter{Prefix: "2023/"}, Transition: lifecycle.Transition{Days: 30, StorageClass: "WARM"}},
+				{ID: "versions", Status: "Disabled", NoncurrentVersionTransition: lifecycle.NoncurrentVersionTransition{NoncurrentDays: 7, StorageClass: "COLD"}},
+			}}, nil
+		case "archive":
+			return &lifecycle.Configuration{Rules: []lifecycle.Rule{
+				{ID: "archive", Status: "Enabled", Transition: lifecycle.Transition{Days: 1, StorageClass: "COLD"}},
+			}}, nil
+		}
+		return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration"}
+	}
+}
+
+func TestRemoveTier(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	minClient := minioClientMock{}
+	ctx := context.Background()
+	mockTierLifecycles()
+
+	var removed []string
+	minioRemoveTierMock = func(_ context.Context, tierName string) error {
+		removed = append(removed, tierName)
+		return nil
+	}
+
+	// tiers targeted by lifecycle rules, even disabled ones, can't be removed
+	err := removeTier(ctx, adminClient, minClient, "COLD")
+	assert.ErrorIs(err, errTierInUse)
+	assert.Contains(err.Error(), "archive (rule archive), logs (rule versions)")
+	assert.Empty(removed)
+
+	assert.Nil(removeTier(ctx, adminClient, minClient, "UNUSED"))
+	assert.Equal([]string{"UNUSED"}, removed)
+
+	// errors reading lifecycle configurations prevent the removal
+	minioGetLifecycleRulesMock = func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+		return nil, errors.New("access denied")
+	}
+	assert.NotNil(removeTier(ctx, adminClient, minClient, "UNUSED"))
+	assert.Len(removed, 1)
+}
+
+func TestVerifyTier(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+	defer func() { minioVerifyTierMock = nil }()
+
+	minioListTiersMock = func(_ context.Context) ([]*madmin.TierConfig, error) {
+		return []*madmin.TierConfig{{Name: "WARM", Type: madmin.MinIO}}, nil
+	}
+	minioVerifyTierMock = func(_ context.Context, _ string) error {
+		return nil
+	}
+	verifyResp, err := verifyTier(ctx, adminClient, "WARM")
+	assert.Nil(err)
+	assert.True(verifyResp.Status)
+	assert.Empty(verifyResp.Error)
+
+	minioVerifyTierMock = func(_ context.Context, _ string) error {
+		return errors.New("remote tier bucket not found")
+	}
+	verifyResp, err = verifyTier(ctx, adminClient, "WARM")
+	assert.Nil(err)
+	assert.False(verifyResp.Status)
+	assert.Equal("remote tier bucket not found", verifyResp.Error)
+
+	_, err = verifyTier(ctx, adminClient, "MISSING")
+	assert.Equal(ErrRemoteTierNotFound, err)
+}
+
+func TestGetTiersUsage(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	minClient := minioClientMock{}
+	ctx := context.Background()
+	mockTierLifecycles()
+
+	minioListTiersMock = func(_ context.Context) ([]*madmin.TierConfig, error) {
+		return []*madmin.TierConfig{{Name: "WARM", Type: madmin.MinIO}, {Name: "COLD", Type: madmin.S3}, {Name: "EMPTY", Type: madmin.GCS}}, nil
+	}
+	daily := madmin.DailyTierStats{}
+	daily.Bins[0] = madmin.TierStats{TotalSize: 10, NumObjects: 1}
+	daily.Bins[23] = madmin.TierStats{TotalSize: 5, NumObjects: 2}
+	minioTierStatsMock = func(_ context.Context) ([]madmin.TierInfo, error) {
+		return []madmin.TierInfo{
+			{Name: "WARM", Stats: madmin.TierStats{TotalSize: 1024, NumObjects: 4, NumVersions: 5}, DailyStats: daily},
+			{Name: "COLD", Stats: madmin.TierStats{TotalSize: 2048, NumObjects: 8, NumVersions: 8}},
+		}, nil
+	}
+
+	usageResp, err := getTiersUsage(ctx, adminClient, minClient)
+	assert.Nil(err)
+	assert.Len(usageResp.Tiers, 3)
+
+	warm := usageResp.Tiers[0]
+	assert.Equal("minio", warm.Type)
+	assert.Equal(int64(15), warm.DailySize)
+	assert.Equal(int64(3), warm.DailyObjects)
+	assert.Len(warm.Buckets, 1)
+	// a single bucket transitions to the tier, its usage is the tier usage
+	assert.True(warm.Buckets[0].Attributed)
+	assert.Equal("logs", warm.Buckets[0].Bucket)
+	assert.Equal(int64(1024), warm.Buckets[0].Size)
+	assert.Equal("2023/", warm.Buckets[0].Rules[0].Prefix)
+
+	cold := usageResp.Tiers[1]
+	assert.Equal(int64(2048), cold.Size)
+	assert.Len(cold.Buckets, 2)
+	assert.Equal("archive", cold.Buckets[0].Bucket)
+	assert.False(cold.Buckets[0].Attributed)
+	assert.Zero(cold.Buckets[0].Size)
+	assert.True(cold.Buckets[1].Rules[0].Noncurrent)
+
+	assert.Empty(usageResp.Tiers[2].Buckets)
+	assert.Zero(usageResp.Tiers[2].Size)
+}
@@ -144,6 +144,7 @@ func listUsers(ctx context.Context, client MinioAdmin) ([]*models.User, error) {
 			Status:    string(user.Status),
 			Policy:    strings.Split(user.PolicyName, ","),
 			MemberOf:  user.MemberOf,
+			Usage:     accessKeyUsage(globalAccessKeyUsage, accessKey),
 		}
 		users = append(users, userElem)
 	}
@@ -168,7 +169,8 @@ func getListUsersResponse(session *models.Principal, params userApi.ListUsersPar
 	}
 	// serialize output
 	listUsersResponse := &models.ListUsersResponse{
-		Users: users,
+		Users:             users,
+		UsageTrackedSince: usageTrackedSince(globalAccessKeyUsage),
 	}
 	return listUsersResponse, nil
 }
@@ -179,6 +181,11 @@ func addUser(ctx context.Context, client MinioAdmin, accessKey, secretKey *strin
 	if err := client.addUser(ctx, *accessKey, *secretKey); err != nil {
 		return nil, err
 	}
+	// a user left half configured is removed
+	var undo iamUndoLog
+	undo.add(func(ctx context.Context) error {
+		return removeUser(ctx, client, *accessKey)
+	})
 	// set groups for the newly created user
 	var userWithGroups *models.User
 	if len(groups) > 0 {
@@ -186,14 +193,14 @@ func addUser(ctx context.Context, client MinioAdmin, accessKey, secretKey *strin
 		userWithGroups, errUG = updateUserGroups(ctx, client, *accessKey, groups)
 
 		if errUG != nil {
-			return nil, errUG
+			return nil, undo.rollbackOnError(ctx, errUG)
 		}
 	}
 	// set policies for the newly created user
 	if len(policies) > 0 {
 		policyString := strings.Join(policies, ",")
 		if err := SetPolicy(ctx, client, policyString, *accessKey, "user"); err != nil {
-			return nil, err
+			return nil, undo.rollbackOnError(ctx, err)
 		}
 	}
 
@@ -242,6 +249,7 @@ func getUserAddResponse(session *models.Principal, params userApi.AddUserParams)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetUser, user.AccessKey, nil, params.Body)
 	return user, nil
 }
 
@@ -267,12 +275,32 @@ func getRemoveUserResponse(session *models.Principal, params userApi.RemoveUserP
 	// create a minioClient interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
+	before := auditUserState(ctx, adminClient, userName)
 	if err := removeUser(ctx, adminClient, userName); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetUser, userName, before, nil)
 	return nil
 }
 
+// auditUserState returns the user as recorded in audit events, nil if it can't be fetched
+func auditUserState(ctx context.Context, client MinioAdmin, accessKey string) *models.User {
+	userInfo, err := client.getUserInfo(ctx, accessKey)
+	if err != nil {
+		return nil
+	}
+	policies := []string{}
+	if userInfo.PolicyName != "" {
+		policies = strings.Split(userInfo.PolicyName, ",")
+	}
+	return &models.User{
+		AccessKey: accessKey,
+		MemberOf:  userInfo.MemberOf,
+		Policy:    policies,
+		Status:    string(userInfo.Status),
+	}
+}
+
 // getUserInfo calls MinIO server get the User Information
 func getUserInfo(ctx context.Context, client MinioAdmin, accessKey string) (*madmin.UserInfo, error) {
 	userInfo, err := client.getUserInfo(ctx, accessKey)
@@ -451,10 +479,12 @@ func getUpdateUserGroupsResponse(session *models.Principal, params userApi.Updat
 		return nil, ErrorWithContext(ctx, err)
 	}
 
+	before := auditUserState(ctx, adminClient, userName)
 	user, err := updateUserGroups(ctx, adminClient, userName, params.Body.Groups)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetUser, userName, before, user)
 
 	return user, nil
 }
@@ -492,6 +522,7 @@ func getUpdateUserResponse(session *models.Principal, params userApi.UpdateUserI
 	}
 	status := *params.Body.Status
 	groups := params.Body.Groups
+	before := auditUserState(ctx, adminClient, userName)
 
 	if err := setUserStatus(ctx, adminClient, userName, status); err != nil {
 		return nil, ErrorWithContext(ctx, err)
@@ -502,6 +533,7 @@ func getUpdateUserResponse(session *models.Principal, params userApi.UpdateUserI
 	if errUG != nil {
 		return nil, ErrorWithContext(ctx, errUG)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetUser, userName, before, userElem)
 	return userElem, nil
 }
 
@@ -567,6 +599,7 @@ func getAddUsersListToGroupsResponse(session *models.Principal, params userApi.B
 	if err := addUsersListToGroups(ctx, adminClient, usersList, groupsList); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetUser, strings.Join(usersList, ","), nil, params.Body)
 
 	return nil
 }
@@ -701,6 +734,7 @@ func getChangeUserPasswordResponse(session *models.Principal, params accountApi.
 	if err := changeUserPassword(ctx, adminClient, user, newSecretKey); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetUser, user, nil, params.Body)
 	return nil
 }
 
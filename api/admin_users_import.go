@@ -0,0 +1,389 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	userApi "github.com/minio/console/api/operations/user"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+)
+
+const (
+	// generatedSecretKeyLength is the length of the secret keys generated for imported users
+	generatedSecretKeyLength = 40
+	// userListSeparator separates the groups and policies of a user in a CSV cell
+	userListSeparator = ";"
+	// maxUsersImportSize limits the size of an imported file
+	maxUsersImportSize = 10 << 20
+)
+
+// usersCSVHeader are the columns of exported users, imports also accept a secretKey column
+var usersCSVHeader = []string{"accessKey", "groups", "policies", "status"}
+
+// userRecord is a user of an import or export file, secret keys are never exported
+type userRecord struct {
+	AccessKey string   `json:"accessKey"`
+	SecretKey string   `json:"secretKey,omitempty"`
+	Groups    []string `json:"groups"`
+	Policies  []string `json:"policies"`
+	Status    string   `json:"status,omitempty"`
+}
+
+func registerUsersImportExportHandlers(api *operations.ConsoleAPI) {
+	// Import users
+	api.UserImportUsersHandler = userApi.ImportUsersHandlerFunc(func(params userApi.ImportUsersParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportUsersResponse(session, params)
+		if err != nil {
+			return userApi.NewImportUsersDefault(err.Code).WithPayload(err.APIError)
+		}
+		return userApi.NewImportUsersOK().WithPayload(resp)
+	})
+	// Export users
+	api.UserExportUsersHandler = userApi.ExportUsersHandlerFunc(func(params userApi.ExportUsersParams, session *models.Principal) middleware.Responder {
+		users, err := getExportUsersResponse(session, params)
+		if err != nil {
+			return userApi.NewExportUsersDefault(err.Code).WithPayload(err.APIError)
+		}
+		return middleware.ResponderFunc(processExportUsersResponse(*params.Format, users))
+	})
+}
+
+// splitUserList splits a CSV cell of groups or policies dropping empty values
+func splitUserList(value string) []string {
+	list := []string{}
+	for _, v := range strings.Split(value, userListSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// parseUsersCSV reads users from a CSV file with a header row, column names are case-insensitive
+func parseUsersCSV(r io.Reader) ([]userRecord, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.TrimLeadingSpace = true
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the CSV header: %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "accesskey", "secretkey", "groups", "policies", "status":
+			columns[name] = i
+		default:
+			return nil, fmt.Errorf("unsupported CSV column: %s", header[i])
+		}
+	}
+	if _, ok := columns["accesskey"]; !ok {
+		return nil, errors.New("the CSV file has no accessKey column")
+	}
+	cell := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var records []userRecord
+	for {
+		row, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, userRecord{
+			AccessKey: cell(row, "accesskey"),
+			SecretKey: cell(row, "secretkey"),
+			Groups:    splitUserList(cell(row, "groups")),
+			Policies:  splitUserList(cell(row, "policies")),
+			Status:    strings.ToLower(cell(row, "status")),
+		})
+	}
+}
+
+// parseUsersFile reads the users of an import file, the format is taken from the file extension
+// unless it's set
+func parseUsersFile(r io.Reader, format, fileName string) ([]userRecord, error) {
+	if format == "" {
+		format = "json"
+		if strings.HasSuffix(strings.ToLower(fileName), ".csv") {
+			format = "csv"
+		}
+	}
+	// one byte past the limit tells a file that is too large from one that fits exactly
+	data, err := io.ReadAll(io.LimitReader(r, maxUsersImportSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxUsersImportSize {
+		return nil, fmt.Errorf("the file is larger than %d MiB", maxUsersImportSize>>20)
+	}
+	if format == "csv" {
+		return parseUsersCSV(bytes.NewReader(data))
+	}
+	var records []userRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("unable to read the JSON file: %v", err)
+	}
+	for i := range records {
+		records[i].Status = strings.ToLower(records[i].Status)
+	}
+	return records, nil
+}
+
+// validateUserRecords checks every user before anything is created and returns the errors of each one,
+// users existing already and policies that don't exist are reported
+func validateUserRecords(records []userRecord, existingUsers map[string]madmin.UserInfo, policies map[string]bool) [][]string {
+	rowErrors := make([][]string, len(records))
+	firstRow := map[string]int{}
+	for i, record := range records {
+		var errs []string
+		switch {
+		case record.AccessKey == "":
+			errs = append(errs, "access key is required")
+		case len(record.AccessKey) < 3:
+			errs = append(errs, "access key must be at least 3 characters long")
+		}
+		if row, ok := firstRow[record.AccessKey]; ok && record.AccessKey != "" {
+			errs = append(errs, fmt.Sprintf("duplicate access key, also on row %d", row))
+		} else {
+			firstRow[record.AccessKey] = i + 1
+		}
+		if _, ok := existingUsers[record.AccessKey]; ok {
+			errs = append(errs, ErrNonUniqueAccessKey.Error())
+		}
+		if record.SecretKey != "" && (len(record.SecretKey) < 8 || len(record.SecretKey) > 40) {
+			errs = append(errs, "secret key must be between 8 and 40 characters long")
+		}
+		if record.Status != "" && record.Status != string(madmin.AccountEnabled) && record.Status != string(madmin.AccountDisabled) {
+			errs = append(errs, fmt.Sprintf("invalid status %s", record.Status))
+		}
+		for _, policy := range record.Policies {
+			if !policies[policy] {
+				errs = append(errs, fmt.Sprintf("policy %s does not exist", policy))
+			}
+		}
+		rowErrors[i] = errs
+	}
+	return rowErrors
+}
+
+// importUsers validates all the users first and then creates the valid ones, users without a secret
+// key get a generated one which is returned in the report
+func importUsers(ctx context.Context, client MinioAdmin, records []userRecord) (*models.ImportUsersResponse, error) {
+	existingUsers, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingPolicies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies := map[string]bool{}
+	for name := range existingPolicies {
+		policies[name] = true
+	}
+
+	resp := &models.ImportUsersResponse{Rows: []*models.ImportUsersRow{}}
+	rowErrors := validateUserRecords(records, existingUsers, policies)
+	for i, record := range records {
+		row := &models.ImportUsersRow{Row: int64(i + 1), AccessKey: record.AccessKey, Errors: rowErrors[i]}
+		resp.Rows = append(resp.Rows, row)
+		if len(row.Errors) > 0 {
+			row.Status = models.ImportUsersRowStatusInvalid
+			resp.Invalid++
+			continue
+		}
+		secretKey := record.SecretKey
+		if secretKey == "" {
+			secretKey = RandomCharString(generatedSecretKeyLength)
+		}
+		if err := importUser(ctx, client, record, secretKey); err != nil {
+			row.Status = models.ImportUsersRowStatusFailed
+			row.Errors = []string{err.Error()}
+			resp.Failed++
+			continue
+		}
+		row.Status = models.ImportUsersRowStatusCreated
+		if record.SecretKey == "" {
+			row.SecretKey = secretKey
+		}
+		resp.Created++
+	}
+	return resp, nil
+}
+
+// importUser creates a single user, a user that can't be fully configured is removed
+func importUser(ctx context.Context, client MinioAdmin, record userRecord, secretKey string) error {
+	if _, err := addUser(ctx, client, &record.AccessKey, &secretKey, record.Groups, record.Policies); err != nil {
+		return err
+	}
+	if record.Status != string(madmin.AccountDisabled) {
+		return nil
+	}
+	if err := client.setUserStatus(ctx, record.AccessKey, madmin.AccountDisabled); err != nil {
+		undo := iamUndoLog{func(ctx context.Context) error {
+			return removeUser(ctx, client, record.AccessKey)
+		}}
+		return undo.rollbackOnError(ctx, err)
+	}
+	return nil
+}
+
+func getImportUsersResponse(session *models.Principal, params userApi.ImportUsersParams) (*models.ImportUsersResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	defer params.File.Close()
+
+	format, fileName := "", ""
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if file, ok := params.File.(*runtime.File); ok && file.Header != nil {
+		fileName = file.Header.Filename
+	}
+	records, err := parseUsersFile(params.File, format, fileName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	if len(records) == 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("the file has no users"))
+	}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	resp, err := importUsers(ctx, adminClient, records)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+
+	var created []string
+	for _, row := range resp.Rows {
+		if row.Status == models.ImportUsersRowStatusCreated {
+			created = append(created, row.AccessKey)
+		}
+	}
+	if len(created) > 0 {
+		auditEvent(params.HTTPRequest, session, auditTargetUser, strings.Join(created, ","), nil, map[string]interface{}{"users": created})
+	}
+	return resp, nil
+}
+
+// exportUsers returns all the users sorted by access key, secret keys are never exported
+func exportUsers(ctx context.Context, client MinioAdmin) ([]userRecord, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records := []userRecord{}
+	for accessKey, user := range users {
+		groups := user.MemberOf
+		if groups == nil {
+			groups = []string{}
+		}
+		policies := []string{}
+		for _, policy := range strings.Split(user.PolicyName, ",") {
+			if policy != "" {
+				policies = append(policies, policy)
+			}
+		}
+		records = append(records, userRecord{
+			AccessKey: accessKey,
+			Groups:    groups,
+			Policies:  policies,
+			Status:    string(user.Status),
+		})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].AccessKey < records[j].AccessKey
+	})
+	return records, nil
+}
+
+func getExportUsersResponse(session *models.Principal, params userApi.ExportUsersParams) ([]userRecord, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	users, err := exportUsers(ctx, adminClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return users, nil
+}
+
+// writeUsers writes users in the format read back by the import
+func writeUsers(w io.Writer, format string, users []userRecord) error {
+	if format == "json" {
+		return json.NewEncoder(w).Encode(users)
+	}
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(usersCSVHeader); err != nil {
+		return err
+	}
+	for _, user := range users {
+		row := []string{user.AccessKey, strings.Join(user.Groups, userListSeparator), strings.Join(user.Policies, userListSeparator), user.Status}
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func processExportUsersResponse(format string, users []userRecord) func(w http.ResponseWriter, _ runtime.Producer) {
+	return func(w http.ResponseWriter, _ runtime.Producer) {
+		contentType := "application/json"
+		if format == "csv" {
+			contentType = "text/csv"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"users.%s\"", format))
+		if err := writeUsers(w, format, users); err != nil {
+			LogError("unable to export users: %v", err)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	iampolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseUsersFile(t *testing.T) {
+	csvFile := "accessKey,SecretKey,groups,policies,status\n" +
+		"alice,alice-secret,devs;ops,readwrite,Enabled\n" +
+		"bob,,,,\n"
+	records, err := parseUsersFile(strings.NewReader(csvFile), "", "users.CSV")
+	assert.NoError(t, err)
+	assert.Equal(t, []userRecord{
+		{AccessKey: "alice", SecretKey: "alice-secret", Groups: []string{"devs", "ops"}, Policies: []string{"readwrite"}, Status: "enabled"},
+		{AccessKey: "bob", Groups: []string{}, Policies: []string{}},
+	}, records)
+
+	jsonFile := `[{"accessKey":"carol","groups":["devs"],"policies":["readonly"],"status":"DISABLED"}]`
+	records, err = parseUsersFile(strings.NewReader(jsonFile), "", "users.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, []userRecord{{AccessKey: "carol", Groups: []string{"devs"}, Policies: []string{"readonly"}, Status: "disabled"}}, records)
+
+	_, err = parseUsersFile(strings.NewReader("name,groups\nalice,devs\n"), "csv", "")
+	assert.EqualError(t, err, "unsupported CSV column: name")
+	_, err = parseUsersFile(strings.NewReader("groups\ndevs\n"), "csv", "")
+	assert.EqualError(t, err, "the CSV file has no accessKey column")
+	_, err = parseUsersFile(strings.NewReader(csvFile), "json", "users.csv")
+	assert.Error(t, err)
+
+	// a file over the limit is refused rather than read partially
+	largeFile := csvFile + strings.Repeat("#", maxUsersImportSize-len(csvFile)+1)
+	_, err = parseUsersFile(strings.NewReader(largeFile), "csv", "")
+	assert.EqualError(t, err, "the file is larger than 10 MiB")
+}
+
+func Test_importUsers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	mocks := setIAMChangeSetMocks()
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"admin": {Status: madmin.AccountEnabled}}, nil
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readwrite": {}, "readonly": {}}, nil
+	}
+	var disabled []string
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		if status == madmin.AccountDisabled {
+			disabled = append(disabled, accessKey)
+		}
+		return nil
+	}
+
+	resp, err := importUsers(ctx, adminClient, []userRecord{
+		{AccessKey: "alice", SecretKey: "alice-secret", Policies: []string{"readwrite"}},
+		{AccessKey: "bob", Status: "disabled"},
+		{AccessKey: "alice", SecretKey: "short", Policies: []string{"missing"}},
+		{AccessKey: "admin", Status: "paused"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), resp.Created)
+	assert.Equal(t, int64(2), resp.Invalid)
+	assert.Equal(t, int64(0), resp.Failed)
+
+	assert.Equal(t, models.ImportUsersRowStatusCreated, resp.Rows[0].Status)
+	// provided secrets aren't echoed back, generated ones are returned once
+	assert.Empty(t, resp.Rows[0].SecretKey)
+	assert.Len(t, resp.Rows[1].SecretKey, generatedSecretKeyLength)
+	assert.Equal(t, []string{"bob"}, disabled)
+
+	assert.Equal(t, models.ImportUsersRowStatusInvalid, resp.Rows[2].Status)
+	assert.Equal(t, []string{
+		"duplicate access key, also on row 1",
+		"secret key must be between 8 and 40 characters long",
+		"policy missing does not exist",
+	}, resp.Rows[2].Errors)
+	assert.Equal(t, []string{"access key already in use", "invalid status paused"}, resp.Rows[3].Errors)
+	assert.Equal(t, []string{"addUser alice", "setPolicy alice=readwrite", "addUser bob"}, mocks.calls)
+}
+
+func Test_exportUsers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"bob":   {Status: madmin.AccountDisabled, SecretKey: "bob-secret"},
+			"alice": {Status: madmin.AccountEnabled, PolicyName: "readwrite,diagnostics", MemberOf: []string{"devs", "ops"}, SecretKey: "alice-secret"},
+		}, nil
+	}
+
+	users, err := exportUsers(ctx, adminClient)
+	assert.NoError(t, err)
+
+	var csvOut bytes.Buffer
+	assert.NoError(t, writeUsers(&csvOut, "csv", users))
+	assert.Equal(t, "accessKey,groups,policies,status\n"+
+		"alice,devs;ops,readwrite;diagnostics,enabled\n"+
+		"bob,,,disabled\n", csvOut.String())
+
+	var jsonOut bytes.Buffer
+	assert.NoError(t, writeUsers(&jsonOut, "json", users))
+	assert.NotContains(t, jsonOut.String(), "secret")
+
+	// exported files can be imported back
+	records, err := parseUsersFile(&csvOut, "csv", "")
+	assert.NoError(t, err)
+	assert.Equal(t, users, records)
+}
@@ -0,0 +1,105 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/logger/message/audit"
+)
+
+// Types of the entities console actions are applied to
+const (
+	auditTargetUser            = "user"
+	auditTargetGroup           = "group"
+	auditTargetPolicy          = "policy"
+	auditTargetServiceAccount  = "service-account"
+	auditTargetBucket          = "bucket"
+	auditTargetConfig          = "config"
+	auditTargetIDP             = "idp"
+	auditTargetKMSKey          = "kms-key"
+	auditTargetKMSPolicy       = "kms-policy"
+	auditTargetKMSIdentity     = "kms-identity"
+	auditTargetTier            = "tier"
+	auditTargetSiteReplication = "site-replication"
+)
+
+// auditOperationID returns the swagger operation ID of the route matched for the request
+func auditOperationID(req *http.Request) string {
+	if route := middleware.MatchedRouteFrom(req); route != nil && route.Operation != nil {
+		return route.Operation.ID
+	}
+	return ""
+}
+
+// sessionParentUser returns the user the STS credentials of the session were issued for, this is the
+// parent user when the console session was opened with a service account or an identity provider
+func sessionParentUser(session *models.Principal) string {
+	return sessionClaim(session, "parent")
+}
+
+// sessionClaim returns a string claim of the STS credentials of the session
+func sessionClaim(session *models.Principal, name string) string {
+	if session == nil || session.STSSessionToken == "" {
+		return ""
+	}
+	claims := jwt.MapClaims{}
+	// the token was issued and is validated by MinIO, only the claims are read
+	_, _, err := jwt.NewParser().ParseUnverified(session.STSSessionToken, claims)
+	if err != nil {
+		return ""
+	}
+	value, _ := claims[name].(string)
+	return value
+}
+
+// sessionIdentity returns who owns what the session stores in console, the access key the session
+// was opened with or, since identity provider sessions have none, the parent user of their STS
+// credentials or the subject of the identity provider
+func sessionIdentity(session *models.Principal) (string, error) {
+	if session == nil {
+		return "", ErrInvalidSession
+	}
+	if session.AccountAccessKey != "" {
+		return session.AccountAccessKey, nil
+	}
+	if parent := sessionParentUser(session); parent != "" {
+		return parent, nil
+	}
+	if subject := sessionClaim(session, "sub"); subject != "" {
+		return subject, nil
+	}
+	return "", ErrForbidden
+}
+
+// auditEvent attaches the event of a mutating console action to the audit entry of the request, only
+// the fields that differ between before and after are recorded and secrets are redacted
+func auditEvent(req *http.Request, session *models.Principal, targetType, targetName string, before, after interface{}) {
+	if req == nil {
+		return
+	}
+	event := audit.NewEvent(auditOperationID(req), targetType, targetName, before, after)
+	if session != nil {
+		event.AccessKey = session.AccountAccessKey
+		event.ParentUser = sessionParentUser(session)
+	}
+	logger.SetAuditEvent(req.Context(), event)
+}
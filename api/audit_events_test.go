@@ -0,0 +1,94 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/logger/message/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sessionParentUser(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{
+		"accessKey": "STSKEY",
+		"parent":    "alice",
+	}).SignedString([]byte("minio-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "alice", sessionParentUser(&models.Principal{STSSessionToken: token}))
+	assert.Equal(t, "", sessionParentUser(&models.Principal{STSSessionToken: "not-a-token"}))
+	assert.Equal(t, "", sessionParentUser(&models.Principal{}))
+	assert.Equal(t, "", sessionParentUser(nil))
+}
+
+func Test_sessionIdentity(t *testing.T) {
+	parentToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{"parent": "openid-alice", "sub": "alice"}).SignedString([]byte("minio-secret"))
+	subjectToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{"sub": "bob"}).SignedString([]byte("minio-secret"))
+	anonymousToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{"accessKey": "STSKEY"}).SignedString([]byte("minio-secret"))
+
+	tests := []struct {
+		name     string
+		session  *models.Principal
+		identity string
+		err      error
+	}{
+		{name: "access key", session: &models.Principal{AccountAccessKey: "carol", STSSessionToken: parentToken}, identity: "carol"},
+		{name: "identity provider parent user", session: &models.Principal{STSSessionToken: parentToken}, identity: "openid-alice"},
+		{name: "identity provider subject", session: &models.Principal{STSSessionToken: subjectToken}, identity: "bob"},
+		{name: "no identity", session: &models.Principal{STSSessionToken: anonymousToken}, err: ErrForbidden},
+		{name: "no session", err: ErrInvalidSession},
+	}
+	for _, tt := range tests {
+		identity, err := sessionIdentity(tt.session)
+		assert.Equal(t, tt.identity, identity, tt.name)
+		assert.Equal(t, tt.err, err, tt.name)
+	}
+}
+
+func Test_auditEvent(t *testing.T) {
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{"parent": "alice"}).SignedString([]byte("minio-secret"))
+	session := &models.Principal{AccountAccessKey: "alice-sa", STSSessionToken: token}
+
+	entry := &audit.Entry{}
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users", nil)
+	req = req.WithContext(logger.SetAuditEntry(req.Context(), entry))
+
+	body := &models.AddUserRequest{AccessKey: swag.String("bob"), SecretKey: swag.String("bob-secret"), Groups: []string{"devs"}}
+	auditEvent(req, session, auditTargetUser, "bob", nil, body)
+
+	if assert.NotNil(t, entry.Event) {
+		assert.Equal(t, "alice-sa", entry.Event.AccessKey)
+		assert.Equal(t, "alice", entry.Event.ParentUser)
+		assert.Equal(t, audit.Target{Type: auditTargetUser, Name: "bob"}, entry.Event.Target)
+		// no route was matched for the request
+		assert.Equal(t, "", entry.Event.OperationID)
+		assert.Equal(t, audit.RedactedValue, entry.Event.Diff["secretKey"].After)
+		assert.Equal(t, "bob", entry.Event.Diff["accessKey"].After)
+	}
+
+	// requests without an audit entry, and missing requests, are ignored
+	auditEvent(httptest.NewRequest(http.MethodGet, "/", nil), session, auditTargetUser, "bob", nil, body)
+	auditEvent(nil, session, auditTargetUser, "bob", nil, body)
+}
@@ -0,0 +1,436 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/policy"
+	iampolicy "github.com/minio/pkg/v2/policy"
+)
+
+// bucketCapability is a column of the access matrix, it's granted by any of its actions. Object
+// actions are checked on everything under a prefix, the others on the bucket itself.
+type bucketCapability struct {
+	actions []iampolicy.Action
+	object  bool
+}
+
+var (
+	capabilityRead   = bucketCapability{actions: []iampolicy.Action{iampolicy.GetObjectAction}, object: true}
+	capabilityWrite  = bucketCapability{actions: []iampolicy.Action{iampolicy.PutObjectAction}, object: true}
+	capabilityDelete = bucketCapability{actions: []iampolicy.Action{iampolicy.DeleteObjectAction}, object: true}
+	capabilityList   = bucketCapability{actions: []iampolicy.Action{iampolicy.ListBucketAction}}
+	// changing who can access the bucket or removing it
+	capabilityAdmin = bucketCapability{actions: []iampolicy.Action{iampolicy.PutBucketPolicyAction, iampolicy.DeleteBucketPolicyAction, iampolicy.DeleteBucketAction}}
+)
+
+var accessMatrixCSVHeader = []string{"type", "principal", "prefix", "read", "write", "delete", "list", "admin", "via"}
+
+// accessMatrixSubject is a principal of the access matrix. Its capabilities are the intersection of
+// what each of its policies allows, a service account is limited by its parent and its inline
+// policy. Without policies nothing limits it, like a service account of the root user.
+type accessMatrixSubject struct {
+	principal string
+	kind      string
+	// username replaces ${aws:username} in resources
+	username string
+	policies [][]iampolicy.Statement
+	via      []string
+}
+
+// capabilityRank orders capabilities from the most restrictive
+var capabilityRank = map[models.BucketAccessCapability]int{
+	models.BucketAccessCapabilityNone:        0,
+	models.BucketAccessCapabilityConditional: 1,
+	models.BucketAccessCapabilityGranted:     2,
+}
+
+// statementApplies tells whether a statement is about action on resource
+func statementApplies(statement iampolicy.Statement, action iampolicy.Action, resource string, conditionValues map[string][]string) bool {
+	if len(statement.NotActions) > 0 {
+		if statement.NotActions.Match(action) {
+			return false
+		}
+	} else if !statement.Actions.Match(action) {
+		return false
+	}
+	return statement.Resources.Match(resource, conditionValues)
+}
+
+// evaluateAction tells whether statements allow action on resource. Allows depending on conditions
+// are reported as conditional, as are unconditional allows with a conditional deny.
+func evaluateAction(statements []iampolicy.Statement, action iampolicy.Action, resource string, conditionValues map[string][]string) models.BucketAccessCapability {
+	var allowed, conditionalAllow, conditionalDeny bool
+	for _, statement := range statements {
+		if !statementApplies(statement, action, resource, conditionValues) {
+			continue
+		}
+		switch {
+		case statement.Effect == iampolicy.Deny && len(statement.Conditions) == 0:
+			return models.BucketAccessCapabilityNone
+		case statement.Effect == iampolicy.Deny:
+			conditionalDeny = true
+		case len(statement.Conditions) == 0:
+			allowed = true
+		default:
+			conditionalAllow = true
+		}
+	}
+	switch {
+	case allowed && !conditionalDeny:
+		return models.BucketAccessCapabilityGranted
+	case allowed || conditionalAllow:
+		return models.BucketAccessCapabilityConditional
+	}
+	return models.BucketAccessCapabilityNone
+}
+
+// evaluate returns the capability of subject on a prefix of bucket
+func (s *accessMatrixSubject) evaluate(capability bucketCapability, bucket, prefix string) models.BucketAccessCapability {
+	resource := bucket
+	if capability.object {
+		resource = bucket + "/" + prefix + "*"
+	}
+	var conditionValues map[string][]string
+	if s.username != "" {
+		conditionValues = map[string][]string{"username": {s.username}}
+	}
+	result := models.BucketAccessCapabilityGranted
+	for _, statements := range s.policies {
+		best := models.BucketAccessCapabilityNone
+		for _, action := range capability.actions {
+			if c := evaluateAction(statements, action, resource, conditionValues); capabilityRank[c] > capabilityRank[best] {
+				best = c
+			}
+		}
+		if capabilityRank[best] < capabilityRank[result] {
+			result = best
+		}
+	}
+	return result
+}
+
+// entry returns the row of subject for a prefix of bucket
+func (s *accessMatrixSubject) entry(bucket, prefix string) *models.BucketAccessMatrixEntry {
+	return &models.BucketAccessMatrixEntry{
+		Principal: s.principal,
+		Type:      s.kind,
+		Prefix:    prefix,
+		Read:      s.evaluate(capabilityRead, bucket, prefix),
+		Write:     s.evaluate(capabilityWrite, bucket, prefix),
+		Delete:    s.evaluate(capabilityDelete, bucket, prefix),
+		List:      s.evaluate(capabilityList, bucket, prefix),
+		Admin:     s.evaluate(capabilityAdmin, bucket, prefix),
+		Via:       s.via,
+	}
+}
+
+func sameCapabilities(a, b *models.BucketAccessMatrixEntry) bool {
+	return a.Read == b.Read && a.Write == b.Write && a.Delete == b.Delete && a.List == b.List && a.Admin == b.Admin
+}
+
+// resourcePrefixes returns the prefixes of bucket statements name resources under
+func resourcePrefixes(bucket string, statements []iampolicy.Statement) []string {
+	var prefixes []string
+	for _, statement := range statements {
+		for resource := range statement.Resources {
+			rest, ok := strings.CutPrefix(resource.Pattern, bucket+"/")
+			if !ok {
+				continue
+			}
+			if i := strings.IndexAny(rest, "*?"); i >= 0 {
+				rest = rest[:i]
+			}
+			// policy variables are different for everyone
+			if rest != "" && !strings.Contains(rest, "$") {
+				prefixes = append(prefixes, rest)
+			}
+		}
+	}
+	return prefixes
+}
+
+// accessMatrixPolicies resolves policy names, appending them to via
+func accessMatrixPolicies(policies map[string]*iampolicy.Policy, names []string, via *[]string) []iampolicy.Statement {
+	var statements []iampolicy.Statement
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		*via = append(*via, "policy "+name)
+		if p, ok := policies[name]; ok && p != nil {
+			statements = append(statements, p.Statements...)
+		}
+	}
+	return statements
+}
+
+// anonymousAccess returns the statements of the bucket policy applying to everyone and the access
+// rules they make up
+func anonymousAccess(bucket, bucketPolicy string) ([]iampolicy.Statement, []string, error) {
+	if bucketPolicy == "" {
+		return nil, nil, nil
+	}
+	bp, err := iampolicy.ParseBucketPolicyConfig(strings.NewReader(bucketPolicy), bucket)
+	if err != nil {
+		return nil, nil, err
+	}
+	var statements []iampolicy.Statement
+	for _, statement := range bp.Statements {
+		if !statement.Principal.Match("*") {
+			continue
+		}
+		statements = append(statements, iampolicy.Statement{
+			SID:        statement.SID,
+			Effect:     statement.Effect,
+			Actions:    statement.Actions,
+			NotActions: statement.NotActions,
+			Resources:  statement.Resources,
+			Conditions: statement.Conditions,
+		})
+	}
+
+	var p policy.BucketAccessPolicy
+	if err = json.Unmarshal([]byte(bucketPolicy), &p); err != nil {
+		return nil, nil, err
+	}
+	var rules []string
+	for resource, access := range policy.GetPolicies(p.Statements, bucket, "") {
+		rules = append(rules, fmt.Sprintf("access rule %s on %s", access, resource[len(bucket)+1:len(resource)-1]))
+	}
+	sort.Strings(rules)
+	return statements, rules, nil
+}
+
+// getBucketAccessMatrix returns the capabilities of every user, group, service account, LDAP mapping
+// and of anonymous requests on a bucket. A row is returned for the whole bucket and one for each
+// prefix named by a policy where the capabilities differ. LDAP users are only shown with the
+// policies mapped to them, their LDAP groups are resolved when they log in.
+func getBucketAccessMatrix(ctx context.Context, adminClient MinioAdmin, client MinioClient, bucket string) (*models.BucketAccessMatrix, error) {
+	policies, err := adminClient.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := adminClient.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := listGroupDescriptions(ctx, adminClient)
+	if err != nil {
+		return nil, err
+	}
+	bucketPolicy, err := client.getBucketPolicy(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var subjects []*accessMatrixSubject
+	userSubjects := map[string]*accessMatrixSubject{}
+	var userNames []string
+	for name := range users {
+		userNames = append(userNames, name)
+	}
+	sort.Strings(userNames)
+	for _, name := range userNames {
+		subject := &accessMatrixSubject{principal: name, kind: models.BucketAccessMatrixEntryTypeUser, username: name, via: []string{}}
+		statements := accessMatrixPolicies(policies, strings.Split(users[name].PolicyName, ","), &subject.via)
+		for _, group := range users[name].MemberOf {
+			subject.via = append(subject.via, "group "+group)
+			if desc, ok := groups[group]; ok {
+				statements = append(statements, accessMatrixPolicies(policies, strings.Split(desc.Policy, ","), &subject.via)...)
+			}
+		}
+		subject.via = UniqueKeys(subject.via)
+		subject.policies = [][]iampolicy.Statement{statements}
+		userSubjects[name] = subject
+		subjects = append(subjects, subject)
+	}
+
+	var groupNames []string
+	for name := range groups {
+		groupNames = append(groupNames, name)
+	}
+	sort.Strings(groupNames)
+	for _, name := range groupNames {
+		subject := &accessMatrixSubject{principal: name, kind: models.BucketAccessMatrixEntryTypeGroup, via: []string{}}
+		subject.policies = [][]iampolicy.Statement{accessMatrixPolicies(policies, strings.Split(groups[name].Policy, ","), &subject.via)}
+		subjects = append(subjects, subject)
+	}
+
+	// LDAP isn't necessarily configured
+	var ldapSubjects []*accessMatrixSubject
+	ldapUsers := map[string]*accessMatrixSubject{}
+	if entities, err := adminClient.getLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{}); err == nil {
+		for _, mapping := range entities.UserMappings {
+			subject := &accessMatrixSubject{principal: mapping.User, kind: models.BucketAccessMatrixEntryTypeLdapUser, username: mapping.User, via: []string{}}
+			subject.policies = [][]iampolicy.Statement{accessMatrixPolicies(policies, mapping.Policies, &subject.via)}
+			ldapUsers[mapping.User] = subject
+			ldapSubjects = append(ldapSubjects, subject)
+		}
+		for _, mapping := range entities.GroupMappings {
+			subject := &accessMatrixSubject{principal: mapping.Group, kind: models.BucketAccessMatrixEntryTypeLdapGroup, via: []string{}}
+			subject.policies = [][]iampolicy.Statement{accessMatrixPolicies(policies, mapping.Policies, &subject.via)}
+			ldapSubjects = append(ldapSubjects, subject)
+		}
+	}
+
+	accounts, err := listAllServiceAccounts(ctx, adminClient, userNames)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range accounts {
+		subject := &accessMatrixSubject{principal: info.AccessKey, kind: models.BucketAccessMatrixEntryTypeServiceAccount, username: info.ParentUser}
+		parent, ok := userSubjects[info.ParentUser]
+		if !ok {
+			parent, ok = ldapUsers[info.ParentUser]
+		}
+		if ok {
+			subject.policies = append(subject.policies, parent.policies...)
+			subject.via = []string{"parent " + info.ParentUser}
+		} else {
+			// like the root user
+			subject.via = []string{"parent " + info.ParentUser + " (unrestricted)"}
+		}
+		if !info.ImpliedPolicy && info.Policy != "" {
+			inline, err := iampolicy.ParseConfig(strings.NewReader(info.Policy))
+			if err != nil {
+				return nil, fmt.Errorf("service account %s has an invalid policy: %v", info.AccessKey, err)
+			}
+			subject.policies = append(subject.policies, inline.Statements)
+			subject.via = append(subject.via, "inline policy")
+		}
+		subjects = append(subjects, subject)
+	}
+	subjects = append(subjects, ldapSubjects...)
+
+	anonymous, rules, err := anonymousAccess(bucket, bucketPolicy)
+	if err != nil {
+		return nil, err
+	}
+	anonymousSubject := &accessMatrixSubject{principal: "*", kind: models.BucketAccessMatrixEntryTypeAnonymous, via: []string{}}
+	if bucketPolicy != "" {
+		anonymousSubject.via = append([]string{"bucket policy"}, rules...)
+	}
+	anonymousSubject.policies = [][]iampolicy.Statement{anonymous}
+	subjects = append(subjects, anonymousSubject)
+
+	var prefixes []string
+	for _, subject := range subjects {
+		for _, statements := range subject.policies {
+			prefixes = append(prefixes, resourcePrefixes(bucket, statements)...)
+		}
+	}
+	prefixes = UniqueKeys(prefixes)
+	sort.Strings(prefixes)
+
+	matrix := &models.BucketAccessMatrix{
+		Bucket:   bucket,
+		Prefixes: append([]string{""}, prefixes...),
+		Entries:  []*models.BucketAccessMatrixEntry{},
+	}
+	for _, subject := range subjects {
+		whole := subject.entry(bucket, "")
+		matrix.Entries = append(matrix.Entries, whole)
+		for _, prefix := range prefixes {
+			if entry := subject.entry(bucket, prefix); !sameCapabilities(whole, entry) {
+				matrix.Entries = append(matrix.Entries, entry)
+			}
+		}
+	}
+	return matrix, nil
+}
+
+// writeBucketAccessMatrixCSV writes a row for each entry of the matrix
+func writeBucketAccessMatrixCSV(w io.Writer, matrix *models.BucketAccessMatrix) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(accessMatrixCSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range matrix.Entries {
+		row := []string{
+			entry.Type, entry.Principal, entry.Prefix,
+			string(entry.Read), string(entry.Write), string(entry.Delete), string(entry.List), string(entry.Admin),
+			strings.Join(entry.Via, ";"),
+		}
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func registerBucketAccessMatrixHandlers(api *operations.ConsoleAPI) {
+	// Get the effective permissions on a bucket
+	api.BucketGetBucketAccessMatrixHandler = bucketApi.GetBucketAccessMatrixHandlerFunc(func(params bucketApi.GetBucketAccessMatrixParams, session *models.Principal) middleware.Responder {
+		matrix, err := getBucketAccessMatrixResponse(session, params.HTTPRequest, params.Bucket)
+		if err != nil {
+			return bucketApi.NewGetBucketAccessMatrixDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetBucketAccessMatrixOK().WithPayload(matrix)
+	})
+	// Export the effective permissions on a bucket as CSV
+	api.BucketExportBucketAccessMatrixHandler = bucketApi.ExportBucketAccessMatrixHandlerFunc(func(params bucketApi.ExportBucketAccessMatrixParams, session *models.Principal) middleware.Responder {
+		matrix, err := getBucketAccessMatrixResponse(session, params.HTTPRequest, params.Bucket)
+		if err != nil {
+			return bucketApi.NewExportBucketAccessMatrixDefault(err.Code).WithPayload(err.APIError)
+		}
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-access.csv\"", matrix.Bucket))
+			if err := writeBucketAccessMatrixCSV(w, matrix); err != nil {
+				LogError("unable to export the access matrix of %s: %v", matrix.Bucket, err)
+			}
+		})
+	})
+}
+
+func getBucketAccessMatrixResponse(session *models.Principal, req *http.Request, bucket string) (*models.BucketAccessMatrix, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(req.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	mClient, err := newMinioClient(session, getClientIP(req))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	matrix, err := getBucketAccessMatrix(ctx, adminClient, minioClient{client: mClient}, bucket)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return matrix, nil
+}
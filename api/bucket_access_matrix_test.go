@@ -0,0 +1,173 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	iampolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+// publicPrefixBucketPolicy is what an anonymous readonly access rule on photos/public sets
+const publicPrefixBucketPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetBucketLocation"],"Resource":["arn:aws:s3:::photos"]},{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::photos"],"Condition":{"StringEquals":{"s3:prefix":["public"]}}},{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/public*"]}]}`
+
+func setBucketAccessMatrixMocks(t *testing.T) {
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{
+			"readwrite":   mustParsePolicy(t, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`),
+			"photos-read": mustParsePolicy(t, readOnlyPhotosPolicy),
+			"photos-2024": mustParsePolicy(t, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::photos/2024/*"]},{"Effect":"Deny","Action":["s3:DeleteObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`),
+		}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {MemberOf: []string{"photographers"}},
+			"bob":   {PolicyName: "readwrite"},
+		}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"photographers", "editors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "editors" {
+			return &madmin.GroupDesc{Name: group, Policy: "photos-2024"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Policy: "photos-read", Members: []string{"alice"}}, nil
+	}
+	minioGetLDAPPolicyEntitiesMock = func(_ context.Context, _ madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
+		return madmin.PolicyEntitiesResult{
+			UserMappings:  []madmin.UserPolicyEntities{{User: "uid=carol,dc=example,dc=org", Policies: []string{"photos-read"}}},
+			GroupMappings: []madmin.GroupPolicyEntities{{Group: "cn=ops,dc=example,dc=org", Policies: []string{"readwrite"}}},
+		}, nil
+	}
+	accounts := map[string][]string{"alice": {"sa-1"}, "bob": {"sa-2"}, "": {"sa-3", "sa-1"}}
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		var resp madmin.ListServiceAccountsResp
+		for _, accessKey := range accounts[user] {
+			resp.Accounts = append(resp.Accounts, madmin.ServiceAccountInfo{AccessKey: accessKey})
+		}
+		return resp, nil
+	}
+	infos := map[string]madmin.InfoServiceAccountResp{
+		"sa-1": {ParentUser: "alice", ImpliedPolicy: true},
+		"sa-2": {ParentUser: "bob", Policy: getPhotosPolicy},
+		"sa-3": {ParentUser: "minioadmin", ImpliedPolicy: true},
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, accessKey string) (madmin.InfoServiceAccountResp, error) {
+		return infos[accessKey], nil
+	}
+	minioGetBucketPolicyMock = func(_ string) (string, error) {
+		return publicPrefixBucketPolicy, nil
+	}
+}
+
+// matrixRows returns every entry as type, principal, prefix and read/write/delete/list/admin
+func matrixRows(matrix *models.BucketAccessMatrix) []string {
+	short := map[models.BucketAccessCapability]string{
+		models.BucketAccessCapabilityNone:        "-",
+		models.BucketAccessCapabilityConditional: "c",
+		models.BucketAccessCapabilityGranted:     "g",
+	}
+	var rows []string
+	for _, e := range matrix.Entries {
+		rows = append(rows, fmt.Sprintf("%s %s %q %s%s%s%s%s", e.Type, e.Principal, e.Prefix,
+			short[e.Read], short[e.Write], short[e.Delete], short[e.List], short[e.Admin]))
+	}
+	return rows
+}
+
+func Test_getBucketAccessMatrix(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	client := minioClientMock{}
+	setBucketAccessMatrixMocks(t)
+
+	matrix, err := getBucketAccessMatrix(ctx, adminClient, client, "photos")
+	assert.NoError(t, err)
+	assert.Equal(t, "photos", matrix.Bucket)
+	assert.Equal(t, []string{"", "2024/", "public"}, matrix.Prefixes)
+	assert.Equal(t, []string{
+		`user alice "" g--g-`,
+		`user bob "" ggggg`,
+		`group editors "" -----`,
+		`group editors "2024/" -g---`,
+		`group photographers "" g--g-`,
+		`serviceAccount sa-1 "" g--g-`,
+		// limited by its inline policy
+		`serviceAccount sa-2 "" -----`,
+		`serviceAccount sa-2 "2024/" g----`,
+		`serviceAccount sa-3 "" ggggg`,
+		`ldapUser uid=carol,dc=example,dc=org "" g--g-`,
+		`ldapGroup cn=ops,dc=example,dc=org "" ggggg`,
+		`anonymous * "" ---c-`,
+		`anonymous * "public" g--c-`,
+	}, matrixRows(matrix))
+	assert.Equal(t, []string{"group photographers", "policy photos-read"}, matrix.Entries[0].Via)
+	assert.Equal(t, []string{"parent bob", "inline policy"}, matrix.Entries[6].Via)
+	assert.Equal(t, []string{"parent minioadmin (unrestricted)"}, matrix.Entries[8].Via)
+	assert.Equal(t, []string{"bucket policy", "access rule readonly on public"}, matrix.Entries[11].Via)
+
+	// without LDAP
+	minioGetLDAPPolicyEntitiesMock = func(_ context.Context, _ madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
+		return madmin.PolicyEntitiesResult{}, errors.New("LDAP not configured")
+	}
+	minioGetBucketPolicyMock = func(_ string) (string, error) {
+		return "", nil
+	}
+	matrix, err = getBucketAccessMatrix(ctx, adminClient, client, "photos")
+	assert.NoError(t, err)
+	assert.Len(t, matrix.Entries, 10)
+	assert.Equal(t, `anonymous * "" -----`, matrixRows(matrix)[9])
+	assert.Empty(t, matrix.Entries[9].Via)
+
+	minioGetBucketPolicyMock = func(_ string) (string, error) {
+		return "", errors.New("The specified bucket does not exist")
+	}
+	_, err = getBucketAccessMatrix(ctx, adminClient, client, "photos")
+	assert.EqualError(t, err, "The specified bucket does not exist")
+}
+
+func Test_writeBucketAccessMatrixCSV(t *testing.T) {
+	matrix := &models.BucketAccessMatrix{
+		Bucket: "photos",
+		Entries: []*models.BucketAccessMatrixEntry{
+			{
+				Type:      models.BucketAccessMatrixEntryTypeUser,
+				Principal: "alice",
+				Prefix:    "2024/",
+				Read:      models.BucketAccessCapabilityGranted,
+				Write:     models.BucketAccessCapabilityNone,
+				Delete:    models.BucketAccessCapabilityNone,
+				List:      models.BucketAccessCapabilityConditional,
+				Admin:     models.BucketAccessCapabilityNone,
+				Via:       []string{"group photographers", "policy photos-read"},
+			},
+		},
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, writeBucketAccessMatrixCSV(&buf, matrix))
+	assert.Equal(t, "type,principal,prefix,read,write,delete,list,admin,via\n"+
+		"user,alice,2024/,granted,none,none,conditional,none,group photographers;policy photos-read\n", buf.String())
+}
@@ -62,11 +62,13 @@ type MinioAdmin interface {
 	delConfigKV(ctx context.Context, kv string) (err error)
 	serviceRestart(ctx context.Context) error
 	serverInfo(ctx context.Context) (madmin.InfoMessage, error)
+	realtimeMetrics(ctx context.Context, opts madmin.MetricsOptions, out func(madmin.RealtimeMetrics)) error
 	startProfiling(ctx context.Context, profiler madmin.ProfilerType) ([]madmin.StartProfilingResult, error)
 	stopProfiling(ctx context.Context) (io.ReadCloser, error)
 	serviceTrace(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo
 	getLogs(ctx context.Context, node string, lineCnt int, logKind string) <-chan madmin.LogInfo
 	AccountInfo(ctx context.Context) (madmin.AccountInfo, error)
+	getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error)
 	heal(ctx context.Context, bucket, prefix string, healOpts madmin.HealOpts, clientToken string,
 		forceStart, forceStop bool) (healStart madmin.HealStartSuccess, healTaskStatus madmin.HealTaskStatus, err error)
 	// Service Accounts
@@ -93,16 +95,24 @@ type MinioAdmin interface {
 	editTierCreds(ctx context.Context, tierName string, creds madmin.TierCreds) error
 	// verify Tier status
 	verifyTierStatus(ctx context.Context, tierName string) error
+	// Remove Tier
+	removeTier(ctx context.Context, tierName string) error
 	// Speedtest
 	speedtest(ctx context.Context, opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error)
+	driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error)
+	netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error)
+	siteReplicationPerf(ctx context.Context, duration time.Duration) (madmin.SiteNetPerfResult, error)
 	// Site Relication
 	getSiteReplicationInfo(ctx context.Context) (*madmin.SiteReplicationInfo, error)
 	addSiteReplicationInfo(ctx context.Context, sites []madmin.PeerSite, opts madmin.SRAddOptions) (*madmin.ReplicateAddStatus, error)
 	editSiteReplicationInfo(ctx context.Context, site madmin.PeerInfo, opts madmin.SREditOptions) (*madmin.ReplicateEditStatus, error)
 	deleteSiteReplicationInfo(ctx context.Context, removeReq madmin.SRRemoveReq) (*madmin.ReplicateRemoveStatus, error)
+	siteReplicationResyncOp(ctx context.Context, site madmin.PeerInfo, op madmin.SiteResyncOp) (madmin.SRResyncOpStatus, error)
 
 	// Replication status
 	getSiteReplicationStatus(ctx context.Context, params madmin.SRStatusOptions) (*madmin.SRStatusInfo, error)
+	// Bucket replication diff
+	bucketReplicationDiff(ctx context.Context, bucketName string, opts madmin.ReplDiffOpts) <-chan madmin.DiffInfo
 
 	// KMS
 	kmsStatus(ctx context.Context) (madmin.KMSStatus, error)
@@ -133,6 +143,7 @@ type MinioAdmin interface {
 
 	// LDAP
 	getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error)
+	attachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
 }
 
 // Interface implementation
@@ -273,6 +284,11 @@ func (ac AdminClient) serverInfo(ctx context.Context) (madmin.InfoMessage, error
 	return ac.Client.ServerInfo(ctx)
 }
 
+// implements madmin.Metrics()
+func (ac AdminClient) realtimeMetrics(ctx context.Context, opts madmin.MetricsOptions, out func(madmin.RealtimeMetrics)) error {
+	return ac.Client.Metrics(ctx, opts, out)
+}
+
 // implements madmin.StartProfiling()
 func (ac AdminClient) startProfiling(ctx context.Context, profiler madmin.ProfilerType) ([]madmin.StartProfilingResult, error) {
 	return ac.Client.StartProfiling(ctx, profiler)
@@ -443,6 +459,11 @@ func (ac AdminClient) verifyTierStatus(ctx context.Context, tierName string) err
 	return ac.Client.VerifyTier(ctx, tierName)
 }
 
+// implements madmin.RemoveTier()
+func (ac AdminClient) removeTier(ctx context.Context, tierName string) error {
+	return ac.Client.RemoveTier(ctx, tierName)
+}
+
 func NewMinioAdminClient(ctx context.Context, sessionClaims *models.Principal) (*madmin.AdminClient, error) {
 	clientIP := utils.ClientIPFromContext(ctx)
 	adminClient, err := newAdminFromClaims(sessionClaims, clientIP)
@@ -547,6 +568,21 @@ func (ac AdminClient) speedtest(ctx context.Context, opts madmin.SpeedtestOpts)
 	return ac.Client.Speedtest(ctx, opts)
 }
 
+// implements madmin.DriveSpeedtest()
+func (ac AdminClient) driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+	return ac.Client.DriveSpeedtest(ctx, opts)
+}
+
+// implements madmin.Netperf()
+func (ac AdminClient) netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+	return ac.Client.Netperf(ctx, duration)
+}
+
+// implements madmin.SiteReplicationPerf()
+func (ac AdminClient) siteReplicationPerf(ctx context.Context, duration time.Duration) (madmin.SiteNetPerfResult, error) {
+	return ac.Client.SiteReplicationPerf(ctx, duration)
+}
+
 // Site Replication
 func (ac AdminClient) getSiteReplicationInfo(ctx context.Context) (*madmin.SiteReplicationInfo, error) {
 	res, err := ac.Client.SiteReplicationInfo(ctx)
@@ -598,6 +634,11 @@ func (ac AdminClient) deleteSiteReplicationInfo(ctx context.Context, removeReq m
 	}, nil
 }
 
+// implements madmin.SiteReplicationResyncOp()
+func (ac AdminClient) siteReplicationResyncOp(ctx context.Context, site madmin.PeerInfo, op madmin.SiteResyncOp) (madmin.SRResyncOpStatus, error) {
+	return ac.Client.SiteReplicationResyncOp(ctx, site, op)
+}
+
 func (ac AdminClient) getSiteReplicationStatus(ctx context.Context, params madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
 	res, err := ac.Client.SRStatusInfo(ctx, params)
 	if err != nil {
@@ -606,6 +647,11 @@ func (ac AdminClient) getSiteReplicationStatus(ctx context.Context, params madmi
 	return &res, nil
 }
 
+// implements madmin.BucketReplicationDiff()
+func (ac AdminClient) bucketReplicationDiff(ctx context.Context, bucketName string, opts madmin.ReplDiffOpts) <-chan madmin.DiffInfo {
+	return ac.Client.BucketReplicationDiff(ctx, bucketName, opts)
+}
+
 func (ac AdminClient) kmsStatus(ctx context.Context) (madmin.KMSStatus, error) {
 	return ac.Client.KMSStatus(ctx)
 }
@@ -701,3 +747,7 @@ func (ac AdminClient) getIDPConfig(ctx context.Context, idpType, cfgName string)
 func (ac AdminClient) getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
 	return ac.Client.GetLDAPPolicyEntities(ctx, query)
 }
+
+func (ac AdminClient) attachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	return ac.Client.AttachPolicyLDAP(ctx, req)
+}
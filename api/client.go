@@ -77,9 +77,15 @@ type MinioClient interface {
 	getLifecycleRules(ctx context.Context, bucketName string) (lifecycle *lifecycle.Configuration, err error)
 	setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
 	copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+	getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error)
 	GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error)
 	SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) error
 	RemoveBucketTagging(ctx context.Context, bucketName string) error
+	resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error)
+	getBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error)
+	getBucketReplicationMetricsV2(ctx context.Context, bucketName string) (replication.MetricsV2, error)
+	getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
 }
 
 // Interface implementation
@@ -145,6 +151,21 @@ func (c minioClient) getBucketReplication(ctx context.Context, bucketName string
 	return c.client.GetBucketReplication(ctx, bucketName)
 }
 
+// implements minio.ResetBucketReplicationOnTarget(ctx, bucketName, olderThan, arn)
+func (c minioClient) resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error) {
+	return c.client.ResetBucketReplicationOnTarget(ctx, bucketName, olderThan, arn)
+}
+
+// implements minio.GetBucketReplicationResyncStatus(ctx, bucketName, arn)
+func (c minioClient) getBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+	return c.client.GetBucketReplicationResyncStatus(ctx, bucketName, arn)
+}
+
+// implements minio.GetBucketReplicationMetricsV2(ctx, bucketName)
+func (c minioClient) getBucketReplicationMetricsV2(ctx context.Context, bucketName string) (replication.MetricsV2, error) {
+	return c.client.GetBucketReplicationMetricsV2(ctx, bucketName)
+}
+
 // implements minio.listObjects(ctx)
 func (c minioClient) listObjects(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
 	return c.client.ListObjects(ctx, bucket, opts)
@@ -221,6 +242,16 @@ func (c minioClient) copyObject(ctx context.Context, dst minio.CopyDestOptions,
 	return c.client.CopyObject(ctx, dst, src)
 }
 
+// implements minio.GetObject(ctx, bucketName, objectName, opts)
+func (c minioClient) getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	return c.client.GetObject(ctx, bucketName, objectName, opts)
+}
+
+// implements minio.RemoveObject(ctx, bucketName, objectName, opts)
+func (c minioClient) removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+	return c.client.RemoveObject(ctx, bucketName, objectName, opts)
+}
+
 // MCClient interface with all functions to be implemented
 // by mock when testing, it should include all mc/S3Client respective api calls
 // that are used within this project.
@@ -19,13 +19,17 @@ package api
 import (
 	"crypto/x509"
 	"net"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/certs"
 	xcerts "github.com/minio/pkg/v2/certs"
 	"github.com/minio/pkg/v2/env"
 	xnet "github.com/minio/pkg/v2/net"
+	"github.com/mitchellh/go-homedir"
 )
 
 var (
@@ -44,6 +48,9 @@ var (
 	// TLSRedirect console tls redirect rule
 	TLSRedirect = "on"
 
+	// LogSearchRetention for how long entries are kept in the local log search index
+	LogSearchRetention = 7 * 24 * time.Hour
+
 	ConsoleResourceName = "console-ui"
 )
 
@@ -234,6 +241,45 @@ func getLogSearchURL() string {
 	return env.Get(ConsoleLogQueryURL, "")
 }
 
+// getLogSearchLocalEnabled returns whether console indexes audit entries on the local disk when
+// no external log search service is configured
+func getLogSearchLocalEnabled() bool {
+	return strings.ToLower(env.Get(ConsoleLogSearchLocal, "off")) == "on"
+}
+
+// GetLogSearchRetention gets the log search retention set on env variable
+// or default one
+func GetLogSearchRetention() time.Duration {
+	retention, err := time.ParseDuration(env.Get(ConsoleLogSearchRetention, LogSearchRetention.String()))
+	if err != nil || retention <= 0 {
+		return 7 * 24 * time.Hour
+	}
+	return retention
+}
+
+// getLogSearchWebhookToken returns the token MinIO has to present to deliver audit entries to console
+func getLogSearchWebhookToken() string {
+	return env.Get(ConsoleLogSearchWebhookToken, "")
+}
+
+// getAccessKeyUsageEnabled returns whether console tracks the usage of the access keys from the
+// audit entries MinIO delivers to its webhook
+func getAccessKeyUsageEnabled() bool {
+	return strings.ToLower(env.Get(ConsoleAccessKeyUsage, "off")) == "on"
+}
+
+// getPolicyHistoryBucket returns the bucket where console keeps the revisions of the policies it
+// writes, policy history is disabled when it's not set
+func getPolicyHistoryBucket() string {
+	return env.Get(ConsolePolicyHistoryBucket, "")
+}
+
+// getLDAPLookupBindPassword returns the password of MinIO's LDAP lookup bind for the directory browser,
+// for servers that don't return it with the LDAP configuration
+func getLDAPLookupBindPassword() string {
+	return env.Get(ConsoleLDAPLookupBindPassword, "")
+}
+
 func getPrometheusURL() string {
 	return env.Get(PrometheusURL, "")
 }
@@ -275,3 +321,81 @@ func getConsoleDevMode() bool {
 func getConsoleAnimatedLogin() bool {
 	return strings.ToLower(env.Get(ConsoleAnimatedLogin, "on")) == "on"
 }
+
+// getConsoleDataDir returns the directory where console keeps its own state (dashboards, history, etc.)
+func getConsoleDataDir() string {
+	if dataDir := strings.TrimSpace(env.Get(ConsoleDataDir, "")); dataDir != "" {
+		return dataDir
+	}
+	homeDir, err := homedir.Dir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, certs.DefaultConsoleConfigDir, "data")
+}
+
+// getRealtimeMetricsEnabled returns whether realtime metrics are collected when prometheus is not configured,
+// collection needs dedicated credentials since it keeps running in the background
+func getRealtimeMetricsEnabled() bool {
+	accessKey, secretKey := getRealtimeMetricsCredentials()
+	return strings.ToLower(env.Get(ConsoleRealtimeMetrics, "on")) == "on" && accessKey != "" && secretKey != ""
+}
+
+// getRealtimeMetricsCredentials returns the credentials used to collect realtime metrics in the background
+func getRealtimeMetricsCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleRealtimeMetricsAccessKey, ""), env.Get(ConsoleRealtimeMetricsSecretKey, "")
+}
+
+// getRealtimeMetricsRetention returns for how long realtime metrics samples are kept in memory
+func getRealtimeMetricsRetention() time.Duration {
+	retention, err := time.ParseDuration(env.Get(ConsoleRealtimeMetricsRetention, "3h"))
+	if err != nil || retention <= 0 {
+		return 3 * time.Hour
+	}
+	return retention
+}
+
+// getReplicationMetricsRetention returns for how long replication metrics samples are kept in memory
+func getReplicationMetricsRetention() time.Duration {
+	retention, err := time.ParseDuration(env.Get(ConsoleReplicationMetricsRetention, "1h"))
+	if err != nil || retention <= 0 {
+		return time.Hour
+	}
+	return retention
+}
+
+// getHealthInfoOffline returns whether health diagnostics are analyzed locally instead of being uploaded to SUBNET,
+// meant for air-gapped deployments
+func getHealthInfoOffline() bool {
+	return strings.ToLower(env.Get(ConsoleHealthInfoOffline, "off")) == "on"
+}
+
+// getCertsExpiryWarningDays returns how many days before expiring a certificate is reported
+func getCertsExpiryWarningDays() int64 {
+	days, err := strconv.ParseInt(env.Get(ConsoleCertsExpiryWarningDays, "30"), 10, 64)
+	if err != nil || days < 0 {
+		return 30
+	}
+	return days
+}
+
+// getAlertsCredentials returns the credentials used to evaluate alert rules in the background,
+// alert rules are only evaluated on demand when they are not set
+func getAlertsCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleAlertsAccessKey, ""), env.Get(ConsoleAlertsSecretKey, "")
+}
+
+// getAlertsInterval returns how often alert rules are evaluated in the background
+func getAlertsInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleAlertsInterval, "1m"))
+	if err != nil || interval < 10*time.Second {
+		return time.Minute
+	}
+	return interval
+}
+
+// getAlertsAllowPrivateWebhooks returns whether notifications may be sent to loopback, link-local and
+// private addresses, i.e. to a webhook receiver running next to console
+func getAlertsAllowPrivateWebhooks() bool {
+	return strings.ToLower(env.Get(ConsoleAlertsAllowPrivateWebhooks, "off")) == "on"
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/logger/message/audit"
 	"github.com/minio/console/pkg/utils"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 
@@ -115,10 +116,20 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerBucketsHandlers(api)
 	// Register all users handlers
 	registerUsersHandlers(api)
+	// Register users import and export handlers
+	registerUsersImportExportHandlers(api)
+	// Register IAM change set handlers
+	registerIAMChangeSetHandlers(api)
 	// Register groups handlers
 	registerGroupsHandlers(api)
 	// Register policies handlers
 	registersPoliciesHandler(api)
+	// Register policy history handlers
+	registerPolicyHistoryHandlers(api)
+	// Register policy linter handlers
+	registerPoliciesLintHandlers(api)
+	// Register bucket access matrix handlers
+	registerBucketAccessMatrixHandlers(api)
 	// Register configurations handlers
 	registerConfigHandlers(api)
 	// Register bucket events handlers
@@ -131,14 +142,26 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerSessionHandlers(api)
 	// Register admin info handlers
 	registerAdminInfoHandlers(api)
+	// Register user defined dashboards handlers
+	registerDashboardsHandlers(api)
+	// Register alert rules handlers
+	registerAlertsHandlers(api)
+	// Register certificates handlers
+	registerCertificatesHandlers(api)
 	// Register admin arns handlers
 	registerAdminArnsHandlers(api)
 	// Register admin notification endpoints handlers
 	registerAdminNotificationEndpointsHandlers(api)
 	// Register admin Service Account Handlers
 	registerServiceAccountsHandlers(api)
+	// Register service accounts hygiene report and rotation handlers
+	registerServiceAccountHygieneHandlers(api)
+	// Register inactive access keys cleanup handler
+	registerAccessKeyUsageHandlers(api)
 	// Register admin remote buckets
 	registerAdminBucketRemoteHandlers(api)
+	registerBucketReplicationResyncHandlers(api)
+	registerReplicationMetricsHandlers(api)
 	// Register admin log search
 	registerLogSearchHandlers(api)
 	// Register admin subnet handlers
@@ -147,10 +170,14 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerKMSHandlers(api)
 	// Register admin IDP handlers
 	registerIDPHandlers(api)
+	// Register LDAP directory handlers
+	registerLDAPDirectoryHandlers(api)
 	// Register Account handlers
 	registerAdminTiersHandlers(api)
 	// Register Inspect Handler
 	registerInspectHandler(api)
+	// Register speedtest history handlers
+	registerSpeedtestHandlers(api)
 	// Register nodes handlers
 	registerNodesHandler(api)
 
@@ -163,6 +190,7 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 
 	// Register Object's Handlers
 	registerObjectsHandlers(api)
+	registerObjectVersionsHandlers(api)
 	// Register Bucket Quota's Handlers
 	registerBucketQuotaHandlers(api)
 	// Register Account handlers
@@ -177,6 +205,18 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	// do an initial subnet plan caching
 	fetchLicensePlan()
 
+	// evaluate alert rules in the background if credentials were provided
+	startAlertsEvaluator()
+
+	// warn about certificates close to expire
+	startCertificateExpiryWarnings()
+
+	// index audit entries for log search when no external service is configured
+	startLogSearchIndex()
+
+	// track the usage of the access keys from the MinIO audit entries
+	startAccessKeyUsage()
+
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
 
@@ -207,9 +247,16 @@ func ContextMiddleware(next http.Handler) http.Handler {
 func AuditLogMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		rw := logger.NewResponseWriter(w)
+		// handlers of console actions attach their event to this entry
+		r = r.WithContext(logger.SetAuditEntry(r.Context(), &audit.Entry{}))
 		next.ServeHTTP(rw, r)
-		if strings.HasPrefix(r.URL.Path, "/ws") || strings.HasPrefix(r.URL.Path, "/api") {
-			logger.AuditLog(r.Context(), rw, r, map[string]interface{}{}, "Authorization", "Cookie", "Set-Cookie")
+		if (strings.HasPrefix(r.URL.Path, "/ws") || strings.HasPrefix(r.URL.Path, "/api")) && len(logger.AuditTargets()) > 0 {
+			reqClaims := map[string]interface{}{}
+			// record who made the request so entries can be searched by user
+			if claims, err := auth.GetClaimsFromTokenInRequest(r); err == nil && claims.AccountAccessKey != "" {
+				reqClaims["accessKey"] = claims.AccountAccessKey
+			}
+			logger.AuditLog(r.Context(), rw, r, reqClaims, "Authorization", "Cookie", "Set-Cookie")
 		}
 	})
 }
@@ -316,6 +363,8 @@ func FileServerMiddleware(next http.Handler) http.Handler {
 		switch {
 		case strings.HasPrefix(r.URL.Path, "/ws"):
 			serveWS(w, r)
+		case r.URL.Path == logSearchWebhookPath:
+			serveLogSearchWebhook(w, r)
 		case strings.HasPrefix(r.URL.Path, "/api"):
 			next.ServeHTTP(w, r)
 		default:
@@ -0,0 +1,38 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"sync"
+
+	"github.com/minio/console/pkg/store"
+)
+
+var (
+	consoleStore     *store.Store
+	consoleStoreOnce sync.Once
+)
+
+// getConsoleStore returns the local store used to persist console owned documents
+func getConsoleStore() *store.Store {
+	consoleStoreOnce.Do(func() {
+		if consoleStore == nil {
+			consoleStore = store.New(getConsoleDataDir())
+		}
+	})
+	return consoleStore
+}
@@ -52,10 +52,28 @@ const (
 	PrometheusExtraLabels                        = "CONSOLE_PROMETHEUS_EXTRA_LABELS"
 	ConsoleLogQueryURL                           = "CONSOLE_LOG_QUERY_URL"
 	ConsoleLogQueryAuthToken                     = "CONSOLE_LOG_QUERY_AUTH_TOKEN"
+	ConsoleLogSearchLocal                        = "CONSOLE_LOG_SEARCH_LOCAL"
+	ConsoleLogSearchRetention                    = "CONSOLE_LOG_SEARCH_RETENTION"
+	ConsoleLogSearchWebhookToken                 = "CONSOLE_LOG_SEARCH_WEBHOOK_TOKEN"
+	ConsoleAccessKeyUsage                        = "CONSOLE_ACCESS_KEY_USAGE"
+	ConsolePolicyHistoryBucket                   = "CONSOLE_POLICY_HISTORY_BUCKET"
+	ConsoleLDAPLookupBindPassword                = "CONSOLE_LDAP_LOOKUP_BIND_PASSWORD"
 	ConsoleMaxConcurrentUploads                  = "CONSOLE_MAX_CONCURRENT_UPLOADS"
 	ConsoleMaxConcurrentDownloads                = "CONSOLE_MAX_CONCURRENT_DOWNLOADS"
 	ConsoleDevMode                               = "CONSOLE_DEV_MODE"
 	ConsoleAnimatedLogin                         = "CONSOLE_ANIMATED_LOGIN"
+	ConsoleDataDir                               = "CONSOLE_DATA_DIR"
+	ConsoleRealtimeMetrics                       = "CONSOLE_REALTIME_METRICS"
+	ConsoleRealtimeMetricsRetention              = "CONSOLE_REALTIME_METRICS_RETENTION"
+	ConsoleRealtimeMetricsAccessKey              = "CONSOLE_REALTIME_METRICS_ACCESS_KEY"
+	ConsoleRealtimeMetricsSecretKey              = "CONSOLE_REALTIME_METRICS_SECRET_KEY"
+	ConsoleAlertsAccessKey                       = "CONSOLE_ALERTS_ACCESS_KEY"
+	ConsoleAlertsSecretKey                       = "CONSOLE_ALERTS_SECRET_KEY"
+	ConsoleAlertsInterval                        = "CONSOLE_ALERTS_INTERVAL"
+	ConsoleAlertsAllowPrivateWebhooks            = "CONSOLE_ALERTS_ALLOW_PRIVATE_WEBHOOKS"
+	ConsoleCertsExpiryWarningDays                = "CONSOLE_CERTS_EXPIRY_WARNING_DAYS"
+	ConsoleReplicationMetricsRetention           = "CONSOLE_REPLICATION_METRICS_RETENTION"
+	ConsoleHealthInfoOffline                     = "CONSOLE_HEALTH_INFO_OFFLINE"
 	LogSearchQueryAuthToken                      = "LOGSEARCH_QUERY_AUTH_TOKEN"
 	SlashSeparator                               = "/"
 	LocalAddress                                 = "127.0.0.1"
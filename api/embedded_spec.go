@@ -52,6 +52,39 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
+    "/access-keys/cleanup-inactive": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Disable or delete the users and service accounts unused for a number of days",
+        "operationId": "CleanupInactiveAccessKeys",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/inactiveAccessKeysCleanupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/inactiveAccessKeysCleanupResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/account/change-password": {
       "post": {
         "tags": [
@@ -112,18 +145,18 @@ func init() {
         }
       }
     },
-    "/admin/arns": {
+    "/admin/alerts/channels": {
       "get": {
         "tags": [
-          "System"
+          "Alerts"
         ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
+        "summary": "Returns the configured notification channels",
+        "operationId": "ListAlertChannels",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/arnsResponse"
+              "$ref": "#/definitions/alertChannelListResponse"
             }
           },
           "default": {
@@ -133,28 +166,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/admin/info": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "System"
+          "Alerts"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
+        "summary": "Creates a new notification channel",
+        "operationId": "CreateAlertChannel",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "name": "defaultOnly",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertChannel"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
+              "$ref": "#/definitions/alertChannel"
             }
           },
           "default": {
@@ -166,43 +199,111 @@ func init() {
         }
       }
     },
-    "/admin/info/widgets/{widgetId}": {
-      "get": {
+    "/admin/alerts/channels/{id}": {
+      "put": {
         "tags": [
-          "System"
+          "Alerts"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "DashboardWidgetDetails",
+        "summary": "Updates a notification channel",
+        "operationId": "UpdateAlertChannel",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "widgetId",
+            "type": "string",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "name": "start",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertChannel"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertChannel"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Deletes a notification channel",
+        "operationId": "DeleteAlertChannel",
+        "parameters": [
           {
-            "type": "integer",
-            "name": "end",
-            "in": "query"
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/channels/{id}/test": {
+      "post": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Sends a test notification through a notification channel",
+        "operationId": "TestAlertChannel",
+        "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "step",
-            "in": "query"
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
+        }
+      }
+    },
+    "/admin/alerts/evaluate": {
+      "post": {
+        "tags": [
+          "Alerts"
         ],
+        "summary": "Evaluates all the enabled alert rules and returns the alerts that changed state",
+        "operationId": "EvaluateAlertRules",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/widgetDetails"
+              "$ref": "#/definitions/alertHistoryResponse"
             }
           },
           "default": {
@@ -214,32 +315,33 @@ func init() {
         }
       }
     },
-    "/admin/inspect": {
+    "/admin/alerts/history": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Inspect"
+          "Alerts"
         ],
-        "summary": "Inspect Files on Drive",
-        "operationId": "Inspect",
+        "summary": "Returns the history of fired and resolved alerts, newest first",
+        "operationId": "ListAlertHistory",
         "parameters": [
           {
             "type": "string",
-            "name": "file",
-            "in": "query",
-            "required": true
+            "name": "ruleId",
+            "in": "query"
           },
           {
+            "enum": [
+              "firing",
+              "resolved"
+            ],
             "type": "string",
-            "name": "volume",
-            "in": "query",
-            "required": true
+            "name": "status",
+            "in": "query"
           },
           {
-            "type": "boolean",
-            "name": "encrypt",
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
             "in": "query"
           }
         ],
@@ -247,7 +349,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/alertHistoryResponse"
             }
           },
           "default": {
@@ -259,18 +361,18 @@ func init() {
         }
       }
     },
-    "/admin/notification_endpoints": {
+    "/admin/alerts/rules": {
       "get": {
         "tags": [
-          "Configuration"
+          "Alerts"
         ],
-        "summary": "Returns a list of active notification endpoints",
-        "operationId": "NotificationEndpointList",
+        "summary": "Returns the configured alert rules",
+        "operationId": "ListAlertRules",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/notifEndpointResponse"
+              "$ref": "#/definitions/alertRuleListResponse"
             }
           },
           "default": {
@@ -283,17 +385,17 @@ func init() {
       },
       "post": {
         "tags": [
-          "Configuration"
+          "Alerts"
         ],
-        "summary": "Allows to configure a new notification endpoint",
-        "operationId": "AddNotificationEndpoint",
+        "summary": "Creates a new alert rule",
+        "operationId": "CreateAlertRule",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
+              "$ref": "#/definitions/alertRule"
             }
           }
         ],
@@ -301,7 +403,7 @@ func init() {
           "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setNotificationEndpointResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -313,18 +415,26 @@ func init() {
         }
       }
     },
-    "/admin/site-replication": {
+    "/admin/alerts/rules/{id}": {
       "get": {
         "tags": [
-          "SiteReplication"
+          "Alerts"
+        ],
+        "summary": "Returns an alert rule",
+        "operationId": "GetAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Get list of Replication Sites",
-        "operationId": "GetSiteReplicationInfo",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationInfoResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -337,17 +447,23 @@ func init() {
       },
       "put": {
         "tags": [
-          "SiteReplication"
+          "Alerts"
         ],
-        "summary": "Edit a Replication Site",
-        "operationId": "SiteReplicationEdit",
+        "summary": "Updates an alert rule",
+        "operationId": "UpdateAlertRule",
         "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/peerInfo"
+              "$ref": "#/definitions/alertRule"
             }
           }
         ],
@@ -355,7 +471,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/peerSiteEditResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -366,27 +482,45 @@ func init() {
           }
         }
       },
-      "post": {
+      "delete": {
         "tags": [
-          "SiteReplication"
+          "Alerts"
         ],
-        "summary": "Add a Replication Site",
-        "operationId": "SiteReplicationInfoAdd",
+        "summary": "Deletes an alert rule",
+        "operationId": "DeleteAlertRule",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationAddRequest"
+              "$ref": "#/definitions/ApiError"
             }
           }
+        }
+      }
+    },
+    "/admin/alerts/silences": {
+      "get": {
+        "tags": [
+          "Alerts"
         ],
+        "summary": "Returns the active alert silences",
+        "operationId": "ListAlertSilences",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationAddResponse"
+              "$ref": "#/definitions/alertSilenceListResponse"
             }
           },
           "default": {
@@ -397,27 +531,27 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
         "tags": [
-          "SiteReplication"
+          "Alerts"
         ],
-        "summary": "Remove a Replication Site",
-        "operationId": "SiteReplicationRemove",
+        "summary": "Silences notifications for a rule or a subject for a period of time",
+        "operationId": "CreateAlertSilence",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/peerInfoRemove"
+              "$ref": "#/definitions/alertSilenceRequest"
             }
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/peerSiteRemoveResponse"
+              "$ref": "#/definitions/alertSilence"
             }
           },
           "default": {
@@ -429,60 +563,69 @@ func init() {
         }
       }
     },
-    "/admin/site-replication/status": {
-      "get": {
+    "/admin/alerts/silences/{id}": {
+      "delete": {
         "tags": [
-          "SiteReplication"
+          "Alerts"
         ],
-        "summary": "Display overall site replication status",
-        "operationId": "GetSiteReplicationStatus",
+        "summary": "Removes an alert silence",
+        "operationId": "DeleteAlertSilence",
         "parameters": [
           {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Bucket stats",
-            "name": "buckets",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Group stats",
-            "name": "groups",
-            "in": "query"
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Policies stats",
-            "name": "policies",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Policies stats",
-            "name": "users",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Entity Type to lookup",
-            "name": "entityType",
-            "in": "query"
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/arns": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/arnsResponse"
+            }
           },
-          {
-            "type": "string",
-            "description": "Entity Value to lookup",
-            "name": "entityValue",
-            "in": "query"
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
+        }
+      }
+    },
+    "/admin/certificates": {
+      "get": {
+        "tags": [
+          "System"
         ],
+        "summary": "Returns the certificates known to Console with their expiration",
+        "operationId": "ListCertificates",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationStatusResponse"
+              "$ref": "#/definitions/certificatesResponse"
             }
           },
           "default": {
@@ -494,18 +637,18 @@ func init() {
         }
       }
     },
-    "/admin/tiers": {
+    "/admin/dashboards": {
       "get": {
         "tags": [
-          "Tiering"
+          "System"
         ],
-        "summary": "Returns a list of tiers for ilm",
-        "operationId": "TiersList",
+        "summary": "Returns the dashboards owned by or shared with the current user",
+        "operationId": "ListDashboards",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tierListResponse"
+              "$ref": "#/definitions/dashboardListResponse"
             }
           },
           "default": {
@@ -518,23 +661,26 @@ func init() {
       },
       "post": {
         "tags": [
-          "Tiering"
+          "System"
         ],
-        "summary": "Allows to configure a new tier",
-        "operationId": "AddTier",
+        "summary": "Creates a new dashboard owned by the current user",
+        "operationId": "CreateDashboard",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/dashboardRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -545,38 +691,28 @@ func init() {
         }
       }
     },
-    "/admin/tiers/{type}/{name}": {
-      "get": {
+    "/admin/dashboards/import": {
+      "post": {
         "tags": [
-          "Tiering"
+          "System"
         ],
-        "summary": "Get Tier",
-        "operationId": "GetTier",
+        "summary": "Imports a previously exported dashboard as a new dashboard owned by the current user",
+        "operationId": "ImportDashboard",
         "parameters": [
           {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure",
-              "minio"
-            ],
-            "type": "string",
-            "name": "type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/dashboard"
             }
           },
           "default": {
@@ -588,29 +724,46 @@ func init() {
         }
       }
     },
-    "/admin/tiers/{type}/{name}/credentials": {
-      "put": {
+    "/admin/dashboards/{id}": {
+      "get": {
         "tags": [
-          "Tiering"
+          "System"
         ],
-        "summary": "Edit Tier Credentials",
-        "operationId": "EditTierCredentials",
+        "summary": "Returns a dashboard",
+        "operationId": "GetDashboard",
         "parameters": [
           {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure",
-              "minio"
-            ],
             "type": "string",
-            "name": "type",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Updates a dashboard owned by the current user",
+        "operationId": "UpdateDashboard",
+        "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "id",
             "in": "path",
             "required": true
           },
@@ -619,13 +772,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/tierCredentialsRequest"
+              "$ref": "#/definitions/dashboardRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -634,35 +790,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/bucket-policy/{bucket}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Policies With Given Bucket",
-        "operationId": "ListPoliciesWithBucket",
+        "summary": "Deletes a dashboard owned by the current user",
+        "operationId": "DeleteDashboard",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -673,35 +818,26 @@ func init() {
         }
       }
     },
-    "/bucket-users/{bucket}": {
+    "/admin/dashboards/{id}/export": {
       "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Users With Access to a Given Bucket",
-        "operationId": "ListUsersWithAccessToBucket",
+        "summary": "Exports a dashboard so it can be imported on another deployment",
+        "operationId": "ExportDashboard",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/dashboard"
             }
           },
           "default": {
@@ -713,32 +849,49 @@ func init() {
         }
       }
     },
-    "/bucket/{bucket}/access-rules": {
+    "/admin/dashboards/{id}/widgets/{widgetId}": {
       "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Access Rules With Given Bucket",
-        "operationId": "ListAccessRulesWithBucket",
+        "summary": "Returns the results of a dashboard widget",
+        "operationId": "DashboardCustomWidgetDetails",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/offset"
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
           },
           {
-            "$ref": "#/parameters/limit"
+            "type": "integer",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listAccessRulesResponse"
+              "$ref": "#/definitions/widgetDetails"
             }
           },
           "default": {
@@ -748,34 +901,28 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/admin/info": {
+      "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "Add Access Rule To Given Bucket",
-        "operationId": "SetAccessRuleWithBucket",
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "prefixaccess",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/prefixAccessPair"
-            }
+            "type": "boolean",
+            "default": false,
+            "name": "defaultOnly",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "boolean"
+              "$ref": "#/definitions/adminInfoResponse"
             }
           },
           "default": {
@@ -785,34 +932,90 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/info/widgets/{widgetId}": {
+      "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "Delete Access Rule From Given Bucket",
-        "operationId": "DeleteAccessRuleWithBucket",
+        "summary": "Returns information about the deployment",
+        "operationId": "DashboardWidgetDetails",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
           },
           {
-            "name": "prefix",
-            "in": "body",
-            "required": true,
+            "type": "integer",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/prefixWrapper"
+              "$ref": "#/definitions/widgetDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
             }
           }
+        }
+      }
+    },
+    "/admin/inspect": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Inspect"
+        ],
+        "summary": "Inspect Files on Drive",
+        "operationId": "Inspect",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "file",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "volume",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "encrypt",
+            "in": "query"
+          }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "boolean"
+              "type": "file"
             }
           },
           "default": {
@@ -824,18 +1027,18 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/admin/notification_endpoints": {
       "get": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
+        "summary": "Returns a list of active notification endpoints",
+        "operationId": "NotificationEndpointList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/notifEndpointResponse"
             }
           },
           "default": {
@@ -848,25 +1051,25 @@ func init() {
       },
       "post": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "Allows to configure a new notification endpoint",
+        "operationId": "AddNotificationEndpoint",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
+              "$ref": "#/definitions/notificationEndpoint"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/makeBucketsResponse"
+              "$ref": "#/definitions/setNotificationEndpointResponse"
             }
           },
           "default": {
@@ -878,28 +1081,25 @@ func init() {
         }
       }
     },
-    "/buckets-replication": {
-      "post": {
+    "/admin/replication/metrics": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Replication"
         ],
-        "summary": "Sets Multi Bucket Replication in multiple Buckets",
-        "operationId": "SetMultiBucketReplication",
+        "summary": "Returns replication metrics and backlog history for every bucket remote target and site replication peer",
+        "operationId": "GetReplicationMetrics",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplication"
-            }
+            "type": "string",
+            "name": "bucket",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiBucketResponseState"
+              "$ref": "#/definitions/replicationMetricsResponse"
             }
           },
           "default": {
@@ -911,18 +1111,18 @@ func init() {
         }
       }
     },
-    "/buckets/max-share-exp": {
+    "/admin/site-replication": {
       "get": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Get max expiration time for share link in seconds",
-        "operationId": "GetMaxShareLinkExp",
+        "summary": "Get list of Replication Sites",
+        "operationId": "GetSiteReplicationInfo",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/maxShareLinkExpResponse"
+              "$ref": "#/definitions/siteReplicationInfoResponse"
             }
           },
           "default": {
@@ -932,22 +1132,20 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/multi-lifecycle": {
-      "post": {
+      },
+      "put": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Add Multi Bucket Lifecycle",
-        "operationId": "AddMultiBucketLifecycle",
+        "summary": "Edit a Replication Site",
+        "operationId": "SiteReplicationEdit",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addMultiBucketLifecycle"
+              "$ref": "#/definitions/peerInfo"
             }
           }
         ],
@@ -955,7 +1153,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiLifecycleResult"
+              "$ref": "#/definitions/peerSiteEditResponse"
             }
           },
           "default": {
@@ -965,26 +1163,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/delete-all-replication-rules": {
-      "delete": {
+      },
+      "post": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Deletes all replication rules from a bucket",
-        "operationId": "DeleteAllReplicationRules",
+        "summary": "Add a Replication Site",
+        "operationId": "SiteReplicationInfoAdd",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -993,47 +1194,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/delete-objects": {
-      "post": {
+      },
+      "delete": {
         "tags": [
-          "Object"
+          "SiteReplication"
         ],
-        "summary": "Delete Multiple Objects",
-        "operationId": "DeleteMultipleObjects",
+        "summary": "Remove a Replication Site",
+        "operationId": "SiteReplicationRemove",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
-          },
-          {
-            "name": "files",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/deleteFile"
-              }
+              "$ref": "#/definitions/peerInfoRemove"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response."
+          "204": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/peerSiteRemoveResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1044,32 +1227,39 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-selected-replication-rules": {
-      "delete": {
+    "/admin/site-replication/diff": {
+      "get": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Deletes selected replication rules from a bucket",
-        "operationId": "DeleteSelectedReplicationRules",
+        "summary": "List the buckets, policies, users and groups out of sync across sites",
+        "operationId": "GetSiteReplicationDiff",
         "parameters": [
           {
+            "enum": [
+              "bucket",
+              "policy",
+              "user",
+              "group"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "description": "Only report entities of this type",
+            "name": "entityType",
+            "in": "query"
           },
           {
-            "name": "rules",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketReplicationRuleList"
-            }
+            "type": "string",
+            "description": "Only report this entity, requires entityType",
+            "name": "entityValue",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationDiffResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1080,24 +1270,19 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/disable": {
-      "post": {
+    "/admin/site-replication/health": {
+      "get": {
         "tags": [
-          "Bucket"
-        ],
-        "summary": "Disable bucket encryption.",
-        "operationId": "DisableBucketEncryption",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
+          "SiteReplication"
         ],
+        "summary": "Check reachability and latency of every site replication peer",
+        "operationId": "GetSiteReplicationPeerHealth",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationPeerHealthResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1108,32 +1293,29 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/enable": {
+    "/admin/site-replication/resync": {
       "post": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Enable bucket encryption.",
-        "operationId": "EnableBucketEncryption",
+        "summary": "Start or cancel a site replication resync to a peer site",
+        "operationId": "SiteReplicationResync",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionRequest"
+              "$ref": "#/definitions/siteReplicationResyncRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationResyncResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1144,26 +1326,60 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/info": {
+    "/admin/site-replication/status": {
       "get": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Get bucket encryption information.",
-        "operationId": "GetBucketEncryptionInfo",
+        "summary": "Display overall site replication status",
+        "operationId": "GetSiteReplicationStatus",
         "parameters": [
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Bucket stats",
+            "name": "buckets",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Group stats",
+            "name": "groups",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Policies stats",
+            "name": "policies",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Policies stats",
+            "name": "users",
+            "in": "query"
+          },
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "description": "Entity Type to lookup",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Entity Value to lookup",
+            "name": "entityValue",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionInfo"
+              "$ref": "#/definitions/siteReplicationStatusResponse"
             }
           },
           "default": {
@@ -1175,32 +1391,44 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events": {
+    "/admin/speedtest/export": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Bucket"
+          "Speedtest"
         ],
-        "summary": "List Bucket Events",
-        "operationId": "ListBucketEvents",
+        "summary": "Export the speedtest history as JSON or CSV",
+        "operationId": "ExportSpeedtestResults",
         "parameters": [
           {
+            "enum": [
+              "json",
+              "csv"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/offset"
+            "default": "json",
+            "name": "format",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/limit"
+            "enum": [
+              "object",
+              "drive",
+              "net",
+              "site"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketEventsResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -1210,32 +1438,34 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/admin/speedtest/results": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Speedtest"
         ],
-        "summary": "Create Bucket Event",
-        "operationId": "CreateBucketEvent",
+        "summary": "List previous speedtest runs, newest first",
+        "operationId": "ListSpeedtestResults",
         "parameters": [
           {
+            "enum": [
+              "object",
+              "drive",
+              "net",
+              "site"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketEventRequest"
-            }
+            "name": "type",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestResultsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1246,33 +1476,48 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events/{arn}": {
-      "delete": {
+    "/admin/speedtest/results/{id}": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Speedtest"
         ],
-        "summary": "Delete Bucket Event",
-        "operationId": "DeleteBucketEvent",
+        "summary": "Returns a speedtest run including the raw result",
+        "operationId": "GetSpeedtestResult",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestResult"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Removes a speedtest run from the history",
+        "operationId": "DeleteSpeedtestResult",
+        "parameters": [
           {
             "type": "string",
-            "name": "arn",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/notificationDeleteRequest"
-            }
           }
         ],
         "responses": {
@@ -1288,26 +1533,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle": {
+    "/admin/tiers": {
       "get": {
         "tags": [
-          "Bucket"
-        ],
-        "summary": "Bucket Lifecycle",
-        "operationId": "GetBucketLifecycle",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
+          "Tiering"
         ],
+        "summary": "Returns a list of tiers for ilm",
+        "operationId": "TiersList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketLifecycleResponse"
+              "$ref": "#/definitions/tierListResponse"
             }
           },
           "default": {
@@ -1320,23 +1557,17 @@ func init() {
       },
       "post": {
         "tags": [
-          "Bucket"
+          "Tiering"
         ],
-        "summary": "Add Bucket Lifecycle",
-        "operationId": "AddBucketLifecycle",
+        "summary": "Allows to configure a new tier",
+        "operationId": "AddTier",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addBucketLifecycle"
+              "$ref": "#/definitions/tier"
             }
           }
         ],
@@ -1353,38 +1584,19 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
-      "put": {
+    "/admin/tiers/usage": {
+      "get": {
         "tags": [
-          "Bucket"
-        ],
-        "summary": "Update Lifecycle rule",
-        "operationId": "UpdateBucketLifecycle",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateBucketLifecycle"
-            }
-          }
+          "Tiering"
         ],
+        "summary": "Returns the data transitioned to each tier and the bucket lifecycle rules targeting it",
+        "operationId": "TiersUsage",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tierUsageResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1393,23 +1605,19 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/admin/tiers/{name}": {
       "delete": {
         "tags": [
-          "Bucket"
+          "Tiering"
         ],
-        "summary": "Delete Lifecycle rule",
-        "operationId": "DeleteBucketLifecycleRule",
+        "summary": "Remove Tier",
+        "operationId": "RemoveTier",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -1427,17 +1635,17 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/object-locking": {
-      "get": {
+    "/admin/tiers/{name}/verify": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Tiering"
         ],
-        "summary": "Returns the status of object locking support on the bucket",
-        "operationId": "GetBucketObjectLockingStatus",
+        "summary": "Verify Tier",
+        "operationId": "VerifyTier",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -1446,7 +1654,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketObLockingResponse"
+              "$ref": "#/definitions/tierVerifyResponse"
             }
           },
           "default": {
@@ -1458,57 +1666,38 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects": {
+    "/admin/tiers/{type}/{name}": {
       "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
         "tags": [
-          "Object"
+          "Tiering"
         ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
+        "summary": "Get Tier",
+        "operationId": "GetTier",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
+            "enum": [
+              "s3",
+              "gcs",
+              "azure",
+              "minio"
+            ],
+            "type": "string",
+            "name": "type",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_metadata",
-            "in": "query"
-          },
-          {
-            "$ref": "#/parameters/limit"
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
+              "$ref": "#/definitions/tier"
             }
           },
           "default": {
@@ -1518,50 +1707,41 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/tiers/{type}/{name}/credentials": {
+      "put": {
         "tags": [
-          "Object"
+          "Tiering"
         ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
+        "summary": "Edit Tier Credentials",
+        "operationId": "EditTierCredentials",
         "parameters": [
           {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure",
+              "minio"
+            ],
             "type": "string",
-            "name": "bucket_name",
+            "name": "type",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
+            "name": "name",
+            "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "non_current_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tierCredentialsRequest"
+            }
           }
         ],
         "responses": {
@@ -1577,60 +1757,32 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
+    "/bucket-policy/{bucket}": {
       "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
+        "summary": "List Policies With Given Bucket",
+        "operationId": "ListPoliciesWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "name": "preview",
-            "in": "query"
+            "$ref": "#/parameters/offset"
           },
           {
-            "type": "string",
-            "default": "",
-            "name": "override_file_name",
-            "in": "query"
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/listPoliciesResponse"
             }
           },
           "default": {
@@ -1642,48 +1794,35 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download-multiple": {
-      "post": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/bucket-users/{bucket}": {
+      "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Download Multiple Objects",
-        "operationId": "DownloadMultipleObjects",
+        "summary": "List Users With Access to a Given Bucket",
+        "operationId": "ListUsersWithAccessToBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "name": "objectList",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -1695,44 +1834,27 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/legalhold": {
-      "put": {
+    "/bucket/{bucket}/access-matrix": {
+      "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Put Object's legalhold status",
-        "operationId": "PutObjectLegalHold",
+        "summary": "Effective permissions of every user, group and service account on a bucket, per prefix",
+        "operationId": "GetBucketAccessMatrix",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectLegalHoldRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketAccessMatrix"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1743,32 +1865,29 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/metadata": {
+    "/bucket/{bucket}/access-matrix/export": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Gets the metadata of an object",
-        "operationId": "GetObjectMetadata",
+        "summary": "Export the effective permissions on a bucket as CSV",
+        "operationId": "ExportBucketAccessMatrix",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/metadata"
+              "type": "file"
             }
           },
           "default": {
@@ -1780,36 +1899,33 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/restore": {
-      "put": {
+    "/bucket/{bucket}/access-rules": {
+      "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Restore Object to a selected version",
-        "operationId": "PutObjectRestore",
+        "summary": "List Access Rules With Given Bucket",
+        "operationId": "ListAccessRulesWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
+            "$ref": "#/parameters/offset"
           },
           {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listAccessRulesResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1818,46 +1934,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects/retention": {
+      },
       "put": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Put Object's retention status",
-        "operationId": "PutObjectRetention",
+        "summary": "Add Access Rule To Given Bucket",
+        "operationId": "SetAccessRuleWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "prefixaccess",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putObjectRetentionRequest"
+              "$ref": "#/definitions/prefixAccessPair"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1869,33 +1974,32 @@ func init() {
       },
       "delete": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Delete Object retention from an object",
-        "operationId": "DeleteObjectRetention",
+        "summary": "Delete Access Rule From Given Bucket",
+        "operationId": "DeleteAccessRuleWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
             "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/prefixWrapper"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1906,43 +2010,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
+    "/buckets": {
       "get": {
         "tags": [
-          "Object"
-        ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "expires",
-            "in": "query"
-          }
+          "Bucket"
         ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -1952,46 +2031,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects/tags": {
-      "put": {
+      },
+      "post": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
+              "$ref": "#/definitions/makeBucketRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/makeBucketsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2002,39 +2064,29 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
+    "/buckets-replication": {
       "post": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "consumes": [
-          "multipart/form-data"
-        ],
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Uploads an Object.",
+        "summary": "Sets Multi Bucket Replication in multiple Buckets",
+        "operationId": "SetMultiBucketReplication",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/multiBucketReplication"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/multiBucketResponseState"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2045,26 +2097,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication": {
+    "/buckets/max-share-exp": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplication",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Get max expiration time for share link in seconds",
+        "operationId": "GetMaxShareLinkExp",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationResponse"
+              "$ref": "#/definitions/maxShareLinkExpResponse"
             }
           },
           "default": {
@@ -2076,32 +2120,28 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication/{rule_id}": {
-      "get": {
+    "/buckets/multi-lifecycle": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplicationRule",
+        "summary": "Add Multi Bucket Lifecycle",
+        "operationId": "AddMultiBucketLifecycle",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addMultiBucketLifecycle"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationRule"
+              "$ref": "#/definitions/multiLifecycleResult"
             }
           },
           "default": {
@@ -2111,37 +2151,25 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/delete-all-replication-rules": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Update Replication rule",
-        "operationId": "UpdateMultiBucketReplication",
+        "summary": "Deletes all replication rules from a bucket",
+        "operationId": "DeleteAllReplicationRules",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplicationEdit"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -2151,13 +2179,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/delete-objects": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Bucket Replication Rule Delete",
-        "operationId": "DeleteBucketReplicationRule",
+        "summary": "Delete Multiple Objects",
+        "operationId": "DeleteMultipleObjects",
         "parameters": [
           {
             "type": "string",
@@ -2166,46 +2196,30 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
           },
-          "default": {
-            "description": "Generic error response.",
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
+          },
+          {
+            "name": "files",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ApiError"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/deleteFile"
+              }
             }
           }
-        }
-      }
-    },
-    "/buckets/{bucket_name}/retention": {
-      "get": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Get Bucket's retention config",
-        "operationId": "GetBucketRetentionConfig",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/getBucketRetentionConfig"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2214,13 +2228,15 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/delete-selected-replication-rules": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Set Bucket's retention config",
-        "operationId": "SetBucketRetentionConfig",
+        "summary": "Deletes selected replication rules from a bucket",
+        "operationId": "DeleteSelectedReplicationRules",
         "parameters": [
           {
             "type": "string",
@@ -2229,16 +2245,16 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
+            "name": "rules",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putBucketRetentionRequest"
+              "$ref": "#/definitions/bucketReplicationRuleList"
             }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -2250,38 +2266,24 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/rewind/{date}": {
-      "get": {
+    "/buckets/{bucket_name}/encryption/disable": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
+        "summary": "Disable bucket encryption.",
+        "operationId": "DisableBucketEncryption",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "date",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/rewindResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2292,13 +2294,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/tags": {
-      "put": {
+    "/buckets/{bucket_name}/encryption/enable": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Put Bucket's tags",
-        "operationId": "PutBucketTags",
+        "summary": "Enable bucket encryption.",
+        "operationId": "EnableBucketEncryption",
         "parameters": [
           {
             "type": "string",
@@ -2311,7 +2313,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putBucketTagsRequest"
+              "$ref": "#/definitions/bucketEncryptionRequest"
             }
           }
         ],
@@ -2328,13 +2330,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
+    "/buckets/{bucket_name}/encryption/info": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
+        "summary": "Get bucket encryption information.",
+        "operationId": "GetBucketEncryptionInfo",
         "parameters": [
           {
             "type": "string",
@@ -2347,7 +2349,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
+              "$ref": "#/definitions/bucketEncryptionInfo"
             }
           },
           "default": {
@@ -2357,13 +2359,15 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/events": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
+        "summary": "List Bucket Events",
+        "operationId": "ListBucketEvents",
         "parameters": [
           {
             "type": "string",
@@ -2372,17 +2376,18 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
-            }
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketEventsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2391,29 +2396,32 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{name}": {
-      "get": {
+      },
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
+        "summary": "Create Bucket Event",
+        "operationId": "CreateBucketEvent",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEventRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2422,19 +2430,35 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/events/{arn}": {
       "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
+        "summary": "Delete Bucket Event",
+        "operationId": "DeleteBucketEvent",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationDeleteRequest"
+            }
           }
         ],
         "responses": {
@@ -2450,17 +2474,17 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/quota": {
+    "/buckets/{bucket_name}/lifecycle": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
+        "summary": "Bucket Lifecycle",
+        "operationId": "GetBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -2469,7 +2493,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketQuota"
+              "$ref": "#/definitions/bucketLifecycleResponse"
             }
           },
           "default": {
@@ -2480,16 +2504,16 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Quota",
-        "operationId": "SetBucketQuota",
+        "summary": "Add Bucket Lifecycle",
+        "operationId": "AddBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -2498,16 +2522,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketQuota"
+              "$ref": "#/definitions/addBucketLifecycle"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2518,17 +2539,23 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/set-policy": {
+    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
       "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
+        "summary": "Update Lifecycle rule",
+        "operationId": "UpdateBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
             "in": "path",
             "required": true
           },
@@ -2537,16 +2564,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
+              "$ref": "#/definitions/updateBucketLifecycle"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2555,29 +2579,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
+        "summary": "Delete Lifecycle rule",
+        "operationId": "DeleteBucketLifecycleRule",
         "parameters": [
           {
-            "$ref": "#/parameters/offset"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "$ref": "#/parameters/limit"
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listConfigResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2588,18 +2613,26 @@ func init() {
         }
       }
     },
-    "/configs/export": {
+    "/buckets/{bucket_name}/object-locking": {
       "get": {
         "tags": [
-          "Configuration"
+          "Bucket"
+        ],
+        "summary": "Returns the status of object locking support on the bucket",
+        "operationId": "GetBucketObjectLockingStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Export the current config from MinIO server",
-        "operationId": "ExportConfig",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/configExportResponse"
+              "$ref": "#/definitions/bucketObLockingResponse"
             }
           },
           "default": {
@@ -2611,26 +2644,58 @@ func init() {
         }
       }
     },
-    "/configs/import": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
+    "/buckets/{bucket_name}/objects": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
         ],
         "tags": [
-          "Configuration"
+          "Object"
         ],
-        "summary": "Uploads a file to import MinIO server config.",
+        "summary": "List Objects",
+        "operationId": "ListObjects",
         "parameters": [
           {
-            "type": "file",
-            "name": "file",
-            "in": "formData",
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_metadata",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2639,32 +2704,55 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs/{name}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Configuration"
+          "Object"
         ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "non_current_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/configuration"
-              }
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2673,34 +2761,76 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Configuration"
+          "Object"
         ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
+        "summary": "Download Object",
+        "operationId": "Download Object",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setConfigRequest"
-            }
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "name": "preview",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "default": "",
+            "name": "override_file_name",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "zip-deflate",
+              "zip-store",
+              "tar",
+              "tar.gz",
+              "tar.zst"
+            ],
+            "type": "string",
+            "default": "zip-deflate",
+            "description": "archive format used when downloading multiple objects or a folder",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setConfigResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -2712,26 +2842,45 @@ func init() {
         }
       }
     },
-    "/configs/{name}/reset": {
+    "/buckets/{bucket_name}/objects/download-archive": {
       "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Configuration"
+          "Object"
         ],
-        "summary": "Configuration reset",
-        "operationId": "ResetConfig",
+        "summary": "Download objects, optionally selecting their version, as a single archive",
+        "operationId": "DownloadObjectsArchive",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/downloadArchiveRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setConfigResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -2743,26 +2892,62 @@ func init() {
         }
       }
     },
-    "/group/{name}": {
-      "get": {
+    "/buckets/{bucket_name}/objects/download-multiple": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
+        "summary": "Download Multiple Objects",
+        "operationId": "DownloadMultipleObjects",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "objectList",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          {
+            "enum": [
+              "zip-deflate",
+              "zip-store",
+              "tar",
+              "tar.gz",
+              "tar.zst"
+            ],
+            "type": "string",
+            "default": "zip-deflate",
+            "description": "archive format used when downloading multiple objects or a folder",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "type": "file"
             }
           },
           "default": {
@@ -2772,60 +2957,45 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/objects/legalhold": {
       "put": {
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
+        "summary": "Put Object's legalhold status",
+        "operationId": "PutObjectLegalHold",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/group"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      },
-      "delete": {
-        "tags": [
-          "Group"
-        ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -2837,26 +3007,32 @@ func init() {
         }
       }
     },
-    "/groups": {
+    "/buckets/{bucket_name}/objects/metadata": {
       "get": {
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
+        "summary": "Gets the metadata of an object",
+        "operationId": "GetObjectMetadata",
         "parameters": [
           {
-            "$ref": "#/parameters/offset"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "$ref": "#/parameters/limit"
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
+              "$ref": "#/definitions/metadata"
             }
           },
           "default": {
@@ -2866,25 +3042,37 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/restore": {
+      "put": {
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
+        "summary": "Restore Object to a selected version",
+        "operationId": "PutObjectRestore",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addGroupRequest"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -2896,28 +3084,44 @@ func init() {
         }
       }
     },
-    "/idp/{type}": {
-      "get": {
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
         "tags": [
-          "idp"
+          "Object"
         ],
-        "summary": "List IDP Configurations",
-        "operationId": "ListConfigurations",
+        "summary": "Put Object's retention status",
+        "operationId": "PutObjectRetention",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectRetentionRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/idpListConfigurationsResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2927,38 +3131,35 @@ func init() {
           }
         }
       },
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
+      "delete": {
         "tags": [
-          "idp"
+          "Object"
         ],
-        "summary": "Create IDP Configuration",
-        "operationId": "CreateConfiguration",
+        "summary": "Delete Object retention from an object",
+        "operationId": "DeleteObjectRetention",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/idpServerConfiguration"
-            }
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setIDPResponse"
-            }
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2969,34 +3170,43 @@ func init() {
         }
       }
     },
-    "/idp/{type}/{name}": {
+    "/buckets/{bucket_name}/objects/share": {
       "get": {
         "tags": [
-          "idp"
+          "Object"
         ],
-        "summary": "Get IDP Configuration",
-        "operationId": "GetConfiguration",
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Name",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
-            "in": "path",
+            "name": "prefix",
+            "in": "query",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "expires",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/idpServerConfiguration"
+              "type": "string"
             }
           },
           "default": {
@@ -3006,46 +3216,46 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/objects/tags": {
       "put": {
-        "consumes": [
-          "application/json"
-        ],
         "tags": [
-          "idp"
+          "Object"
         ],
-        "summary": "Update IDP Configuration",
-        "operationId": "UpdateConfiguration",
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/idpServerConfiguration"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "IDP Configuration Name",
-            "name": "name",
-            "in": "path",
+            "name": "prefix",
+            "in": "query",
             "required": true
           },
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
-            "in": "path",
+            "name": "version_id",
+            "in": "query",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectTagsRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setIDPResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3054,35 +3264,41 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "idp"
-        ],
-        "summary": "Delete IDP Configuration",
-        "operationId": "DeleteConfiguration",
+      }
+    },
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Uploads an Object.",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Name",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
-            "in": "path",
-            "required": true
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setIDPResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3093,41 +3309,32 @@ func init() {
         }
       }
     },
-    "/kms/apis": {
+    "/buckets/{bucket_name}/objects/versions": {
       "get": {
         "tags": [
-          "KMS"
+          "Object"
         ],
-        "summary": "KMS apis",
-        "operationId": "KMSAPIs",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/kmsAPIsResponse"
-            }
+        "summary": "Lists the version history of an object",
+        "operationId": "GetObjectVersionHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
           }
-        }
-      }
-    },
-    "/kms/describe-self/identity": {
-      "get": {
-        "tags": [
-          "KMS"
         ],
-        "summary": "KMS describe self identity",
-        "operationId": "KMSDescribeSelfIdentity",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsDescribeSelfIdentityResponse"
+              "$ref": "#/definitions/objectVersionHistory"
             }
           },
           "default": {
@@ -3139,18 +3346,36 @@ func init() {
         }
       }
     },
-    "/kms/identities": {
+    "/buckets/{bucket_name}/objects/versions/diff": {
       "get": {
         "tags": [
-          "KMS"
+          "Object"
         ],
-        "summary": "KMS list identities",
-        "operationId": "KMSListIdentities",
+        "summary": "Returns a unified diff between two versions of a text object",
+        "operationId": "GetObjectVersionDiff",
         "parameters": [
           {
             "type": "string",
-            "description": "pattern to retrieve identities",
-            "name": "pattern",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "from_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "defaults to the latest version",
+            "name": "to_version",
             "in": "query"
           }
         ],
@@ -3158,7 +3383,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsListIdentitiesResponse"
+              "$ref": "#/definitions/objectVersionDiff"
             }
           },
           "default": {
@@ -3170,25 +3395,35 @@ func init() {
         }
       }
     },
-    "/kms/identities/{name}": {
-      "delete": {
+    "/buckets/{bucket_name}/objects/versions/purge": {
+      "post": {
         "tags": [
-          "KMS"
+          "Object"
         ],
-        "summary": "KMS delete identity",
-        "operationId": "KMSDeleteIdentity",
+        "summary": "Purges old object versions under a prefix",
+        "operationId": "PurgeObjectVersions",
         "parameters": [
           {
             "type": "string",
-            "description": "KMS identity name",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/objectVersionPurgeRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectVersionPurgeResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3199,18 +3434,17 @@ func init() {
         }
       }
     },
-    "/kms/identities/{name}/describe": {
+    "/buckets/{bucket_name}/replication": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS describe identity",
-        "operationId": "KMSDescribeIdentity",
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplication",
         "parameters": [
           {
             "type": "string",
-            "description": "KMS identity name",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -3219,7 +3453,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsDescribeIdentityResponse"
+              "$ref": "#/definitions/bucketReplicationResponse"
             }
           },
           "default": {
@@ -3231,18 +3465,23 @@ func init() {
         }
       }
     },
-    "/kms/keys": {
+    "/buckets/{bucket_name}/replication-resync": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS list keys",
-        "operationId": "KMSListKeys",
+        "summary": "Returns the replication resync progress to every remote target of a bucket",
+        "operationId": "GetBucketReplicationResyncStatus",
         "parameters": [
           {
             "type": "string",
-            "description": "pattern to retrieve keys",
-            "name": "pattern",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
             "in": "query"
           }
         ],
@@ -3250,7 +3489,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsListKeysResponse"
+              "$ref": "#/definitions/replicationResyncResponse"
             }
           },
           "default": {
@@ -3262,24 +3501,34 @@ func init() {
         }
       },
       "post": {
+        "description": "A started resync runs until it completes, canceling a bucket replication resync is not supported.",
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS create key",
-        "operationId": "KMSCreateKey",
+        "summary": "Starts replicating again the existing objects of a bucket to a remote target",
+        "operationId": "StartBucketReplicationResync",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/kmsCreateKeyRequest"
+              "$ref": "#/definitions/replicationResyncRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationResyncResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3290,18 +3539,23 @@ func init() {
         }
       }
     },
-    "/kms/keys/{name}": {
+    "/buckets/{bucket_name}/replication/{rule_id}": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS key status",
-        "operationId": "KMSKeyStatus",
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplicationRule",
         "parameters": [
           {
             "type": "string",
-            "description": "KMS key name",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
             "in": "path",
             "required": true
           }
@@ -3310,7 +3564,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsKeyStatusResponse"
+              "$ref": "#/definitions/bucketReplicationRule"
             }
           },
           "default": {
@@ -3321,23 +3575,36 @@ func init() {
           }
         }
       },
-      "delete": {
+      "put": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS delete key",
-        "operationId": "KMSDeleteKey",
+        "summary": "Update Replication rule",
+        "operationId": "UpdateMultiBucketReplication",
         "parameters": [
           {
             "type": "string",
-            "description": "KMS key name",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/multiBucketReplicationEdit"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -3347,34 +3614,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/kms/keys/{name}/import": {
-      "post": {
+      },
+      "delete": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS import key",
-        "operationId": "KMSImportKey",
+        "summary": "Bucket Replication Rule Delete",
+        "operationId": "DeleteBucketReplicationRule",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/kmsImportKeyRequest"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "KMS key name",
-            "name": "name",
+            "name": "rule_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -3386,18 +3648,26 @@ func init() {
         }
       }
     },
-    "/kms/metrics": {
+    "/buckets/{bucket_name}/retention": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
+        ],
+        "summary": "Get Bucket's retention config",
+        "operationId": "GetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "KMS metrics",
-        "operationId": "KMSMetrics",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsMetricsResponse"
+              "$ref": "#/definitions/getBucketRetentionConfig"
             }
           },
           "default": {
@@ -3407,20 +3677,65 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/kms/policies": {
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket's retention config",
+        "operationId": "SetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/rewind/{date}": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS list policies",
-        "operationId": "KMSListPolicies",
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
         "parameters": [
           {
             "type": "string",
-            "description": "pattern to retrieve policies",
-            "name": "pattern",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "date",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
             "in": "query"
           }
         ],
@@ -3428,7 +3743,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsListPoliciesResponse"
+              "$ref": "#/definitions/rewindResponse"
             }
           },
           "default": {
@@ -3438,20 +3753,28 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/buckets/{bucket_name}/tags": {
+      "put": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS set policy",
-        "operationId": "KMSSetPolicy",
+        "summary": "Put Bucket's tags",
+        "operationId": "PutBucketTags",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/kmsSetPolicyRequest"
+              "$ref": "#/definitions/putBucketTagsRequest"
             }
           }
         ],
@@ -3468,18 +3791,17 @@ func init() {
         }
       }
     },
-    "/kms/policies/{name}": {
+    "/buckets/{bucket_name}/versioning": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS get policy",
-        "operationId": "KMSGetPolicy",
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
         "parameters": [
           {
             "type": "string",
-            "description": "KMS policy name",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -3488,7 +3810,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsGetPolicyResponse"
+              "$ref": "#/definitions/bucketVersioningResponse"
             }
           },
           "default": {
@@ -3499,60 +3821,30 @@ func init() {
           }
         }
       },
-      "delete": {
+      "put": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS delete policy",
-        "operationId": "KMSDeletePolicy",
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
         "parameters": [
           {
             "type": "string",
-            "description": "KMS policy name",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      }
-    },
-    "/kms/policies/{name}/assign": {
-      "post": {
-        "tags": [
-          "KMS"
-        ],
-        "summary": "KMS assign policy",
-        "operationId": "KMSAssignPolicy",
-        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/kmsAssignPolicyRequest"
+              "$ref": "#/definitions/setBucketVersioning"
             }
-          },
-          {
-            "type": "string",
-            "description": "KMS policy name",
-            "name": "name",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -3564,17 +3856,16 @@ func init() {
         }
       }
     },
-    "/kms/policies/{name}/describe": {
+    "/buckets/{name}": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
         ],
-        "summary": "KMS describe policy",
-        "operationId": "KMSDescribePolicy",
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
         "parameters": [
           {
             "type": "string",
-            "description": "KMS policy name",
             "name": "name",
             "in": "path",
             "required": true
@@ -3584,7 +3875,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsDescribePolicyResponse"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -3594,21 +3885,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/kms/status": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "KMS"
+          "Bucket"
+        ],
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "KMS status",
-        "operationId": "KMSStatus",
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/kmsStatusResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3619,18 +3913,26 @@ func init() {
         }
       }
     },
-    "/kms/version": {
+    "/buckets/{name}/quota": {
       "get": {
         "tags": [
-          "KMS"
+          "Bucket"
+        ],
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "KMS version",
-        "operationId": "KMSVersion",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsVersionResponse"
+              "$ref": "#/definitions/bucketQuota"
             }
           },
           "default": {
@@ -3640,22 +3942,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/ldap-entities": {
-      "post": {
+      },
+      "put": {
         "tags": [
-          "idp"
+          "Bucket"
         ],
-        "summary": "Get LDAP Entities",
-        "operationId": "GetLDAPEntities",
+        "summary": "Bucket Quota",
+        "operationId": "SetBucketQuota",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ldapEntitiesRequest"
+              "$ref": "#/definitions/setBucketQuota"
             }
           }
         ],
@@ -3663,7 +3969,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/ldapEntities"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -3675,20 +3981,26 @@ func init() {
         }
       }
     },
-    "/list-external-buckets": {
-      "post": {
+    "/buckets/{name}/set-policy": {
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Lists an External list of buckets using custom credentials",
-        "operationId": "ListExternalBuckets",
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/listExternalBucketsParams"
+              "$ref": "#/definitions/setBucketPolicyRequest"
             }
           }
         ],
@@ -3696,7 +4008,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -3708,19 +4020,26 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/configs": {
       "get": {
-        "security": [],
         "tags": [
-          "Auth"
+          "Configuration"
+        ],
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
+        "parameters": [
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "$ref": "#/definitions/listConfigResponse"
             }
           },
           "default": {
@@ -3730,27 +4049,21 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [],
+      }
+    },
+    "/configs/export": {
+      "get": {
         "tags": [
-          "Auth"
+          "Configuration"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "Export the current config from MinIO server",
+        "operationId": "ExportConfig",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginRequest"
+              "$ref": "#/definitions/configExportResponse"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful login."
           },
           "default": {
             "description": "Generic error response.",
@@ -3761,27 +4074,26 @@ func init() {
         }
       }
     },
-    "/login/oauth2/auth": {
+    "/configs/import": {
       "post": {
-        "security": [],
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "Auth"
+          "Configuration"
         ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
+        "summary": "Uploads a file to import MinIO server config.",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
-            }
+            "type": "file",
+            "name": "file",
+            "in": "formData",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3792,26 +4104,30 @@ func init() {
         }
       }
     },
-    "/logout": {
-      "post": {
+    "/configs/{name}": {
+      "get": {
         "tags": [
-          "Auth"
+          "Configuration"
         ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logoutRequest"
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/configuration"
+              }
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3820,66 +4136,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/logs/search": {
-      "get": {
+      },
+      "put": {
         "tags": [
-          "Logging"
+          "Configuration"
         ],
-        "summary": "Search the logs",
-        "operationId": "LogSearch",
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
         "parameters": [
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Filter Parameters",
-            "name": "fp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 10,
-            "name": "pageSize",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "pageNo",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "timeDesc",
-              "timeAsc"
-            ],
-            "type": "string",
-            "default": "timeDesc",
-            "name": "order",
-            "in": "query"
-          },
           {
             "type": "string",
-            "name": "timeStart",
-            "in": "query"
+            "name": "name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "name": "timeEnd",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setConfigRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/logSearchResponse"
+              "$ref": "#/definitions/setConfigResponse"
             }
           },
           "default": {
@@ -3891,21 +4175,26 @@ func init() {
         }
       }
     },
-    "/nodes": {
-      "get": {
+    "/configs/{name}/reset": {
+      "post": {
         "tags": [
-          "System"
+          "Configuration"
+        ],
+        "summary": "Configuration reset",
+        "operationId": "ResetConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Lists Nodes",
-        "operationId": "ListNodes",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/setConfigResponse"
             }
           },
           "default": {
@@ -3917,26 +4206,26 @@ func init() {
         }
       }
     },
-    "/policies": {
+    "/group/{name}": {
       "get": {
         "tags": [
-          "Policy"
+          "Group"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
+        "summary": "Group info",
+        "operationId": "GroupInfo",
         "parameters": [
           {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/limit"
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -3947,27 +4236,33 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "Policy"
+          "Group"
         ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
+              "$ref": "#/definitions/updateGroupRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -3977,32 +4272,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/policies/{policy}/groups": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Policy"
+          "Group"
         ],
-        "summary": "List Groups for a Policy",
-        "operationId": "ListGroupsForPolicy",
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
         "parameters": [
           {
             "type": "string",
-            "name": "policy",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -4013,29 +4300,26 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/users": {
+    "/groups": {
       "get": {
         "tags": [
-          "Policy"
+          "Group"
         ],
-        "summary": "List Users for a Policy",
-        "operationId": "ListUsersForPolicy",
+        "summary": "List Groups",
+        "operationId": "ListGroups",
         "parameters": [
           {
-            "type": "string",
-            "name": "policy",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/listGroupsResponse"
             }
           },
           "default": {
@@ -4045,54 +4329,25 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/policy/{name}": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "Policy"
+          "Group"
         ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
+        "summary": "Add Group",
+        "operationId": "AddGroup",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ApiError"
+              "$ref": "#/definitions/addGroupRequest"
             }
           }
-        }
-      },
-      "delete": {
-        "tags": [
-          "Policy"
-        ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -4104,28 +4359,28 @@ func init() {
         }
       }
     },
-    "/profiling/start": {
+    "/iam/change-set": {
       "post": {
         "tags": [
-          "Profile"
+          "User"
         ],
-        "summary": "Start recording profile data",
-        "operationId": "ProfilingStart",
+        "summary": "Apply a list of IAM operations as a single unit, rolling back the applied ones on failure",
+        "operationId": "ApplyIAMChangeSet",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/profilingStartRequest"
+              "$ref": "#/definitions/iamChangeSet"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/startProfilingList"
+              "$ref": "#/definitions/iamChangeSetResponse"
             }
           },
           "default": {
@@ -4137,21 +4392,27 @@ func init() {
         }
       }
     },
-    "/profiling/stop": {
-      "post": {
-        "produces": [
-          "application/zip"
-        ],
+    "/idp/{type}": {
+      "get": {
         "tags": [
-          "Profile"
+          "idp"
+        ],
+        "summary": "List IDP Configurations",
+        "operationId": "ListConfigurations",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Stop and download profile data",
-        "operationId": "ProfilingStop",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/idpListConfigurationsResponse"
             }
           },
           "default": {
@@ -4161,47 +4422,38 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/releases": {
-      "get": {
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
         "tags": [
-          "release"
+          "idp"
         ],
-        "summary": "Get repo releases for a given version",
-        "operationId": "ListReleases",
+        "summary": "Create IDP Configuration",
+        "operationId": "CreateConfiguration",
         "parameters": [
           {
             "type": "string",
-            "description": "repo name",
-            "name": "repo",
-            "in": "query",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Current Release",
-            "name": "current",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "search content",
-            "name": "search",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "filter releases",
-            "name": "filter",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/idpServerConfiguration"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/releaseListResponse"
+              "$ref": "#/definitions/setIDPResponse"
             }
           },
           "default": {
@@ -4213,18 +4465,34 @@ func init() {
         }
       }
     },
-    "/remote-buckets": {
+    "/idp/{type}/{name}": {
       "get": {
         "tags": [
-          "Bucket"
+          "idp"
+        ],
+        "summary": "Get IDP Configuration",
+        "operationId": "GetConfiguration",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "IDP Configuration Name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "List Remote Buckets",
-        "operationId": "ListRemoteBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listRemoteBucketsResponse"
+              "$ref": "#/definitions/idpServerConfiguration"
             }
           },
           "default": {
@@ -4235,55 +4503,44 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
         "tags": [
-          "Bucket"
+          "idp"
         ],
-        "summary": "Add Remote Bucket",
-        "operationId": "AddRemoteBucket",
+        "summary": "Update IDP Configuration",
+        "operationId": "UpdateConfiguration",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createRemoteBucket"
+              "$ref": "#/definitions/idpServerConfiguration"
             }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      }
-    },
-    "/remote-buckets/{name}": {
-      "get": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Remote Bucket Details",
-        "operationId": "RemoteBucketDetails",
-        "parameters": [
           {
             "type": "string",
+            "description": "IDP Configuration Name",
             "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/remoteBucket"
+              "$ref": "#/definitions/setIDPResponse"
             }
           },
           "default": {
@@ -4293,32 +4550,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/remote-buckets/{source-bucket-name}/{arn}": {
+      },
       "delete": {
         "tags": [
-          "Bucket"
+          "idp"
         ],
-        "summary": "Delete Remote Bucket",
-        "operationId": "DeleteRemoteBucket",
+        "summary": "Delete IDP Configuration",
+        "operationId": "DeleteConfiguration",
         "parameters": [
           {
             "type": "string",
-            "name": "source-bucket-name",
+            "description": "IDP Configuration Name",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "arn",
+            "description": "IDP Configuration Type",
+            "name": "type",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setIDPResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4329,28 +4589,18 @@ func init() {
         }
       }
     },
-    "/service-account-credentials": {
-      "post": {
+    "/kms/apis": {
+      "get": {
         "tags": [
-          "ServiceAccount"
-        ],
-        "summary": "Create Service Account With Credentials",
-        "operationId": "CreateServiceAccountCreds",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceAccountRequestCreds"
-            }
-          }
+          "KMS"
         ],
+        "summary": "KMS apis",
+        "operationId": "KMSAPIs",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/kmsAPIsResponse"
             }
           },
           "default": {
@@ -4362,26 +4612,18 @@ func init() {
         }
       }
     },
-    "/service-accounts": {
+    "/kms/describe-self/identity": {
       "get": {
         "tags": [
-          "ServiceAccount"
-        ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
-        "parameters": [
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/limit"
-          }
+          "KMS"
         ],
+        "summary": "KMS describe self identity",
+        "operationId": "KMSDescribeSelfIdentity",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/kmsDescribeSelfIdentityResponse"
             }
           },
           "default": {
@@ -4391,28 +4633,28 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/kms/identities": {
+      "get": {
         "tags": [
-          "ServiceAccount"
+          "KMS"
         ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
+        "summary": "KMS list identities",
+        "operationId": "KMSListIdentities",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
-            }
+            "type": "string",
+            "description": "pattern to retrieve identities",
+            "name": "pattern",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/kmsListIdentitiesResponse"
             }
           },
           "default": {
@@ -4424,25 +4666,24 @@ func init() {
         }
       }
     },
-    "/service-accounts/delete-multi": {
+    "/kms/identities/{name}": {
       "delete": {
         "tags": [
-          "ServiceAccount"
+          "KMS"
         ],
-        "summary": "Delete Multiple Service Accounts",
-        "operationId": "DeleteMultipleServiceAccounts",
+        "summary": "KMS delete identity",
+        "operationId": "KMSDeleteIdentity",
         "parameters": [
           {
-            "name": "selectedSA",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/selectedSAs"
-            }
+            "type": "string",
+            "description": "KMS identity name",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -4454,17 +4695,18 @@ func init() {
         }
       }
     },
-    "/service-accounts/{access_key}": {
+    "/kms/identities/{name}/describe": {
       "get": {
         "tags": [
-          "ServiceAccount"
+          "KMS"
         ],
-        "summary": "Get Service Account",
-        "operationId": "GetServiceAccount",
+        "summary": "KMS describe identity",
+        "operationId": "KMSDescribeIdentity",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
+            "description": "KMS identity name",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -4473,7 +4715,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccount"
+              "$ref": "#/definitions/kmsDescribeIdentityResponse"
             }
           },
           "default": {
@@ -4483,32 +4725,29 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/kms/keys": {
+      "get": {
         "tags": [
-          "ServiceAccount"
+          "KMS"
         ],
-        "summary": "Set Service Account Policy",
-        "operationId": "UpdateServiceAccount",
+        "summary": "KMS list keys",
+        "operationId": "KMSListKeys",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateServiceAccountRequest"
-            }
+            "description": "pattern to retrieve keys",
+            "name": "pattern",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/kmsListKeysResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4518,42 +4757,24 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
         "tags": [
-          "ServiceAccount"
+          "KMS"
         ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
+        "summary": "KMS create key",
+        "operationId": "KMSCreateKey",
         "parameters": [
           {
-            "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ApiError"
+              "$ref": "#/definitions/kmsCreateKeyRequest"
             }
           }
-        }
-      }
-    },
-    "/service/restart": {
-      "post": {
-        "tags": [
-          "Service"
         ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
         "responses": {
-          "204": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -4565,18 +4786,27 @@ func init() {
         }
       }
     },
-    "/session": {
+    "/kms/keys/{name}": {
       "get": {
         "tags": [
-          "Auth"
+          "KMS"
+        ],
+        "summary": "KMS key status",
+        "operationId": "KMSKeyStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "KMS key name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/sessionResponse"
+              "$ref": "#/definitions/kmsKeyStatusResponse"
             }
           },
           "default": {
@@ -4586,27 +4816,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/set-policy": {
-      "put": {
+      },
+      "delete": {
         "tags": [
-          "Policy"
+          "KMS"
         ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
+        "summary": "KMS delete key",
+        "operationId": "KMSDeleteKey",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setPolicyNameRequest"
-            }
+            "type": "string",
+            "description": "KMS key name",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -4618,25 +4845,32 @@ func init() {
         }
       }
     },
-    "/set-policy-multi": {
-      "put": {
+    "/kms/keys/{name}/import": {
+      "post": {
         "tags": [
-          "Policy"
+          "KMS"
         ],
-        "summary": "Set policy to multiple users/groups",
-        "operationId": "SetPolicyMultiple",
+        "summary": "KMS import key",
+        "operationId": "KMSImportKey",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setPolicyMultipleNameRequest"
+              "$ref": "#/definitions/kmsImportKeyRequest"
             }
+          },
+          {
+            "type": "string",
+            "description": "KMS key name",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -4648,26 +4882,18 @@ func init() {
         }
       }
     },
-    "/subnet/apikey": {
+    "/kms/metrics": {
       "get": {
         "tags": [
-          "Subnet"
-        ],
-        "summary": "Subnet api key",
-        "operationId": "SubnetApiKey",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "token",
-            "in": "query",
-            "required": true
-          }
+          "KMS"
         ],
+        "summary": "KMS metrics",
+        "operationId": "KMSMetrics",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiKey"
+              "$ref": "#/definitions/kmsMetricsResponse"
             }
           },
           "default": {
@@ -4679,18 +4905,26 @@ func init() {
         }
       }
     },
-    "/subnet/info": {
+    "/kms/policies": {
       "get": {
         "tags": [
-          "Subnet"
+          "KMS"
+        ],
+        "summary": "KMS list policies",
+        "operationId": "KMSListPolicies",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "pattern to retrieve policies",
+            "name": "pattern",
+            "in": "query"
+          }
         ],
-        "summary": "Subnet info",
-        "operationId": "SubnetInfo",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/license"
+              "$ref": "#/definitions/kmsListPoliciesResponse"
             }
           },
           "default": {
@@ -4700,31 +4934,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/subnet/login": {
+      },
       "post": {
         "tags": [
-          "Subnet"
+          "KMS"
         ],
-        "summary": "Login to SUBNET",
-        "operationId": "SubnetLogin",
+        "summary": "KMS set policy",
+        "operationId": "KMSSetPolicy",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/subnetLoginRequest"
+              "$ref": "#/definitions/kmsSetPolicyRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/subnetLoginResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -4735,28 +4964,27 @@ func init() {
         }
       }
     },
-    "/subnet/login/mfa": {
-      "post": {
+    "/kms/policies/{name}": {
+      "get": {
         "tags": [
-          "Subnet"
+          "KMS"
         ],
-        "summary": "Login to SUBNET using mfa",
-        "operationId": "SubnetLoginMFA",
+        "summary": "KMS get policy",
+        "operationId": "KMSGetPolicy",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/subnetLoginMFARequest"
-            }
+            "type": "string",
+            "description": "KMS policy name",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/subnetLoginResponse"
+              "$ref": "#/definitions/kmsGetPolicyResponse"
             }
           },
           "default": {
@@ -4766,23 +4994,20 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/subnet/register": {
-      "post": {
+      },
+      "delete": {
         "tags": [
-          "Subnet"
+          "KMS"
         ],
-        "summary": "Register cluster with Subnet",
-        "operationId": "SubnetRegister",
+        "summary": "KMS delete policy",
+        "operationId": "KMSDeletePolicy",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/subnetRegisterRequest"
-            }
+            "type": "string",
+            "description": "KMS policy name",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -4798,20 +5023,34 @@ func init() {
         }
       }
     },
-    "/subnet/registration-token": {
-      "get": {
+    "/kms/policies/{name}/assign": {
+      "post": {
         "tags": [
-          "Subnet"
+          "KMS"
         ],
-        "summary": "SUBNET registraton token",
-        "operationId": "SubnetRegToken",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
+        "summary": "KMS assign policy",
+        "operationId": "KMSAssignPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/SubnetRegTokenResponse"
+              "$ref": "#/definitions/kmsAssignPolicyRequest"
             }
           },
+          {
+            "type": "string",
+            "description": "KMS policy name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
           "default": {
             "description": "Generic error response.",
             "schema": {
@@ -4821,18 +5060,27 @@ func init() {
         }
       }
     },
-    "/support/callhome": {
+    "/kms/policies/{name}/describe": {
       "get": {
         "tags": [
-          "Support"
+          "KMS"
+        ],
+        "summary": "KMS describe policy",
+        "operationId": "KMSDescribePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "KMS policy name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Get Callhome current status",
-        "operationId": "GetCallHomeOptionValue",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/callHomeGetResponse"
+              "$ref": "#/definitions/kmsDescribePolicyResponse"
             }
           },
           "default": {
@@ -4842,26 +5090,21 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/kms/status": {
+      "get": {
         "tags": [
-          "Support"
+          "KMS"
         ],
-        "summary": "Sets callhome status",
-        "operationId": "SetCallHomeStatus",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "KMS status",
+        "operationId": "KMSStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/callHomeSetStatus"
+              "$ref": "#/definitions/kmsStatusResponse"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -4872,18 +5115,18 @@ func init() {
         }
       }
     },
-    "/user/policy": {
+    "/kms/version": {
       "get": {
         "tags": [
-          "Policy"
+          "KMS"
         ],
-        "summary": "returns policies for logged in user",
-        "operationId": "GetUserPolicy",
+        "summary": "KMS version",
+        "operationId": "KMSVersion",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/kmsVersionResponse"
             }
           },
           "default": {
@@ -4895,26 +5138,33 @@ func init() {
         }
       }
     },
-    "/user/{name}": {
+    "/ldap-directory/groups": {
       "get": {
         "tags": [
-          "User"
+          "idp"
         ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
+        "summary": "Search the LDAP directory for groups",
+        "operationId": "SearchLDAPGroups",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "description": "part of the name to look for, everything when empty",
+            "name": "query",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/ldapDirectorySearchResponse"
             }
           },
           "default": {
@@ -4924,26 +5174,22 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/ldap-directory/policies": {
+      "post": {
         "tags": [
-          "User"
+          "idp"
         ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
+        "summary": "Attach policies to an LDAP user or group",
+        "operationId": "AttachLDAPPolicies",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateUser"
+              "$ref": "#/definitions/ldapPolicyMappingRequest"
             }
           }
         ],
@@ -4951,7 +5197,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/ldapPolicyMappingResponse"
             }
           },
           "default": {
@@ -4961,24 +5207,29 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/ldap-directory/user-groups": {
+      "get": {
         "tags": [
-          "User"
+          "idp"
         ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
+        "summary": "Groups an LDAP user is a member of and the policies mapped to them",
+        "operationId": "GetLDAPUserGroups",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
-            "in": "path",
+            "name": "dn",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapUserGroupsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4989,34 +5240,33 @@ func init() {
         }
       }
     },
-    "/user/{name}/groups": {
-      "put": {
+    "/ldap-directory/users": {
+      "get": {
         "tags": [
-          "User"
+          "idp"
         ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
+        "summary": "Search the LDAP directory for users",
+        "operationId": "SearchLDAPUsers",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "description": "part of the name to look for, everything when empty",
+            "name": "query",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUserGroups"
-            }
+            "type": "integer",
+            "format": "int32",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/ldapDirectorySearchResponse"
             }
           },
           "default": {
@@ -5028,26 +5278,28 @@ func init() {
         }
       }
     },
-    "/user/{name}/policies": {
-      "get": {
+    "/ldap-entities": {
+      "post": {
         "tags": [
-          "Policy"
+          "idp"
         ],
-        "summary": "returns policies assigned for a specified user",
-        "operationId": "GetSAUserPolicy",
+        "summary": "Get LDAP Entities",
+        "operationId": "GetLDAPEntities",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ldapEntitiesRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/aUserPolicyResponse"
+              "$ref": "#/definitions/ldapEntities"
             }
           },
           "default": {
@@ -5059,34 +5311,28 @@ func init() {
         }
       }
     },
-    "/user/{name}/service-account-credentials": {
+    "/list-external-buckets": {
       "post": {
         "tags": [
-          "User"
+          "Bucket"
         ],
-        "summary": "Create Service Account for User With Credentials",
-        "operationId": "CreateServiceAccountCredentials",
+        "summary": "Lists an External list of buckets using custom credentials",
+        "operationId": "ListExternalBuckets",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequestCreds"
+              "$ref": "#/definitions/listExternalBucketsParams"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -5098,26 +5344,19 @@ func init() {
         }
       }
     },
-    "/user/{name}/service-accounts": {
+    "/login": {
       "get": {
+        "security": [],
         "tags": [
-          "User"
-        ],
-        "summary": "returns a list of service accounts for a user",
-        "operationId": "ListAUserServiceAccounts",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
+          "Auth"
         ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/loginDetails"
             }
           },
           "default": {
@@ -5129,32 +5368,154 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
-          "User"
+          "Auth"
         ],
-        "summary": "Create Service Account for User",
-        "operationId": "CreateAUserServiceAccount",
+        "summary": "Login to Console",
+        "operationId": "Login",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/loginOauth2AuthRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "204": {
+            "description": "A successful login."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/logout": {
+      "post": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/logs/search": {
+      "get": {
+        "tags": [
+          "Logging"
+        ],
+        "summary": "Search the logs",
+        "operationId": "LogSearch",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 10,
+            "name": "pageSize",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "pageNo",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
+            "type": "string",
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/logSearchResponse"
             }
           },
           "default": {
@@ -5166,13 +5527,39 @@ func init() {
         }
       }
     },
-    "/users": {
+    "/nodes": {
       "get": {
         "tags": [
-          "User"
+          "System"
         ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
+        "summary": "Lists Nodes",
+        "operationId": "ListNodes",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/policies": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
         "parameters": [
           {
             "$ref": "#/parameters/offset"
@@ -5185,7 +5572,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listUsersResponse"
+              "$ref": "#/definitions/listPoliciesResponse"
             }
           },
           "default": {
@@ -5198,17 +5585,17 @@ func init() {
       },
       "post": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Add User",
-        "operationId": "AddUser",
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addUserRequest"
+              "$ref": "#/definitions/addPolicyRequest"
             }
           }
         ],
@@ -5216,7 +5603,7 @@ func init() {
           "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/policy"
             }
           },
           "default": {
@@ -5228,26 +5615,50 @@ func init() {
         }
       }
     },
-    "/users-groups-bulk": {
-      "put": {
+    "/policies/lint": {
+      "get": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
+        "summary": "Lint every policy for security issues",
+        "operationId": "LintPolicies",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyLintReport"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Lint a policy document before saving it",
+        "operationId": "LintPolicy",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
+              "$ref": "#/definitions/addPolicyRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyLintReport"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -5258,28 +5669,29 @@ func init() {
         }
       }
     },
-    "/users/service-accounts": {
-      "post": {
+    "/policies/{policy}/groups": {
+      "get": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Check number of service accounts for each user specified",
-        "operationId": "CheckUserServiceAccounts",
+        "summary": "List Groups for a Policy",
+        "operationId": "ListGroupsForPolicy",
         "parameters": [
           {
-            "name": "selectedUsers",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/selectedUsers"
-            }
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/userServiceAccountSummary"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -5290,832 +5702,4140 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "ApiError": {
-      "type": "object",
-      "properties": {
-        "detailedMessage": {
-          "type": "string"
-        },
-        "message": {
-          "type": "string"
-        }
-      }
     },
-    "BackendProperties": {
-      "type": "object",
-      "properties": {
-        "backendType": {
-          "type": "string"
-        },
-        "offlineDrives": {
-          "type": "integer"
-        },
-        "onlineDrives": {
-          "type": "integer"
-        },
-        "rrSCParity": {
-          "type": "integer"
-        },
-        "standardSCParity": {
-          "type": "integer"
+    "/policies/{policy}/users": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List Users for a Policy",
+        "operationId": "ListUsersForPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "SubnetRegTokenResponse": {
-      "type": "object",
-      "properties": {
-        "regToken": {
-          "type": "string"
+    "/policy/{name}": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "aUserPolicyResponse": {
-      "type": "object",
-      "properties": {
-        "policy": {
-          "type": "string"
+    "/policy/{name}/revisions": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List the revisions kept for a policy, newest first",
+        "operationId": "ListPolicyRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPolicyRevisionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "accessRule": {
-      "type": "object",
-      "properties": {
-        "access": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
+    "/policy/{name}/revisions/diff": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Compare two revisions of a policy statement by statement",
+        "operationId": "DiffPolicyRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "to",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyRevisionDiff"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "accountChangePasswordRequest": {
-      "type": "object",
-      "required": [
-        "current_secret_key",
-        "new_secret_key"
-      ],
-      "properties": {
-        "current_secret_key": {
-          "type": "string"
-        },
-        "new_secret_key": {
-          "type": "string"
+    "/policy/{name}/revisions/{revision}/rollback": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Restore a policy to one of its revisions",
+        "operationId": "RollbackPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "revision",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rollbackPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "addBucketLifecycle": {
-      "type": "object",
-      "properties": {
-        "disable": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_all": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "newer_noncurrentversion_expiration_versions": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+    "/profiling/start": {
+      "post": {
+        "tags": [
+          "Profile"
+        ],
+        "summary": "Start recording profile data",
+        "operationId": "ProfilingStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/profilingStartRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/startProfilingList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/stop": {
+      "post": {
+        "produces": [
+          "application/zip"
+        ],
+        "tags": [
+          "Profile"
+        ],
+        "summary": "Stop and download profile data",
+        "operationId": "ProfilingStop",
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/releases": {
+      "get": {
+        "tags": [
+          "release"
+        ],
+        "summary": "Get repo releases for a given version",
+        "operationId": "ListReleases",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "repo name",
+            "name": "repo",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Current Release",
+            "name": "current",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "search content",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "filter releases",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/releaseListResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/remote-buckets": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Remote Buckets",
+        "operationId": "ListRemoteBuckets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listRemoteBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Remote Bucket",
+        "operationId": "AddRemoteBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRemoteBucket"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/remote-buckets/{name}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Remote Bucket Details",
+        "operationId": "RemoteBucketDetails",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/remoteBucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/remote-buckets/{source-bucket-name}/{arn}": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Remote Bucket",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "source-bucket-name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-account-credentials": {
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Create Service Account With Credentials",
+        "operationId": "CreateServiceAccountCreds",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequestCreds"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
+        "parameters": [
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/delete-multi": {
+      "delete": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Delete Multiple Service Accounts",
+        "operationId": "DeleteMultipleServiceAccounts",
+        "parameters": [
+          {
+            "name": "selectedSA",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/selectedSAs"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/hygiene": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Report service accounts that are expired, about to expire, never expire or have a policy broader than their parent",
+        "operationId": "GetServiceAccountHygieneReport",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 7,
+            "name": "expiringWithinDays",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountHygieneReport"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/{access_key}": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Get Service Account",
+        "operationId": "GetServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccount"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Set Service Account Policy",
+        "operationId": "UpdateServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateServiceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/{access_key}/rotate": {
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Replace a service account with a new credential and expire the old one after a grace period",
+        "operationId": "RotateServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rotateServiceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/rotateServiceAccountResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service/restart": {
+      "post": {
+        "tags": [
+          "Service"
+        ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/session": {
+      "get": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/set-policy": {
+      "put": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Set policy",
+        "operationId": "SetPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyNameRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/set-policy-multi": {
+      "put": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Set policy to multiple users/groups",
+        "operationId": "SetPolicyMultiple",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyMultipleNameRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/subnet/apikey": {
+      "get": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Subnet api key",
+        "operationId": "SubnetApiKey",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiKey"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/subnet/info": {
+      "get": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Subnet info",
+        "operationId": "SubnetInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/license"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/subnet/login": {
+      "post": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Login to SUBNET",
+        "operationId": "SubnetLogin",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetLoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/subnetLoginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/subnet/login/mfa": {
+      "post": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Login to SUBNET using mfa",
+        "operationId": "SubnetLoginMFA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetLoginMFARequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/subnetLoginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/subnet/register": {
+      "post": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Register cluster with Subnet",
+        "operationId": "SubnetRegister",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetRegisterRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/subnet/registration-token": {
+      "get": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "SUBNET registraton token",
+        "operationId": "SubnetRegToken",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/SubnetRegTokenResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/support/callhome": {
+      "get": {
+        "tags": [
+          "Support"
+        ],
+        "summary": "Get Callhome current status",
+        "operationId": "GetCallHomeOptionValue",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/callHomeGetResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Support"
+        ],
+        "summary": "Sets callhome status",
+        "operationId": "SetCallHomeStatus",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/callHomeSetStatus"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/policy": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "returns policies for logged in user",
+        "operationId": "GetUserPolicy",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUser"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/groups": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/policies": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "returns policies assigned for a specified user",
+        "operationId": "GetSAUserPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/aUserPolicyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/service-account-credentials": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Create Service Account for User With Credentials",
+        "operationId": "CreateServiceAccountCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequestCreds"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/service-accounts": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "returns a list of service accounts for a user",
+        "operationId": "ListAUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Create Service Account for User",
+        "operationId": "CreateAUserServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "List Users",
+        "operationId": "ListUsers",
+        "parameters": [
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Add User",
+        "operationId": "AddUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users-groups-bulk": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "User"
+        ],
+        "summary": "Export all users with their groups, policies and status as JSON or CSV",
+        "operationId": "ExportUsers",
+        "parameters": [
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "default": "json",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users/import": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "User"
+        ],
+        "summary": "Create users in bulk from a CSV or JSON file",
+        "operationId": "ImportUsers",
+        "parameters": [
+          {
+            "type": "file",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/importUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users/service-accounts": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Check number of service accounts for each user specified",
+        "operationId": "CheckUserServiceAccounts",
+        "parameters": [
+          {
+            "name": "selectedUsers",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/selectedUsers"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/userServiceAccountSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiError": {
+      "type": "object",
+      "properties": {
+        "detailedMessage": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "BackendProperties": {
+      "type": "object",
+      "properties": {
+        "backendType": {
+          "type": "string"
+        },
+        "offlineDrives": {
+          "type": "integer"
+        },
+        "onlineDrives": {
+          "type": "integer"
+        },
+        "rrSCParity": {
+          "type": "integer"
+        },
+        "standardSCParity": {
+          "type": "integer"
+        }
+      }
+    },
+    "SubnetRegTokenResponse": {
+      "type": "object",
+      "properties": {
+        "regToken": {
+          "type": "string"
+        }
+      }
+    },
+    "aUserPolicyResponse": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "accessKeyUsage": {
+      "type": "object",
+      "properties": {
+        "apiCalls": {
+          "type": "object",
+          "title": "number of requests per API",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "lastUsed": {
+          "type": "string"
+        },
+        "requests": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sourceIPs": {
+          "type": "array",
+          "title": "latest addresses the access key was used from, most recent first",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "accessRule": {
+      "type": "object",
+      "properties": {
+        "access": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "accountChangePasswordRequest": {
+      "type": "object",
+      "required": [
+        "current_secret_key",
+        "new_secret_key"
+      ],
+      "properties": {
+        "current_secret_key": {
+          "type": "string"
+        },
+        "new_secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "addBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "disable": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expired_object_delete_all": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "newer_noncurrentversion_expiration_versions": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
+        },
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
+          "type": "string",
+          "enum": [
+            "expiry",
+            "transition"
+          ]
+        }
+      }
+    },
+    "addBucketReplication": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "destination_bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "addGroupRequest": {
+      "type": "object",
+      "required": [
+        "group",
+        "members"
+      ],
+      "properties": {
+        "group": {
+          "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "addMultiBucketLifecycle": {
+      "type": "object",
+      "required": [
+        "buckets",
+        "type"
+      ],
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "expired_object_delete_all": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
+        },
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
+          "type": "string",
+          "enum": [
+            "expiry",
+            "transition"
+          ]
+        }
+      }
+    },
+    "addPolicyRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string",
+          "title": "kept with the policy revision when policy history is enabled"
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey",
+        "groups",
+        "policies"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "advancedMetricsStatus": {
+          "type": "string",
+          "enum": [
+            "not configured",
+            "available",
+            "unavailable",
+            "realtime"
+          ]
+        },
+        "backend": {
+          "$ref": "#/definitions/BackendProperties"
+        },
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverProperties"
+          }
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
+          }
+        }
+      }
+    },
+    "alertChannel": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "authToken": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "smtpFrom": {
+          "type": "string"
+        },
+        "smtpPassword": {
+          "type": "string"
+        },
+        "smtpServer": {
+          "type": "string"
+        },
+        "smtpTo": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "smtpUsername": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "smtp",
+            "slack"
+          ]
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "alertChannelListResponse": {
+      "type": "object",
+      "properties": {
+        "channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertChannel"
+          }
+        }
+      }
+    },
+    "alertEvent": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "notificationErrors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "notified": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "ruleName": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "silenced": {
+          "type": "boolean"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "firing",
+            "resolved"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "alertHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertEvent"
+          }
+        }
+      }
+    },
+    "alertRule": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "channels": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "repeatInterval": {
+          "description": "seconds to wait before notifying again about an alert that is still firing",
+          "type": "integer",
+          "format": "int64"
+        },
+        "severity": {
+          "type": "string",
+          "default": "warning",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "threshold": {
+          "type": "number",
+          "format": "double"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "drives_offline",
+            "bucket_usage",
+            "replication_backlog",
+            "kms_status",
+            "tier_verification",
+            "certificate_expiry"
+          ]
+        }
+      }
+    },
+    "alertRuleListResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
+          }
+        }
+      }
+    },
+    "alertSilence": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
+    "alertSilenceListResponse": {
+      "type": "object",
+      "properties": {
+        "silences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertSilence"
+          }
+        }
+      }
+    },
+    "alertSilenceRequest": {
+      "type": "object",
+      "required": [
+        "duration"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "duration": {
+          "description": "seconds the silence stays active",
+          "type": "integer",
+          "format": "int64"
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
+    "apiKey": {
+      "type": "object",
+      "properties": {
+        "apiKey": {
+          "type": "string"
+        }
+      }
+    },
+    "arnsResponse": {
+      "type": "object",
+      "properties": {
+        "arns": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucket": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "creation_date": {
+          "type": "string"
+        },
+        "definition": {
+          "type": "string"
+        },
+        "details": {
+          "type": "object",
+          "properties": {
+            "locking": {
+              "type": "boolean"
+            },
+            "quota": {
+              "type": "object",
+              "properties": {
+                "quota": {
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "type": {
+                  "type": "string",
+                  "enum": [
+                    "hard"
+                  ]
+                }
+              }
+            },
+            "replication": {
+              "type": "boolean"
+            },
+            "tags": {
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            },
+            "versioning": {
+              "type": "boolean"
+            },
+            "versioningSuspended": {
+              "type": "boolean"
+            }
+          }
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rw_access": {
+          "type": "object",
+          "properties": {
+            "read": {
+              "type": "boolean"
+            },
+            "write": {
+              "type": "boolean"
+            }
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketAccessCapability": {
+      "type": "string",
+      "enum": [
+        "none",
+        "conditional",
+        "granted"
+      ]
+    },
+    "bucketAccessMatrix": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketAccessMatrixEntry"
+          }
+        },
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketAccessMatrixEntry": {
+      "type": "object",
+      "properties": {
+        "admin": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "delete": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "list": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "prefix of the bucket the capabilities apply to, empty for the whole bucket"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "read": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount",
+            "ldapUser",
+            "ldapGroup",
+            "anonymous"
+          ]
+        },
+        "via": {
+          "type": "array",
+          "title": "policies, groups, parents and rules the capabilities come from",
+          "items": {
+            "type": "string"
+          }
+        },
+        "write": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        }
+      }
+    },
+    "bucketEncryptionInfo": {
+      "type": "object",
+      "properties": {
+        "algorithm": {
+          "type": "string"
+        },
+        "kmsMasterKeyID": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketEncryptionRequest": {
+      "type": "object",
+      "properties": {
+        "encType": {
+          "$ref": "#/definitions/bucketEncryptionType"
+        },
+        "kmsKeyID": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketEncryptionType": {
+      "type": "string",
+      "default": "sse-s3",
+      "enum": [
+        "sse-s3",
+        "sse-kms"
+      ]
+    },
+    "bucketEventRequest": {
+      "type": "object",
+      "required": [
+        "configuration"
+      ],
+      "properties": {
+        "configuration": {
+          "$ref": "#/definitions/notificationConfig"
+        },
+        "ignoreExisting": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bucketLifecycleResponse": {
+      "type": "object",
+      "properties": {
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectBucketLifecycle"
+          }
+        }
+      }
+    },
+    "bucketObLockingResponse": {
+      "type": "object",
+      "properties": {
+        "object_locking_enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "expiration_rule_id": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
+        }
+      }
+    },
+    "bucketReplicationDestination": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
+          }
+        }
+      }
+    },
+    "bucketReplicationRule": {
+      "type": "object",
+      "properties": {
+        "bandwidth": {
+          "type": "string"
+        },
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "destination": {
+          "$ref": "#/definitions/bucketReplicationDestination"
+        },
+        "existingObjects": {
+          "type": "boolean"
+        },
+        "healthCheckPeriod": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "metadata_replication": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "storageClass": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationRuleList": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketVersioningResponse": {
+      "type": "object",
+      "properties": {
+        "MFADelete": {
+          "type": "string"
+        },
+        "excludeFolders": {
+          "type": "boolean"
+        },
+        "excludedPrefixes": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "prefix": {
+                "type": "string"
+              }
+            }
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkUserGroups": {
+      "type": "object",
+      "required": [
+        "users",
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "callHomeGetResponse": {
+      "type": "object",
+      "properties": {
+        "diagnosticsStatus": {
+          "type": "boolean"
+        },
+        "logsStatus": {
+          "type": "boolean"
+        }
+      }
+    },
+    "callHomeSetStatus": {
+      "type": "object",
+      "required": [
+        "diagState",
+        "logsState"
+      ],
+      "properties": {
+        "diagState": {
+          "type": "boolean"
+        },
+        "logsState": {
+          "type": "boolean"
+        }
+      }
+    },
+    "certificateInfo": {
+      "type": "object",
+      "properties": {
+        "daysRemaining": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "isCA": {
+          "type": "boolean"
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "notAfter": {
+          "type": "string"
+        },
+        "notBefore": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "sans": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "serialNumber": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "console",
+            "ca",
+            "minio"
+          ]
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "valid",
+            "expiring",
+            "expired"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
+    "certificatesResponse": {
+      "type": "object",
+      "properties": {
+        "certificates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/certificateInfo"
+          }
+        },
+        "minioEndpointError": {
+          "type": "string"
+        },
+        "warningDays": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "changeUserPasswordRequest": {
+      "type": "object",
+      "required": [
+        "selectedUser",
+        "newSecretKey"
+      ],
+      "properties": {
+        "newSecretKey": {
+          "type": "string"
+        },
+        "selectedUser": {
+          "type": "string"
+        }
+      }
+    },
+    "configDescription": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "configExportResponse": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "type": "string"
+        },
+        "value": {
+          "description": "Returns base64 encoded value",
+          "type": "string"
+        }
+      }
+    },
+    "configuration": {
+      "type": "object",
+      "properties": {
+        "key_values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "configurationKV": {
+      "type": "object",
+      "properties": {
+        "env_override": {
+          "$ref": "#/definitions/envOverride"
+        },
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "createRemoteBucket": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "sourceBucket",
+        "targetBucket"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "sourceBucket": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "dashboard": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "updatedAt": {
+          "type": "string"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
+          }
+        }
+      }
+    },
+    "dashboardListResponse": {
+      "type": "object",
+      "properties": {
+        "dashboards": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboard"
+          }
+        }
+      }
+    },
+    "dashboardRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
+          }
+        }
+      }
+    },
+    "dashboardWidget": {
+      "type": "object",
+      "required": [
+        "title",
+        "type",
+        "targets"
+      ],
+      "properties": {
+        "gridPos": {
+          "$ref": "#/definitions/dashboardWidgetGridPos"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "maxDataPoints": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidgetTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "dashboardWidgetGridPos": {
+      "type": "object",
+      "properties": {
+        "h": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "w": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "x": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "y": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "dashboardWidgetTarget": {
+      "type": "object",
+      "required": [
+        "expr"
+      ],
+      "properties": {
+        "expr": {
+          "type": "string"
+        },
+        "initialTime": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "legendFormat": {
+          "type": "string"
+        },
+        "step": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "deleteFile": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "downloadArchiveObject": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "description": "object name, names ending with ` + "`" + `/` + "`" + ` include every object under the prefix",
+          "type": "string"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "downloadArchiveRequest": {
+      "type": "object",
+      "required": [
+        "objects"
+      ],
+      "properties": {
+        "format": {
+          "type": "string",
+          "default": "zip-deflate",
+          "enum": [
+            "zip-deflate",
+            "zip-store",
+            "tar",
+            "tar.gz",
+            "tar.zst"
+          ]
+        },
+        "objects": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/downloadArchiveObject"
+          }
+        }
+      }
+    },
+    "envOverride": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "environmentConstants": {
+      "type": "object",
+      "properties": {
+        "maxConcurrentDownloads": {
+          "type": "integer"
+        },
+        "maxConcurrentUploads": {
+          "type": "integer"
+        }
+      }
+    },
+    "expirationResponse": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "delete_all": {
+          "type": "boolean"
+        },
+        "delete_marker": {
+          "type": "boolean"
+        },
+        "newer_noncurrent_expiration_versions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "getBucketRetentionConfig": {
+      "type": "object",
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamChangeSet": {
+      "type": "object",
+      "required": [
+        "operations"
+      ],
+      "properties": {
+        "operations": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/iamChangeSetOperation"
+          }
+        }
+      }
+    },
+    "iamChangeSetOperation": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "group": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string",
+          "title": "secret key of the user created by createUser"
+        },
+        "serviceAccount": {
+          "$ref": "#/definitions/serviceAccountRequestCreds"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "createUser",
+            "setUserGroups",
+            "setGroupMembers",
+            "attachPolicies",
+            "createServiceAccount"
+          ]
+        },
+        "user": {
+          "type": "string",
+          "title": "user the operation applies to, for attachPolicies either user or group is set"
+        }
+      }
+    },
+    "iamChangeSetResponse": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean"
+        },
+        "rolledBack": {
+          "type": "boolean"
+        },
+        "steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamChangeSetStep"
+          }
+        }
+      }
+    },
+    "iamChangeSetStep": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "index": {
+          "type": "integer"
+        },
+        "serviceAccount": {
+          "$ref": "#/definitions/serviceAccountCreds"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "applied",
+            "failed",
+            "skipped",
+            "rolledBack",
+            "rollbackFailed"
+          ]
+        },
+        "target": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "iamEntity": {
+      "type": "string",
+      "pattern": "^[\\w+=,.@-]{1,64}$"
+    },
+    "iamPolicy": {
+      "type": "object",
+      "properties": {
+        "statement": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamPolicyStatement"
+          }
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "iamPolicyStatement": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "condition": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "effect": {
+          "type": "string"
+        },
+        "resource": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "idpListConfigurationsResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idpServerConfiguration"
+          }
+        }
+      }
+    },
+    "idpServerConfiguration": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "info": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idpServerConfigurationInfo"
+          }
+        },
+        "input": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "idpServerConfigurationInfo": {
+      "type": "object",
+      "properties": {
+        "isCfg": {
+          "type": "boolean"
+        },
+        "isEnv": {
+          "type": "boolean"
+        },
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "importUsersResponse": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "integer"
+        },
+        "failed": {
+          "type": "integer"
+        },
+        "invalid": {
+          "type": "integer"
+        },
+        "rows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/importUsersRow"
+          }
+        }
+      }
+    },
+    "importUsersRow": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
         },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
+        "row": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
+          "title": "position of the user in the file starting at 1, the CSV header isn't counted"
         },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
+        "secretKey": {
+          "type": "string",
+          "title": "secret key generated for the user, only returned once"
         },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+        "status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "invalid",
+            "failed"
+          ]
+        }
+      }
+    },
+    "inactiveAccessKey": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
           "type": "string"
         },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
+        "error": {
           "type": "string"
         },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
+        "lastUsed": {
+          "type": "string",
+          "title": "empty when the access key wasn't used since usage is tracked"
+        },
+        "parentUser": {
           "type": "string"
         },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "status": {
+          "type": "string"
         },
         "type": {
-          "description": "ILM Rule type (Expiry or transition)",
           "type": "string",
           "enum": [
-            "expiry",
-            "transition"
+            "user",
+            "serviceAccount"
           ]
         }
       }
     },
-    "addBucketReplication": {
+    "inactiveAccessKeysCleanupRequest": {
       "type": "object",
+      "required": [
+        "inactiveDays",
+        "action"
+      ],
       "properties": {
-        "arn": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "disable",
+            "delete"
+          ]
+        },
+        "credentialType": {
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "all",
+            "user",
+            "serviceAccount"
+          ]
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "inactiveDays": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "inactiveAccessKeysCleanupResponse": {
+      "type": "object",
+      "properties": {
+        "accessKeys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inactiveAccessKey"
+          }
+        },
+        "action": {
           "type": "string"
         },
-        "destination_bucket": {
+        "cutoff": {
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "usageTrackedSince": {
           "type": "string"
         }
       }
     },
-    "addGroupRequest": {
+    "kmDeleteKeyRequest": {
+      "type": "object"
+    },
+    "kmsAPI": {
       "type": "object",
-      "required": [
-        "group",
-        "members"
-      ],
       "properties": {
-        "group": {
+        "maxBody": {
+          "type": "integer"
+        },
+        "method": {
           "type": "string"
         },
-        "members": {
+        "path": {
+          "type": "string"
+        },
+        "timeout": {
+          "type": "integer"
+        }
+      }
+    },
+    "kmsAPIsResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/kmsAPI"
           }
         }
       }
     },
-    "addMultiBucketLifecycle": {
+    "kmsAssignPolicyRequest": {
+      "type": "object",
+      "properties": {
+        "identity": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsCreateKeyRequest": {
       "type": "object",
       "required": [
-        "buckets",
-        "type"
+        "key"
       ],
       "properties": {
-        "buckets": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "expired_object_delete_all": {
-          "description": "Non required, toggle to disable or enable rule",
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsDescribeIdentityResponse": {
+      "type": "object",
+      "properties": {
+        "admin": {
           "type": "boolean"
         },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
+        "createdAt": {
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "identity": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsDescribePolicyResponse": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsDescribeSelfIdentityResponse": {
+      "type": "object",
+      "properties": {
+        "admin": {
           "type": "boolean"
         },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "createdAt": {
+          "type": "string"
         },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "createdBy": {
+          "type": "string"
         },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "identity": {
+          "type": "string"
         },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
+        "policy": {
+          "$ref": "#/definitions/kmsGetPolicyResponse"
+        },
+        "policyName": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsEndpoint": {
+      "type": "object",
+      "properties": {
+        "status": {
           "type": "string"
         },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+        "url": {
           "type": "string"
+        }
+      }
+    },
+    "kmsGetPolicyResponse": {
+      "type": "object",
+      "properties": {
+        "allow": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
+        "deny": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "kmsIdentityInfo": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
           "type": "string"
         },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
+        "createdBy": {
           "type": "string"
         },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "error": {
+          "type": "string"
         },
-        "type": {
-          "description": "ILM Rule type (Expiry or transition)",
-          "type": "string",
-          "enum": [
-            "expiry",
-            "transition"
-          ]
+        "identity": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
         }
       }
     },
-    "addPolicyRequest": {
+    "kmsImportKeyRequest": {
       "type": "object",
       "required": [
-        "name",
-        "policy"
+        "bytes"
       ],
       "properties": {
-        "name": {
-          "type": "string"
-        },
-        "policy": {
+        "bytes": {
           "type": "string"
         }
       }
     },
-    "addUserRequest": {
+    "kmsKeyInfo": {
       "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey",
-        "groups",
-        "policies"
-      ],
       "properties": {
-        "accessKey": {
+        "createdAt": {
           "type": "string"
         },
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "policies": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "createdBy": {
+          "type": "string"
         },
-        "secretKey": {
+        "name": {
           "type": "string"
         }
       }
     },
-    "adminInfoResponse": {
+    "kmsKeyStatusResponse": {
       "type": "object",
       "properties": {
-        "advancedMetricsStatus": {
-          "type": "string",
-          "enum": [
-            "not configured",
-            "available",
-            "unavailable"
-          ]
-        },
-        "backend": {
-          "$ref": "#/definitions/BackendProperties"
+        "decryptionErr": {
+          "type": "string"
         },
-        "buckets": {
-          "type": "integer"
+        "encryptionErr": {
+          "type": "string"
         },
-        "objects": {
+        "keyID": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsLatencyHistogram": {
+      "type": "object",
+      "properties": {
+        "duration": {
           "type": "integer"
         },
-        "servers": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/serverProperties"
-          }
-        },
-        "usage": {
+        "total": {
           "type": "integer"
-        },
-        "widgets": {
+        }
+      }
+    },
+    "kmsListIdentitiesResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/widget"
+            "$ref": "#/definitions/kmsIdentityInfo"
           }
         }
       }
     },
-    "apiKey": {
+    "kmsListKeysResponse": {
       "type": "object",
       "properties": {
-        "apiKey": {
-          "type": "string"
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/kmsKeyInfo"
+          }
         }
       }
     },
-    "arnsResponse": {
+    "kmsListPoliciesResponse": {
       "type": "object",
       "properties": {
-        "arns": {
+        "results": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/kmsPolicyInfo"
           }
         }
       }
     },
-    "bucket": {
+    "kmsMetricsResponse": {
       "type": "object",
       "required": [
-        "name"
+        "requestOK",
+        "requestErr",
+        "requestFail",
+        "requestActive",
+        "auditEvents",
+        "errorEvents",
+        "latencyHistogram",
+        "uptime",
+        "cpus",
+        "usableCPUs",
+        "threads",
+        "heapAlloc",
+        "stackAlloc"
       ],
       "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
+        "auditEvents": {
+          "type": "integer"
         },
-        "creation_date": {
-          "type": "string"
+        "cpus": {
+          "type": "integer"
         },
-        "definition": {
-          "type": "string"
+        "errorEvents": {
+          "type": "integer"
         },
-        "details": {
-          "type": "object",
-          "properties": {
-            "locking": {
-              "type": "boolean"
-            },
-            "quota": {
-              "type": "object",
-              "properties": {
-                "quota": {
-                  "type": "integer",
-                  "format": "int64"
-                },
-                "type": {
-                  "type": "string",
-                  "enum": [
-                    "hard"
-                  ]
-                }
-              }
-            },
-            "replication": {
-              "type": "boolean"
-            },
-            "tags": {
-              "type": "object",
-              "additionalProperties": {
-                "type": "string"
-              }
-            },
-            "versioning": {
-              "type": "boolean"
-            },
-            "versioningSuspended": {
-              "type": "boolean"
-            }
+        "heapAlloc": {
+          "type": "integer"
+        },
+        "heapObjects": {
+          "type": "integer"
+        },
+        "latencyHistogram": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/kmsLatencyHistogram"
           }
         },
-        "name": {
-          "type": "string",
-          "minLength": 3
+        "requestActive": {
+          "type": "integer"
         },
-        "objects": {
-          "type": "integer",
-          "format": "int64"
+        "requestErr": {
+          "type": "integer"
         },
-        "rw_access": {
-          "type": "object",
-          "properties": {
-            "read": {
-              "type": "boolean"
-            },
-            "write": {
-              "type": "boolean"
-            }
-          }
+        "requestFail": {
+          "type": "integer"
         },
-        "size": {
-          "type": "integer",
-          "format": "int64"
+        "requestOK": {
+          "type": "integer"
+        },
+        "stackAlloc": {
+          "type": "integer"
+        },
+        "threads": {
+          "type": "integer"
+        },
+        "uptime": {
+          "type": "integer"
+        },
+        "usableCPUs": {
+          "type": "integer"
         }
       }
     },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketEncryptionInfo": {
+    "kmsPolicyInfo": {
       "type": "object",
       "properties": {
-        "algorithm": {
+        "createdAt": {
           "type": "string"
         },
-        "kmsMasterKeyID": {
+        "createdBy": {
           "type": "string"
-        }
-      }
-    },
-    "bucketEncryptionRequest": {
-      "type": "object",
-      "properties": {
-        "encType": {
-          "$ref": "#/definitions/bucketEncryptionType"
         },
-        "kmsKeyID": {
+        "name": {
           "type": "string"
         }
       }
     },
-    "bucketEncryptionType": {
-      "type": "string",
-      "default": "sse-s3",
-      "enum": [
-        "sse-s3",
-        "sse-kms"
-      ]
-    },
-    "bucketEventRequest": {
+    "kmsSetPolicyRequest": {
       "type": "object",
       "required": [
-        "configuration"
+        "policy"
       ],
       "properties": {
-        "configuration": {
-          "$ref": "#/definitions/notificationConfig"
+        "allow": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "ignoreExisting": {
-          "type": "boolean"
+        "deny": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "string"
         }
       }
     },
-    "bucketLifecycleResponse": {
+    "kmsStatusResponse": {
       "type": "object",
       "properties": {
-        "lifecycle": {
+        "defaultKeyID": {
+          "type": "string"
+        },
+        "endpoints": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/objectBucketLifecycle"
+            "$ref": "#/definitions/kmsEndpoint"
           }
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "bucketObLockingResponse": {
+    "kmsVersionResponse": {
       "type": "object",
       "properties": {
-        "object_locking_enabled": {
-          "type": "boolean"
+        "version": {
+          "type": "string"
         }
       }
     },
-    "bucketObject": {
+    "ldapDirectoryEntry": {
       "type": "object",
       "properties": {
-        "content_type": {
-          "type": "string"
-        },
-        "etag": {
-          "type": "string"
-        },
-        "expiration": {
-          "type": "string"
-        },
-        "expiration_rule_id": {
+        "dn": {
           "type": "string"
         },
-        "is_delete_marker": {
-          "type": "boolean"
-        },
-        "is_latest": {
-          "type": "boolean"
-        },
-        "last_modified": {
+        "email": {
           "type": "string"
         },
-        "legal_hold_status": {
+        "name": {
           "type": "string"
         },
-        "metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        "username": {
+          "type": "string",
+          "title": "name the user logs in with, only set for users"
+        }
+      }
+    },
+    "ldapDirectorySearchResponse": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapDirectoryEntry"
           }
         },
-        "name": {
-          "type": "string"
+        "truncated": {
+          "type": "boolean",
+          "title": "more entries match than the limit"
+        }
+      }
+    },
+    "ldapEntities": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapGroupPolicyEntity"
+          }
         },
-        "retention_mode": {
-          "type": "string"
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapPolicyEntity"
+          }
         },
-        "retention_until_date": {
+        "timestamp": {
           "type": "string"
         },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "tags": {
-          "type": "object",
-          "additionalProperties": {
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapUserPolicyEntity"
+          }
+        }
+      }
+    },
+    "ldapEntitiesRequest": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
             "type": "string"
           }
         },
-        "user_metadata": {
-          "type": "object",
-          "additionalProperties": {
+        "policies": {
+          "type": "array",
+          "items": {
             "type": "string"
           }
         },
-        "user_tags": {
-          "type": "object",
-          "additionalProperties": {
+        "users": {
+          "type": "array",
+          "items": {
             "type": "string"
           }
-        },
-        "version_id": {
-          "type": "string"
         }
       }
     },
-    "bucketQuota": {
+    "ldapGroupPolicyEntity": {
       "type": "object",
       "properties": {
-        "quota": {
-          "type": "integer"
+        "group": {
+          "type": "string"
         },
-        "type": {
-          "type": "string",
-          "enum": [
-            "hard"
-          ]
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "bucketReplicationDestination": {
+    "ldapPolicyEntity": {
       "type": "object",
       "properties": {
-        "bucket": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
           "type": "string"
-        }
-      }
-    },
-    "bucketReplicationResponse": {
-      "type": "object",
-      "properties": {
-        "rules": {
+        },
+        "users": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/bucketReplicationRule"
+            "type": "string"
           }
         }
       }
     },
-    "bucketReplicationRule": {
+    "ldapPolicyMappingRequest": {
       "type": "object",
+      "required": [
+        "type",
+        "dn",
+        "policies"
+      ],
       "properties": {
-        "bandwidth": {
-          "type": "string"
-        },
-        "delete_marker_replication": {
-          "type": "boolean"
-        },
-        "deletes_replication": {
-          "type": "boolean"
-        },
-        "destination": {
-          "$ref": "#/definitions/bucketReplicationDestination"
-        },
-        "existingObjects": {
-          "type": "boolean"
-        },
-        "healthCheckPeriod": {
-          "type": "integer"
-        },
-        "id": {
-          "type": "string"
-        },
-        "metadata_replication": {
-          "type": "boolean"
-        },
-        "prefix": {
+        "dn": {
           "type": "string"
         },
-        "priority": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "Enabled",
-            "Disabled"
-          ]
-        },
-        "storageClass": {
-          "type": "string"
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "syncMode": {
+        "type": {
           "type": "string",
-          "default": "async",
           "enum": [
-            "async",
-            "sync"
+            "user",
+            "group"
           ]
-        },
-        "tags": {
-          "type": "string"
         }
       }
     },
-    "bucketReplicationRuleList": {
+    "ldapPolicyMappingResponse": {
       "type": "object",
       "properties": {
-        "rules": {
+        "policiesAttached": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "bucketVersioningResponse": {
-      "type": "object",
-      "properties": {
-        "MFADelete": {
-          "type": "string"
-        },
-        "excludeFolders": {
-          "type": "boolean"
-        },
-        "excludedPrefixes": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "prefix": {
-                "type": "string"
-              }
-            }
-          }
         },
-        "status": {
+        "updatedAt": {
           "type": "string"
         }
       }
     },
-    "bulkUserGroups": {
+    "ldapUserGroupsResponse": {
       "type": "object",
-      "required": [
-        "users",
-        "groups"
-      ],
       "properties": {
+        "dn": {
+          "type": "string"
+        },
         "groups": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/ldapGroupPolicyEntity"
           }
         },
-        "users": {
+        "policies": {
           "type": "array",
+          "title": "policies mapped to the user itself",
           "items": {
             "type": "string"
           }
+        },
+        "username": {
+          "type": "string"
         }
       }
     },
-    "callHomeGetResponse": {
+    "ldapUserPolicyEntity": {
       "type": "object",
       "properties": {
-        "diagnosticsStatus": {
-          "type": "boolean"
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "logsStatus": {
-          "type": "boolean"
+        "user": {
+          "type": "string"
         }
       }
     },
-    "callHomeSetStatus": {
+    "license": {
       "type": "object",
-      "required": [
-        "diagState",
-        "logsState"
-      ],
       "properties": {
-        "diagState": {
-          "type": "boolean"
+        "account_id": {
+          "type": "integer"
         },
-        "logsState": {
-          "type": "boolean"
+        "email": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string"
+        },
+        "organization": {
+          "type": "string"
+        },
+        "plan": {
+          "type": "string"
+        },
+        "storage_capacity": {
+          "type": "integer"
         }
       }
     },
-    "changeUserPasswordRequest": {
+    "lifecycleTag": {
       "type": "object",
-      "required": [
-        "selectedUser",
-        "newSecretKey"
-      ],
       "properties": {
-        "newSecretKey": {
+        "key": {
           "type": "string"
         },
-        "selectedUser": {
+        "value": {
           "type": "string"
         }
       }
     },
-    "configDescription": {
+    "listAccessRulesResponse": {
       "type": "object",
       "properties": {
-        "description": {
-          "type": "string"
+        "accessRules": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/accessRule"
+          }
         },
-        "key": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of policies"
         }
       }
     },
-    "configExportResponse": {
+    "listBucketEventsResponse": {
       "type": "object",
       "properties": {
-        "status": {
-          "type": "string"
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationConfig"
+          }
         },
-        "value": {
-          "description": "Returns base64 encoded value",
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of bucket events"
         }
       }
     },
-    "configuration": {
+    "listBucketsResponse": {
       "type": "object",
       "properties": {
-        "key_values": {
+        "buckets": {
           "type": "array",
+          "title": "list of resulting buckets",
           "items": {
-            "$ref": "#/definitions/configurationKV"
+            "$ref": "#/definitions/bucket"
           }
         },
-        "name": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of buckets accessible to the user"
         }
       }
     },
-    "configurationKV": {
+    "listConfigResponse": {
       "type": "object",
       "properties": {
-        "env_override": {
-          "$ref": "#/definitions/envOverride"
-        },
-        "key": {
-          "type": "string"
+        "configurations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configDescription"
+          }
         },
-        "value": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of configurations"
         }
       }
     },
-    "createRemoteBucket": {
+    "listExternalBucketsParams": {
       "required": [
         "accessKey",
         "secretKey",
         "targetURL",
-        "sourceBucket",
-        "targetBucket"
+        "useTLS"
       ],
       "properties": {
         "accessKey": {
           "type": "string",
           "minLength": 3
         },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
         "region": {
           "type": "string"
         },
@@ -6123,527 +9843,741 @@ func init() {
           "type": "string",
           "minLength": 8
         },
-        "sourceBucket": {
-          "type": "string"
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "targetBucket": {
-          "type": "string"
-        },
         "targetURL": {
           "type": "string"
-        }
-      }
-    },
-    "deleteFile": {
-      "type": "object",
-      "properties": {
-        "path": {
-          "type": "string"
         },
-        "recursive": {
+        "useTLS": {
           "type": "boolean"
-        },
-        "versionID": {
-          "type": "string"
         }
       }
     },
-    "envOverride": {
+    "listGroupsResponse": {
       "type": "object",
       "properties": {
-        "name": {
-          "type": "string"
+        "groups": {
+          "type": "array",
+          "title": "list of groups",
+          "items": {
+            "type": "string"
+          }
         },
-        "value": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of groups"
         }
       }
     },
-    "environmentConstants": {
+    "listObjectsResponse": {
       "type": "object",
       "properties": {
-        "maxConcurrentDownloads": {
-          "type": "integer"
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
         },
-        "maxConcurrentUploads": {
-          "type": "integer"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects"
         }
       }
     },
-    "expirationResponse": {
+    "listPoliciesResponse": {
       "type": "object",
       "properties": {
-        "date": {
-          "type": "string"
-        },
-        "days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "delete_all": {
-          "type": "boolean"
-        },
-        "delete_marker": {
-          "type": "boolean"
-        },
-        "newer_noncurrent_expiration_versions": {
-          "type": "integer",
-          "format": "int64"
+        "policies": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
         },
-        "noncurrent_expiration_days": {
+        "total": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "title": "total number of policies"
         }
       }
     },
-    "getBucketRetentionConfig": {
+    "listPolicyRevisionsResponse": {
       "type": "object",
       "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
-        },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+        "revisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyRevision"
+          }
         }
       }
     },
-    "group": {
+    "listRemoteBucketsResponse": {
       "type": "object",
       "properties": {
-        "members": {
+        "buckets": {
           "type": "array",
+          "title": "list of remote buckets",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/remoteBucket"
           }
         },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of remote buckets accessible to user"
         }
       }
     },
-    "iamEntity": {
-      "type": "string",
-      "pattern": "^[\\w+=,.@-]{1,64}$"
-    },
-    "iamPolicy": {
+    "listUsersResponse": {
       "type": "object",
       "properties": {
-        "statement": {
+        "usageTrackedSince": {
+          "type": "string",
+          "title": "start of the period covered by the usage of the users, empty when usage isn't tracked"
+        },
+        "users": {
           "type": "array",
+          "title": "list of resulting users",
           "items": {
-            "$ref": "#/definitions/iamPolicyStatement"
+            "$ref": "#/definitions/user"
           }
+        }
+      }
+    },
+    "logSearchResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "object",
+          "title": "list of log search responses"
         },
-        "version": {
-          "type": "string"
+        "truncated": {
+          "type": "boolean",
+          "title": "the page is past the records a search of the local index reads"
         }
       }
     },
-    "iamPolicyStatement": {
+    "loginDetails": {
       "type": "object",
       "properties": {
-        "action": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "animatedLogin": {
+          "type": "boolean"
         },
-        "condition": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
-          }
+        "isK8S": {
+          "type": "boolean"
         },
-        "effect": {
-          "type": "string"
+        "loginStrategy": {
+          "type": "string",
+          "enum": [
+            "form",
+            "redirect",
+            "service-account",
+            "redirect-service-account"
+          ]
         },
-        "resource": {
+        "redirectRules": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/redirectRule"
           }
         }
       }
     },
-    "idpListConfigurationsResponse": {
+    "loginOauth2AuthRequest": {
       "type": "object",
+      "required": [
+        "state",
+        "code"
+      ],
       "properties": {
-        "results": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/idpServerConfiguration"
-          }
+        "code": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
         }
       }
     },
-    "idpServerConfiguration": {
+    "loginRequest": {
       "type": "object",
       "properties": {
-        "enabled": {
-          "type": "boolean"
+        "accessKey": {
+          "type": "string"
         },
-        "info": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/idpServerConfigurationInfo"
+        "features": {
+          "type": "object",
+          "properties": {
+            "hide_menu": {
+              "type": "boolean"
+            }
           }
         },
-        "input": {
-          "type": "string"
-        },
-        "name": {
+        "secretKey": {
           "type": "string"
         },
-        "type": {
+        "sts": {
           "type": "string"
         }
       }
     },
-    "idpServerConfigurationInfo": {
+    "loginResponse": {
       "type": "object",
       "properties": {
-        "isCfg": {
-          "type": "boolean"
-        },
-        "isEnv": {
-          "type": "boolean"
-        },
-        "key": {
+        "IDPRefreshToken": {
           "type": "string"
         },
-        "value": {
+        "sessionId": {
           "type": "string"
         }
       }
     },
-    "kmDeleteKeyRequest": {
-      "type": "object"
+    "logoutRequest": {
+      "type": "object",
+      "properties": {
+        "state": {
+          "type": "string"
+        }
+      }
     },
-    "kmsAPI": {
+    "makeBucketRequest": {
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "maxBody": {
-          "type": "integer"
+        "locking": {
+          "type": "boolean"
         },
-        "method": {
+        "name": {
           "type": "string"
         },
-        "path": {
-          "type": "string"
+        "quota": {
+          "$ref": "#/definitions/setBucketQuota"
         },
-        "timeout": {
-          "type": "integer"
+        "retention": {
+          "$ref": "#/definitions/putBucketRetentionRequest"
+        },
+        "versioning": {
+          "$ref": "#/definitions/setBucketVersioning"
         }
       }
     },
-    "kmsAPIsResponse": {
+    "makeBucketsResponse": {
       "type": "object",
       "properties": {
-        "results": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/kmsAPI"
-          }
+        "bucketName": {
+          "type": "string"
         }
       }
     },
-    "kmsAssignPolicyRequest": {
+    "maxShareLinkExpResponse": {
       "type": "object",
+      "required": [
+        "exp"
+      ],
       "properties": {
-        "identity": {
-          "type": "string"
+        "exp": {
+          "type": "number",
+          "format": "int64"
         }
       }
     },
-    "kmsCreateKeyRequest": {
+    "metadata": {
       "type": "object",
+      "properties": {
+        "objectMetadata": {
+          "type": "object",
+          "additionalProperties": true
+        }
+      }
+    },
+    "multiBucketReplication": {
       "required": [
-        "key"
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "bucketsRelation"
       ],
       "properties": {
-        "key": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bucketsRelation": {
+          "type": "array",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/multiBucketsRelation"
+          }
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "region": {
+          "type": "string"
+        },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
+        },
+        "targetURL": {
           "type": "string"
         }
       }
     },
-    "kmsDescribeIdentityResponse": {
+    "multiBucketReplicationEdit": {
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateExistingObjects": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
+        "ruleState": {
+          "type": "boolean"
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "tags": {
+          "type": "string",
+          "default": ""
+        }
+      }
+    },
+    "multiBucketResponseItem": {
       "type": "object",
       "properties": {
-        "admin": {
-          "type": "boolean"
-        },
-        "createdAt": {
-          "type": "string"
-        },
-        "createdBy": {
+        "errorString": {
           "type": "string"
         },
-        "identity": {
+        "originBucket": {
           "type": "string"
         },
-        "policy": {
+        "targetBucket": {
           "type": "string"
         }
       }
     },
-    "kmsDescribePolicyResponse": {
+    "multiBucketResponseState": {
       "type": "object",
       "properties": {
-        "createdAt": {
-          "type": "string"
-        },
-        "createdBy": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
+        "replicationState": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/multiBucketResponseItem"
+          }
         }
       }
     },
-    "kmsDescribeSelfIdentityResponse": {
+    "multiBucketsRelation": {
       "type": "object",
       "properties": {
-        "admin": {
-          "type": "boolean"
-        },
-        "createdAt": {
-          "type": "string"
-        },
-        "createdBy": {
-          "type": "string"
-        },
-        "identity": {
+        "destinationBucket": {
           "type": "string"
         },
-        "policy": {
-          "$ref": "#/definitions/kmsGetPolicyResponse"
-        },
-        "policyName": {
+        "originBucket": {
           "type": "string"
         }
       }
     },
-    "kmsEndpoint": {
+    "multiLifecycleResult": {
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/multicycleResultItem"
+          }
+        }
+      }
+    },
+    "multicycleResultItem": {
       "type": "object",
       "properties": {
-        "status": {
+        "bucketName": {
           "type": "string"
         },
-        "url": {
+        "error": {
           "type": "string"
         }
       }
     },
-    "kmsGetPolicyResponse": {
+    "nofiticationService": {
+      "type": "string",
+      "enum": [
+        "webhook",
+        "amqp",
+        "kafka",
+        "mqtt",
+        "nats",
+        "nsq",
+        "mysql",
+        "postgres",
+        "elasticsearch",
+        "redis"
+      ]
+    },
+    "notifEndpointResponse": {
       "type": "object",
       "properties": {
-        "allow": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "deny": {
+        "notification_endpoints": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/notificationEndpointItem"
           }
         }
       }
     },
-    "kmsIdentityInfo": {
+    "notificationConfig": {
       "type": "object",
+      "required": [
+        "arn"
+      ],
       "properties": {
-        "createdAt": {
+        "arn": {
           "type": "string"
         },
-        "createdBy": {
-          "type": "string"
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
         },
-        "error": {
+        "id": {
           "type": "string"
         },
-        "identity": {
-          "type": "string"
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
         },
-        "policy": {
-          "type": "string"
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "kmsImportKeyRequest": {
+    "notificationDeleteRequest": {
       "type": "object",
       "required": [
-        "bytes"
+        "events",
+        "prefix",
+        "suffix"
       ],
       "properties": {
-        "bytes": {
-          "type": "string"
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "kmsKeyInfo": {
+    "notificationEndpoint": {
       "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
       "properties": {
-        "createdAt": {
+        "account_id": {
           "type": "string"
         },
-        "createdBy": {
-          "type": "string"
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         },
-        "name": {
-          "type": "string"
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "kmsKeyStatusResponse": {
+    "notificationEndpointItem": {
       "type": "object",
       "properties": {
-        "decryptionErr": {
+        "account_id": {
           "type": "string"
         },
-        "encryptionErr": {
-          "type": "string"
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
         },
-        "keyID": {
+        "status": {
           "type": "string"
         }
       }
     },
-    "kmsLatencyHistogram": {
+    "notificationEventType": {
+      "type": "string",
+      "enum": [
+        "put",
+        "delete",
+        "get",
+        "replica",
+        "ilm",
+        "scanner"
+      ]
+    },
+    "objectBucketLifecycle": {
       "type": "object",
       "properties": {
-        "duration": {
-          "type": "integer"
+        "expiration": {
+          "$ref": "#/definitions/expirationResponse"
         },
-        "total": {
-          "type": "integer"
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "transition": {
+          "$ref": "#/definitions/transitionResponse"
         }
       }
     },
-    "kmsListIdentitiesResponse": {
+    "objectLegalHoldStatus": {
+      "type": "string",
+      "enum": [
+        "enabled",
+        "disabled"
+      ]
+    },
+    "objectRetentionMode": {
+      "type": "string",
+      "enum": [
+        "governance",
+        "compliance"
+      ]
+    },
+    "objectRetentionUnit": {
+      "type": "string",
+      "enum": [
+        "days",
+        "years"
+      ]
+    },
+    "objectVersionDiff": {
       "type": "object",
       "properties": {
-        "results": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/kmsIdentityInfo"
-          }
+        "diff": {
+          "type": "string"
+        },
+        "from_version": {
+          "type": "string"
+        },
+        "identical": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "to_version": {
+          "type": "string"
         }
       }
     },
-    "kmsListKeysResponse": {
+    "objectVersionHistory": {
       "type": "object",
       "properties": {
-        "results": {
+        "name": {
+          "type": "string"
+        },
+        "versions": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/kmsKeyInfo"
+            "$ref": "#/definitions/objectVersionHistoryEntry"
           }
         }
       }
     },
-    "kmsListPoliciesResponse": {
+    "objectVersionHistoryEntry": {
       "type": "object",
       "properties": {
-        "results": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/kmsPolicyInfo"
-          }
+        "etag": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size_delta": {
+          "description": "size difference with the previous version",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "kmsMetricsResponse": {
+    "objectVersionPurgeEntry": {
       "type": "object",
-      "required": [
-        "requestOK",
-        "requestErr",
-        "requestFail",
-        "requestActive",
-        "auditEvents",
-        "errorEvents",
-        "latencyHistogram",
-        "uptime",
-        "cpus",
-        "usableCPUs",
-        "threads",
-        "heapAlloc",
-        "stackAlloc"
-      ],
       "properties": {
-        "auditEvents": {
-          "type": "integer"
+        "error": {
+          "type": "string"
         },
-        "cpus": {
-          "type": "integer"
+        "is_delete_marker": {
+          "type": "boolean"
         },
-        "errorEvents": {
-          "type": "integer"
+        "last_modified": {
+          "type": "string"
         },
-        "heapAlloc": {
-          "type": "integer"
+        "name": {
+          "type": "string"
         },
-        "heapObjects": {
-          "type": "integer"
+        "size": {
+          "type": "integer",
+          "format": "int64"
         },
-        "latencyHistogram": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/kmsLatencyHistogram"
-          }
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "objectVersionPurgeRequest": {
+      "type": "object",
+      "properties": {
+        "dry_run": {
+          "type": "boolean"
         },
-        "requestActive": {
-          "type": "integer"
+        "keep_latest": {
+          "description": "number of newest versions kept for each object",
+          "type": "integer",
+          "format": "int64"
         },
-        "requestErr": {
-          "type": "integer"
+        "newer_than": {
+          "description": "versions modified after this date (RFC3339) are kept",
+          "type": "string"
         },
-        "requestFail": {
-          "type": "integer"
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "objectVersionPurgeResponse": {
+      "type": "object",
+      "properties": {
+        "bytes_freed": {
+          "type": "integer",
+          "format": "int64"
         },
-        "requestOK": {
-          "type": "integer"
+        "dry_run": {
+          "type": "boolean"
         },
-        "stackAlloc": {
-          "type": "integer"
+        "failed": {
+          "type": "integer",
+          "format": "int64"
         },
-        "threads": {
-          "type": "integer"
+        "purged": {
+          "type": "integer",
+          "format": "int64"
         },
-        "uptime": {
-          "type": "integer"
+        "truncated": {
+          "description": "only the first versions are listed, totals include all of them",
+          "type": "boolean"
         },
-        "usableCPUs": {
-          "type": "integer"
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectVersionPurgeEntry"
+          }
         }
       }
     },
-    "kmsPolicyInfo": {
+    "peerInfo": {
       "type": "object",
       "properties": {
-        "createdAt": {
+        "deploymentID": {
           "type": "string"
         },
-        "createdBy": {
+        "endpoint": {
           "type": "string"
         },
         "name": {
@@ -6651,507 +10585,533 @@ func init() {
         }
       }
     },
-    "kmsSetPolicyRequest": {
+    "peerInfoRemove": {
       "type": "object",
       "required": [
-        "policy"
+        "sites"
       ],
       "properties": {
-        "allow": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "all": {
+          "type": "boolean"
         },
-        "deny": {
+        "sites": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        },
-        "policy": {
-          "type": "string"
         }
       }
     },
-    "kmsStatusResponse": {
+    "peerSite": {
       "type": "object",
       "properties": {
-        "defaultKeyID": {
+        "accessKey": {
           "type": "string"
         },
-        "endpoints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/kmsEndpoint"
-          }
+        "endpoint": {
+          "type": "string"
         },
         "name": {
           "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
         }
       }
     },
-    "kmsVersionResponse": {
+    "peerSiteEditResponse": {
       "type": "object",
       "properties": {
-        "version": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
           "type": "string"
+        },
+        "success": {
+          "type": "boolean"
         }
       }
     },
-    "ldapEntities": {
+    "peerSiteRemoveResponse": {
       "type": "object",
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ldapGroupPolicyEntity"
-          }
-        },
-        "policies": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ldapPolicyEntity"
-          }
-        },
-        "timestamp": {
+        "errorDetail": {
           "type": "string"
         },
-        "users": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ldapUserPolicyEntity"
-          }
+        "status": {
+          "type": "string"
         }
       }
     },
-    "ldapEntitiesRequest": {
+    "permissionResource": {
       "type": "object",
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "conditionOperator": {
+          "type": "string"
         },
-        "policies": {
+        "prefixes": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "users": {
+        "resource": {
+          "type": "string"
+        }
+      }
+    },
+    "policy": {
+      "type": "object",
+      "properties": {
+        "findings": {
           "type": "array",
+          "title": "lint findings, only set when the policy is saved",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/policyLintFinding"
           }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
         }
       }
     },
-    "ldapGroupPolicyEntity": {
+    "policyArgs": {
       "type": "object",
       "properties": {
-        "group": {
+        "action": {
           "type": "string"
         },
-        "policies": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "bucket_name": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
         }
       }
     },
-    "ldapPolicyEntity": {
+    "policyEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group"
+      ]
+    },
+    "policyLintFinding": {
       "type": "object",
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "check": {
+          "type": "string",
+          "enum": [
+            "invalidPolicy",
+            "wildcardAccess",
+            "adminActionsBroadGroup",
+            "shadowedDeny",
+            "nonExistentBucket",
+            "unusedPolicy",
+            "unsupportedConditionKey"
+          ]
+        },
+        "message": {
+          "type": "string"
         },
         "policy": {
           "type": "string"
         },
-        "users": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "remediation": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "high",
+            "medium",
+            "low"
+          ]
+        },
+        "statement": {
+          "type": "integer",
+          "format": "int32",
+          "title": "1-based position of the statement, 0 when the finding is about the whole policy"
         }
       }
     },
-    "ldapUserPolicyEntity": {
+    "policyLintReport": {
       "type": "object",
       "properties": {
-        "policies": {
+        "findings": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/policyLintFinding"
           }
         },
-        "user": {
-          "type": "string"
+        "policies": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "license": {
+    "policyRevision": {
       "type": "object",
       "properties": {
-        "account_id": {
-          "type": "integer"
-        },
-        "email": {
+        "author": {
           "type": "string"
         },
-        "expires_at": {
+        "comment": {
           "type": "string"
         },
-        "organization": {
+        "name": {
           "type": "string"
         },
-        "plan": {
+        "policy": {
           "type": "string"
         },
-        "storage_capacity": {
-          "type": "integer"
-        }
-      }
-    },
-    "lifecycleTag": {
-      "type": "object",
-      "properties": {
-        "key": {
+        "revision": {
           "type": "string"
         },
-        "value": {
+        "time": {
           "type": "string"
         }
       }
     },
-    "listAccessRulesResponse": {
+    "policyRevisionDiff": {
       "type": "object",
       "properties": {
-        "accessRules": {
+        "from": {
+          "$ref": "#/definitions/policyRevision"
+        },
+        "name": {
+          "type": "string"
+        },
+        "statements": {
           "type": "array",
-          "title": "list of policies",
           "items": {
-            "$ref": "#/definitions/accessRule"
+            "$ref": "#/definitions/policyStatementChange"
           }
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
+        "to": {
+          "$ref": "#/definitions/policyRevision"
         }
       }
     },
-    "listBucketEventsResponse": {
+    "policyStatementChange": {
       "type": "object",
       "properties": {
-        "events": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationConfig"
-          }
+        "after": {
+          "type": "string"
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of bucket events"
+        "before": {
+          "type": "string"
+        },
+        "change": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "modified",
+            "unchanged"
+          ]
+        },
+        "sid": {
+          "type": "string"
         }
       }
     },
-    "listBucketsResponse": {
+    "prefixAccessPair": {
       "type": "object",
       "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of resulting buckets",
-          "items": {
-            "$ref": "#/definitions/bucket"
-          }
+        "access": {
+          "type": "string"
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of buckets accessible to the user"
+        "prefix": {
+          "type": "string"
         }
       }
     },
-    "listConfigResponse": {
+    "prefixWrapper": {
       "type": "object",
       "properties": {
-        "configurations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configDescription"
-          }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of configurations"
+        "prefix": {
+          "type": "string"
         }
       }
     },
-    "listExternalBucketsParams": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "useTLS"
-      ],
+    "principal": {
+      "type": "object",
       "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
+        "STSAccessKeyID": {
+          "type": "string"
         },
-        "region": {
+        "STSSecretAccessKey": {
           "type": "string"
         },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
+        "STSSessionToken": {
+          "type": "string"
         },
-        "targetURL": {
+        "accountAccessKey": {
           "type": "string"
         },
-        "useTLS": {
+        "customStyleOb": {
+          "type": "string"
+        },
+        "hm": {
           "type": "boolean"
-        }
-      }
-    },
-    "listGroupsResponse": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "title": "list of groups",
-          "items": {
-            "type": "string"
-          }
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of groups"
+        "ob": {
+          "type": "boolean"
         }
       }
     },
-    "listObjectsResponse": {
+    "profilingStartRequest": {
       "type": "object",
+      "required": [
+        "type"
+      ],
       "properties": {
-        "objects": {
-          "type": "array",
-          "title": "list of resulting objects",
-          "items": {
-            "$ref": "#/definitions/bucketObject"
-          }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of objects"
+        "type": {
+          "type": "string"
         }
       }
     },
-    "listPoliciesResponse": {
+    "putBucketRetentionRequest": {
       "type": "object",
+      "required": [
+        "mode",
+        "unit",
+        "validity"
+      ],
       "properties": {
-        "policies": {
-          "type": "array",
-          "title": "list of policies",
-          "items": {
-            "$ref": "#/definitions/policy"
-          }
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
         },
-        "total": {
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
           "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
+          "format": "int32"
         }
       }
     },
-    "listRemoteBucketsResponse": {
+    "putBucketTagsRequest": {
       "type": "object",
       "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of remote buckets",
-          "items": {
-            "$ref": "#/definitions/remoteBucket"
+        "tags": {
+          "additionalProperties": {
+            "type": "string"
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of remote buckets accessible to user"
         }
       }
     },
-    "listUsersResponse": {
+    "putObjectLegalHoldRequest": {
       "type": "object",
+      "required": [
+        "status"
+      ],
       "properties": {
-        "users": {
-          "type": "array",
-          "title": "list of resulting users",
-          "items": {
-            "$ref": "#/definitions/user"
-          }
+        "status": {
+          "$ref": "#/definitions/objectLegalHoldStatus"
         }
       }
     },
-    "logSearchResponse": {
+    "putObjectRetentionRequest": {
       "type": "object",
+      "required": [
+        "mode",
+        "expires"
+      ],
       "properties": {
-        "results": {
-          "type": "object",
-          "title": "list of log search responses"
+        "expires": {
+          "type": "string"
+        },
+        "governance_bypass": {
+          "type": "boolean"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
         }
       }
     },
-    "loginDetails": {
+    "putObjectTagsRequest": {
       "type": "object",
       "properties": {
-        "animatedLogin": {
-          "type": "boolean"
-        },
-        "isK8S": {
-          "type": "boolean"
-        },
-        "loginStrategy": {
-          "type": "string",
-          "enum": [
-            "form",
-            "redirect",
-            "service-account",
-            "redirect-service-account"
-          ]
-        },
-        "redirectRules": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/redirectRule"
+        "tags": {
+          "additionalProperties": {
+            "type": "string"
           }
         }
       }
     },
-    "loginOauth2AuthRequest": {
-      "type": "object",
-      "required": [
-        "state",
-        "code"
-      ],
+    "redirectRule": {
+      "type": "object",
       "properties": {
-        "code": {
+        "displayName": {
           "type": "string"
         },
-        "state": {
+        "redirect": {
+          "type": "string"
+        },
+        "serviceType": {
           "type": "string"
         }
       }
     },
-    "loginRequest": {
+    "releaseAuthor": {
       "type": "object",
       "properties": {
-        "accessKey": {
+        "avatar_url": {
           "type": "string"
         },
-        "features": {
-          "type": "object",
-          "properties": {
-            "hide_menu": {
-              "type": "boolean"
-            }
-          }
+        "events_url": {
+          "type": "string"
         },
-        "secretKey": {
+        "followers_url": {
           "type": "string"
         },
-        "sts": {
+        "following_url": {
+          "type": "string"
+        },
+        "gists_url": {
+          "type": "string"
+        },
+        "gravatar_id": {
+          "type": "string"
+        },
+        "html_url": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "login": {
+          "type": "string"
+        },
+        "node_id": {
+          "type": "string"
+        },
+        "organizations_url": {
+          "type": "string"
+        },
+        "receivedEvents_url": {
+          "type": "string"
+        },
+        "repos_url": {
+          "type": "string"
+        },
+        "site_admin": {
+          "type": "boolean"
+        },
+        "starred_url": {
+          "type": "string"
+        },
+        "subscriptions_url": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "url": {
           "type": "string"
         }
       }
     },
-    "loginResponse": {
+    "releaseInfo": {
       "type": "object",
       "properties": {
-        "IDPRefreshToken": {
+        "breakingChangesContent": {
           "type": "string"
         },
-        "sessionId": {
+        "contextContent": {
+          "type": "string"
+        },
+        "metadata": {
+          "$ref": "#/definitions/releaseMetadata"
+        },
+        "newFeaturesContent": {
+          "type": "string"
+        },
+        "notesContent": {
+          "type": "string"
+        },
+        "securityContent": {
           "type": "string"
         }
       }
     },
-    "logoutRequest": {
+    "releaseListResponse": {
       "type": "object",
       "properties": {
-        "state": {
-          "type": "string"
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/releaseInfo"
+          }
         }
       }
     },
-    "makeBucketRequest": {
+    "releaseMetadata": {
       "type": "object",
-      "required": [
-        "name"
-      ],
       "properties": {
-        "locking": {
+        "assets_url": {
+          "type": "string"
+        },
+        "author": {
+          "$ref": "#/definitions/releaseAuthor"
+        },
+        "created_at": {
+          "type": "string"
+        },
+        "draft": {
           "type": "boolean"
         },
+        "html_url": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
-        "quota": {
-          "$ref": "#/definitions/setBucketQuota"
+        "node_id": {
+          "type": "string"
         },
-        "retention": {
-          "$ref": "#/definitions/putBucketRetentionRequest"
+        "prerelease": {
+          "type": "boolean"
         },
-        "versioning": {
-          "$ref": "#/definitions/setBucketVersioning"
-        }
-      }
-    },
-    "makeBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "bucketName": {
+        "published_at": {
+          "type": "string"
+        },
+        "tag_name": {
+          "type": "string"
+        },
+        "tarball_url": {
+          "type": "string"
+        },
+        "target_commitish": {
+          "type": "string"
+        },
+        "upload_url": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        },
+        "zipball_url": {
           "type": "string"
         }
       }
     },
-    "maxShareLinkExpResponse": {
-      "type": "object",
-      "required": [
-        "exp"
-      ],
-      "properties": {
-        "exp": {
-          "type": "number",
-          "format": "int64"
-        }
-      }
-    },
-    "metadata": {
+    "remoteBucket": {
       "type": "object",
-      "properties": {
-        "objectMetadata": {
-          "type": "object",
-          "additionalProperties": true
-        }
-      }
-    },
-    "multiBucketReplication": {
       "required": [
         "accessKey",
-        "secretKey",
-        "targetURL",
-        "bucketsRelation"
+        "sourceBucket",
+        "remoteARN"
       ],
       "properties": {
         "accessKey": {
@@ -7162,2057 +11122,2622 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
-        "bucketsRelation": {
-          "type": "array",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/multiBucketsRelation"
-          }
-        },
         "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "priority": {
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+          "type": "integer"
         },
-        "region": {
+        "remoteARN": {
           "type": "string"
         },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
-        },
-        "replicateMetadata": {
-          "type": "boolean"
-        },
         "secretKey": {
           "type": "string",
           "minLength": 8
         },
-        "storageClass": {
-          "type": "string",
-          "default": ""
-        },
-        "syncMode": {
+        "service": {
           "type": "string",
-          "default": "async",
           "enum": [
-            "async",
-            "sync"
+            "replication"
           ]
         },
-        "tags": {
-          "type": "string"
-        },
-        "targetURL": {
-          "type": "string"
-        }
-      }
-    },
-    "multiBucketReplicationEdit": {
-      "properties": {
-        "arn": {
+        "sourceBucket": {
           "type": "string"
         },
-        "prefix": {
+        "status": {
           "type": "string"
         },
-        "priority": {
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
-        },
-        "replicateExistingObjects": {
-          "type": "boolean"
-        },
-        "replicateMetadata": {
-          "type": "boolean"
-        },
-        "ruleState": {
-          "type": "boolean"
-        },
-        "storageClass": {
-          "type": "string",
-          "default": ""
+        "syncMode": {
+          "type": "string"
         },
-        "tags": {
-          "type": "string",
-          "default": ""
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
         }
       }
     },
-    "multiBucketResponseItem": {
+    "replicationMetricsPoint": {
       "type": "object",
       "properties": {
-        "errorString": {
-          "type": "string"
+        "currentBandwidth": {
+          "type": "number",
+          "format": "double"
         },
-        "originBucket": {
-          "type": "string"
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
         },
-        "targetBucket": {
+        "queuedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "queuedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "timestamp": {
           "type": "string"
         }
       }
     },
-    "multiBucketResponseState": {
+    "replicationMetricsResponse": {
       "type": "object",
       "properties": {
-        "replicationState": {
+        "sampleInterval": {
+          "description": "seconds between history points",
+          "type": "integer",
+          "format": "int64"
+        },
+        "siteReplicationError": {
+          "type": "string"
+        },
+        "targets": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/multiBucketResponseItem"
+            "$ref": "#/definitions/replicationTargetMetrics"
           }
         }
       }
     },
-    "multiBucketsRelation": {
+    "replicationResyncRequest": {
       "type": "object",
+      "required": [
+        "arn"
+      ],
       "properties": {
-        "destinationBucket": {
+        "arn": {
           "type": "string"
         },
-        "originBucket": {
+        "olderThan": {
+          "description": "only resync objects older than this duration, e.g. 72h",
           "type": "string"
         }
       }
     },
-    "multiLifecycleResult": {
+    "replicationResyncResponse": {
+      "type": "object",
       "properties": {
-        "results": {
+        "targets": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/multicycleResultItem"
+            "$ref": "#/definitions/replicationResyncTarget"
           }
         }
       }
     },
-    "multicycleResultItem": {
+    "replicationResyncTarget": {
       "type": "object",
       "properties": {
-        "bucketName": {
+        "arn": {
           "type": "string"
         },
-        "error": {
+        "endTime": {
+          "type": "string"
+        },
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastObject": {
+          "description": "last object replicated by the resync",
+          "type": "string"
+        },
+        "replicatedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "resetId": {
+          "type": "string"
+        },
+        "startTime": {
+          "type": "string"
+        },
+        "status": {
           "type": "string"
         }
       }
     },
-    "nofiticationService": {
-      "type": "string",
-      "enum": [
-        "webhook",
-        "amqp",
-        "kafka",
-        "mqtt",
-        "nats",
-        "nsq",
-        "mysql",
-        "postgres",
-        "elasticsearch",
-        "redis"
-      ]
-    },
-    "notifEndpointResponse": {
+    "replicationTargetMetrics": {
       "type": "object",
       "properties": {
-        "notification_endpoints": {
+        "arn": {
+          "type": "string"
+        },
+        "backlogTrend": {
+          "type": "string",
+          "enum": [
+            "unknown",
+            "draining",
+            "growing",
+            "steady"
+          ]
+        },
+        "bandwidthLimit": {
+          "description": "bytes per second, 0 when unlimited",
+          "type": "integer",
+          "format": "int64"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "currentBandwidth": {
+          "description": "bytes per second",
+          "type": "number",
+          "format": "double"
+        },
+        "deploymentId": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedLastHourCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "history": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/notificationEndpointItem"
+            "$ref": "#/definitions/replicationMetricsPoint"
           }
+        },
+        "latencyAverageMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "latencyCurrentMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "latencyMaxMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "online": {
+          "type": "boolean"
+        },
+        "queuedCount": {
+          "description": "objects waiting in the replication queue of the source bucket, or of the site for peers",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queuedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "bucket",
+            "site"
+          ]
         }
       }
     },
-    "notificationConfig": {
+    "resultTarget": {
       "type": "object",
-      "required": [
-        "arn"
-      ],
       "properties": {
-        "arn": {
+        "legendFormat": {
           "type": "string"
         },
-        "events": {
+        "result": {
           "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
           "items": {
-            "$ref": "#/definitions/notificationEventType"
+            "$ref": "#/definitions/widgetResult"
           }
         },
-        "id": {
+        "resultType": {
           "type": "string"
-        },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
-        },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "notificationDeleteRequest": {
+    "rewindItem": {
       "type": "object",
-      "required": [
-        "events",
-        "prefix",
-        "suffix"
-      ],
       "properties": {
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
-          }
+        "action": {
+          "type": "string"
         },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
+        "delete_flag": {
+          "type": "boolean"
         },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "notificationEndpoint": {
+    "rewindResponse": {
       "type": "object",
-      "required": [
-        "service",
-        "account_id",
-        "properties"
-      ],
       "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rewindItem"
           }
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "notificationEndpointItem": {
+    "rollbackPolicyRequest": {
       "type": "object",
       "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
-        },
-        "status": {
+        "comment": {
           "type": "string"
         }
       }
     },
-    "notificationEventType": {
-      "type": "string",
-      "enum": [
-        "put",
-        "delete",
-        "get",
-        "replica",
-        "ilm",
-        "scanner"
-      ]
+    "rotateServiceAccountRequest": {
+      "type": "object",
+      "properties": {
+        "expiry": {
+          "type": "string",
+          "title": "expiration of the new credential, RFC3339"
+        },
+        "gracePeriod": {
+          "type": "string",
+          "title": "how long the old credential keeps working, as a duration like 24h, defaults to 24h"
+        }
+      }
     },
-    "objectBucketLifecycle": {
+    "rotateServiceAccountResponse": {
       "type": "object",
       "properties": {
-        "expiration": {
-          "$ref": "#/definitions/expirationResponse"
-        },
-        "id": {
-          "type": "string"
+        "credentials": {
+          "$ref": "#/definitions/serviceAccountCreds"
         },
-        "prefix": {
+        "previousAccessKey": {
           "type": "string"
         },
-        "status": {
+        "previousExpiration": {
           "type": "string"
-        },
-        "tags": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/lifecycleTag"
-          }
-        },
-        "transition": {
-          "$ref": "#/definitions/transitionResponse"
         }
       }
     },
-    "objectLegalHoldStatus": {
-      "type": "string",
-      "enum": [
-        "enabled",
-        "disabled"
-      ]
-    },
-    "objectRetentionMode": {
-      "type": "string",
-      "enum": [
-        "governance",
-        "compliance"
-      ]
+    "selectedSAs": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
     },
-    "objectRetentionUnit": {
-      "type": "string",
-      "enum": [
-        "days",
-        "years"
-      ]
+    "selectedUsers": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
     },
-    "peerInfo": {
+    "serverDrives": {
       "type": "object",
       "properties": {
-        "deploymentID": {
+        "availableSpace": {
+          "type": "integer"
+        },
+        "drivePath": {
           "type": "string"
         },
         "endpoint": {
           "type": "string"
         },
-        "name": {
+        "healing": {
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "rootDisk": {
+          "type": "boolean"
+        },
+        "state": {
+          "type": "string"
+        },
+        "totalSpace": {
+          "type": "integer"
+        },
+        "usedSpace": {
+          "type": "integer"
+        },
+        "uuid": {
           "type": "string"
         }
       }
     },
-    "peerInfoRemove": {
+    "serverProperties": {
       "type": "object",
-      "required": [
-        "sites"
-      ],
       "properties": {
-        "all": {
-          "type": "boolean"
+        "commitID": {
+          "type": "string"
         },
-        "sites": {
+        "drives": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/serverDrives"
           }
-        }
-      }
-    },
-    "peerSite": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
         },
         "endpoint": {
           "type": "string"
         },
-        "name": {
+        "network": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "poolNumber": {
+          "type": "integer"
+        },
+        "state": {
           "type": "string"
         },
-        "secretKey": {
+        "uptime": {
+          "type": "integer"
+        },
+        "version": {
           "type": "string"
         }
       }
     },
-    "peerSiteEditResponse": {
+    "serviceAccount": {
       "type": "object",
       "properties": {
-        "errorDetail": {
+        "accountStatus": {
           "type": "string"
         },
-        "status": {
+        "description": {
           "type": "string"
         },
-        "success": {
+        "expiration": {
+          "type": "string"
+        },
+        "impliedPolicy": {
           "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
         }
       }
     },
-    "peerSiteRemoveResponse": {
+    "serviceAccountCreds": {
       "type": "object",
       "properties": {
-        "errorDetail": {
+        "accessKey": {
           "type": "string"
         },
-        "status": {
+        "secretKey": {
+          "type": "string"
+        },
+        "url": {
           "type": "string"
         }
       }
     },
-    "permissionResource": {
+    "serviceAccountHygieneItem": {
       "type": "object",
       "properties": {
-        "conditionOperator": {
+        "accessKey": {
           "type": "string"
         },
-        "prefixes": {
+        "accountStatus": {
+          "type": "string"
+        },
+        "details": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "resource": {
+        "expiration": {
+          "type": "string"
+        },
+        "findings": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "expired",
+              "expiringSoon",
+              "noExpiry",
+              "broaderThanParent"
+            ]
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "parentUser": {
           "type": "string"
         }
       }
     },
-    "policy": {
+    "serviceAccountHygieneReport": {
       "type": "object",
       "properties": {
-        "name": {
-          "type": "string"
+        "accounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountHygieneItem"
+          }
         },
-        "policy": {
-          "type": "string"
+        "expiringWithinDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "flagged": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "policyArgs": {
+    "serviceAccountRequest": {
       "type": "object",
       "properties": {
-        "action": {
+        "comment": {
           "type": "string"
         },
-        "bucket_name": {
+        "description": {
           "type": "string"
         },
-        "id": {
+        "expiry": {
           "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string",
+          "title": "policy to be applied to the Service Account if any"
         }
       }
     },
-    "policyEntity": {
-      "type": "string",
-      "default": "user",
-      "enum": [
-        "user",
-        "group"
-      ]
-    },
-    "prefixAccessPair": {
+    "serviceAccountRequestCreds": {
       "type": "object",
       "properties": {
-        "access": {
+        "accessKey": {
           "type": "string"
         },
-        "prefix": {
+        "comment": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "expiry": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string",
+          "title": "policy to be applied to the Service Account if any"
+        },
+        "secretKey": {
           "type": "string"
         }
       }
     },
-    "prefixWrapper": {
-      "type": "object",
-      "properties": {
-        "prefix": {
-          "type": "string"
+    "serviceAccounts": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "accessKey": {
+            "type": "string"
+          },
+          "accountStatus": {
+            "type": "string"
+          },
+          "description": {
+            "type": "string"
+          },
+          "expiration": {
+            "type": "string"
+          },
+          "name": {
+            "type": "string"
+          },
+          "usage": {
+            "$ref": "#/definitions/accessKeyUsage"
+          }
         }
       }
     },
-    "principal": {
+    "sessionResponse": {
       "type": "object",
       "properties": {
-        "STSAccessKeyID": {
-          "type": "string"
+        "allowResources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/permissionResource"
+          }
         },
-        "STSSecretAccessKey": {
+        "customStyles": {
           "type": "string"
         },
-        "STSSessionToken": {
-          "type": "string"
+        "distributedMode": {
+          "type": "boolean"
         },
-        "accountAccessKey": {
-          "type": "string"
+        "envConstants": {
+          "$ref": "#/definitions/environmentConstants"
         },
-        "customStyleOb": {
-          "type": "string"
+        "features": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "hm": {
+        "operator": {
           "type": "boolean"
         },
-        "ob": {
-          "type": "boolean"
+        "permissions": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "serverEndPoint": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "ok"
+          ]
         }
       }
     },
-    "profilingStartRequest": {
+    "setBucketPolicyRequest": {
       "type": "object",
       "required": [
-        "type"
+        "access"
       ],
       "properties": {
-        "type": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "definition": {
           "type": "string"
         }
       }
     },
-    "putBucketRetentionRequest": {
+    "setBucketQuota": {
       "type": "object",
       "required": [
-        "mode",
-        "unit",
-        "validity"
+        "enabled"
       ],
       "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
+        "amount": {
+          "type": "integer"
         },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
+        "enabled": {
+          "type": "boolean"
         },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+        "quota_type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
         }
       }
     },
-    "putBucketTagsRequest": {
+    "setBucketVersioning": {
       "type": "object",
       "properties": {
-        "tags": {
-          "additionalProperties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "excludeFolders": {
+          "type": "boolean"
+        },
+        "excludePrefixes": {
+          "type": "array",
+          "maxLength": 10,
+          "items": {
             "type": "string"
           }
         }
       }
     },
-    "putObjectLegalHoldRequest": {
+    "setConfigRequest": {
       "type": "object",
       "required": [
-        "status"
+        "key_values"
       ],
       "properties": {
-        "status": {
-          "$ref": "#/definitions/objectLegalHoldStatus"
+        "arn_resource_id": {
+          "type": "string",
+          "title": "Used if configuration is an event notification's target"
+        },
+        "key_values": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
         }
       }
     },
-    "putObjectRetentionRequest": {
+    "setConfigResponse": {
+      "type": "object",
+      "properties": {
+        "restart": {
+          "description": "Returns wheter server needs to restart to apply changes or not",
+          "type": "boolean"
+        }
+      }
+    },
+    "setIDPResponse": {
+      "type": "object",
+      "properties": {
+        "restart": {
+          "type": "boolean"
+        }
+      }
+    },
+    "setNotificationEndpointResponse": {
       "type": "object",
       "required": [
-        "mode",
-        "expires"
+        "service",
+        "account_id",
+        "properties"
       ],
       "properties": {
-        "expires": {
+        "account_id": {
           "type": "string"
         },
-        "governance_bypass": {
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "restart": {
           "type": "boolean"
         },
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "putObjectTagsRequest": {
+    "setPolicyMultipleNameRequest": {
       "type": "object",
       "properties": {
-        "tags": {
-          "additionalProperties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
+        },
+        "name": {
+          "type": "array",
+          "items": {
             "type": "string"
           }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
         }
       }
     },
-    "redirectRule": {
+    "setPolicyNameRequest": {
       "type": "object",
+      "required": [
+        "name",
+        "entityType",
+        "entityName"
+      ],
       "properties": {
-        "displayName": {
+        "entityName": {
           "type": "string"
         },
-        "redirect": {
-          "type": "string"
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
         },
-        "serviceType": {
-          "type": "string"
+        "name": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "releaseAuthor": {
+    "setPolicyRequest": {
       "type": "object",
+      "required": [
+        "entityType",
+        "entityName"
+      ],
       "properties": {
-        "avatar_url": {
-          "type": "string"
-        },
-        "events_url": {
-          "type": "string"
-        },
-        "followers_url": {
-          "type": "string"
-        },
-        "following_url": {
-          "type": "string"
-        },
-        "gists_url": {
-          "type": "string"
-        },
-        "gravatar_id": {
-          "type": "string"
-        },
-        "html_url": {
-          "type": "string"
-        },
-        "id": {
-          "type": "integer"
-        },
-        "login": {
-          "type": "string"
-        },
-        "node_id": {
-          "type": "string"
-        },
-        "organizations_url": {
-          "type": "string"
-        },
-        "receivedEvents_url": {
-          "type": "string"
-        },
-        "repos_url": {
-          "type": "string"
-        },
-        "site_admin": {
-          "type": "boolean"
-        },
-        "starred_url": {
-          "type": "string"
-        },
-        "subscriptions_url": {
-          "type": "string"
-        },
-        "type": {
+        "entityName": {
           "type": "string"
         },
-        "url": {
-          "type": "string"
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
         }
       }
     },
-    "releaseInfo": {
+    "siteReplicationAddRequest": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/peerSite"
+      }
+    },
+    "siteReplicationAddResponse": {
       "type": "object",
       "properties": {
-        "breakingChangesContent": {
-          "type": "string"
-        },
-        "contextContent": {
+        "errorDetail": {
           "type": "string"
         },
-        "metadata": {
-          "$ref": "#/definitions/releaseMetadata"
-        },
-        "newFeaturesContent": {
+        "initialSyncErrorMessage": {
           "type": "string"
         },
-        "notesContent": {
+        "status": {
           "type": "string"
         },
-        "securityContent": {
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationDiffEntry": {
+      "type": "object",
+      "properties": {
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "bucket",
+            "policy",
+            "user",
+            "group"
+          ]
+        },
+        "name": {
           "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationDiffSite"
+          }
         }
       }
     },
-    "releaseListResponse": {
+    "siteReplicationDiffResponse": {
       "type": "object",
       "properties": {
-        "results": {
+        "entries": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/releaseInfo"
+            "$ref": "#/definitions/siteReplicationDiffEntry"
           }
         }
       }
     },
-    "releaseMetadata": {
+    "siteReplicationDiffSite": {
       "type": "object",
       "properties": {
-        "assets_url": {
+        "deploymentId": {
           "type": "string"
         },
-        "author": {
-          "$ref": "#/definitions/releaseAuthor"
-        },
-        "created_at": {
-          "type": "string"
+        "fields": {
+          "description": "settings that differ from the other sites",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "draft": {
+        "missing": {
           "type": "boolean"
         },
-        "html_url": {
-          "type": "string"
-        },
-        "id": {
-          "type": "integer"
-        },
         "name": {
           "type": "string"
-        },
-        "node_id": {
-          "type": "string"
-        },
-        "prerelease": {
+        }
+      }
+    },
+    "siteReplicationInfoResponse": {
+      "type": "object",
+      "properties": {
+        "enabled": {
           "type": "boolean"
         },
-        "published_at": {
-          "type": "string"
-        },
-        "tag_name": {
-          "type": "string"
-        },
-        "tarball_url": {
-          "type": "string"
-        },
-        "target_commitish": {
-          "type": "string"
-        },
-        "upload_url": {
+        "name": {
           "type": "string"
         },
-        "url": {
+        "serviceAccountAccessKey": {
           "type": "string"
         },
-        "zipball_url": {
-          "type": "string"
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerInfo"
+          }
         }
       }
     },
-    "remoteBucket": {
+    "siteReplicationPeerHealth": {
       "type": "object",
-      "required": [
-        "accessKey",
-        "sourceBucket",
-        "remoteARN"
-      ],
       "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
+        "checkLatencyMs": {
+          "type": "number",
+          "format": "double"
         },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
+        "deploymentId": {
+          "type": "string"
         },
-        "healthCheckPeriod": {
-          "type": "integer"
+        "endpoint": {
+          "type": "string"
         },
-        "remoteARN": {
+        "error": {
           "type": "string"
         },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
+        "lastOnline": {
+          "type": "string"
         },
-        "service": {
-          "type": "string",
-          "enum": [
-            "replication"
-          ]
+        "latencyAverageMs": {
+          "type": "number",
+          "format": "double"
         },
-        "sourceBucket": {
-          "type": "string"
+        "latencyCurrentMs": {
+          "type": "number",
+          "format": "double"
         },
-        "status": {
-          "type": "string"
+        "latencyMaxMs": {
+          "type": "number",
+          "format": "double"
         },
-        "syncMode": {
+        "name": {
           "type": "string"
         },
-        "targetBucket": {
-          "type": "string"
+        "online": {
+          "description": "peer state as seen by MinIO",
+          "type": "boolean"
         },
-        "targetURL": {
-          "type": "string"
+        "reachable": {
+          "description": "whether Console could reach the peer liveness endpoint",
+          "type": "boolean"
+        },
+        "totalDowntime": {
+          "description": "seconds",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "resultTarget": {
+    "siteReplicationPeerHealthResponse": {
       "type": "object",
       "properties": {
-        "legendFormat": {
-          "type": "string"
-        },
-        "result": {
+        "peers": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/widgetResult"
+            "$ref": "#/definitions/siteReplicationPeerHealth"
           }
-        },
-        "resultType": {
-          "type": "string"
         }
       }
     },
-    "rewindItem": {
+    "siteReplicationResyncBucketStatus": {
       "type": "object",
       "properties": {
-        "action": {
-          "type": "string"
-        },
-        "delete_flag": {
-          "type": "boolean"
-        },
-        "is_latest": {
-          "type": "boolean"
-        },
-        "last_modified": {
+        "bucket": {
           "type": "string"
         },
-        "name": {
+        "errorDetail": {
           "type": "string"
         },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "version_id": {
+        "status": {
           "type": "string"
         }
       }
     },
-    "rewindResponse": {
+    "siteReplicationResyncRequest": {
       "type": "object",
+      "required": [
+        "deploymentId",
+        "op"
+      ],
       "properties": {
-        "objects": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/rewindItem"
-          }
+        "deploymentId": {
+          "type": "string"
+        },
+        "op": {
+          "type": "string",
+          "enum": [
+            "start",
+            "cancel"
+          ]
         }
       }
     },
-    "selectedSAs": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      }
-    },
-    "selectedUsers": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      }
-    },
-    "serverDrives": {
+    "siteReplicationResyncResponse": {
       "type": "object",
       "properties": {
-        "availableSpace": {
-          "type": "integer"
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationResyncBucketStatus"
+          }
         },
-        "drivePath": {
+        "errorDetail": {
           "type": "string"
         },
-        "endpoint": {
+        "op": {
           "type": "string"
         },
-        "healing": {
-          "type": "boolean"
+        "resyncId": {
+          "type": "string"
         },
-        "model": {
+        "status": {
           "type": "string"
+        }
+      }
+    },
+    "siteReplicationStatusResponse": {
+      "type": "object",
+      "properties": {
+        "bucketStats": {
+          "type": "object"
         },
-        "rootDisk": {
+        "enabled": {
           "type": "boolean"
         },
-        "state": {
-          "type": "string"
+        "groupStats": {
+          "type": "object"
         },
-        "totalSpace": {
+        "maxBuckets": {
           "type": "integer"
         },
-        "usedSpace": {
+        "maxGroups": {
           "type": "integer"
         },
-        "uuid": {
+        "maxPolicies": {
+          "type": "integer"
+        },
+        "maxUsers": {
+          "type": "integer"
+        },
+        "policyStats": {
+          "type": "object"
+        },
+        "sites": {
+          "type": "object"
+        },
+        "statsSummary": {
+          "type": "object"
+        },
+        "userStats": {
+          "type": "object"
+        }
+      }
+    },
+    "speedtestMetric": {
+      "type": "object",
+      "properties": {
+        "drive": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "node": {
           "type": "string"
+        },
+        "value": {
+          "type": "number",
+          "format": "double"
         }
       }
     },
-    "serverProperties": {
+    "speedtestResult": {
       "type": "object",
       "properties": {
-        "commitID": {
+        "endTime": {
           "type": "string"
         },
-        "drives": {
+        "error": {
+          "type": "string"
+        },
+        "errors": {
+          "description": "errors reported by individual nodes or drives",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/serverDrives"
+            "type": "string"
           }
         },
-        "endpoint": {
+        "id": {
           "type": "string"
         },
-        "network": {
+        "metrics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestMetric"
+          }
+        },
+        "parameters": {
           "type": "object",
           "additionalProperties": {
             "type": "string"
           }
         },
-        "poolNumber": {
-          "type": "integer"
+        "result": {
+          "description": "final result as returned by MinIO, only included when requesting a single run",
+          "type": "object"
         },
-        "state": {
+        "startTime": {
           "type": "string"
         },
-        "uptime": {
-          "type": "integer"
-        },
-        "version": {
-          "type": "string"
+        "type": {
+          "type": "string",
+          "enum": [
+            "object",
+            "drive",
+            "net",
+            "site"
+          ]
         }
       }
     },
-    "serviceAccount": {
+    "speedtestResultsResponse": {
       "type": "object",
       "properties": {
-        "accountStatus": {
-          "type": "string"
-        },
-        "description": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestResult"
+          }
+        }
+      }
+    },
+    "startProfilingItem": {
+      "type": "object",
+      "properties": {
+        "error": {
           "type": "string"
         },
-        "expiration": {
+        "nodeName": {
           "type": "string"
         },
-        "impliedPolicy": {
+        "success": {
           "type": "boolean"
-        },
-        "name": {
-          "type": "string"
-        },
-        "parentUser": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
         }
       }
     },
-    "serviceAccountCreds": {
+    "startProfilingList": {
       "type": "object",
       "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string"
+        "startResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/startProfilingItem"
+          }
         },
-        "url": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of start results"
         }
       }
     },
-    "serviceAccountRequest": {
+    "subnetLoginMFARequest": {
       "type": "object",
+      "required": [
+        "username",
+        "otp",
+        "mfa_token"
+      ],
       "properties": {
-        "comment": {
-          "type": "string"
-        },
-        "description": {
+        "mfa_token": {
           "type": "string"
         },
-        "expiry": {
+        "otp": {
           "type": "string"
         },
-        "name": {
+        "username": {
           "type": "string"
-        },
-        "policy": {
-          "type": "string",
-          "title": "policy to be applied to the Service Account if any"
         }
       }
     },
-    "serviceAccountRequestCreds": {
+    "subnetLoginRequest": {
       "type": "object",
       "properties": {
-        "accessKey": {
+        "apiKey": {
           "type": "string"
         },
-        "comment": {
+        "password": {
           "type": "string"
         },
-        "description": {
+        "username": {
           "type": "string"
-        },
-        "expiry": {
+        }
+      }
+    },
+    "subnetLoginResponse": {
+      "type": "object",
+      "properties": {
+        "access_token": {
           "type": "string"
         },
-        "name": {
+        "mfa_token": {
           "type": "string"
         },
-        "policy": {
-          "type": "string",
-          "title": "policy to be applied to the Service Account if any"
-        },
-        "secretKey": {
-          "type": "string"
-        }
-      }
-    },
-    "serviceAccounts": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "accessKey": {
-            "type": "string"
-          },
-          "accountStatus": {
-            "type": "string"
-          },
-          "description": {
-            "type": "string"
-          },
-          "expiration": {
-            "type": "string"
-          },
-          "name": {
-            "type": "string"
+        "organizations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/subnetOrganization"
           }
+        },
+        "registered": {
+          "type": "boolean"
         }
       }
     },
-    "sessionResponse": {
+    "subnetOrganization": {
       "type": "object",
       "properties": {
-        "allowResources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/permissionResource"
-          }
+        "accountId": {
+          "type": "integer"
         },
-        "customStyles": {
+        "company": {
           "type": "string"
         },
-        "distributedMode": {
-          "type": "boolean"
-        },
-        "envConstants": {
-          "$ref": "#/definitions/environmentConstants"
-        },
-        "features": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "operator": {
+        "isAccountOwner": {
           "type": "boolean"
         },
-        "permissions": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "array",
-            "items": {
-              "type": "string"
-            }
-          }
+        "shortName": {
+          "type": "string"
         },
-        "serverEndPoint": {
+        "subscriptionStatus": {
           "type": "string"
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "ok"
-          ]
+        "userId": {
+          "type": "integer"
         }
       }
     },
-    "setBucketPolicyRequest": {
+    "subnetRegisterRequest": {
       "type": "object",
       "required": [
-        "access"
+        "token",
+        "account_id"
       ],
       "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
+        "account_id": {
+          "type": "string"
         },
-        "definition": {
+        "token": {
           "type": "string"
         }
       }
     },
-    "setBucketQuota": {
+    "tier": {
       "type": "object",
-      "required": [
-        "enabled"
-      ],
       "properties": {
-        "amount": {
-          "type": "integer"
+        "azure": {
+          "type": "object",
+          "$ref": "#/definitions/tier_azure"
         },
-        "enabled": {
+        "gcs": {
+          "type": "object",
+          "$ref": "#/definitions/tier_gcs"
+        },
+        "minio": {
+          "type": "object",
+          "$ref": "#/definitions/tier_minio"
+        },
+        "s3": {
+          "type": "object",
+          "$ref": "#/definitions/tier_s3"
+        },
+        "status": {
           "type": "boolean"
         },
-        "quota_type": {
+        "type": {
           "type": "string",
           "enum": [
-            "hard"
+            "s3",
+            "gcs",
+            "azure",
+            "minio",
+            "unsupported"
           ]
         }
       }
     },
-    "setBucketVersioning": {
+    "tierBucketUsage": {
       "type": "object",
       "properties": {
-        "enabled": {
+        "attributed": {
+          "description": "the tier usage is attributed to the bucket, only possible when it's the only bucket transitioning to the tier",
           "type": "boolean"
         },
-        "excludeFolders": {
-          "type": "boolean"
+        "bucket": {
+          "type": "string"
         },
-        "excludePrefixes": {
-          "type": "array",
-          "maxLength": 10,
-          "items": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "setConfigRequest": {
-      "type": "object",
-      "required": [
-        "key_values"
-      ],
-      "properties": {
-        "arn_resource_id": {
-          "type": "string",
-          "title": "Used if configuration is an event notification's target"
+        "objects": {
+          "type": "integer",
+          "format": "int64"
         },
-        "key_values": {
+        "rules": {
           "type": "array",
-          "minItems": 1,
           "items": {
-            "$ref": "#/definitions/configurationKV"
+            "$ref": "#/definitions/tierLifecycleRule"
           }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "setConfigResponse": {
+    "tierCredentialsRequest": {
       "type": "object",
       "properties": {
-        "restart": {
-          "description": "Returns wheter server needs to restart to apply changes or not",
-          "type": "boolean"
+        "access_key": {
+          "type": "string"
+        },
+        "creds": {
+          "description": "a base64 encoded value",
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
         }
       }
     },
-    "setIDPResponse": {
+    "tierLifecycleRule": {
       "type": "object",
       "properties": {
-        "restart": {
+        "id": {
+          "type": "string"
+        },
+        "noncurrent": {
+          "description": "the rule transitions noncurrent versions",
           "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
         }
       }
     },
-    "setNotificationEndpointResponse": {
+    "tierListResponse": {
       "type": "object",
-      "required": [
-        "service",
-        "account_id",
-        "properties"
-      ],
       "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tier"
           }
-        },
-        "restart": {
-          "type": "boolean"
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "setPolicyMultipleNameRequest": {
+    "tierUsage": {
       "type": "object",
       "properties": {
-        "groups": {
+        "buckets": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/iamEntity"
+            "$ref": "#/definitions/tierBucketUsage"
           }
         },
+        "dailyObjects": {
+          "description": "objects transitioned during the last 24 hours",
+          "type": "integer",
+          "format": "int64"
+        },
+        "dailySize": {
+          "description": "bytes transitioned during the last 24 hours",
+          "type": "integer",
+          "format": "int64"
+        },
         "name": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+          "type": "string"
         },
-        "users": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/iamEntity"
-          }
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "setPolicyNameRequest": {
+    "tierUsageResponse": {
       "type": "object",
-      "required": [
-        "name",
-        "entityType",
-        "entityName"
-      ],
       "properties": {
-        "entityName": {
-          "type": "string"
-        },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
-        },
-        "name": {
+        "tiers": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/tierUsage"
           }
         }
       }
     },
-    "setPolicyRequest": {
+    "tierVerifyResponse": {
       "type": "object",
-      "required": [
-        "entityType",
-        "entityName"
-      ],
       "properties": {
-        "entityName": {
+        "error": {
           "type": "string"
         },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "boolean"
         }
       }
     },
-    "siteReplicationAddRequest": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/peerSite"
-      }
-    },
-    "siteReplicationAddResponse": {
+    "tier_azure": {
       "type": "object",
       "properties": {
-        "errorDetail": {
+        "accountkey": {
           "type": "string"
         },
-        "initialSyncErrorMessage": {
+        "accountname": {
           "type": "string"
         },
-        "status": {
+        "bucket": {
           "type": "string"
         },
-        "success": {
-          "type": "boolean"
-        }
-      }
-    },
-    "siteReplicationInfoResponse": {
-      "type": "object",
-      "properties": {
-        "enabled": {
-          "type": "boolean"
+        "endpoint": {
+          "type": "string"
         },
         "name": {
           "type": "string"
         },
-        "serviceAccountAccessKey": {
+        "objects": {
           "type": "string"
         },
-        "sites": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/peerInfo"
-          }
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "string"
         }
       }
     },
-    "siteReplicationStatusResponse": {
+    "tier_gcs": {
       "type": "object",
       "properties": {
-        "bucketStats": {
-          "type": "object"
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "groupStats": {
-          "type": "object"
+        "bucket": {
+          "type": "string"
         },
-        "maxBuckets": {
-          "type": "integer"
+        "creds": {
+          "type": "string"
         },
-        "maxGroups": {
-          "type": "integer"
+        "endpoint": {
+          "type": "string"
         },
-        "maxPolicies": {
-          "type": "integer"
+        "name": {
+          "type": "string"
         },
-        "maxUsers": {
-          "type": "integer"
+        "objects": {
+          "type": "string"
         },
-        "policyStats": {
-          "type": "object"
+        "prefix": {
+          "type": "string"
         },
-        "sites": {
-          "type": "object"
+        "region": {
+          "type": "string"
         },
-        "statsSummary": {
-          "type": "object"
+        "usage": {
+          "type": "string"
         },
-        "userStats": {
-          "type": "object"
+        "versions": {
+          "type": "string"
         }
       }
     },
-    "startProfilingItem": {
+    "tier_minio": {
       "type": "object",
       "properties": {
-        "error": {
+        "accesskey": {
           "type": "string"
         },
-        "nodeName": {
+        "bucket": {
           "type": "string"
         },
-        "success": {
-          "type": "boolean"
-        }
-      }
-    },
-    "startProfilingList": {
-      "type": "object",
-      "properties": {
-        "startResults": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/startProfilingItem"
-          }
+        "endpoint": {
+          "type": "string"
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of start results"
-        }
-      }
-    },
-    "subnetLoginMFARequest": {
-      "type": "object",
-      "required": [
-        "username",
-        "otp",
-        "mfa_token"
-      ],
-      "properties": {
-        "mfa_token": {
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretkey": {
           "type": "string"
         },
-        "otp": {
+        "storageclass": {
           "type": "string"
         },
-        "username": {
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
           "type": "string"
         }
       }
     },
-    "subnetLoginRequest": {
+    "tier_s3": {
       "type": "object",
       "properties": {
-        "apiKey": {
+        "accesskey": {
           "type": "string"
         },
-        "password": {
+        "bucket": {
           "type": "string"
         },
-        "username": {
-          "type": "string"
-        }
-      }
-    },
-    "subnetLoginResponse": {
-      "type": "object",
-      "properties": {
-        "access_token": {
+        "endpoint": {
           "type": "string"
         },
-        "mfa_token": {
+        "name": {
           "type": "string"
         },
-        "organizations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/subnetOrganization"
-          }
+        "objects": {
+          "type": "string"
         },
-        "registered": {
-          "type": "boolean"
-        }
-      }
-    },
-    "subnetOrganization": {
-      "type": "object",
-      "properties": {
-        "accountId": {
-          "type": "integer"
+        "prefix": {
+          "type": "string"
         },
-        "company": {
+        "region": {
           "type": "string"
         },
-        "isAccountOwner": {
-          "type": "boolean"
+        "secretkey": {
+          "type": "string"
         },
-        "shortName": {
+        "storageclass": {
           "type": "string"
         },
-        "subscriptionStatus": {
+        "usage": {
           "type": "string"
         },
-        "userId": {
-          "type": "integer"
+        "versions": {
+          "type": "string"
         }
       }
     },
-    "subnetRegisterRequest": {
+    "transitionResponse": {
       "type": "object",
-      "required": [
-        "token",
-        "account_id"
-      ],
       "properties": {
-        "account_id": {
+        "date": {
           "type": "string"
         },
-        "token": {
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_storage_class": {
+          "type": "string"
+        },
+        "noncurrent_transition_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
           "type": "string"
         }
       }
     },
-    "tier": {
+    "updateBucketLifecycle": {
       "type": "object",
+      "required": [
+        "type"
+      ],
       "properties": {
-        "azure": {
-          "type": "object",
-          "$ref": "#/definitions/tier_azure"
+        "disable": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
         },
-        "gcs": {
-          "type": "object",
-          "$ref": "#/definitions/tier_gcs"
+        "expired_object_delete_all": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
         },
-        "minio": {
-          "type": "object",
-          "$ref": "#/definitions/tier_minio"
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
         },
-        "s3": {
-          "type": "object",
-          "$ref": "#/definitions/tier_s3"
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
         },
-        "status": {
-          "type": "boolean"
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
         },
-        "type": {
-          "type": "string",
-          "enum": [
-            "s3",
-            "gcs",
-            "azure",
-            "minio",
-            "unsupported"
-          ]
-        }
-      }
-    },
-    "tierCredentialsRequest": {
-      "type": "object",
-      "properties": {
-        "access_key": {
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
           "type": "string"
         },
-        "creds": {
-          "description": "a base64 encoded value",
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
           "type": "string"
         },
-        "secret_key": {
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files",
           "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
+          "type": "string",
+          "enum": [
+            "expiry",
+            "transition"
+          ]
         }
       }
     },
-    "tierListResponse": {
+    "updateGroupRequest": {
       "type": "object",
+      "required": [
+        "members",
+        "status"
+      ],
       "properties": {
-        "items": {
+        "members": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/tier"
+            "type": "string"
           }
+        },
+        "status": {
+          "type": "string"
         }
       }
     },
-    "tier_azure": {
+    "updateServiceAccountRequest": {
       "type": "object",
+      "required": [
+        "policy"
+      ],
       "properties": {
-        "accountkey": {
-          "type": "string"
-        },
-        "accountname": {
-          "type": "string"
-        },
-        "bucket": {
+        "description": {
           "type": "string"
         },
-        "endpoint": {
+        "expiry": {
           "type": "string"
         },
         "name": {
           "type": "string"
         },
-        "objects": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
+        "policy": {
           "type": "string"
         },
-        "usage": {
+        "secretKey": {
           "type": "string"
         },
-        "versions": {
+        "status": {
           "type": "string"
         }
       }
     },
-    "tier_gcs": {
+    "updateUser": {
       "type": "object",
+      "required": [
+        "status",
+        "groups"
+      ],
       "properties": {
-        "bucket": {
-          "type": "string"
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "creds": {
+        "status": {
           "type": "string"
-        },
-        "endpoint": {
+        }
+      }
+    },
+    "updateUserGroups": {
+      "type": "object",
+      "required": [
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
           "type": "string"
         },
-        "name": {
-          "type": "string"
+        "hasPolicy": {
+          "type": "boolean"
         },
-        "objects": {
-          "type": "string"
+        "memberOf": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "prefix": {
-          "type": "string"
+        "policy": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "region": {
+        "status": {
           "type": "string"
         },
         "usage": {
-          "type": "string"
-        },
-        "versions": {
-          "type": "string"
+          "$ref": "#/definitions/accessKeyUsage"
         }
       }
     },
-    "tier_minio": {
+    "userSAs": {
       "type": "object",
       "properties": {
-        "accesskey": {
-          "type": "string"
-        },
-        "bucket": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "objects": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
-          "type": "string"
-        },
-        "secretkey": {
+        "path": {
           "type": "string"
         },
-        "storageclass": {
-          "type": "string"
+        "recursive": {
+          "type": "boolean"
         },
-        "usage": {
+        "versionID": {
           "type": "string"
+        }
+      }
+    },
+    "userServiceAccountItem": {
+      "type": "object",
+      "properties": {
+        "numSAs": {
+          "type": "integer",
+          "format": "int64"
         },
-        "versions": {
+        "userName": {
           "type": "string"
         }
       }
     },
-    "tier_s3": {
+    "userServiceAccountSummary": {
       "type": "object",
       "properties": {
-        "accesskey": {
-          "type": "string"
+        "hasSA": {
+          "type": "boolean"
         },
-        "bucket": {
-          "type": "string"
+        "userServiceAccountList": {
+          "type": "array",
+          "title": "list of users with number of service accounts",
+          "items": {
+            "$ref": "#/definitions/userServiceAccountItem"
+          }
+        }
+      }
+    },
+    "widget": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int32"
         },
-        "endpoint": {
-          "type": "string"
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
         },
-        "name": {
-          "type": "string"
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resultTarget"
+          }
         },
-        "objects": {
+        "title": {
           "type": "string"
         },
-        "prefix": {
+        "type": {
           "type": "string"
+        }
+      }
+    },
+    "widgetDetails": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int32"
         },
-        "region": {
-          "type": "string"
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
         },
-        "secretkey": {
-          "type": "string"
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resultTarget"
+          }
         },
-        "storageclass": {
+        "title": {
           "type": "string"
         },
-        "usage": {
+        "type": {
           "type": "string"
+        }
+      }
+    },
+    "widgetResult": {
+      "type": "object",
+      "properties": {
+        "metric": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         },
-        "versions": {
-          "type": "string"
+        "values": {
+          "type": "array",
+          "items": {}
+        }
+      }
+    }
+  },
+  "parameters": {
+    "limit": {
+      "type": "number",
+      "format": "int32",
+      "default": 20,
+      "name": "limit",
+      "in": "query"
+    },
+    "offset": {
+      "type": "number",
+      "format": "int32",
+      "default": 0,
+      "name": "offset",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "anonymous": {
+      "type": "apiKey",
+      "name": "X-Anonymous",
+      "in": "header"
+    },
+    "key": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://min.io",
+      "tokenUrl": "http://min.io"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "ws"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "MinIO Console Server",
+    "version": "0.1.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/access-keys/cleanup-inactive": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Disable or delete the users and service accounts unused for a number of days",
+        "operationId": "CleanupInactiveAccessKeys",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/inactiveAccessKeysCleanupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/inactiveAccessKeysCleanupResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/account/change-password": {
+      "post": {
+        "tags": [
+          "Account"
+        ],
+        "summary": "Change password of currently logged in user.",
+        "operationId": "AccountChangePassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/accountChangePasswordRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "transitionResponse": {
-      "type": "object",
-      "properties": {
-        "date": {
-          "type": "string"
-        },
-        "days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "noncurrent_storage_class": {
-          "type": "string"
-        },
-        "noncurrent_transition_days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "storage_class": {
-          "type": "string"
+    "/account/change-user-password": {
+      "post": {
+        "tags": [
+          "Account"
+        ],
+        "summary": "Change password of currently logged in user.",
+        "operationId": "ChangeUserPassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/changeUserPasswordRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Password successfully changed."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "updateBucketLifecycle": {
-      "type": "object",
-      "required": [
-        "type"
-      ],
-      "properties": {
-        "disable": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_all": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
-        },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
-          "type": "string"
-        },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
-          "type": "string"
-        },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
-          "type": "string"
-        },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "type": {
-          "description": "ILM Rule type (Expiry or transition)",
-          "type": "string",
-          "enum": [
-            "expiry",
-            "transition"
-          ]
+    "/admin/alerts/channels": {
+      "get": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Returns the configured notification channels",
+        "operationId": "ListAlertChannels",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertChannelListResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Creates a new notification channel",
+        "operationId": "CreateAlertChannel",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertChannel"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertChannel"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "updateGroupRequest": {
-      "type": "object",
-      "required": [
-        "members",
-        "status"
-      ],
-      "properties": {
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/admin/alerts/channels/{id}": {
+      "put": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Updates a notification channel",
+        "operationId": "UpdateAlertChannel",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertChannel"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertChannel"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Deletes a notification channel",
+        "operationId": "DeleteAlertChannel",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "updateServiceAccountRequest": {
-      "type": "object",
-      "required": [
-        "policy"
-      ],
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "expiry": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
+    "/admin/alerts/channels/{id}/test": {
+      "post": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Sends a test notification through a notification channel",
+        "operationId": "TestAlertChannel",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "updateUser": {
-      "type": "object",
-      "required": [
-        "status",
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/admin/alerts/evaluate": {
+      "post": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Evaluates all the enabled alert rules and returns the alerts that changed state",
+        "operationId": "EvaluateAlertRules",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertHistoryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "updateUserGroups": {
-      "type": "object",
-      "required": [
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/admin/alerts/history": {
+      "get": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Returns the history of fired and resolved alerts, newest first",
+        "operationId": "ListAlertHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "ruleId",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "firing",
+              "resolved"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 100,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertHistoryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "user": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "hasPolicy": {
-          "type": "boolean"
-        },
-        "memberOf": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/admin/alerts/rules": {
+      "get": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Returns the configured alert rules",
+        "operationId": "ListAlertRules",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRuleListResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "policy": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        }
+      },
+      "post": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Creates a new alert rule",
+        "operationId": "CreateAlertRule",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "userSAs": {
-      "type": "object",
-      "properties": {
-        "path": {
-          "type": "string"
-        },
-        "recursive": {
-          "type": "boolean"
-        },
-        "versionID": {
-          "type": "string"
+    "/admin/alerts/rules/{id}": {
+      "get": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Returns an alert rule",
+        "operationId": "GetAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Updates an alert rule",
+        "operationId": "UpdateAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Deletes an alert rule",
+        "operationId": "DeleteAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "userServiceAccountItem": {
-      "type": "object",
-      "properties": {
-        "numSAs": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "userName": {
-          "type": "string"
+    "/admin/alerts/silences": {
+      "get": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Returns the active alert silences",
+        "operationId": "ListAlertSilences",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertSilenceListResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
-      }
-    },
-    "userServiceAccountSummary": {
-      "type": "object",
-      "properties": {
-        "hasSA": {
-          "type": "boolean"
-        },
-        "userServiceAccountList": {
-          "type": "array",
-          "title": "list of users with number of service accounts",
-          "items": {
-            "$ref": "#/definitions/userServiceAccountItem"
+      },
+      "post": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Silences notifications for a rule or a subject for a period of time",
+        "operationId": "CreateAlertSilence",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertSilenceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertSilence"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "widget": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "options": {
-          "type": "object",
-          "properties": {
-            "reduceOptions": {
-              "type": "object",
-              "properties": {
-                "calcs": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
-            }
+    "/admin/alerts/silences/{id}": {
+      "delete": {
+        "tags": [
+          "Alerts"
+        ],
+        "summary": "Removes an alert silence",
+        "operationId": "DeleteAlertSilence",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
-        },
-        "targets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resultTarget"
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "title": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
         }
       }
     },
-    "widgetDetails": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "options": {
-          "type": "object",
-          "properties": {
-            "reduceOptions": {
-              "type": "object",
-              "properties": {
-                "calcs": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+    "/admin/arns": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/arnsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
             }
           }
-        },
-        "targets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resultTarget"
-          }
-        },
-        "title": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
         }
       }
     },
-    "widgetResult": {
-      "type": "object",
-      "properties": {
-        "metric": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/admin/certificates": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns the certificates known to Console with their expiration",
+        "operationId": "ListCertificates",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/certificatesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "values": {
-          "type": "array",
-          "items": {}
         }
       }
-    }
-  },
-  "parameters": {
-    "limit": {
-      "type": "number",
-      "format": "int32",
-      "default": 20,
-      "name": "limit",
-      "in": "query"
-    },
-    "offset": {
-      "type": "number",
-      "format": "int32",
-      "default": 0,
-      "name": "offset",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "anonymous": {
-      "type": "apiKey",
-      "name": "X-Anonymous",
-      "in": "header"
     },
-    "key": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://min.io",
-      "tokenUrl": "http://min.io"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "ws"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "MinIO Console Server",
-    "version": "0.1.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/account/change-password": {
+    "/admin/dashboards": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns the dashboards owned by or shared with the current user",
+        "operationId": "ListDashboards",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardListResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Creates a new dashboard owned by the current user",
+        "operationId": "CreateDashboard",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboards/import": {
       "post": {
         "tags": [
-          "Account"
+          "System"
         ],
-        "summary": "Change password of currently logged in user.",
-        "operationId": "AccountChangePassword",
+        "summary": "Imports a previously exported dashboard as a new dashboard owned by the current user",
+        "operationId": "ImportDashboard",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/accountChangePasswordRequest"
+              "$ref": "#/definitions/dashboard"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9223,26 +13748,90 @@ func init() {
         }
       }
     },
-    "/account/change-user-password": {
-      "post": {
+    "/admin/dashboards/{id}": {
+      "get": {
         "tags": [
-          "Account"
+          "System"
         ],
-        "summary": "Change password of currently logged in user.",
-        "operationId": "ChangeUserPassword",
+        "summary": "Returns a dashboard",
+        "operationId": "GetDashboard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Updates a dashboard owned by the current user",
+        "operationId": "UpdateDashboard",
         "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/changeUserPasswordRequest"
+              "$ref": "#/definitions/dashboardRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Password successfully changed."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Deletes a dashboard owned by the current user",
+        "operationId": "DeleteDashboard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9253,18 +13842,80 @@ func init() {
         }
       }
     },
-    "/admin/arns": {
+    "/admin/dashboards/{id}/export": {
       "get": {
         "tags": [
           "System"
         ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
+        "summary": "Exports a dashboard so it can be imported on another deployment",
+        "operationId": "ExportDashboard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/arnsResponse"
+              "$ref": "#/definitions/dashboard"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboards/{id}/widgets/{widgetId}": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns the results of a dashboard widget",
+        "operationId": "DashboardCustomWidgetDetails",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/widgetDetails"
             }
           },
           "default": {
@@ -9454,6 +14105,36 @@ func init() {
         }
       }
     },
+    "/admin/replication/metrics": {
+      "get": {
+        "tags": [
+          "Replication"
+        ],
+        "summary": "Returns replication metrics and backlog history for every bucket remote target and site replication peer",
+        "operationId": "GetReplicationMetrics",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationMetricsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/admin/site-replication": {
       "get": {
         "tags": [
@@ -9527,7 +14208,104 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationAddResponse"
+              "$ref": "#/definitions/siteReplicationAddResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Remove a Replication Site",
+        "operationId": "SiteReplicationRemove",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/peerInfoRemove"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/peerSiteRemoveResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/site-replication/diff": {
+      "get": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "List the buckets, policies, users and groups out of sync across sites",
+        "operationId": "GetSiteReplicationDiff",
+        "parameters": [
+          {
+            "enum": [
+              "bucket",
+              "policy",
+              "user",
+              "group"
+            ],
+            "type": "string",
+            "description": "Only report entities of this type",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only report this entity, requires entityType",
+            "name": "entityValue",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationDiffResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/site-replication/health": {
+      "get": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Check reachability and latency of every site replication peer",
+        "operationId": "GetSiteReplicationPeerHealth",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationPeerHealthResponse"
             }
           },
           "default": {
@@ -9537,28 +14315,30 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/site-replication/resync": {
+      "post": {
         "tags": [
           "SiteReplication"
         ],
-        "summary": "Remove a Replication Site",
-        "operationId": "SiteReplicationRemove",
+        "summary": "Start or cancel a site replication resync to a peer site",
+        "operationId": "SiteReplicationResync",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/peerInfoRemove"
+              "$ref": "#/definitions/siteReplicationResyncRequest"
             }
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/peerSiteRemoveResponse"
+              "$ref": "#/definitions/siteReplicationResyncResponse"
             }
           },
           "default": {
@@ -9635,6 +14415,148 @@ func init() {
         }
       }
     },
+    "/admin/speedtest/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Export the speedtest history as JSON or CSV",
+        "operationId": "ExportSpeedtestResults",
+        "parameters": [
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "default": "json",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "object",
+              "drive",
+              "net",
+              "site"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/speedtest/results": {
+      "get": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "List previous speedtest runs, newest first",
+        "operationId": "ListSpeedtestResults",
+        "parameters": [
+          {
+            "enum": [
+              "object",
+              "drive",
+              "net",
+              "site"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestResultsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/speedtest/results/{id}": {
+      "get": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Returns a speedtest run including the raw result",
+        "operationId": "GetSpeedtestResult",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Removes a speedtest run from the history",
+        "operationId": "DeleteSpeedtestResult",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/admin/tiers": {
       "get": {
         "tags": [
@@ -9686,6 +14608,88 @@ func init() {
         }
       }
     },
+    "/admin/tiers/usage": {
+      "get": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Returns the data transitioned to each tier and the bucket lifecycle rules targeting it",
+        "operationId": "TiersUsage",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tierUsageResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers/{name}": {
+      "delete": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Remove Tier",
+        "operationId": "RemoveTier",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers/{name}/verify": {
+      "post": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Verify Tier",
+        "operationId": "VerifyTier",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tierVerifyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/admin/tiers/{type}/{name}": {
       "get": {
         "tags": [
@@ -9844,21 +14848,86 @@ func init() {
             "in": "query"
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/bucket/{bucket}/access-matrix": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Effective permissions of every user, group and service account on a bucket, per prefix",
+        "operationId": "GetBucketAccessMatrix",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketAccessMatrix"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/bucket/{bucket}/access-matrix/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Export the effective permissions on a bucket as CSV",
+        "operationId": "ExportBucketAccessMatrix",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "type": "file"
             }
           },
           "default": {
@@ -10801,6 +15870,70 @@ func init() {
             "default": "",
             "name": "override_file_name",
             "in": "query"
+          },
+          {
+            "enum": [
+              "zip-deflate",
+              "zip-store",
+              "tar",
+              "tar.gz",
+              "tar.zst"
+            ],
+            "type": "string",
+            "default": "zip-deflate",
+            "description": "archive format used when downloading multiple objects or a folder",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/download-archive": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Download objects, optionally selecting their version, as a single archive",
+        "operationId": "DownloadObjectsArchive",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/downloadArchiveRequest"
+            }
           }
         ],
         "responses": {
@@ -10854,6 +15987,20 @@ func init() {
                 "type": "string"
               }
             }
+          },
+          {
+            "enum": [
+              "zip-deflate",
+              "zip-store",
+              "tar",
+              "tar.gz",
+              "tar.zst"
+            ],
+            "type": "string",
+            "default": "zip-deflate",
+            "description": "archive format used when downloading multiple objects or a folder",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
@@ -11179,23 +16326,212 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
-      "post": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "consumes": [
-          "multipart/form-data"
-        ],
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Uploads an Object.",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/versions": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Lists the version history of an object",
+        "operationId": "GetObjectVersionHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectVersionHistory"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/versions/diff": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Returns a unified diff between two versions of a text object",
+        "operationId": "GetObjectVersionDiff",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "from_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "defaults to the latest version",
+            "name": "to_version",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectVersionDiff"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/versions/purge": {
+      "post": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Purges old object versions under a prefix",
+        "operationId": "PurgeObjectVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/objectVersionPurgeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectVersionPurgeResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication-resync": {
+      "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Uploads an Object.",
+        "summary": "Returns the replication resync progress to every remote target of a bucket",
+        "operationId": "GetBucketReplicationResyncStatus",
         "parameters": [
           {
             "type": "string",
@@ -11205,13 +16541,16 @@ func init() {
           },
           {
             "type": "string",
-            "name": "prefix",
+            "name": "arn",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationResyncResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -11220,28 +16559,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/replication": {
-      "get": {
+      },
+      "post": {
+        "description": "A started resync runs until it completes, canceling a bucket replication resync is not supported.",
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplication",
+        "summary": "Starts replicating again the existing objects of a bucket to a remote target",
+        "operationId": "StartBucketReplicationResync",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/replicationResyncRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationResponse"
+              "$ref": "#/definitions/replicationResyncResponse"
             }
           },
           "default": {
@@ -12089,6 +17435,39 @@ func init() {
         }
       }
     },
+    "/iam/change-set": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Apply a list of IAM operations as a single unit, rolling back the applied ones on failure",
+        "operationId": "ApplyIAMChangeSet",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/iamChangeSet"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/iamChangeSetResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/idp/{type}": {
       "get": {
         "tags": [
@@ -12835,6 +18214,146 @@ func init() {
         }
       }
     },
+    "/ldap-directory/groups": {
+      "get": {
+        "tags": [
+          "idp"
+        ],
+        "summary": "Search the LDAP directory for groups",
+        "operationId": "SearchLDAPGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "part of the name to look for, everything when empty",
+            "name": "query",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapDirectorySearchResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/ldap-directory/policies": {
+      "post": {
+        "tags": [
+          "idp"
+        ],
+        "summary": "Attach policies to an LDAP user or group",
+        "operationId": "AttachLDAPPolicies",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ldapPolicyMappingRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapPolicyMappingResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/ldap-directory/user-groups": {
+      "get": {
+        "tags": [
+          "idp"
+        ],
+        "summary": "Groups an LDAP user is a member of and the policies mapped to them",
+        "operationId": "GetLDAPUserGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "dn",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapUserGroupsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/ldap-directory/users": {
+      "get": {
+        "tags": [
+          "idp"
+        ],
+        "summary": "Search the LDAP directory for users",
+        "operationId": "SearchLDAPUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "part of the name to look for, everything when empty",
+            "name": "query",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapDirectorySearchResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/ldap-entities": {
       "post": {
         "tags": [
@@ -13095,10 +18614,80 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/policies": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
+        "parameters": [
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
             }
           },
           "default": {
@@ -13110,34 +18699,18 @@ func init() {
         }
       }
     },
-    "/policies": {
+    "/policies/lint": {
       "get": {
         "tags": [
           "Policy"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
-        "parameters": [
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
-          }
-        ],
+        "summary": "Lint every policy for security issues",
+        "operationId": "LintPolicies",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/policyLintReport"
             }
           },
           "default": {
@@ -13152,8 +18725,8 @@ func init() {
         "tags": [
           "Policy"
         ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
+        "summary": "Lint a policy document before saving it",
+        "operationId": "LintPolicy",
         "parameters": [
           {
             "name": "body",
@@ -13165,10 +18738,10 @@ func init() {
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "$ref": "#/definitions/policyLintReport"
             }
           },
           "default": {
@@ -13305,6 +18878,125 @@ func init() {
         }
       }
     },
+    "/policy/{name}/revisions": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List the revisions kept for a policy, newest first",
+        "operationId": "ListPolicyRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPolicyRevisionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/policy/{name}/revisions/diff": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Compare two revisions of a policy statement by statement",
+        "operationId": "DiffPolicyRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "to",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyRevisionDiff"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/policy/{name}/revisions/{revision}/rollback": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Restore a policy to one of its revisions",
+        "operationId": "RollbackPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "revision",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rollbackPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/profiling/start": {
       "post": {
         "tags": [
@@ -13663,6 +19355,39 @@ func init() {
         }
       }
     },
+    "/service-accounts/hygiene": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Report service accounts that are expired, about to expire, never expire or have a policy broader than their parent",
+        "operationId": "GetServiceAccountHygieneReport",
+        "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int32",
+            "default": 7,
+            "name": "expiringWithinDays",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountHygieneReport"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/service-accounts/{access_key}": {
       "get": {
         "tags": [
@@ -13754,6 +19479,45 @@ func init() {
         }
       }
     },
+    "/service-accounts/{access_key}/rotate": {
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Replace a service account with a new credential and expire the old one after a grace period",
+        "operationId": "RotateServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rotateServiceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/rotateServiceAccountResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/service/restart": {
       "post": {
         "tags": [
@@ -14445,26 +20209,107 @@ func init() {
         }
       }
     },
-    "/users-groups-bulk": {
-      "put": {
+    "/users-groups-bulk": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "User"
+        ],
+        "summary": "Export all users with their groups, policies and status as JSON or CSV",
+        "operationId": "ExportUsers",
+        "parameters": [
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "default": "json",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users/import": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "User"
         ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
+        "summary": "Create users in bulk from a CSV or JSON file",
+        "operationId": "ImportUsers",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
-            }
+            "type": "file",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/importUsersResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -14613,6 +20458,33 @@ func init() {
         }
       }
     },
+    "DashboardWidgetOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "type": "object",
+          "properties": {
+            "calcs": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "DashboardWidgetOptionsReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "LoginRequestFeatures": {
       "type": "object",
       "properties": {
@@ -14638,6 +20510,9 @@ func init() {
         },
         "name": {
           "type": "string"
+        },
+        "usage": {
+          "$ref": "#/definitions/accessKeyUsage"
         }
       }
     },
@@ -14711,6 +20586,33 @@ func init() {
         }
       }
     },
+    "accessKeyUsage": {
+      "type": "object",
+      "properties": {
+        "apiCalls": {
+          "type": "object",
+          "title": "number of requests per API",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "lastUsed": {
+          "type": "string"
+        },
+        "requests": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sourceIPs": {
+          "type": "array",
+          "title": "latest addresses the access key was used from, most recent first",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "accessRule": {
       "type": "object",
       "properties": {
@@ -14915,6 +20817,10 @@ func init() {
         "policy"
       ],
       "properties": {
+        "comment": {
+          "type": "string",
+          "title": "kept with the policy revision when policy history is enabled"
+        },
         "name": {
           "type": "string"
         },
@@ -14960,7 +20866,8 @@ func init() {
           "enum": [
             "not configured",
             "available",
-            "unavailable"
+            "unavailable",
+            "realtime"
           ]
         },
         "backend": {
@@ -14969,23 +20876,269 @@ func init() {
         "buckets": {
           "type": "integer"
         },
-        "objects": {
-          "type": "integer"
+        "objects": {
+          "type": "integer"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverProperties"
+          }
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
+          }
+        }
+      }
+    },
+    "alertChannel": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "authToken": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "smtpFrom": {
+          "type": "string"
+        },
+        "smtpPassword": {
+          "type": "string"
+        },
+        "smtpServer": {
+          "type": "string"
+        },
+        "smtpTo": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "smtpUsername": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "smtp",
+            "slack"
+          ]
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "alertChannelListResponse": {
+      "type": "object",
+      "properties": {
+        "channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertChannel"
+          }
+        }
+      }
+    },
+    "alertEvent": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "notificationErrors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "notified": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "ruleName": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "silenced": {
+          "type": "boolean"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "firing",
+            "resolved"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "alertHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertEvent"
+          }
+        }
+      }
+    },
+    "alertRule": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "channels": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "repeatInterval": {
+          "description": "seconds to wait before notifying again about an alert that is still firing",
+          "type": "integer",
+          "format": "int64"
+        },
+        "severity": {
+          "type": "string",
+          "default": "warning",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "threshold": {
+          "type": "number",
+          "format": "double"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "drives_offline",
+            "bucket_usage",
+            "replication_backlog",
+            "kms_status",
+            "tier_verification",
+            "certificate_expiry"
+          ]
+        }
+      }
+    },
+    "alertRuleListResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
+          }
+        }
+      }
+    },
+    "alertSilence": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "ruleId": {
+          "type": "string"
         },
-        "servers": {
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
+    "alertSilenceListResponse": {
+      "type": "object",
+      "properties": {
+        "silences": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/serverProperties"
+            "$ref": "#/definitions/alertSilence"
           }
+        }
+      }
+    },
+    "alertSilenceRequest": {
+      "type": "object",
+      "required": [
+        "duration"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
         },
-        "usage": {
-          "type": "integer"
+        "duration": {
+          "description": "seconds the silence stays active",
+          "type": "integer",
+          "format": "int64"
         },
-        "widgets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widget"
-          }
+        "ruleId": {
+          "type": "string"
+        },
+        "subject": {
+          "type": "string"
         }
       }
     },
@@ -15095,6 +21248,79 @@ func init() {
         "CUSTOM"
       ]
     },
+    "bucketAccessCapability": {
+      "type": "string",
+      "enum": [
+        "none",
+        "conditional",
+        "granted"
+      ]
+    },
+    "bucketAccessMatrix": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketAccessMatrixEntry"
+          }
+        },
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketAccessMatrixEntry": {
+      "type": "object",
+      "properties": {
+        "admin": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "delete": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "list": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "prefix of the bucket the capabilities apply to, empty for the whole bucket"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "read": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount",
+            "ldapUser",
+            "ldapGroup",
+            "anonymous"
+          ]
+        },
+        "via": {
+          "type": "array",
+          "title": "policies, groups, parents and rules the capabilities come from",
+          "items": {
+            "type": "string"
+          }
+        },
+        "write": {
+          "$ref": "#/definitions/bucketAccessCapability"
+        }
+      }
+    },
     "bucketEncryptionInfo": {
       "type": "object",
       "properties": {
@@ -15395,6 +21621,76 @@ func init() {
         }
       }
     },
+    "certificateInfo": {
+      "type": "object",
+      "properties": {
+        "daysRemaining": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "isCA": {
+          "type": "boolean"
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "notAfter": {
+          "type": "string"
+        },
+        "notBefore": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "sans": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "serialNumber": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "console",
+            "ca",
+            "minio"
+          ]
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "valid",
+            "expiring",
+            "expired"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
+    "certificatesResponse": {
+      "type": "object",
+      "properties": {
+        "certificates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/certificateInfo"
+          }
+        },
+        "minioEndpointError": {
+          "type": "string"
+        },
+        "warningDays": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "changeUserPasswordRequest": {
       "type": "object",
       "required": [
@@ -15482,29 +21778,187 @@ func init() {
           "type": "integer",
           "format": "int32"
         },
-        "region": {
-          "type": "string"
+        "region": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "sourceBucket": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "dashboard": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "updatedAt": {
+          "type": "string"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
+          }
+        }
+      }
+    },
+    "dashboardListResponse": {
+      "type": "object",
+      "properties": {
+        "dashboards": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboard"
+          }
+        }
+      }
+    },
+    "dashboardRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
+          }
+        }
+      }
+    },
+    "dashboardWidget": {
+      "type": "object",
+      "required": [
+        "title",
+        "type",
+        "targets"
+      ],
+      "properties": {
+        "gridPos": {
+          "$ref": "#/definitions/dashboardWidgetGridPos"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "maxDataPoints": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidgetTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "dashboardWidgetGridPos": {
+      "type": "object",
+      "properties": {
+        "h": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "w": {
+          "type": "integer",
+          "format": "int32"
         },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
+        "x": {
+          "type": "integer",
+          "format": "int32"
         },
-        "sourceBucket": {
+        "y": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "dashboardWidgetTarget": {
+      "type": "object",
+      "required": [
+        "expr"
+      ],
+      "properties": {
+        "expr": {
           "type": "string"
         },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
+        "initialTime": {
+          "type": "integer",
+          "format": "int64"
         },
-        "targetBucket": {
+        "legendFormat": {
           "type": "string"
         },
-        "targetURL": {
-          "type": "string"
+        "step": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
@@ -15522,6 +21976,47 @@ func init() {
         }
       }
     },
+    "downloadArchiveObject": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "description": "object name, names ending with ` + "`" + `/` + "`" + ` include every object under the prefix",
+          "type": "string"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "downloadArchiveRequest": {
+      "type": "object",
+      "required": [
+        "objects"
+      ],
+      "properties": {
+        "format": {
+          "type": "string",
+          "default": "zip-deflate",
+          "enum": [
+            "zip-deflate",
+            "zip-store",
+            "tar",
+            "tar.gz",
+            "tar.zst"
+          ]
+        },
+        "objects": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/downloadArchiveObject"
+          }
+        }
+      }
+    },
     "envOverride": {
       "type": "object",
       "properties": {
@@ -15605,6 +22100,118 @@ func init() {
         }
       }
     },
+    "iamChangeSet": {
+      "type": "object",
+      "required": [
+        "operations"
+      ],
+      "properties": {
+        "operations": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/iamChangeSetOperation"
+          }
+        }
+      }
+    },
+    "iamChangeSetOperation": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "group": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string",
+          "title": "secret key of the user created by createUser"
+        },
+        "serviceAccount": {
+          "$ref": "#/definitions/serviceAccountRequestCreds"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "createUser",
+            "setUserGroups",
+            "setGroupMembers",
+            "attachPolicies",
+            "createServiceAccount"
+          ]
+        },
+        "user": {
+          "type": "string",
+          "title": "user the operation applies to, for attachPolicies either user or group is set"
+        }
+      }
+    },
+    "iamChangeSetResponse": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean"
+        },
+        "rolledBack": {
+          "type": "boolean"
+        },
+        "steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamChangeSetStep"
+          }
+        }
+      }
+    },
+    "iamChangeSetStep": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "index": {
+          "type": "integer"
+        },
+        "serviceAccount": {
+          "$ref": "#/definitions/serviceAccountCreds"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "applied",
+            "failed",
+            "skipped",
+            "rolledBack",
+            "rollbackFailed"
+          ]
+        },
+        "target": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
     "iamEntity": {
       "type": "string",
       "pattern": "^[\\w+=,.@-]{1,64}$"
@@ -15660,42 +22267,180 @@ func init() {
         }
       }
     },
-    "idpServerConfiguration": {
+    "idpServerConfiguration": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "info": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idpServerConfigurationInfo"
+          }
+        },
+        "input": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "idpServerConfigurationInfo": {
+      "type": "object",
+      "properties": {
+        "isCfg": {
+          "type": "boolean"
+        },
+        "isEnv": {
+          "type": "boolean"
+        },
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "importUsersResponse": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "integer"
+        },
+        "failed": {
+          "type": "integer"
+        },
+        "invalid": {
+          "type": "integer"
+        },
+        "rows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/importUsersRow"
+          }
+        }
+      }
+    },
+    "importUsersRow": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "row": {
+          "type": "integer",
+          "title": "position of the user in the file starting at 1, the CSV header isn't counted"
+        },
+        "secretKey": {
+          "type": "string",
+          "title": "secret key generated for the user, only returned once"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "invalid",
+            "failed"
+          ]
+        }
+      }
+    },
+    "inactiveAccessKey": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "lastUsed": {
+          "type": "string",
+          "title": "empty when the access key wasn't used since usage is tracked"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "user",
+            "serviceAccount"
+          ]
+        }
+      }
+    },
+    "inactiveAccessKeysCleanupRequest": {
+      "type": "object",
+      "required": [
+        "inactiveDays",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "disable",
+            "delete"
+          ]
+        },
+        "credentialType": {
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "all",
+            "user",
+            "serviceAccount"
+          ]
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "inactiveDays": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "inactiveAccessKeysCleanupResponse": {
       "type": "object",
       "properties": {
-        "enabled": {
-          "type": "boolean"
-        },
-        "info": {
+        "accessKeys": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/idpServerConfigurationInfo"
+            "$ref": "#/definitions/inactiveAccessKey"
           }
         },
-        "input": {
-          "type": "string"
-        },
-        "name": {
+        "action": {
           "type": "string"
         },
-        "type": {
+        "cutoff": {
           "type": "string"
-        }
-      }
-    },
-    "idpServerConfigurationInfo": {
-      "type": "object",
-      "properties": {
-        "isCfg": {
-          "type": "boolean"
         },
-        "isEnv": {
+        "dryRun": {
           "type": "boolean"
         },
-        "key": {
-          "type": "string"
+        "failed": {
+          "type": "integer",
+          "format": "int64"
         },
-        "value": {
+        "usageTrackedSince": {
           "type": "string"
         }
       }
@@ -16065,6 +22810,39 @@ func init() {
         }
       }
     },
+    "ldapDirectoryEntry": {
+      "type": "object",
+      "properties": {
+        "dn": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string",
+          "title": "name the user logs in with, only set for users"
+        }
+      }
+    },
+    "ldapDirectorySearchResponse": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapDirectoryEntry"
+          }
+        },
+        "truncated": {
+          "type": "boolean",
+          "title": "more entries match than the limit"
+        }
+      }
+    },
     "ldapEntities": {
       "type": "object",
       "properties": {
@@ -16148,6 +22926,70 @@ func init() {
         }
       }
     },
+    "ldapPolicyMappingRequest": {
+      "type": "object",
+      "required": [
+        "type",
+        "dn",
+        "policies"
+      ],
+      "properties": {
+        "dn": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
+        }
+      }
+    },
+    "ldapPolicyMappingResponse": {
+      "type": "object",
+      "properties": {
+        "policiesAttached": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "updatedAt": {
+          "type": "string"
+        }
+      }
+    },
+    "ldapUserGroupsResponse": {
+      "type": "object",
+      "properties": {
+        "dn": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapGroupPolicyEntity"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "title": "policies mapped to the user itself",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
     "ldapUserPolicyEntity": {
       "type": "object",
       "properties": {
@@ -16340,6 +23182,17 @@ func init() {
         }
       }
     },
+    "listPolicyRevisionsResponse": {
+      "type": "object",
+      "properties": {
+        "revisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyRevision"
+          }
+        }
+      }
+    },
     "listRemoteBucketsResponse": {
       "type": "object",
       "properties": {
@@ -16360,6 +23213,10 @@ func init() {
     "listUsersResponse": {
       "type": "object",
       "properties": {
+        "usageTrackedSince": {
+          "type": "string",
+          "title": "start of the period covered by the usage of the users, empty when usage isn't tracked"
+        },
         "users": {
           "type": "array",
           "title": "list of resulting users",
@@ -16375,6 +23232,10 @@ func init() {
         "results": {
           "type": "object",
           "title": "list of log search responses"
+        },
+        "truncated": {
+          "type": "boolean",
+          "title": "the page is past the records a search of the local index reads"
         }
       }
     },
@@ -16832,27 +23693,164 @@ func init() {
         }
       }
     },
-    "objectLegalHoldStatus": {
-      "type": "string",
-      "enum": [
-        "enabled",
-        "disabled"
-      ]
-    },
-    "objectRetentionMode": {
-      "type": "string",
-      "enum": [
-        "governance",
-        "compliance"
-      ]
-    },
-    "objectRetentionUnit": {
-      "type": "string",
-      "enum": [
-        "days",
-        "years"
-      ]
-    },
+    "objectLegalHoldStatus": {
+      "type": "string",
+      "enum": [
+        "enabled",
+        "disabled"
+      ]
+    },
+    "objectRetentionMode": {
+      "type": "string",
+      "enum": [
+        "governance",
+        "compliance"
+      ]
+    },
+    "objectRetentionUnit": {
+      "type": "string",
+      "enum": [
+        "days",
+        "years"
+      ]
+    },
+    "objectVersionDiff": {
+      "type": "object",
+      "properties": {
+        "diff": {
+          "type": "string"
+        },
+        "from_version": {
+          "type": "string"
+        },
+        "identical": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "to_version": {
+          "type": "string"
+        }
+      }
+    },
+    "objectVersionHistory": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectVersionHistoryEntry"
+          }
+        }
+      }
+    },
+    "objectVersionHistoryEntry": {
+      "type": "object",
+      "properties": {
+        "etag": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size_delta": {
+          "description": "size difference with the previous version",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "objectVersionPurgeEntry": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "objectVersionPurgeRequest": {
+      "type": "object",
+      "properties": {
+        "dry_run": {
+          "type": "boolean"
+        },
+        "keep_latest": {
+          "description": "number of newest versions kept for each object",
+          "type": "integer",
+          "format": "int64"
+        },
+        "newer_than": {
+          "description": "versions modified after this date (RFC3339) are kept",
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "objectVersionPurgeResponse": {
+      "type": "object",
+      "properties": {
+        "bytes_freed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dry_run": {
+          "type": "boolean"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "purged": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "description": "only the first versions are listed, totals include all of them",
+          "type": "boolean"
+        },
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectVersionPurgeEntry"
+          }
+        }
+      }
+    },
     "peerInfo": {
       "type": "object",
       "properties": {
@@ -16946,6 +23944,13 @@ func init() {
     "policy": {
       "type": "object",
       "properties": {
+        "findings": {
+          "type": "array",
+          "title": "lint findings, only set when the policy is saved",
+          "items": {
+            "$ref": "#/definitions/policyLintFinding"
+          }
+        },
         "name": {
           "type": "string"
         },
@@ -16976,6 +23981,126 @@ func init() {
         "group"
       ]
     },
+    "policyLintFinding": {
+      "type": "object",
+      "properties": {
+        "check": {
+          "type": "string",
+          "enum": [
+            "invalidPolicy",
+            "wildcardAccess",
+            "adminActionsBroadGroup",
+            "shadowedDeny",
+            "nonExistentBucket",
+            "unusedPolicy",
+            "unsupportedConditionKey"
+          ]
+        },
+        "message": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "remediation": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "high",
+            "medium",
+            "low"
+          ]
+        },
+        "statement": {
+          "type": "integer",
+          "format": "int32",
+          "title": "1-based position of the statement, 0 when the finding is about the whole policy"
+        }
+      }
+    },
+    "policyLintReport": {
+      "type": "object",
+      "properties": {
+        "findings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyLintFinding"
+          }
+        },
+        "policies": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "policyRevision": {
+      "type": "object",
+      "properties": {
+        "author": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "revision": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string"
+        }
+      }
+    },
+    "policyRevisionDiff": {
+      "type": "object",
+      "properties": {
+        "from": {
+          "$ref": "#/definitions/policyRevision"
+        },
+        "name": {
+          "type": "string"
+        },
+        "statements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyStatementChange"
+          }
+        },
+        "to": {
+          "$ref": "#/definitions/policyRevision"
+        }
+      }
+    },
+    "policyStatementChange": {
+      "type": "object",
+      "properties": {
+        "after": {
+          "type": "string"
+        },
+        "before": {
+          "type": "string"
+        },
+        "change": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "modified",
+            "unchanged"
+          ]
+        },
+        "sid": {
+          "type": "string"
+        }
+      }
+    },
     "prefixAccessPair": {
       "type": "object",
       "properties": {
@@ -17299,14 +24424,223 @@ func init() {
         "status": {
           "type": "string"
         },
-        "syncMode": {
-          "type": "string"
+        "syncMode": {
+          "type": "string"
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationMetricsPoint": {
+      "type": "object",
+      "properties": {
+        "currentBandwidth": {
+          "type": "number",
+          "format": "double"
+        },
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "queuedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "queuedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "timestamp": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationMetricsResponse": {
+      "type": "object",
+      "properties": {
+        "sampleInterval": {
+          "description": "seconds between history points",
+          "type": "integer",
+          "format": "int64"
+        },
+        "siteReplicationError": {
+          "type": "string"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationTargetMetrics"
+          }
+        }
+      }
+    },
+    "replicationResyncRequest": {
+      "type": "object",
+      "required": [
+        "arn"
+      ],
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "olderThan": {
+          "description": "only resync objects older than this duration, e.g. 72h",
+          "type": "string"
+        }
+      }
+    },
+    "replicationResyncResponse": {
+      "type": "object",
+      "properties": {
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationResyncTarget"
+          }
+        }
+      }
+    },
+    "replicationResyncTarget": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "endTime": {
+          "type": "string"
+        },
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastObject": {
+          "description": "last object replicated by the resync",
+          "type": "string"
+        },
+        "replicatedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "resetId": {
+          "type": "string"
+        },
+        "startTime": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationTargetMetrics": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "backlogTrend": {
+          "type": "string",
+          "enum": [
+            "unknown",
+            "draining",
+            "growing",
+            "steady"
+          ]
+        },
+        "bandwidthLimit": {
+          "description": "bytes per second, 0 when unlimited",
+          "type": "integer",
+          "format": "int64"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "currentBandwidth": {
+          "description": "bytes per second",
+          "type": "number",
+          "format": "double"
+        },
+        "deploymentId": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedLastHourCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "history": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationMetricsPoint"
+          }
+        },
+        "latencyAverageMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "latencyCurrentMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "latencyMaxMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "online": {
+          "type": "boolean"
+        },
+        "queuedCount": {
+          "description": "objects waiting in the replication queue of the source bucket, or of the site for peers",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queuedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
         },
         "targetBucket": {
           "type": "string"
         },
-        "targetURL": {
-          "type": "string"
+        "type": {
+          "type": "string",
+          "enum": [
+            "bucket",
+            "site"
+          ]
         }
       }
     },
@@ -17365,6 +24699,41 @@ func init() {
         }
       }
     },
+    "rollbackPolicyRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
+    "rotateServiceAccountRequest": {
+      "type": "object",
+      "properties": {
+        "expiry": {
+          "type": "string",
+          "title": "expiration of the new credential, RFC3339"
+        },
+        "gracePeriod": {
+          "type": "string",
+          "title": "how long the old credential keeps working, as a duration like 24h, defaults to 24h"
+        }
+      }
+    },
+    "rotateServiceAccountResponse": {
+      "type": "object",
+      "properties": {
+        "credentials": {
+          "$ref": "#/definitions/serviceAccountCreds"
+        },
+        "previousAccessKey": {
+          "type": "string"
+        },
+        "previousExpiration": {
+          "type": "string"
+        }
+      }
+    },
     "selectedSAs": {
       "type": "array",
       "items": {
@@ -17487,6 +24856,67 @@ func init() {
         }
       }
     },
+    "serviceAccountHygieneItem": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "accountStatus": {
+          "type": "string"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "findings": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "expired",
+              "expiringSoon",
+              "noExpiry",
+              "broaderThanParent"
+            ]
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountHygieneReport": {
+      "type": "object",
+      "properties": {
+        "accounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountHygieneItem"
+          }
+        },
+        "expiringWithinDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "flagged": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "serviceAccountRequest": {
       "type": "object",
       "properties": {
@@ -17687,120 +25117,291 @@ func init() {
         "account_id": {
           "type": "string"
         },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "restart": {
+          "type": "boolean"
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        }
+      }
+    },
+    "setPolicyMultipleNameRequest": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
+        },
+        "name": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
+        }
+      }
+    },
+    "setPolicyNameRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "entityType",
+        "entityName"
+      ],
+      "properties": {
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
+        },
+        "name": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "setPolicyRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName"
+      ],
+      "properties": {
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
+        }
+      }
+    },
+    "siteReplicationAddRequest": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/peerSite"
+      }
+    },
+    "siteReplicationAddResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "initialSyncErrorMessage": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationDiffEntry": {
+      "type": "object",
+      "properties": {
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "bucket",
+            "policy",
+            "user",
+            "group"
+          ]
+        },
+        "name": {
+          "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationDiffSite"
+          }
+        }
+      }
+    },
+    "siteReplicationDiffResponse": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationDiffEntry"
+          }
+        }
+      }
+    },
+    "siteReplicationDiffSite": {
+      "type": "object",
+      "properties": {
+        "deploymentId": {
+          "type": "string"
+        },
+        "fields": {
+          "description": "settings that differ from the other sites",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "missing": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "siteReplicationInfoResponse": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "serviceAccountAccessKey": {
+          "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerInfo"
+          }
+        }
+      }
+    },
+    "siteReplicationPeerHealth": {
+      "type": "object",
+      "properties": {
+        "checkLatencyMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "deploymentId": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "lastOnline": {
+          "type": "string"
+        },
+        "latencyAverageMs": {
+          "type": "number",
+          "format": "double"
         },
-        "restart": {
+        "latencyCurrentMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "latencyMaxMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "name": {
+          "type": "string"
+        },
+        "online": {
+          "description": "peer state as seen by MinIO",
           "type": "boolean"
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
+        "reachable": {
+          "description": "whether Console could reach the peer liveness endpoint",
+          "type": "boolean"
+        },
+        "totalDowntime": {
+          "description": "seconds",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "setPolicyMultipleNameRequest": {
+    "siteReplicationPeerHealthResponse": {
       "type": "object",
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/iamEntity"
-          }
-        },
-        "name": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "users": {
+        "peers": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/iamEntity"
+            "$ref": "#/definitions/siteReplicationPeerHealth"
           }
         }
       }
     },
-    "setPolicyNameRequest": {
+    "siteReplicationResyncBucketStatus": {
       "type": "object",
-      "required": [
-        "name",
-        "entityType",
-        "entityName"
-      ],
       "properties": {
-        "entityName": {
+        "bucket": {
           "type": "string"
         },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
+        "errorDetail": {
+          "type": "string"
         },
-        "name": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "status": {
+          "type": "string"
         }
       }
     },
-    "setPolicyRequest": {
+    "siteReplicationResyncRequest": {
       "type": "object",
       "required": [
-        "entityType",
-        "entityName"
+        "deploymentId",
+        "op"
       ],
       "properties": {
-        "entityName": {
+        "deploymentId": {
           "type": "string"
         },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
+        "op": {
+          "type": "string",
+          "enum": [
+            "start",
+            "cancel"
+          ]
         }
       }
     },
-    "siteReplicationAddRequest": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/peerSite"
-      }
-    },
-    "siteReplicationAddResponse": {
+    "siteReplicationResyncResponse": {
       "type": "object",
       "properties": {
-        "errorDetail": {
-          "type": "string"
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationResyncBucketStatus"
+          }
         },
-        "initialSyncErrorMessage": {
+        "errorDetail": {
           "type": "string"
         },
-        "status": {
+        "op": {
           "type": "string"
         },
-        "success": {
-          "type": "boolean"
-        }
-      }
-    },
-    "siteReplicationInfoResponse": {
-      "type": "object",
-      "properties": {
-        "enabled": {
-          "type": "boolean"
-        },
-        "name": {
+        "resyncId": {
           "type": "string"
         },
-        "serviceAccountAccessKey": {
+        "status": {
           "type": "string"
-        },
-        "sites": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/peerInfo"
-          }
         }
       }
     },
@@ -17842,6 +25443,84 @@ func init() {
         }
       }
     },
+    "speedtestMetric": {
+      "type": "object",
+      "properties": {
+        "drive": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "node": {
+          "type": "string"
+        },
+        "value": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "speedtestResult": {
+      "type": "object",
+      "properties": {
+        "endTime": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "errors": {
+          "description": "errors reported by individual nodes or drives",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "metrics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestMetric"
+          }
+        },
+        "parameters": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "result": {
+          "description": "final result as returned by MinIO, only included when requesting a single run",
+          "type": "object"
+        },
+        "startTime": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "object",
+            "drive",
+            "net",
+            "site"
+          ]
+        }
+      }
+    },
+    "speedtestResultsResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestResult"
+          }
+        }
+      }
+    },
     "startProfilingItem": {
       "type": "object",
       "properties": {
@@ -17997,6 +25676,36 @@ func init() {
         }
       }
     },
+    "tierBucketUsage": {
+      "type": "object",
+      "properties": {
+        "attributed": {
+          "description": "the tier usage is attributed to the bucket, only possible when it's the only bucket transitioning to the tier",
+          "type": "boolean"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tierLifecycleRule"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "tierCredentialsRequest": {
       "type": "object",
       "properties": {
@@ -18012,6 +25721,24 @@ func init() {
         }
       }
     },
+    "tierLifecycleRule": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "noncurrent": {
+          "description": "the rule transitions noncurrent versions",
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
     "tierListResponse": {
       "type": "object",
       "properties": {
@@ -18023,6 +25750,70 @@ func init() {
         }
       }
     },
+    "tierUsage": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tierBucketUsage"
+          }
+        },
+        "dailyObjects": {
+          "description": "objects transitioned during the last 24 hours",
+          "type": "integer",
+          "format": "int64"
+        },
+        "dailySize": {
+          "description": "bytes transitioned during the last 24 hours",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "tierUsageResponse": {
+      "type": "object",
+      "properties": {
+        "tiers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tierUsage"
+          }
+        }
+      }
+    },
+    "tierVerifyResponse": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "boolean"
+        }
+      }
+    },
     "tier_azure": {
       "type": "object",
       "properties": {
@@ -18355,6 +26146,9 @@ func init() {
         },
         "status": {
           "type": "string"
+        },
+        "usage": {
+          "$ref": "#/definitions/accessKeyUsage"
         }
       }
     },
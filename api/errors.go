@@ -71,6 +71,12 @@ var (
 	ErrDeletingEncryptionConfig         = errors.New("error disabling tenant encryption")
 	ErrEncryptionConfigNotFound         = errors.New("encryption configuration not found")
 	ErrPolicyNotFound                   = errors.New("policy does not exist")
+	ErrPolicyHistoryDisabled            = errors.New("policy history is not enabled")
+	ErrPolicyRevisionNotFound           = errors.New("policy revision does not exist")
+	ErrLDAPNotConfigured                = errors.New("LDAP is not configured")
+	ErrLDAPEntryNotFound                = errors.New("LDAP entry does not exist")
+	ErrAccessKeyUsageDisabled           = errors.New("access key usage tracking is not enabled")
+	ErrAccessKeyUsageTooRecent          = errors.New("access key usage is not tracked for long enough")
 	ErrLoginNotAllowed                  = errors.New("login not allowed")
 	ErrSubnetUploadFail                 = errors.New("SUBNET upload failed")
 	ErrHealthReportFail                 = errors.New("failure to generate Health report")
@@ -212,6 +218,30 @@ func ErrorWithContext(ctx context.Context, err ...interface{}) *CodedAPIError {
 				errorCode = 404
 				errorMessage = ErrPolicyNotFound.Error()
 			}
+			if errors.Is(err1, ErrPolicyHistoryDisabled) {
+				errorCode = 404
+				errorMessage = ErrPolicyHistoryDisabled.Error()
+			}
+			if errors.Is(err1, ErrPolicyRevisionNotFound) {
+				errorCode = 404
+				errorMessage = ErrPolicyRevisionNotFound.Error()
+			}
+			if errors.Is(err1, ErrLDAPNotConfigured) {
+				errorCode = 404
+				errorMessage = ErrLDAPNotConfigured.Error()
+			}
+			if errors.Is(err1, ErrLDAPEntryNotFound) {
+				errorCode = 404
+				errorMessage = ErrLDAPEntryNotFound.Error()
+			}
+			if errors.Is(err1, ErrAccessKeyUsageDisabled) {
+				errorCode = 404
+				errorMessage = ErrAccessKeyUsageDisabled.Error()
+			}
+			if errors.Is(err1, ErrAccessKeyUsageTooRecent) {
+				errorCode = 400
+				errorMessage = ErrAccessKeyUsageTooRecent.Error()
+			}
 			if madmin.ToErrorResponse(err1).Code == "AccessDenied" {
 				errorCode = 403
 				errorMessage = ErrAccessDenied.Error()
@@ -0,0 +1,154 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	logApi "github.com/minio/console/api/operations/logging"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/logsearch"
+)
+
+// logSearchWebhookPath receives the deliveries of the MinIO audit webhook, it's served
+// outside of the swagger API since MinIO batches several entries in a single request
+const logSearchWebhookPath = "/api/v1/logs/webhook"
+
+// maxWebhookDeliverySize limits the size of a single audit webhook delivery
+const maxWebhookDeliverySize = 32 << 20
+
+// globalLogSearchIndex is the local log search index, nil unless enabled
+var globalLogSearchIndex *logsearch.Index
+
+// startLogSearchIndex opens the local log search index when enabled and no external log
+// search service is configured, audit entries of console requests are indexed from now on
+func startLogSearchIndex() {
+	if !getLogSearchLocalEnabled() || getLogSearchURL() != "" {
+		return
+	}
+	index := logsearch.New(filepath.Join(getConsoleDataDir(), "logsearch"), LogSearchRetention)
+	if err := index.Prune(time.Now().UTC()); err != nil {
+		logError("unable to apply the log search retention: %v", err)
+	}
+	if err := logger.AddAuditTarget(logsearch.NewTarget(index)); err != nil {
+		logError("unable to index console audit entries: %v", err)
+		return
+	}
+	globalLogSearchIndex = index
+}
+
+// serveLogSearchWebhook adds the audit entries delivered by MinIO to the local index and to the
+// access key usage, MinIO must be configured with the webhook token as the `auth_token` of its
+// audit webhook target
+func serveLogSearchWebhook(w http.ResponseWriter, r *http.Request) {
+	token := getLogSearchWebhookToken()
+	if (globalLogSearchIndex == nil && globalAccessKeyUsage == nil) || token == "" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	authorization := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if subtle.ConstantTimeCompare([]byte(authorization), []byte(token)) != 1 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	records, err := logsearch.DecodeMinIOEntries(http.MaxBytesReader(w, r.Body, maxWebhookDeliverySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if globalAccessKeyUsage != nil {
+		globalAccessKeyUsage.Add(records...)
+	}
+	if globalLogSearchIndex == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err = globalLogSearchIndex.Add(records...); err != nil {
+		logError("unable to index MinIO audit entries: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// logSearchQuery maps the log search parameters to a query of the local index, filter
+// parameters have the `field:value` form of the external log search service
+func logSearchQuery(params logApi.LogSearchParams) (logsearch.Query, error) {
+	query := logsearch.Query{}
+	if params.PageSize != nil {
+		query.PageSize = int(*params.PageSize)
+	}
+	if params.PageNo != nil {
+		query.PageNo = int(*params.PageNo)
+	}
+	if query.PageSize < 0 || query.PageNo < 0 {
+		return query, errors.New("page size and page number cannot be negative")
+	}
+	query.Ascending = params.Order != nil && *params.Order == "timeAsc"
+
+	var err error
+	if params.TimeStart != nil && *params.TimeStart != "" {
+		if query.Start, err = time.Parse(time.RFC3339, *params.TimeStart); err != nil {
+			return query, fmt.Errorf("invalid timeStart: %v", err)
+		}
+	}
+	if params.TimeEnd != nil && *params.TimeEnd != "" {
+		if query.End, err = time.Parse(time.RFC3339, *params.TimeEnd); err != nil {
+			return query, fmt.Errorf("invalid timeEnd: %v", err)
+		}
+	}
+
+	for _, fp := range params.Fp {
+		field, value, ok := strings.Cut(fp, ":")
+		if !ok {
+			return query, fmt.Errorf("invalid filter parameter: %s", fp)
+		}
+		switch field {
+		case "source":
+			query.Source = value
+		case "access_key":
+			query.AccessKey = value
+		case "bucket":
+			query.Bucket = value
+		case "object":
+			query.Object = value
+		case "api_name":
+			query.APIName = value
+		case "remote_host":
+			query.RemoteHost = value
+		case "request_id":
+			query.RequestID = value
+		case "user_agent":
+			query.UserAgent = value
+		case "response_status", "response_status_code":
+			query.Status = value
+		default:
+			return query, fmt.Errorf("unsupported filter parameter: %s", field)
+		}
+	}
+	return query, nil
+}
@@ -0,0 +1,98 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	logApi "github.com/minio/console/api/operations/logging"
+	"github.com/minio/console/pkg/logsearch"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_logSearchQuery(t *testing.T) {
+	query, err := logSearchQuery(logApi.LogSearchParams{
+		Fp:        []string{"bucket:photos", "access_key:alice", "api_name:PutObject", "response_status:OK", "remote_host:10.0.0.1"},
+		PageSize:  swag.Int32(50),
+		PageNo:    swag.Int32(2),
+		Order:     swag.String("timeAsc"),
+		TimeStart: swag.String("2024-03-10T00:00:00.000Z"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, logsearch.Query{
+		Start:      time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+		AccessKey:  "alice",
+		Bucket:     "photos",
+		APIName:    "PutObject",
+		RemoteHost: "10.0.0.1",
+		Status:     "OK",
+		Ascending:  true,
+		PageSize:   50,
+		PageNo:     2,
+	}, query)
+
+	_, err = logSearchQuery(logApi.LogSearchParams{Fp: []string{"bucket"}})
+	assert.Error(t, err)
+	_, err = logSearchQuery(logApi.LogSearchParams{Fp: []string{"color:red"}})
+	assert.Error(t, err)
+	_, err = logSearchQuery(logApi.LogSearchParams{TimeEnd: swag.String("yesterday")})
+	assert.Error(t, err)
+	_, err = logSearchQuery(logApi.LogSearchParams{PageNo: swag.Int32(-1)})
+	assert.Error(t, err)
+}
+
+func Test_serveLogSearchWebhook(t *testing.T) {
+	index := logsearch.New(t.TempDir(), 0)
+	defer index.Close()
+	globalLogSearchIndex = index
+	defer func() { globalLogSearchIndex = nil }()
+
+	delivery := `{"time":"2024-03-10T12:00:00Z","api":{"name":"PutObject","bucket":"photos","statusCode":200},"accessKey":"alice"}
+{"time":"2024-03-10T12:00:01Z","api":{"name":"GetObject","bucket":"photos","statusCode":200},"accessKey":"bob"}`
+	deliver := func(method, authorization, body string) int {
+		req := httptest.NewRequest(method, logSearchWebhookPath, strings.NewReader(body))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		serveLogSearchWebhook(rec, req)
+		return rec.Code
+	}
+
+	// the webhook is disabled without a token
+	assert.Equal(t, http.StatusNotFound, deliver(http.MethodPost, "", delivery))
+
+	t.Setenv(ConsoleLogSearchWebhookToken, "webhook-token")
+	assert.Equal(t, http.StatusMethodNotAllowed, deliver(http.MethodGet, "webhook-token", ""))
+	assert.Equal(t, http.StatusUnauthorized, deliver(http.MethodPost, "other-token", delivery))
+	assert.Equal(t, http.StatusBadRequest, deliver(http.MethodPost, "Bearer webhook-token", "{"))
+	assert.Equal(t, http.StatusOK, deliver(http.MethodPost, "Bearer webhook-token", delivery))
+
+	result, err := index.Search(logsearch.Query{Bucket: "photos", PageSize: 10})
+	assert.NoError(t, err)
+	records := result.Records
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, "GetObject", records[0].APIName)
+		assert.Equal(t, "alice", records[1].AccessKey)
+		assert.Equal(t, logsearch.SourceMinIO, records[1].Source)
+	}
+}
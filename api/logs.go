@@ -22,6 +22,7 @@ import (
 	"errors"
 	"log"
 	"os"
+	"time"
 
 	"github.com/minio/cli"
 )
@@ -54,6 +55,7 @@ type Context struct {
 	Host                string
 	HTTPPort, HTTPSPort int
 	TLSRedirect         string
+	LogSearchRetention  time.Duration
 	// Legacy options, TODO: remove in future
 	TLSCertificate, TLSKey, TLSca string
 }
@@ -65,6 +67,8 @@ func (c *Context) Load(ctx *cli.Context) error {
 		HTTPPort:    ctx.Int("port"),
 		HTTPSPort:   ctx.Int("tls-port"),
 		TLSRedirect: ctx.String("tls-redirect"),
+		// Local log search index
+		LogSearchRetention: ctx.Duration("log-search-retention"),
 		// Legacy options to be removed.
 		TLSCertificate: ctx.String("tls-certificate"),
 		TLSKey:         ctx.String("tls-key"),
@@ -79,5 +83,8 @@ func (c *Context) Load(ctx *cli.Context) error {
 	if c.TLSRedirect != "on" && c.TLSRedirect != "off" {
 		return errors.New("invalid argument --tls-redirect only accepts either 'on' or 'off'")
 	}
+	if c.LogSearchRetention < 0 {
+		return errors.New("invalid argument --log-search-retention must be a positive duration")
+	}
 	return nil
 }
@@ -62,6 +62,26 @@ func TestContext_Load(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "log search retention",
+			args: args{
+				values: map[string]string{
+					"tls-redirect":         "on",
+					"log-search-retention": "72h",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid log search retention",
+			args: args{
+				values: map[string]string{
+					"tls-redirect":         "on",
+					"log-search-retention": "-1h",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid port http",
 			args: args{
@@ -0,0 +1,102 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"sync"
+	"time"
+)
+
+// timedSample is a sample kept by a metricsWindow
+type timedSample interface {
+	sampleTime() time.Time
+}
+
+// metricsWindow keeps a rolling window of samples in memory, they are collected by a sampler
+// started on demand that is expected to stop once the window is idle
+type metricsWindow[S timedSample] struct {
+	mu          sync.RWMutex
+	samples     []S
+	retention   time.Duration
+	idleTimeout time.Duration
+	running     bool
+	lastAccess  time.Time
+}
+
+// newMetricsWindow returns a window keeping samples for the retention period, it becomes idle
+// when nobody has read it for idleTimeout
+func newMetricsWindow[S timedSample](retention, idleTimeout time.Duration) *metricsWindow[S] {
+	return &metricsWindow[S]{retention: retention, idleTimeout: idleTimeout}
+}
+
+// touch records the samples are still being used
+func (w *metricsWindow[S]) touch() {
+	w.mu.Lock()
+	w.lastAccess = time.Now()
+	w.mu.Unlock()
+}
+
+// idle tells whether nobody has read the samples for the idle timeout
+func (w *metricsWindow[S]) idle() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return time.Since(w.lastAccess) > w.idleTimeout
+}
+
+// ensureRunning runs sampler in the background unless it's already running, the window is
+// touched either way so a running sampler keeps going
+func (w *metricsWindow[S]) ensureRunning(sampler func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastAccess = time.Now()
+	if w.running {
+		return
+	}
+	w.running = true
+	go func() {
+		defer func() {
+			w.mu.Lock()
+			w.running = false
+			w.mu.Unlock()
+		}()
+		sampler()
+	}()
+}
+
+// add appends a sample and drops the samples out of retention relative to it
+func (w *metricsWindow[S]) add(sample S) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.samples = append(w.samples, sample)
+	cutoff := sample.sampleTime().Add(-w.retention)
+	first := 0
+	for first < len(w.samples) && w.samples[first].sampleTime().Before(cutoff) {
+		first++
+	}
+	if first > 0 {
+		w.samples = append([]S(nil), w.samples[first:]...)
+	}
+}
+
+// each calls fn with every sample, oldest first
+func (w *metricsWindow[S]) each(fn func(sample S)) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	for _, sample := range w.samples {
+		fn(sample)
+	}
+}
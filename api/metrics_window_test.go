@@ -0,0 +1,65 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSample time.Time
+
+func (s testSample) sampleTime() time.Time {
+	return time.Time(s)
+}
+
+func TestMetricsWindow(t *testing.T) {
+	assert := assert.New(t)
+	window := newMetricsWindow[testSample](time.Hour, time.Minute)
+	now := time.Now()
+
+	// samples out of retention are dropped
+	window.add(testSample(now.Add(-2 * time.Hour)))
+	window.add(testSample(now.Add(-30 * time.Minute)))
+	window.add(testSample(now))
+	var times []time.Time
+	window.each(func(sample testSample) { times = append(times, sample.sampleTime()) })
+	assert.Equal([]time.Time{now.Add(-30 * time.Minute), now}, times)
+
+	// a single sampler runs at a time and the window is idle once nobody reads it
+	started := make(chan struct{})
+	release := make(chan struct{})
+	window.ensureRunning(func() {
+		close(started)
+		<-release
+	})
+	<-started
+	window.ensureRunning(func() { t.Error("sampler started twice") })
+	assert.False(window.idle())
+	window.mu.Lock()
+	window.lastAccess = now.Add(-2 * time.Minute)
+	window.mu.Unlock()
+	assert.True(window.idle())
+	close(release)
+	assert.Eventually(func() bool {
+		window.mu.RLock()
+		defer window.mu.RUnlock()
+		return !window.running
+	}, time.Second, 10*time.Millisecond)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/minio/console/api/operations/account"
+	"github.com/minio/console/api/operations/alerts"
 	"github.com/minio/console/api/operations/auth"
 	"github.com/minio/console/api/operations/bucket"
 	"github.com/minio/console/api/operations/configuration"
@@ -50,9 +51,11 @@ import (
 	"github.com/minio/console/api/operations/policy"
 	"github.com/minio/console/api/operations/profile"
 	"github.com/minio/console/api/operations/release"
+	"github.com/minio/console/api/operations/replication"
 	"github.com/minio/console/api/operations/service"
 	"github.com/minio/console/api/operations/service_account"
 	"github.com/minio/console/api/operations/site_replication"
+	"github.com/minio/console/api/operations/speedtest"
 	"github.com/minio/console/api/operations/subnet"
 	"github.com/minio/console/api/operations/support"
 	"github.com/minio/console/api/operations/system"
@@ -118,9 +121,15 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SystemAdminInfoHandler: system.AdminInfoHandlerFunc(func(params system.AdminInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.AdminInfo has not yet been implemented")
 		}),
+		UserApplyIAMChangeSetHandler: user.ApplyIAMChangeSetHandlerFunc(func(params user.ApplyIAMChangeSetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ApplyIAMChangeSet has not yet been implemented")
+		}),
 		SystemArnListHandler: system.ArnListHandlerFunc(func(params system.ArnListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.ArnList has not yet been implemented")
 		}),
+		IdpAttachLDAPPoliciesHandler: idp.AttachLDAPPoliciesHandlerFunc(func(params idp.AttachLDAPPoliciesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.AttachLDAPPolicies has not yet been implemented")
+		}),
 		BucketBucketInfoHandler: bucket.BucketInfoHandlerFunc(func(params bucket.BucketInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.BucketInfo has not yet been implemented")
 		}),
@@ -136,18 +145,33 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserCheckUserServiceAccountsHandler: user.CheckUserServiceAccountsHandlerFunc(func(params user.CheckUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CheckUserServiceAccounts has not yet been implemented")
 		}),
+		UserCleanupInactiveAccessKeysHandler: user.CleanupInactiveAccessKeysHandlerFunc(func(params user.CleanupInactiveAccessKeysParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.CleanupInactiveAccessKeys has not yet been implemented")
+		}),
 		ConfigurationConfigInfoHandler: configuration.ConfigInfoHandlerFunc(func(params configuration.ConfigInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ConfigInfo has not yet been implemented")
 		}),
 		UserCreateAUserServiceAccountHandler: user.CreateAUserServiceAccountHandlerFunc(func(params user.CreateAUserServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CreateAUserServiceAccount has not yet been implemented")
 		}),
+		AlertsCreateAlertChannelHandler: alerts.CreateAlertChannelHandlerFunc(func(params alerts.CreateAlertChannelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.CreateAlertChannel has not yet been implemented")
+		}),
+		AlertsCreateAlertRuleHandler: alerts.CreateAlertRuleHandlerFunc(func(params alerts.CreateAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.CreateAlertRule has not yet been implemented")
+		}),
+		AlertsCreateAlertSilenceHandler: alerts.CreateAlertSilenceHandlerFunc(func(params alerts.CreateAlertSilenceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.CreateAlertSilence has not yet been implemented")
+		}),
 		BucketCreateBucketEventHandler: bucket.CreateBucketEventHandlerFunc(func(params bucket.CreateBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.CreateBucketEvent has not yet been implemented")
 		}),
 		IdpCreateConfigurationHandler: idp.CreateConfigurationHandlerFunc(func(params idp.CreateConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.CreateConfiguration has not yet been implemented")
 		}),
+		SystemCreateDashboardHandler: system.CreateDashboardHandlerFunc(func(params system.CreateDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CreateDashboard has not yet been implemented")
+		}),
 		ServiceAccountCreateServiceAccountHandler: service_account.CreateServiceAccountHandlerFunc(func(params service_account.CreateServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.CreateServiceAccount has not yet been implemented")
 		}),
@@ -157,12 +181,24 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountCreateServiceAccountCredsHandler: service_account.CreateServiceAccountCredsHandlerFunc(func(params service_account.CreateServiceAccountCredsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.CreateServiceAccountCreds has not yet been implemented")
 		}),
+		SystemDashboardCustomWidgetDetailsHandler: system.DashboardCustomWidgetDetailsHandlerFunc(func(params system.DashboardCustomWidgetDetailsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DashboardCustomWidgetDetails has not yet been implemented")
+		}),
 		SystemDashboardWidgetDetailsHandler: system.DashboardWidgetDetailsHandlerFunc(func(params system.DashboardWidgetDetailsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.DashboardWidgetDetails has not yet been implemented")
 		}),
 		BucketDeleteAccessRuleWithBucketHandler: bucket.DeleteAccessRuleWithBucketHandlerFunc(func(params bucket.DeleteAccessRuleWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAccessRuleWithBucket has not yet been implemented")
 		}),
+		AlertsDeleteAlertChannelHandler: alerts.DeleteAlertChannelHandlerFunc(func(params alerts.DeleteAlertChannelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.DeleteAlertChannel has not yet been implemented")
+		}),
+		AlertsDeleteAlertRuleHandler: alerts.DeleteAlertRuleHandlerFunc(func(params alerts.DeleteAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.DeleteAlertRule has not yet been implemented")
+		}),
+		AlertsDeleteAlertSilenceHandler: alerts.DeleteAlertSilenceHandlerFunc(func(params alerts.DeleteAlertSilenceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.DeleteAlertSilence has not yet been implemented")
+		}),
 		BucketDeleteAllReplicationRulesHandler: bucket.DeleteAllReplicationRulesHandlerFunc(func(params bucket.DeleteAllReplicationRulesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAllReplicationRules has not yet been implemented")
 		}),
@@ -181,6 +217,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		IdpDeleteConfigurationHandler: idp.DeleteConfigurationHandlerFunc(func(params idp.DeleteConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.DeleteConfiguration has not yet been implemented")
 		}),
+		SystemDeleteDashboardHandler: system.DeleteDashboardHandlerFunc(func(params system.DeleteDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DeleteDashboard has not yet been implemented")
+		}),
 		ObjectDeleteMultipleObjectsHandler: object.DeleteMultipleObjectsHandlerFunc(func(params object.DeleteMultipleObjectsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DeleteMultipleObjects has not yet been implemented")
 		}),
@@ -202,6 +241,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountDeleteServiceAccountHandler: service_account.DeleteServiceAccountHandlerFunc(func(params service_account.DeleteServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.DeleteServiceAccount has not yet been implemented")
 		}),
+		SpeedtestDeleteSpeedtestResultHandler: speedtest.DeleteSpeedtestResultHandlerFunc(func(params speedtest.DeleteSpeedtestResultParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.DeleteSpeedtestResult has not yet been implemented")
+		}),
+		PolicyDiffPolicyRevisionsHandler: policy.DiffPolicyRevisionsHandlerFunc(func(params policy.DiffPolicyRevisionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.DiffPolicyRevisions has not yet been implemented")
+		}),
 		BucketDisableBucketEncryptionHandler: bucket.DisableBucketEncryptionHandlerFunc(func(params bucket.DisableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DisableBucketEncryption has not yet been implemented")
 		}),
@@ -211,15 +256,39 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ObjectDownloadMultipleObjectsHandler: object.DownloadMultipleObjectsHandlerFunc(func(params object.DownloadMultipleObjectsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DownloadMultipleObjects has not yet been implemented")
 		}),
+		ObjectDownloadObjectsArchiveHandler: object.DownloadObjectsArchiveHandlerFunc(func(params object.DownloadObjectsArchiveParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.DownloadObjectsArchive has not yet been implemented")
+		}),
 		TieringEditTierCredentialsHandler: tiering.EditTierCredentialsHandlerFunc(func(params tiering.EditTierCredentialsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.EditTierCredentials has not yet been implemented")
 		}),
 		BucketEnableBucketEncryptionHandler: bucket.EnableBucketEncryptionHandlerFunc(func(params bucket.EnableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.EnableBucketEncryption has not yet been implemented")
 		}),
+		AlertsEvaluateAlertRulesHandler: alerts.EvaluateAlertRulesHandlerFunc(func(params alerts.EvaluateAlertRulesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.EvaluateAlertRules has not yet been implemented")
+		}),
+		BucketExportBucketAccessMatrixHandler: bucket.ExportBucketAccessMatrixHandlerFunc(func(params bucket.ExportBucketAccessMatrixParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ExportBucketAccessMatrix has not yet been implemented")
+		}),
 		ConfigurationExportConfigHandler: configuration.ExportConfigHandlerFunc(func(params configuration.ExportConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ExportConfig has not yet been implemented")
 		}),
+		SystemExportDashboardHandler: system.ExportDashboardHandlerFunc(func(params system.ExportDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ExportDashboard has not yet been implemented")
+		}),
+		SpeedtestExportSpeedtestResultsHandler: speedtest.ExportSpeedtestResultsHandlerFunc(func(params speedtest.ExportSpeedtestResultsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.ExportSpeedtestResults has not yet been implemented")
+		}),
+		UserExportUsersHandler: user.ExportUsersHandlerFunc(func(params user.ExportUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ExportUsers has not yet been implemented")
+		}),
+		AlertsGetAlertRuleHandler: alerts.GetAlertRuleHandlerFunc(func(params alerts.GetAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.GetAlertRule has not yet been implemented")
+		}),
+		BucketGetBucketAccessMatrixHandler: bucket.GetBucketAccessMatrixHandlerFunc(func(params bucket.GetBucketAccessMatrixParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketAccessMatrix has not yet been implemented")
+		}),
 		BucketGetBucketEncryptionInfoHandler: bucket.GetBucketEncryptionInfoHandlerFunc(func(params bucket.GetBucketEncryptionInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketEncryptionInfo has not yet been implemented")
 		}),
@@ -235,6 +304,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketGetBucketReplicationHandler: bucket.GetBucketReplicationHandlerFunc(func(params bucket.GetBucketReplicationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketReplication has not yet been implemented")
 		}),
+		BucketGetBucketReplicationResyncStatusHandler: bucket.GetBucketReplicationResyncStatusHandlerFunc(func(params bucket.GetBucketReplicationResyncStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketReplicationResyncStatus has not yet been implemented")
+		}),
 		BucketGetBucketReplicationRuleHandler: bucket.GetBucketReplicationRuleHandlerFunc(func(params bucket.GetBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketReplicationRule has not yet been implemented")
 		}),
@@ -253,27 +325,54 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		IdpGetConfigurationHandler: idp.GetConfigurationHandlerFunc(func(params idp.GetConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.GetConfiguration has not yet been implemented")
 		}),
+		SystemGetDashboardHandler: system.GetDashboardHandlerFunc(func(params system.GetDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetDashboard has not yet been implemented")
+		}),
 		IdpGetLDAPEntitiesHandler: idp.GetLDAPEntitiesHandlerFunc(func(params idp.GetLDAPEntitiesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.GetLDAPEntities has not yet been implemented")
 		}),
+		IdpGetLDAPUserGroupsHandler: idp.GetLDAPUserGroupsHandlerFunc(func(params idp.GetLDAPUserGroupsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.GetLDAPUserGroups has not yet been implemented")
+		}),
 		BucketGetMaxShareLinkExpHandler: bucket.GetMaxShareLinkExpHandlerFunc(func(params bucket.GetMaxShareLinkExpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetMaxShareLinkExp has not yet been implemented")
 		}),
 		ObjectGetObjectMetadataHandler: object.GetObjectMetadataHandlerFunc(func(params object.GetObjectMetadataParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.GetObjectMetadata has not yet been implemented")
 		}),
+		ObjectGetObjectVersionDiffHandler: object.GetObjectVersionDiffHandlerFunc(func(params object.GetObjectVersionDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.GetObjectVersionDiff has not yet been implemented")
+		}),
+		ObjectGetObjectVersionHistoryHandler: object.GetObjectVersionHistoryHandlerFunc(func(params object.GetObjectVersionHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.GetObjectVersionHistory has not yet been implemented")
+		}),
+		ReplicationGetReplicationMetricsHandler: replication.GetReplicationMetricsHandlerFunc(func(params replication.GetReplicationMetricsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.GetReplicationMetrics has not yet been implemented")
+		}),
 		PolicyGetSAUserPolicyHandler: policy.GetSAUserPolicyHandlerFunc(func(params policy.GetSAUserPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetSAUserPolicy has not yet been implemented")
 		}),
 		ServiceAccountGetServiceAccountHandler: service_account.GetServiceAccountHandlerFunc(func(params service_account.GetServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.GetServiceAccount has not yet been implemented")
 		}),
+		ServiceAccountGetServiceAccountHygieneReportHandler: service_account.GetServiceAccountHygieneReportHandlerFunc(func(params service_account.GetServiceAccountHygieneReportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.GetServiceAccountHygieneReport has not yet been implemented")
+		}),
+		SiteReplicationGetSiteReplicationDiffHandler: site_replication.GetSiteReplicationDiffHandlerFunc(func(params site_replication.GetSiteReplicationDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation site_replication.GetSiteReplicationDiff has not yet been implemented")
+		}),
 		SiteReplicationGetSiteReplicationInfoHandler: site_replication.GetSiteReplicationInfoHandlerFunc(func(params site_replication.GetSiteReplicationInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.GetSiteReplicationInfo has not yet been implemented")
 		}),
+		SiteReplicationGetSiteReplicationPeerHealthHandler: site_replication.GetSiteReplicationPeerHealthHandlerFunc(func(params site_replication.GetSiteReplicationPeerHealthParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation site_replication.GetSiteReplicationPeerHealth has not yet been implemented")
+		}),
 		SiteReplicationGetSiteReplicationStatusHandler: site_replication.GetSiteReplicationStatusHandlerFunc(func(params site_replication.GetSiteReplicationStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.GetSiteReplicationStatus has not yet been implemented")
 		}),
+		SpeedtestGetSpeedtestResultHandler: speedtest.GetSpeedtestResultHandlerFunc(func(params speedtest.GetSpeedtestResultParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.GetSpeedtestResult has not yet been implemented")
+		}),
 		TieringGetTierHandler: tiering.GetTierHandlerFunc(func(params tiering.GetTierParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.GetTier has not yet been implemented")
 		}),
@@ -286,6 +385,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		GroupGroupInfoHandler: group.GroupInfoHandlerFunc(func(params group.GroupInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.GroupInfo has not yet been implemented")
 		}),
+		SystemImportDashboardHandler: system.ImportDashboardHandlerFunc(func(params system.ImportDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ImportDashboard has not yet been implemented")
+		}),
+		UserImportUsersHandler: user.ImportUsersHandlerFunc(func(params user.ImportUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ImportUsers has not yet been implemented")
+		}),
 		InspectInspectHandler: inspect.InspectHandlerFunc(func(params inspect.InspectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		}),
@@ -346,24 +451,48 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		KmsKMSVersionHandler: k_m_s.KMSVersionHandlerFunc(func(params k_m_s.KMSVersionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation k_m_s.KMSVersion has not yet been implemented")
 		}),
+		PolicyLintPoliciesHandler: policy.LintPoliciesHandlerFunc(func(params policy.LintPoliciesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.LintPolicies has not yet been implemented")
+		}),
+		PolicyLintPolicyHandler: policy.LintPolicyHandlerFunc(func(params policy.LintPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.LintPolicy has not yet been implemented")
+		}),
 		UserListAUserServiceAccountsHandler: user.ListAUserServiceAccountsHandlerFunc(func(params user.ListAUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.ListAUserServiceAccounts has not yet been implemented")
 		}),
 		BucketListAccessRulesWithBucketHandler: bucket.ListAccessRulesWithBucketHandlerFunc(func(params bucket.ListAccessRulesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListAccessRulesWithBucket has not yet been implemented")
 		}),
+		AlertsListAlertChannelsHandler: alerts.ListAlertChannelsHandlerFunc(func(params alerts.ListAlertChannelsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.ListAlertChannels has not yet been implemented")
+		}),
+		AlertsListAlertHistoryHandler: alerts.ListAlertHistoryHandlerFunc(func(params alerts.ListAlertHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.ListAlertHistory has not yet been implemented")
+		}),
+		AlertsListAlertRulesHandler: alerts.ListAlertRulesHandlerFunc(func(params alerts.ListAlertRulesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.ListAlertRules has not yet been implemented")
+		}),
+		AlertsListAlertSilencesHandler: alerts.ListAlertSilencesHandlerFunc(func(params alerts.ListAlertSilencesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.ListAlertSilences has not yet been implemented")
+		}),
 		BucketListBucketEventsHandler: bucket.ListBucketEventsHandlerFunc(func(params bucket.ListBucketEventsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListBucketEvents has not yet been implemented")
 		}),
 		BucketListBucketsHandler: bucket.ListBucketsHandlerFunc(func(params bucket.ListBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListBuckets has not yet been implemented")
 		}),
+		SystemListCertificatesHandler: system.ListCertificatesHandlerFunc(func(params system.ListCertificatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListCertificates has not yet been implemented")
+		}),
 		ConfigurationListConfigHandler: configuration.ListConfigHandlerFunc(func(params configuration.ListConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ListConfig has not yet been implemented")
 		}),
 		IdpListConfigurationsHandler: idp.ListConfigurationsHandlerFunc(func(params idp.ListConfigurationsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.ListConfigurations has not yet been implemented")
 		}),
+		SystemListDashboardsHandler: system.ListDashboardsHandlerFunc(func(params system.ListDashboardsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListDashboards has not yet been implemented")
+		}),
 		BucketListExternalBucketsHandler: bucket.ListExternalBucketsHandlerFunc(func(params bucket.ListExternalBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListExternalBuckets has not yet been implemented")
 		}),
@@ -385,12 +514,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketListPoliciesWithBucketHandler: bucket.ListPoliciesWithBucketHandlerFunc(func(params bucket.ListPoliciesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListPoliciesWithBucket has not yet been implemented")
 		}),
+		PolicyListPolicyRevisionsHandler: policy.ListPolicyRevisionsHandlerFunc(func(params policy.ListPolicyRevisionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ListPolicyRevisions has not yet been implemented")
+		}),
 		ReleaseListReleasesHandler: release.ListReleasesHandlerFunc(func(params release.ListReleasesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation release.ListReleases has not yet been implemented")
 		}),
 		BucketListRemoteBucketsHandler: bucket.ListRemoteBucketsHandlerFunc(func(params bucket.ListRemoteBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListRemoteBuckets has not yet been implemented")
 		}),
+		SpeedtestListSpeedtestResultsHandler: speedtest.ListSpeedtestResultsHandlerFunc(func(params speedtest.ListSpeedtestResultsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.ListSpeedtestResults has not yet been implemented")
+		}),
 		ServiceAccountListUserServiceAccountsHandler: service_account.ListUserServiceAccountsHandlerFunc(func(params service_account.ListUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.ListUserServiceAccounts has not yet been implemented")
 		}),
@@ -439,6 +574,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ProfileProfilingStopHandler: profile.ProfilingStopHandlerFunc(func(params profile.ProfilingStopParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation profile.ProfilingStop has not yet been implemented")
 		}),
+		ObjectPurgeObjectVersionsHandler: object.PurgeObjectVersionsHandlerFunc(func(params object.PurgeObjectVersionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.PurgeObjectVersions has not yet been implemented")
+		}),
 		BucketPutBucketTagsHandler: bucket.PutBucketTagsHandlerFunc(func(params bucket.PutBucketTagsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.PutBucketTags has not yet been implemented")
 		}),
@@ -463,6 +601,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		PolicyRemovePolicyHandler: policy.RemovePolicyHandlerFunc(func(params policy.RemovePolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.RemovePolicy has not yet been implemented")
 		}),
+		TieringRemoveTierHandler: tiering.RemoveTierHandlerFunc(func(params tiering.RemoveTierParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tiering.RemoveTier has not yet been implemented")
+		}),
 		UserRemoveUserHandler: user.RemoveUserHandlerFunc(func(params user.RemoveUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.RemoveUser has not yet been implemented")
 		}),
@@ -472,6 +613,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceRestartServiceHandler: service.RestartServiceHandlerFunc(func(params service.RestartServiceParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.RestartService has not yet been implemented")
 		}),
+		PolicyRollbackPolicyHandler: policy.RollbackPolicyHandlerFunc(func(params policy.RollbackPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.RollbackPolicy has not yet been implemented")
+		}),
+		ServiceAccountRotateServiceAccountHandler: service_account.RotateServiceAccountHandlerFunc(func(params service_account.RotateServiceAccountParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.RotateServiceAccount has not yet been implemented")
+		}),
+		IdpSearchLDAPGroupsHandler: idp.SearchLDAPGroupsHandlerFunc(func(params idp.SearchLDAPGroupsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.SearchLDAPGroups has not yet been implemented")
+		}),
+		IdpSearchLDAPUsersHandler: idp.SearchLDAPUsersHandlerFunc(func(params idp.SearchLDAPUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.SearchLDAPUsers has not yet been implemented")
+		}),
 		AuthSessionCheckHandler: auth.SessionCheckHandlerFunc(func(params auth.SessionCheckParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation auth.SessionCheck has not yet been implemented")
 		}),
@@ -514,6 +667,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SiteReplicationSiteReplicationRemoveHandler: site_replication.SiteReplicationRemoveHandlerFunc(func(params site_replication.SiteReplicationRemoveParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.SiteReplicationRemove has not yet been implemented")
 		}),
+		SiteReplicationSiteReplicationResyncHandler: site_replication.SiteReplicationResyncHandlerFunc(func(params site_replication.SiteReplicationResyncParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation site_replication.SiteReplicationResync has not yet been implemented")
+		}),
+		BucketStartBucketReplicationResyncHandler: bucket.StartBucketReplicationResyncHandlerFunc(func(params bucket.StartBucketReplicationResyncParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.StartBucketReplicationResync has not yet been implemented")
+		}),
 		SubnetSubnetAPIKeyHandler: subnet.SubnetAPIKeyHandlerFunc(func(params subnet.SubnetAPIKeyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation subnet.SubnetAPIKey has not yet been implemented")
 		}),
@@ -532,15 +691,30 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SubnetSubnetRegisterHandler: subnet.SubnetRegisterHandlerFunc(func(params subnet.SubnetRegisterParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation subnet.SubnetRegister has not yet been implemented")
 		}),
+		AlertsTestAlertChannelHandler: alerts.TestAlertChannelHandlerFunc(func(params alerts.TestAlertChannelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.TestAlertChannel has not yet been implemented")
+		}),
 		TieringTiersListHandler: tiering.TiersListHandlerFunc(func(params tiering.TiersListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.TiersList has not yet been implemented")
 		}),
+		TieringTiersUsageHandler: tiering.TiersUsageHandlerFunc(func(params tiering.TiersUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tiering.TiersUsage has not yet been implemented")
+		}),
+		AlertsUpdateAlertChannelHandler: alerts.UpdateAlertChannelHandlerFunc(func(params alerts.UpdateAlertChannelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.UpdateAlertChannel has not yet been implemented")
+		}),
+		AlertsUpdateAlertRuleHandler: alerts.UpdateAlertRuleHandlerFunc(func(params alerts.UpdateAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation alerts.UpdateAlertRule has not yet been implemented")
+		}),
 		BucketUpdateBucketLifecycleHandler: bucket.UpdateBucketLifecycleHandlerFunc(func(params bucket.UpdateBucketLifecycleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.UpdateBucketLifecycle has not yet been implemented")
 		}),
 		IdpUpdateConfigurationHandler: idp.UpdateConfigurationHandlerFunc(func(params idp.UpdateConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.UpdateConfiguration has not yet been implemented")
 		}),
+		SystemUpdateDashboardHandler: system.UpdateDashboardHandlerFunc(func(params system.UpdateDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.UpdateDashboard has not yet been implemented")
+		}),
 		GroupUpdateGroupHandler: group.UpdateGroupHandlerFunc(func(params group.UpdateGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.UpdateGroup has not yet been implemented")
 		}),
@@ -556,6 +730,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserUpdateUserInfoHandler: user.UpdateUserInfoHandlerFunc(func(params user.UpdateUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.UpdateUserInfo has not yet been implemented")
 		}),
+		TieringVerifyTierHandler: tiering.VerifyTierHandlerFunc(func(params tiering.VerifyTierParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tiering.VerifyTier has not yet been implemented")
+		}),
 
 		// Applies when the "X-Anonymous" header is set
 		AnonymousAuth: func(token string) (*models.Principal, error) {
@@ -642,8 +819,12 @@ type ConsoleAPI struct {
 	UserAddUserHandler user.AddUserHandler
 	// SystemAdminInfoHandler sets the operation handler for the admin info operation
 	SystemAdminInfoHandler system.AdminInfoHandler
+	// UserApplyIAMChangeSetHandler sets the operation handler for the apply i a m change set operation
+	UserApplyIAMChangeSetHandler user.ApplyIAMChangeSetHandler
 	// SystemArnListHandler sets the operation handler for the arn list operation
 	SystemArnListHandler system.ArnListHandler
+	// IdpAttachLDAPPoliciesHandler sets the operation handler for the attach l d a p policies operation
+	IdpAttachLDAPPoliciesHandler idp.AttachLDAPPoliciesHandler
 	// BucketBucketInfoHandler sets the operation handler for the bucket info operation
 	BucketBucketInfoHandler bucket.BucketInfoHandler
 	// BucketBucketSetPolicyHandler sets the operation handler for the bucket set policy operation
@@ -654,24 +835,42 @@ type ConsoleAPI struct {
 	AccountChangeUserPasswordHandler account.ChangeUserPasswordHandler
 	// UserCheckUserServiceAccountsHandler sets the operation handler for the check user service accounts operation
 	UserCheckUserServiceAccountsHandler user.CheckUserServiceAccountsHandler
+	// UserCleanupInactiveAccessKeysHandler sets the operation handler for the cleanup inactive access keys operation
+	UserCleanupInactiveAccessKeysHandler user.CleanupInactiveAccessKeysHandler
 	// ConfigurationConfigInfoHandler sets the operation handler for the config info operation
 	ConfigurationConfigInfoHandler configuration.ConfigInfoHandler
 	// UserCreateAUserServiceAccountHandler sets the operation handler for the create a user service account operation
 	UserCreateAUserServiceAccountHandler user.CreateAUserServiceAccountHandler
+	// AlertsCreateAlertChannelHandler sets the operation handler for the create alert channel operation
+	AlertsCreateAlertChannelHandler alerts.CreateAlertChannelHandler
+	// AlertsCreateAlertRuleHandler sets the operation handler for the create alert rule operation
+	AlertsCreateAlertRuleHandler alerts.CreateAlertRuleHandler
+	// AlertsCreateAlertSilenceHandler sets the operation handler for the create alert silence operation
+	AlertsCreateAlertSilenceHandler alerts.CreateAlertSilenceHandler
 	// BucketCreateBucketEventHandler sets the operation handler for the create bucket event operation
 	BucketCreateBucketEventHandler bucket.CreateBucketEventHandler
 	// IdpCreateConfigurationHandler sets the operation handler for the create configuration operation
 	IdpCreateConfigurationHandler idp.CreateConfigurationHandler
+	// SystemCreateDashboardHandler sets the operation handler for the create dashboard operation
+	SystemCreateDashboardHandler system.CreateDashboardHandler
 	// ServiceAccountCreateServiceAccountHandler sets the operation handler for the create service account operation
 	ServiceAccountCreateServiceAccountHandler service_account.CreateServiceAccountHandler
 	// UserCreateServiceAccountCredentialsHandler sets the operation handler for the create service account credentials operation
 	UserCreateServiceAccountCredentialsHandler user.CreateServiceAccountCredentialsHandler
 	// ServiceAccountCreateServiceAccountCredsHandler sets the operation handler for the create service account creds operation
 	ServiceAccountCreateServiceAccountCredsHandler service_account.CreateServiceAccountCredsHandler
+	// SystemDashboardCustomWidgetDetailsHandler sets the operation handler for the dashboard custom widget details operation
+	SystemDashboardCustomWidgetDetailsHandler system.DashboardCustomWidgetDetailsHandler
 	// SystemDashboardWidgetDetailsHandler sets the operation handler for the dashboard widget details operation
 	SystemDashboardWidgetDetailsHandler system.DashboardWidgetDetailsHandler
 	// BucketDeleteAccessRuleWithBucketHandler sets the operation handler for the delete access rule with bucket operation
 	BucketDeleteAccessRuleWithBucketHandler bucket.DeleteAccessRuleWithBucketHandler
+	// AlertsDeleteAlertChannelHandler sets the operation handler for the delete alert channel operation
+	AlertsDeleteAlertChannelHandler alerts.DeleteAlertChannelHandler
+	// AlertsDeleteAlertRuleHandler sets the operation handler for the delete alert rule operation
+	AlertsDeleteAlertRuleHandler alerts.DeleteAlertRuleHandler
+	// AlertsDeleteAlertSilenceHandler sets the operation handler for the delete alert silence operation
+	AlertsDeleteAlertSilenceHandler alerts.DeleteAlertSilenceHandler
 	// BucketDeleteAllReplicationRulesHandler sets the operation handler for the delete all replication rules operation
 	BucketDeleteAllReplicationRulesHandler bucket.DeleteAllReplicationRulesHandler
 	// BucketDeleteBucketHandler sets the operation handler for the delete bucket operation
@@ -684,6 +883,8 @@ type ConsoleAPI struct {
 	BucketDeleteBucketReplicationRuleHandler bucket.DeleteBucketReplicationRuleHandler
 	// IdpDeleteConfigurationHandler sets the operation handler for the delete configuration operation
 	IdpDeleteConfigurationHandler idp.DeleteConfigurationHandler
+	// SystemDeleteDashboardHandler sets the operation handler for the delete dashboard operation
+	SystemDeleteDashboardHandler system.DeleteDashboardHandler
 	// ObjectDeleteMultipleObjectsHandler sets the operation handler for the delete multiple objects operation
 	ObjectDeleteMultipleObjectsHandler object.DeleteMultipleObjectsHandler
 	// ServiceAccountDeleteMultipleServiceAccountsHandler sets the operation handler for the delete multiple service accounts operation
@@ -698,18 +899,38 @@ type ConsoleAPI struct {
 	BucketDeleteSelectedReplicationRulesHandler bucket.DeleteSelectedReplicationRulesHandler
 	// ServiceAccountDeleteServiceAccountHandler sets the operation handler for the delete service account operation
 	ServiceAccountDeleteServiceAccountHandler service_account.DeleteServiceAccountHandler
+	// SpeedtestDeleteSpeedtestResultHandler sets the operation handler for the delete speedtest result operation
+	SpeedtestDeleteSpeedtestResultHandler speedtest.DeleteSpeedtestResultHandler
+	// PolicyDiffPolicyRevisionsHandler sets the operation handler for the diff policy revisions operation
+	PolicyDiffPolicyRevisionsHandler policy.DiffPolicyRevisionsHandler
 	// BucketDisableBucketEncryptionHandler sets the operation handler for the disable bucket encryption operation
 	BucketDisableBucketEncryptionHandler bucket.DisableBucketEncryptionHandler
 	// ObjectDownloadObjectHandler sets the operation handler for the download object operation
 	ObjectDownloadObjectHandler object.DownloadObjectHandler
 	// ObjectDownloadMultipleObjectsHandler sets the operation handler for the download multiple objects operation
 	ObjectDownloadMultipleObjectsHandler object.DownloadMultipleObjectsHandler
+	// ObjectDownloadObjectsArchiveHandler sets the operation handler for the download objects archive operation
+	ObjectDownloadObjectsArchiveHandler object.DownloadObjectsArchiveHandler
 	// TieringEditTierCredentialsHandler sets the operation handler for the edit tier credentials operation
 	TieringEditTierCredentialsHandler tiering.EditTierCredentialsHandler
 	// BucketEnableBucketEncryptionHandler sets the operation handler for the enable bucket encryption operation
 	BucketEnableBucketEncryptionHandler bucket.EnableBucketEncryptionHandler
+	// AlertsEvaluateAlertRulesHandler sets the operation handler for the evaluate alert rules operation
+	AlertsEvaluateAlertRulesHandler alerts.EvaluateAlertRulesHandler
+	// BucketExportBucketAccessMatrixHandler sets the operation handler for the export bucket access matrix operation
+	BucketExportBucketAccessMatrixHandler bucket.ExportBucketAccessMatrixHandler
 	// ConfigurationExportConfigHandler sets the operation handler for the export config operation
 	ConfigurationExportConfigHandler configuration.ExportConfigHandler
+	// SystemExportDashboardHandler sets the operation handler for the export dashboard operation
+	SystemExportDashboardHandler system.ExportDashboardHandler
+	// SpeedtestExportSpeedtestResultsHandler sets the operation handler for the export speedtest results operation
+	SpeedtestExportSpeedtestResultsHandler speedtest.ExportSpeedtestResultsHandler
+	// UserExportUsersHandler sets the operation handler for the export users operation
+	UserExportUsersHandler user.ExportUsersHandler
+	// AlertsGetAlertRuleHandler sets the operation handler for the get alert rule operation
+	AlertsGetAlertRuleHandler alerts.GetAlertRuleHandler
+	// BucketGetBucketAccessMatrixHandler sets the operation handler for the get bucket access matrix operation
+	BucketGetBucketAccessMatrixHandler bucket.GetBucketAccessMatrixHandler
 	// BucketGetBucketEncryptionInfoHandler sets the operation handler for the get bucket encryption info operation
 	BucketGetBucketEncryptionInfoHandler bucket.GetBucketEncryptionInfoHandler
 	// BucketGetBucketLifecycleHandler sets the operation handler for the get bucket lifecycle operation
@@ -720,6 +941,8 @@ type ConsoleAPI struct {
 	BucketGetBucketQuotaHandler bucket.GetBucketQuotaHandler
 	// BucketGetBucketReplicationHandler sets the operation handler for the get bucket replication operation
 	BucketGetBucketReplicationHandler bucket.GetBucketReplicationHandler
+	// BucketGetBucketReplicationResyncStatusHandler sets the operation handler for the get bucket replication resync status operation
+	BucketGetBucketReplicationResyncStatusHandler bucket.GetBucketReplicationResyncStatusHandler
 	// BucketGetBucketReplicationRuleHandler sets the operation handler for the get bucket replication rule operation
 	BucketGetBucketReplicationRuleHandler bucket.GetBucketReplicationRuleHandler
 	// BucketGetBucketRetentionConfigHandler sets the operation handler for the get bucket retention config operation
@@ -732,20 +955,38 @@ type ConsoleAPI struct {
 	SupportGetCallHomeOptionValueHandler support.GetCallHomeOptionValueHandler
 	// IdpGetConfigurationHandler sets the operation handler for the get configuration operation
 	IdpGetConfigurationHandler idp.GetConfigurationHandler
+	// SystemGetDashboardHandler sets the operation handler for the get dashboard operation
+	SystemGetDashboardHandler system.GetDashboardHandler
 	// IdpGetLDAPEntitiesHandler sets the operation handler for the get l d a p entities operation
 	IdpGetLDAPEntitiesHandler idp.GetLDAPEntitiesHandler
+	// IdpGetLDAPUserGroupsHandler sets the operation handler for the get l d a p user groups operation
+	IdpGetLDAPUserGroupsHandler idp.GetLDAPUserGroupsHandler
 	// BucketGetMaxShareLinkExpHandler sets the operation handler for the get max share link exp operation
 	BucketGetMaxShareLinkExpHandler bucket.GetMaxShareLinkExpHandler
 	// ObjectGetObjectMetadataHandler sets the operation handler for the get object metadata operation
 	ObjectGetObjectMetadataHandler object.GetObjectMetadataHandler
+	// ObjectGetObjectVersionDiffHandler sets the operation handler for the get object version diff operation
+	ObjectGetObjectVersionDiffHandler object.GetObjectVersionDiffHandler
+	// ObjectGetObjectVersionHistoryHandler sets the operation handler for the get object version history operation
+	ObjectGetObjectVersionHistoryHandler object.GetObjectVersionHistoryHandler
+	// ReplicationGetReplicationMetricsHandler sets the operation handler for the get replication metrics operation
+	ReplicationGetReplicationMetricsHandler replication.GetReplicationMetricsHandler
 	// PolicyGetSAUserPolicyHandler sets the operation handler for the get s a user policy operation
 	PolicyGetSAUserPolicyHandler policy.GetSAUserPolicyHandler
 	// ServiceAccountGetServiceAccountHandler sets the operation handler for the get service account operation
 	ServiceAccountGetServiceAccountHandler service_account.GetServiceAccountHandler
+	// ServiceAccountGetServiceAccountHygieneReportHandler sets the operation handler for the get service account hygiene report operation
+	ServiceAccountGetServiceAccountHygieneReportHandler service_account.GetServiceAccountHygieneReportHandler
+	// SiteReplicationGetSiteReplicationDiffHandler sets the operation handler for the get site replication diff operation
+	SiteReplicationGetSiteReplicationDiffHandler site_replication.GetSiteReplicationDiffHandler
 	// SiteReplicationGetSiteReplicationInfoHandler sets the operation handler for the get site replication info operation
 	SiteReplicationGetSiteReplicationInfoHandler site_replication.GetSiteReplicationInfoHandler
+	// SiteReplicationGetSiteReplicationPeerHealthHandler sets the operation handler for the get site replication peer health operation
+	SiteReplicationGetSiteReplicationPeerHealthHandler site_replication.GetSiteReplicationPeerHealthHandler
 	// SiteReplicationGetSiteReplicationStatusHandler sets the operation handler for the get site replication status operation
 	SiteReplicationGetSiteReplicationStatusHandler site_replication.GetSiteReplicationStatusHandler
+	// SpeedtestGetSpeedtestResultHandler sets the operation handler for the get speedtest result operation
+	SpeedtestGetSpeedtestResultHandler speedtest.GetSpeedtestResultHandler
 	// TieringGetTierHandler sets the operation handler for the get tier operation
 	TieringGetTierHandler tiering.GetTierHandler
 	// UserGetUserInfoHandler sets the operation handler for the get user info operation
@@ -754,6 +995,10 @@ type ConsoleAPI struct {
 	PolicyGetUserPolicyHandler policy.GetUserPolicyHandler
 	// GroupGroupInfoHandler sets the operation handler for the group info operation
 	GroupGroupInfoHandler group.GroupInfoHandler
+	// SystemImportDashboardHandler sets the operation handler for the import dashboard operation
+	SystemImportDashboardHandler system.ImportDashboardHandler
+	// UserImportUsersHandler sets the operation handler for the import users operation
+	UserImportUsersHandler user.ImportUsersHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
 	// KmsKMSAPIsHandler sets the operation handler for the k m s a p is operation
@@ -794,18 +1039,34 @@ type ConsoleAPI struct {
 	KmsKMSStatusHandler k_m_s.KMSStatusHandler
 	// KmsKMSVersionHandler sets the operation handler for the k m s version operation
 	KmsKMSVersionHandler k_m_s.KMSVersionHandler
+	// PolicyLintPoliciesHandler sets the operation handler for the lint policies operation
+	PolicyLintPoliciesHandler policy.LintPoliciesHandler
+	// PolicyLintPolicyHandler sets the operation handler for the lint policy operation
+	PolicyLintPolicyHandler policy.LintPolicyHandler
 	// UserListAUserServiceAccountsHandler sets the operation handler for the list a user service accounts operation
 	UserListAUserServiceAccountsHandler user.ListAUserServiceAccountsHandler
 	// BucketListAccessRulesWithBucketHandler sets the operation handler for the list access rules with bucket operation
 	BucketListAccessRulesWithBucketHandler bucket.ListAccessRulesWithBucketHandler
+	// AlertsListAlertChannelsHandler sets the operation handler for the list alert channels operation
+	AlertsListAlertChannelsHandler alerts.ListAlertChannelsHandler
+	// AlertsListAlertHistoryHandler sets the operation handler for the list alert history operation
+	AlertsListAlertHistoryHandler alerts.ListAlertHistoryHandler
+	// AlertsListAlertRulesHandler sets the operation handler for the list alert rules operation
+	AlertsListAlertRulesHandler alerts.ListAlertRulesHandler
+	// AlertsListAlertSilencesHandler sets the operation handler for the list alert silences operation
+	AlertsListAlertSilencesHandler alerts.ListAlertSilencesHandler
 	// BucketListBucketEventsHandler sets the operation handler for the list bucket events operation
 	BucketListBucketEventsHandler bucket.ListBucketEventsHandler
 	// BucketListBucketsHandler sets the operation handler for the list buckets operation
 	BucketListBucketsHandler bucket.ListBucketsHandler
+	// SystemListCertificatesHandler sets the operation handler for the list certificates operation
+	SystemListCertificatesHandler system.ListCertificatesHandler
 	// ConfigurationListConfigHandler sets the operation handler for the list config operation
 	ConfigurationListConfigHandler configuration.ListConfigHandler
 	// IdpListConfigurationsHandler sets the operation handler for the list configurations operation
 	IdpListConfigurationsHandler idp.ListConfigurationsHandler
+	// SystemListDashboardsHandler sets the operation handler for the list dashboards operation
+	SystemListDashboardsHandler system.ListDashboardsHandler
 	// BucketListExternalBucketsHandler sets the operation handler for the list external buckets operation
 	BucketListExternalBucketsHandler bucket.ListExternalBucketsHandler
 	// GroupListGroupsHandler sets the operation handler for the list groups operation
@@ -820,10 +1081,14 @@ type ConsoleAPI struct {
 	PolicyListPoliciesHandler policy.ListPoliciesHandler
 	// BucketListPoliciesWithBucketHandler sets the operation handler for the list policies with bucket operation
 	BucketListPoliciesWithBucketHandler bucket.ListPoliciesWithBucketHandler
+	// PolicyListPolicyRevisionsHandler sets the operation handler for the list policy revisions operation
+	PolicyListPolicyRevisionsHandler policy.ListPolicyRevisionsHandler
 	// ReleaseListReleasesHandler sets the operation handler for the list releases operation
 	ReleaseListReleasesHandler release.ListReleasesHandler
 	// BucketListRemoteBucketsHandler sets the operation handler for the list remote buckets operation
 	BucketListRemoteBucketsHandler bucket.ListRemoteBucketsHandler
+	// SpeedtestListSpeedtestResultsHandler sets the operation handler for the list speedtest results operation
+	SpeedtestListSpeedtestResultsHandler speedtest.ListSpeedtestResultsHandler
 	// ServiceAccountListUserServiceAccountsHandler sets the operation handler for the list user service accounts operation
 	ServiceAccountListUserServiceAccountsHandler service_account.ListUserServiceAccountsHandler
 	// UserListUsersHandler sets the operation handler for the list users operation
@@ -856,6 +1121,8 @@ type ConsoleAPI struct {
 	ProfileProfilingStartHandler profile.ProfilingStartHandler
 	// ProfileProfilingStopHandler sets the operation handler for the profiling stop operation
 	ProfileProfilingStopHandler profile.ProfilingStopHandler
+	// ObjectPurgeObjectVersionsHandler sets the operation handler for the purge object versions operation
+	ObjectPurgeObjectVersionsHandler object.PurgeObjectVersionsHandler
 	// BucketPutBucketTagsHandler sets the operation handler for the put bucket tags operation
 	BucketPutBucketTagsHandler bucket.PutBucketTagsHandler
 	// ObjectPutObjectLegalHoldHandler sets the operation handler for the put object legal hold operation
@@ -872,12 +1139,22 @@ type ConsoleAPI struct {
 	GroupRemoveGroupHandler group.RemoveGroupHandler
 	// PolicyRemovePolicyHandler sets the operation handler for the remove policy operation
 	PolicyRemovePolicyHandler policy.RemovePolicyHandler
+	// TieringRemoveTierHandler sets the operation handler for the remove tier operation
+	TieringRemoveTierHandler tiering.RemoveTierHandler
 	// UserRemoveUserHandler sets the operation handler for the remove user operation
 	UserRemoveUserHandler user.RemoveUserHandler
 	// ConfigurationResetConfigHandler sets the operation handler for the reset config operation
 	ConfigurationResetConfigHandler configuration.ResetConfigHandler
 	// ServiceRestartServiceHandler sets the operation handler for the restart service operation
 	ServiceRestartServiceHandler service.RestartServiceHandler
+	// PolicyRollbackPolicyHandler sets the operation handler for the rollback policy operation
+	PolicyRollbackPolicyHandler policy.RollbackPolicyHandler
+	// ServiceAccountRotateServiceAccountHandler sets the operation handler for the rotate service account operation
+	ServiceAccountRotateServiceAccountHandler service_account.RotateServiceAccountHandler
+	// IdpSearchLDAPGroupsHandler sets the operation handler for the search l d a p groups operation
+	IdpSearchLDAPGroupsHandler idp.SearchLDAPGroupsHandler
+	// IdpSearchLDAPUsersHandler sets the operation handler for the search l d a p users operation
+	IdpSearchLDAPUsersHandler idp.SearchLDAPUsersHandler
 	// AuthSessionCheckHandler sets the operation handler for the session check operation
 	AuthSessionCheckHandler auth.SessionCheckHandler
 	// BucketSetAccessRuleWithBucketHandler sets the operation handler for the set access rule with bucket operation
@@ -906,6 +1183,10 @@ type ConsoleAPI struct {
 	SiteReplicationSiteReplicationInfoAddHandler site_replication.SiteReplicationInfoAddHandler
 	// SiteReplicationSiteReplicationRemoveHandler sets the operation handler for the site replication remove operation
 	SiteReplicationSiteReplicationRemoveHandler site_replication.SiteReplicationRemoveHandler
+	// SiteReplicationSiteReplicationResyncHandler sets the operation handler for the site replication resync operation
+	SiteReplicationSiteReplicationResyncHandler site_replication.SiteReplicationResyncHandler
+	// BucketStartBucketReplicationResyncHandler sets the operation handler for the start bucket replication resync operation
+	BucketStartBucketReplicationResyncHandler bucket.StartBucketReplicationResyncHandler
 	// SubnetSubnetAPIKeyHandler sets the operation handler for the subnet Api key operation
 	SubnetSubnetAPIKeyHandler subnet.SubnetAPIKeyHandler
 	// SubnetSubnetInfoHandler sets the operation handler for the subnet info operation
@@ -918,12 +1199,22 @@ type ConsoleAPI struct {
 	SubnetSubnetRegTokenHandler subnet.SubnetRegTokenHandler
 	// SubnetSubnetRegisterHandler sets the operation handler for the subnet register operation
 	SubnetSubnetRegisterHandler subnet.SubnetRegisterHandler
+	// AlertsTestAlertChannelHandler sets the operation handler for the test alert channel operation
+	AlertsTestAlertChannelHandler alerts.TestAlertChannelHandler
 	// TieringTiersListHandler sets the operation handler for the tiers list operation
 	TieringTiersListHandler tiering.TiersListHandler
+	// TieringTiersUsageHandler sets the operation handler for the tiers usage operation
+	TieringTiersUsageHandler tiering.TiersUsageHandler
+	// AlertsUpdateAlertChannelHandler sets the operation handler for the update alert channel operation
+	AlertsUpdateAlertChannelHandler alerts.UpdateAlertChannelHandler
+	// AlertsUpdateAlertRuleHandler sets the operation handler for the update alert rule operation
+	AlertsUpdateAlertRuleHandler alerts.UpdateAlertRuleHandler
 	// BucketUpdateBucketLifecycleHandler sets the operation handler for the update bucket lifecycle operation
 	BucketUpdateBucketLifecycleHandler bucket.UpdateBucketLifecycleHandler
 	// IdpUpdateConfigurationHandler sets the operation handler for the update configuration operation
 	IdpUpdateConfigurationHandler idp.UpdateConfigurationHandler
+	// SystemUpdateDashboardHandler sets the operation handler for the update dashboard operation
+	SystemUpdateDashboardHandler system.UpdateDashboardHandler
 	// GroupUpdateGroupHandler sets the operation handler for the update group operation
 	GroupUpdateGroupHandler group.UpdateGroupHandler
 	// BucketUpdateMultiBucketReplicationHandler sets the operation handler for the update multi bucket replication operation
@@ -934,6 +1225,8 @@ type ConsoleAPI struct {
 	UserUpdateUserGroupsHandler user.UpdateUserGroupsHandler
 	// UserUpdateUserInfoHandler sets the operation handler for the update user info operation
 	UserUpdateUserInfoHandler user.UpdateUserInfoHandler
+	// TieringVerifyTierHandler sets the operation handler for the verify tier operation
+	TieringVerifyTierHandler tiering.VerifyTierHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -1057,9 +1350,15 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SystemAdminInfoHandler == nil {
 		unregistered = append(unregistered, "system.AdminInfoHandler")
 	}
+	if o.UserApplyIAMChangeSetHandler == nil {
+		unregistered = append(unregistered, "user.ApplyIAMChangeSetHandler")
+	}
 	if o.SystemArnListHandler == nil {
 		unregistered = append(unregistered, "system.ArnListHandler")
 	}
+	if o.IdpAttachLDAPPoliciesHandler == nil {
+		unregistered = append(unregistered, "idp.AttachLDAPPoliciesHandler")
+	}
 	if o.BucketBucketInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.BucketInfoHandler")
 	}
@@ -1075,18 +1374,33 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserCheckUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.CheckUserServiceAccountsHandler")
 	}
+	if o.UserCleanupInactiveAccessKeysHandler == nil {
+		unregistered = append(unregistered, "user.CleanupInactiveAccessKeysHandler")
+	}
 	if o.ConfigurationConfigInfoHandler == nil {
 		unregistered = append(unregistered, "configuration.ConfigInfoHandler")
 	}
 	if o.UserCreateAUserServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user.CreateAUserServiceAccountHandler")
 	}
+	if o.AlertsCreateAlertChannelHandler == nil {
+		unregistered = append(unregistered, "alerts.CreateAlertChannelHandler")
+	}
+	if o.AlertsCreateAlertRuleHandler == nil {
+		unregistered = append(unregistered, "alerts.CreateAlertRuleHandler")
+	}
+	if o.AlertsCreateAlertSilenceHandler == nil {
+		unregistered = append(unregistered, "alerts.CreateAlertSilenceHandler")
+	}
 	if o.BucketCreateBucketEventHandler == nil {
 		unregistered = append(unregistered, "bucket.CreateBucketEventHandler")
 	}
 	if o.IdpCreateConfigurationHandler == nil {
 		unregistered = append(unregistered, "idp.CreateConfigurationHandler")
 	}
+	if o.SystemCreateDashboardHandler == nil {
+		unregistered = append(unregistered, "system.CreateDashboardHandler")
+	}
 	if o.ServiceAccountCreateServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.CreateServiceAccountHandler")
 	}
@@ -1096,12 +1410,24 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountCreateServiceAccountCredsHandler == nil {
 		unregistered = append(unregistered, "service_account.CreateServiceAccountCredsHandler")
 	}
+	if o.SystemDashboardCustomWidgetDetailsHandler == nil {
+		unregistered = append(unregistered, "system.DashboardCustomWidgetDetailsHandler")
+	}
 	if o.SystemDashboardWidgetDetailsHandler == nil {
 		unregistered = append(unregistered, "system.DashboardWidgetDetailsHandler")
 	}
 	if o.BucketDeleteAccessRuleWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAccessRuleWithBucketHandler")
 	}
+	if o.AlertsDeleteAlertChannelHandler == nil {
+		unregistered = append(unregistered, "alerts.DeleteAlertChannelHandler")
+	}
+	if o.AlertsDeleteAlertRuleHandler == nil {
+		unregistered = append(unregistered, "alerts.DeleteAlertRuleHandler")
+	}
+	if o.AlertsDeleteAlertSilenceHandler == nil {
+		unregistered = append(unregistered, "alerts.DeleteAlertSilenceHandler")
+	}
 	if o.BucketDeleteAllReplicationRulesHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAllReplicationRulesHandler")
 	}
@@ -1120,6 +1446,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.IdpDeleteConfigurationHandler == nil {
 		unregistered = append(unregistered, "idp.DeleteConfigurationHandler")
 	}
+	if o.SystemDeleteDashboardHandler == nil {
+		unregistered = append(unregistered, "system.DeleteDashboardHandler")
+	}
 	if o.ObjectDeleteMultipleObjectsHandler == nil {
 		unregistered = append(unregistered, "object.DeleteMultipleObjectsHandler")
 	}
@@ -1141,6 +1470,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountDeleteServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.DeleteServiceAccountHandler")
 	}
+	if o.SpeedtestDeleteSpeedtestResultHandler == nil {
+		unregistered = append(unregistered, "speedtest.DeleteSpeedtestResultHandler")
+	}
+	if o.PolicyDiffPolicyRevisionsHandler == nil {
+		unregistered = append(unregistered, "policy.DiffPolicyRevisionsHandler")
+	}
 	if o.BucketDisableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "bucket.DisableBucketEncryptionHandler")
 	}
@@ -1150,15 +1485,39 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ObjectDownloadMultipleObjectsHandler == nil {
 		unregistered = append(unregistered, "object.DownloadMultipleObjectsHandler")
 	}
+	if o.ObjectDownloadObjectsArchiveHandler == nil {
+		unregistered = append(unregistered, "object.DownloadObjectsArchiveHandler")
+	}
 	if o.TieringEditTierCredentialsHandler == nil {
 		unregistered = append(unregistered, "tiering.EditTierCredentialsHandler")
 	}
 	if o.BucketEnableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "bucket.EnableBucketEncryptionHandler")
 	}
+	if o.AlertsEvaluateAlertRulesHandler == nil {
+		unregistered = append(unregistered, "alerts.EvaluateAlertRulesHandler")
+	}
+	if o.BucketExportBucketAccessMatrixHandler == nil {
+		unregistered = append(unregistered, "bucket.ExportBucketAccessMatrixHandler")
+	}
 	if o.ConfigurationExportConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ExportConfigHandler")
 	}
+	if o.SystemExportDashboardHandler == nil {
+		unregistered = append(unregistered, "system.ExportDashboardHandler")
+	}
+	if o.SpeedtestExportSpeedtestResultsHandler == nil {
+		unregistered = append(unregistered, "speedtest.ExportSpeedtestResultsHandler")
+	}
+	if o.UserExportUsersHandler == nil {
+		unregistered = append(unregistered, "user.ExportUsersHandler")
+	}
+	if o.AlertsGetAlertRuleHandler == nil {
+		unregistered = append(unregistered, "alerts.GetAlertRuleHandler")
+	}
+	if o.BucketGetBucketAccessMatrixHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketAccessMatrixHandler")
+	}
 	if o.BucketGetBucketEncryptionInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketEncryptionInfoHandler")
 	}
@@ -1174,6 +1533,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketGetBucketReplicationHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketReplicationHandler")
 	}
+	if o.BucketGetBucketReplicationResyncStatusHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketReplicationResyncStatusHandler")
+	}
 	if o.BucketGetBucketReplicationRuleHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketReplicationRuleHandler")
 	}
@@ -1192,27 +1554,54 @@ func (o *ConsoleAPI) Validate() error {
 	if o.IdpGetConfigurationHandler == nil {
 		unregistered = append(unregistered, "idp.GetConfigurationHandler")
 	}
+	if o.SystemGetDashboardHandler == nil {
+		unregistered = append(unregistered, "system.GetDashboardHandler")
+	}
 	if o.IdpGetLDAPEntitiesHandler == nil {
 		unregistered = append(unregistered, "idp.GetLDAPEntitiesHandler")
 	}
+	if o.IdpGetLDAPUserGroupsHandler == nil {
+		unregistered = append(unregistered, "idp.GetLDAPUserGroupsHandler")
+	}
 	if o.BucketGetMaxShareLinkExpHandler == nil {
 		unregistered = append(unregistered, "bucket.GetMaxShareLinkExpHandler")
 	}
 	if o.ObjectGetObjectMetadataHandler == nil {
 		unregistered = append(unregistered, "object.GetObjectMetadataHandler")
 	}
+	if o.ObjectGetObjectVersionDiffHandler == nil {
+		unregistered = append(unregistered, "object.GetObjectVersionDiffHandler")
+	}
+	if o.ObjectGetObjectVersionHistoryHandler == nil {
+		unregistered = append(unregistered, "object.GetObjectVersionHistoryHandler")
+	}
+	if o.ReplicationGetReplicationMetricsHandler == nil {
+		unregistered = append(unregistered, "replication.GetReplicationMetricsHandler")
+	}
 	if o.PolicyGetSAUserPolicyHandler == nil {
 		unregistered = append(unregistered, "policy.GetSAUserPolicyHandler")
 	}
 	if o.ServiceAccountGetServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.GetServiceAccountHandler")
 	}
+	if o.ServiceAccountGetServiceAccountHygieneReportHandler == nil {
+		unregistered = append(unregistered, "service_account.GetServiceAccountHygieneReportHandler")
+	}
+	if o.SiteReplicationGetSiteReplicationDiffHandler == nil {
+		unregistered = append(unregistered, "site_replication.GetSiteReplicationDiffHandler")
+	}
 	if o.SiteReplicationGetSiteReplicationInfoHandler == nil {
 		unregistered = append(unregistered, "site_replication.GetSiteReplicationInfoHandler")
 	}
+	if o.SiteReplicationGetSiteReplicationPeerHealthHandler == nil {
+		unregistered = append(unregistered, "site_replication.GetSiteReplicationPeerHealthHandler")
+	}
 	if o.SiteReplicationGetSiteReplicationStatusHandler == nil {
 		unregistered = append(unregistered, "site_replication.GetSiteReplicationStatusHandler")
 	}
+	if o.SpeedtestGetSpeedtestResultHandler == nil {
+		unregistered = append(unregistered, "speedtest.GetSpeedtestResultHandler")
+	}
 	if o.TieringGetTierHandler == nil {
 		unregistered = append(unregistered, "tiering.GetTierHandler")
 	}
@@ -1225,6 +1614,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.GroupGroupInfoHandler == nil {
 		unregistered = append(unregistered, "group.GroupInfoHandler")
 	}
+	if o.SystemImportDashboardHandler == nil {
+		unregistered = append(unregistered, "system.ImportDashboardHandler")
+	}
+	if o.UserImportUsersHandler == nil {
+		unregistered = append(unregistered, "user.ImportUsersHandler")
+	}
 	if o.InspectInspectHandler == nil {
 		unregistered = append(unregistered, "inspect.InspectHandler")
 	}
@@ -1285,24 +1680,48 @@ func (o *ConsoleAPI) Validate() error {
 	if o.KmsKMSVersionHandler == nil {
 		unregistered = append(unregistered, "k_m_s.KMSVersionHandler")
 	}
+	if o.PolicyLintPoliciesHandler == nil {
+		unregistered = append(unregistered, "policy.LintPoliciesHandler")
+	}
+	if o.PolicyLintPolicyHandler == nil {
+		unregistered = append(unregistered, "policy.LintPolicyHandler")
+	}
 	if o.UserListAUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.ListAUserServiceAccountsHandler")
 	}
 	if o.BucketListAccessRulesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListAccessRulesWithBucketHandler")
 	}
+	if o.AlertsListAlertChannelsHandler == nil {
+		unregistered = append(unregistered, "alerts.ListAlertChannelsHandler")
+	}
+	if o.AlertsListAlertHistoryHandler == nil {
+		unregistered = append(unregistered, "alerts.ListAlertHistoryHandler")
+	}
+	if o.AlertsListAlertRulesHandler == nil {
+		unregistered = append(unregistered, "alerts.ListAlertRulesHandler")
+	}
+	if o.AlertsListAlertSilencesHandler == nil {
+		unregistered = append(unregistered, "alerts.ListAlertSilencesHandler")
+	}
 	if o.BucketListBucketEventsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListBucketEventsHandler")
 	}
 	if o.BucketListBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListBucketsHandler")
 	}
+	if o.SystemListCertificatesHandler == nil {
+		unregistered = append(unregistered, "system.ListCertificatesHandler")
+	}
 	if o.ConfigurationListConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ListConfigHandler")
 	}
 	if o.IdpListConfigurationsHandler == nil {
 		unregistered = append(unregistered, "idp.ListConfigurationsHandler")
 	}
+	if o.SystemListDashboardsHandler == nil {
+		unregistered = append(unregistered, "system.ListDashboardsHandler")
+	}
 	if o.BucketListExternalBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListExternalBucketsHandler")
 	}
@@ -1324,12 +1743,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketListPoliciesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListPoliciesWithBucketHandler")
 	}
+	if o.PolicyListPolicyRevisionsHandler == nil {
+		unregistered = append(unregistered, "policy.ListPolicyRevisionsHandler")
+	}
 	if o.ReleaseListReleasesHandler == nil {
 		unregistered = append(unregistered, "release.ListReleasesHandler")
 	}
 	if o.BucketListRemoteBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListRemoteBucketsHandler")
 	}
+	if o.SpeedtestListSpeedtestResultsHandler == nil {
+		unregistered = append(unregistered, "speedtest.ListSpeedtestResultsHandler")
+	}
 	if o.ServiceAccountListUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "service_account.ListUserServiceAccountsHandler")
 	}
@@ -1378,6 +1803,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ProfileProfilingStopHandler == nil {
 		unregistered = append(unregistered, "profile.ProfilingStopHandler")
 	}
+	if o.ObjectPurgeObjectVersionsHandler == nil {
+		unregistered = append(unregistered, "object.PurgeObjectVersionsHandler")
+	}
 	if o.BucketPutBucketTagsHandler == nil {
 		unregistered = append(unregistered, "bucket.PutBucketTagsHandler")
 	}
@@ -1402,6 +1830,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.PolicyRemovePolicyHandler == nil {
 		unregistered = append(unregistered, "policy.RemovePolicyHandler")
 	}
+	if o.TieringRemoveTierHandler == nil {
+		unregistered = append(unregistered, "tiering.RemoveTierHandler")
+	}
 	if o.UserRemoveUserHandler == nil {
 		unregistered = append(unregistered, "user.RemoveUserHandler")
 	}
@@ -1411,6 +1842,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceRestartServiceHandler == nil {
 		unregistered = append(unregistered, "service.RestartServiceHandler")
 	}
+	if o.PolicyRollbackPolicyHandler == nil {
+		unregistered = append(unregistered, "policy.RollbackPolicyHandler")
+	}
+	if o.ServiceAccountRotateServiceAccountHandler == nil {
+		unregistered = append(unregistered, "service_account.RotateServiceAccountHandler")
+	}
+	if o.IdpSearchLDAPGroupsHandler == nil {
+		unregistered = append(unregistered, "idp.SearchLDAPGroupsHandler")
+	}
+	if o.IdpSearchLDAPUsersHandler == nil {
+		unregistered = append(unregistered, "idp.SearchLDAPUsersHandler")
+	}
 	if o.AuthSessionCheckHandler == nil {
 		unregistered = append(unregistered, "auth.SessionCheckHandler")
 	}
@@ -1453,6 +1896,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SiteReplicationSiteReplicationRemoveHandler == nil {
 		unregistered = append(unregistered, "site_replication.SiteReplicationRemoveHandler")
 	}
+	if o.SiteReplicationSiteReplicationResyncHandler == nil {
+		unregistered = append(unregistered, "site_replication.SiteReplicationResyncHandler")
+	}
+	if o.BucketStartBucketReplicationResyncHandler == nil {
+		unregistered = append(unregistered, "bucket.StartBucketReplicationResyncHandler")
+	}
 	if o.SubnetSubnetAPIKeyHandler == nil {
 		unregistered = append(unregistered, "subnet.SubnetAPIKeyHandler")
 	}
@@ -1471,15 +1920,30 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SubnetSubnetRegisterHandler == nil {
 		unregistered = append(unregistered, "subnet.SubnetRegisterHandler")
 	}
+	if o.AlertsTestAlertChannelHandler == nil {
+		unregistered = append(unregistered, "alerts.TestAlertChannelHandler")
+	}
 	if o.TieringTiersListHandler == nil {
 		unregistered = append(unregistered, "tiering.TiersListHandler")
 	}
+	if o.TieringTiersUsageHandler == nil {
+		unregistered = append(unregistered, "tiering.TiersUsageHandler")
+	}
+	if o.AlertsUpdateAlertChannelHandler == nil {
+		unregistered = append(unregistered, "alerts.UpdateAlertChannelHandler")
+	}
+	if o.AlertsUpdateAlertRuleHandler == nil {
+		unregistered = append(unregistered, "alerts.UpdateAlertRuleHandler")
+	}
 	if o.BucketUpdateBucketLifecycleHandler == nil {
 		unregistered = append(unregistered, "bucket.UpdateBucketLifecycleHandler")
 	}
 	if o.IdpUpdateConfigurationHandler == nil {
 		unregistered = append(unregistered, "idp.UpdateConfigurationHandler")
 	}
+	if o.SystemUpdateDashboardHandler == nil {
+		unregistered = append(unregistered, "system.UpdateDashboardHandler")
+	}
 	if o.GroupUpdateGroupHandler == nil {
 		unregistered = append(unregistered, "group.UpdateGroupHandler")
 	}
@@ -1495,6 +1959,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserUpdateUserInfoHandler == nil {
 		unregistered = append(unregistered, "user.UpdateUserInfoHandler")
 	}
+	if o.TieringVerifyTierHandler == nil {
+		unregistered = append(unregistered, "tiering.VerifyTierHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1645,10 +2112,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/info"] = system.NewAdminInfo(o.context, o.SystemAdminInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/iam/change-set"] = user.NewApplyIAMChangeSet(o.context, o.UserApplyIAMChangeSetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/arns"] = system.NewArnList(o.context, o.SystemArnListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/ldap-directory/policies"] = idp.NewAttachLDAPPolicies(o.context, o.IdpAttachLDAPPoliciesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1669,6 +2144,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/users/service-accounts"] = user.NewCheckUserServiceAccounts(o.context, o.UserCheckUserServiceAccountsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/access-keys/cleanup-inactive"] = user.NewCleanupInactiveAccessKeys(o.context, o.UserCleanupInactiveAccessKeysHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1680,6 +2159,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/alerts/channels"] = alerts.NewCreateAlertChannel(o.context, o.AlertsCreateAlertChannelHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/rules"] = alerts.NewCreateAlertRule(o.context, o.AlertsCreateAlertRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/silences"] = alerts.NewCreateAlertSilence(o.context, o.AlertsCreateAlertSilenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/events"] = bucket.NewCreateBucketEvent(o.context, o.BucketCreateBucketEventHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1688,6 +2179,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/dashboards"] = system.NewCreateDashboard(o.context, o.SystemCreateDashboardHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/service-accounts"] = service_account.NewCreateServiceAccount(o.context, o.ServiceAccountCreateServiceAccountHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1700,6 +2195,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/dashboards/{id}/widgets/{widgetId}"] = system.NewDashboardCustomWidgetDetails(o.context, o.SystemDashboardCustomWidgetDetailsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/info/widgets/{widgetId}"] = system.NewDashboardWidgetDetails(o.context, o.SystemDashboardWidgetDetailsHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1708,6 +2207,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/alerts/channels/{id}"] = alerts.NewDeleteAlertChannel(o.context, o.AlertsDeleteAlertChannelHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/alerts/rules/{id}"] = alerts.NewDeleteAlertRule(o.context, o.AlertsDeleteAlertRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/alerts/silences/{id}"] = alerts.NewDeleteAlertSilence(o.context, o.AlertsDeleteAlertSilenceHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/delete-all-replication-rules"] = bucket.NewDeleteAllReplicationRules(o.context, o.BucketDeleteAllReplicationRulesHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1729,6 +2240,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/idp/{type}/{name}"] = idp.NewDeleteConfiguration(o.context, o.IdpDeleteConfigurationHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/dashboards/{id}"] = system.NewDeleteDashboard(o.context, o.SystemDeleteDashboardHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1757,6 +2272,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/service-accounts/{access_key}"] = service_account.NewDeleteServiceAccount(o.context, o.ServiceAccountDeleteServiceAccountHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/speedtest/results/{id}"] = speedtest.NewDeleteSpeedtestResult(o.context, o.SpeedtestDeleteSpeedtestResultHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/policy/{name}/revisions/diff"] = policy.NewDiffPolicyRevisions(o.context, o.PolicyDiffPolicyRevisionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1769,6 +2292,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/buckets/{bucket_name}/objects/download-multiple"] = object.NewDownloadMultipleObjects(o.context, o.ObjectDownloadMultipleObjectsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/download-archive"] = object.NewDownloadObjectsArchive(o.context, o.ObjectDownloadObjectsArchiveHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1777,6 +2304,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/buckets/{bucket_name}/encryption/enable"] = bucket.NewEnableBucketEncryption(o.context, o.BucketEnableBucketEncryptionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/evaluate"] = alerts.NewEvaluateAlertRules(o.context, o.AlertsEvaluateAlertRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bucket/{bucket}/access-matrix/export"] = bucket.NewExportBucketAccessMatrix(o.context, o.BucketExportBucketAccessMatrixHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1784,6 +2319,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/dashboards/{id}/export"] = system.NewExportDashboard(o.context, o.SystemExportDashboardHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/speedtest/export"] = speedtest.NewExportSpeedtestResults(o.context, o.SpeedtestExportSpeedtestResultsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/export"] = user.NewExportUsers(o.context, o.UserExportUsersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/rules/{id}"] = alerts.NewGetAlertRule(o.context, o.AlertsGetAlertRuleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bucket/{bucket}/access-matrix"] = bucket.NewGetBucketAccessMatrix(o.context, o.BucketGetBucketAccessMatrixHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/encryption/info"] = bucket.NewGetBucketEncryptionInfo(o.context, o.BucketGetBucketEncryptionInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1804,6 +2359,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-resync"] = bucket.NewGetBucketReplicationResyncStatus(o.context, o.BucketGetBucketReplicationResyncStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/replication/{rule_id}"] = bucket.NewGetBucketReplicationRule(o.context, o.BucketGetBucketReplicationRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1825,6 +2384,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/idp/{type}/{name}"] = idp.NewGetConfiguration(o.context, o.IdpGetConfigurationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboards/{id}"] = system.NewGetDashboard(o.context, o.SystemGetDashboardHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1832,6 +2395,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/ldap-directory/user-groups"] = idp.NewGetLDAPUserGroups(o.context, o.IdpGetLDAPUserGroupsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/max-share-exp"] = bucket.NewGetMaxShareLinkExp(o.context, o.BucketGetMaxShareLinkExpHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1840,6 +2407,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/versions/diff"] = object.NewGetObjectVersionDiff(o.context, o.ObjectGetObjectVersionDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/versions"] = object.NewGetObjectVersionHistory(o.context, o.ObjectGetObjectVersionHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/replication/metrics"] = replication.NewGetReplicationMetrics(o.context, o.ReplicationGetReplicationMetricsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user/{name}/policies"] = policy.NewGetSAUserPolicy(o.context, o.PolicyGetSAUserPolicyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1848,14 +2427,30 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/service-accounts/hygiene"] = service_account.NewGetServiceAccountHygieneReport(o.context, o.ServiceAccountGetServiceAccountHygieneReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/site-replication/diff"] = site_replication.NewGetSiteReplicationDiff(o.context, o.SiteReplicationGetSiteReplicationDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/site-replication"] = site_replication.NewGetSiteReplicationInfo(o.context, o.SiteReplicationGetSiteReplicationInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/site-replication/health"] = site_replication.NewGetSiteReplicationPeerHealth(o.context, o.SiteReplicationGetSiteReplicationPeerHealthHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/site-replication/status"] = site_replication.NewGetSiteReplicationStatus(o.context, o.SiteReplicationGetSiteReplicationStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/speedtest/results/{id}"] = speedtest.NewGetSpeedtestResult(o.context, o.SpeedtestGetSpeedtestResultHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/tiers/{type}/{name}"] = tiering.NewGetTier(o.context, o.TieringGetTierHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1869,6 +2464,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/group/{name}"] = group.NewGroupInfo(o.context, o.GroupGroupInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/dashboards/import"] = system.NewImportDashboard(o.context, o.SystemImportDashboardHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/import"] = user.NewImportUsers(o.context, o.UserImportUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1952,6 +2555,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/policies/lint"] = policy.NewLintPolicies(o.context, o.PolicyLintPoliciesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policies/lint"] = policy.NewLintPolicy(o.context, o.PolicyLintPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user/{name}/service-accounts"] = user.NewListAUserServiceAccounts(o.context, o.UserListAUserServiceAccountsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1960,6 +2571,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/alerts/channels"] = alerts.NewListAlertChannels(o.context, o.AlertsListAlertChannelsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/history"] = alerts.NewListAlertHistory(o.context, o.AlertsListAlertHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/rules"] = alerts.NewListAlertRules(o.context, o.AlertsListAlertRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/silences"] = alerts.NewListAlertSilences(o.context, o.AlertsListAlertSilencesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/events"] = bucket.NewListBucketEvents(o.context, o.BucketListBucketEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1968,11 +2595,19 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/certificates"] = system.NewListCertificates(o.context, o.SystemListCertificatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/configs"] = configuration.NewListConfig(o.context, o.ConfigurationListConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/idp/{type}"] = idp.NewListConfigurations(o.context, o.IdpListConfigurationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboards"] = system.NewListDashboards(o.context, o.SystemListDashboardsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -2004,6 +2639,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/policy/{name}/revisions"] = policy.NewListPolicyRevisions(o.context, o.PolicyListPolicyRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/releases"] = release.NewListReleases(o.context, o.ReleaseListReleasesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -2012,6 +2651,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/speedtest/results"] = speedtest.NewListSpeedtestResults(o.context, o.SpeedtestListSpeedtestResultsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/service-accounts"] = service_account.NewListUserServiceAccounts(o.context, o.ServiceAccountListUserServiceAccountsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -2073,6 +2716,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/profiling/stop"] = profile.NewProfilingStop(o.context, o.ProfileProfilingStopHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/versions/purge"] = object.NewPurgeObjectVersions(o.context, o.ObjectPurgeObjectVersionsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -2108,6 +2755,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/tiers/{name}"] = tiering.NewRemoveTier(o.context, o.TieringRemoveTierHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/user/{name}"] = user.NewRemoveUser(o.context, o.UserRemoveUserHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -2117,6 +2768,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/service/restart"] = service.NewRestartService(o.context, o.ServiceRestartServiceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy/{name}/revisions/{revision}/rollback"] = policy.NewRollbackPolicy(o.context, o.PolicyRollbackPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/service-accounts/{access_key}/rotate"] = service_account.NewRotateServiceAccount(o.context, o.ServiceAccountRotateServiceAccountHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/ldap-directory/groups"] = idp.NewSearchLDAPGroups(o.context, o.IdpSearchLDAPGroupsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/ldap-directory/users"] = idp.NewSearchLDAPUsers(o.context, o.IdpSearchLDAPUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -2173,6 +2840,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/admin/site-replication"] = site_replication.NewSiteReplicationRemove(o.context, o.SiteReplicationSiteReplicationRemoveHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/site-replication/resync"] = site_replication.NewSiteReplicationResync(o.context, o.SiteReplicationSiteReplicationResyncHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/replication-resync"] = bucket.NewStartBucketReplicationResync(o.context, o.BucketStartBucketReplicationResyncHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -2197,10 +2872,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/subnet/register"] = subnet.NewSubnetRegister(o.context, o.SubnetSubnetRegisterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/channels/{id}/test"] = alerts.NewTestAlertChannel(o.context, o.AlertsTestAlertChannelHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/tiers"] = tiering.NewTiersList(o.context, o.TieringTiersListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/tiers/usage"] = tiering.NewTiersUsage(o.context, o.TieringTiersUsageHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/alerts/channels/{id}"] = alerts.NewUpdateAlertChannel(o.context, o.AlertsUpdateAlertChannelHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/alerts/rules/{id}"] = alerts.NewUpdateAlertRule(o.context, o.AlertsUpdateAlertRuleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -2212,6 +2903,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/dashboards/{id}"] = system.NewUpdateDashboard(o.context, o.SystemUpdateDashboardHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/group/{name}"] = group.NewUpdateGroup(o.context, o.GroupUpdateGroupHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -2229,6 +2924,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/user/{name}"] = user.NewUpdateUserInfo(o.context, o.UserUpdateUserInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/tiers/{name}/verify"] = tiering.NewVerifyTier(o.context, o.TieringVerifyTierHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -30,14 +30,22 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 )
 
 // NewDownloadMultipleObjectsParams creates a new DownloadMultipleObjectsParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewDownloadMultipleObjectsParams() DownloadMultipleObjectsParams {
 
-	return DownloadMultipleObjectsParams{}
+	var (
+		// initialize parameters with default values
+
+		formatDefault = string("zip-deflate")
+	)
+
+	return DownloadMultipleObjectsParams{
+		Format: &formatDefault,
+	}
 }
 
 // DownloadMultipleObjectsParams contains all the bound params for the download multiple objects operation
@@ -54,6 +62,11 @@ type DownloadMultipleObjectsParams struct {
 	  In: path
 	*/
 	BucketName string
+	/*archive format used when downloading multiple objects or a folder
+	  In: query
+	  Default: "zip-deflate"
+	*/
+	Format *string
 	/*
 	  Required: true
 	  In: body
@@ -70,11 +83,18 @@ func (o *DownloadMultipleObjectsParams) BindRequest(r *http.Request, route *midd
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rBucketName, rhkBucketName, _ := route.Params.GetOK("bucket_name")
 	if err := o.bindBucketName(rBucketName, rhkBucketName, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qFormat, qhkFormat, _ := qs.GetOK("format")
+	if err := o.bindFormat(qFormat, qhkFormat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body []string
@@ -110,3 +130,36 @@ func (o *DownloadMultipleObjectsParams) bindBucketName(rawData []string, hasKey
 
 	return nil
 }
+
+// bindFormat binds and validates parameter Format from query.
+func (o *DownloadMultipleObjectsParams) bindFormat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewDownloadMultipleObjectsParams()
+		return nil
+	}
+	o.Format = &raw
+
+	if err := o.validateFormat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFormat carries on validations for parameter Format
+func (o *DownloadMultipleObjectsParams) validateFormat(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("format", "query", *o.Format, []interface{}{"zip-deflate", "zip-store", "tar", "tar.gz", "tar.zst"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -33,6 +33,8 @@ import (
 type DownloadMultipleObjectsURL struct {
 	BucketName string
 
+	Format *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -72,6 +74,18 @@ func (o *DownloadMultipleObjectsURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var formatQ string
+	if o.Format != nil {
+		formatQ = *o.Format
+	}
+	if formatQ != "" {
+		qs.Set("format", formatQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
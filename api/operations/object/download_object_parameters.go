@@ -40,12 +40,15 @@ func NewDownloadObjectParams() DownloadObjectParams {
 	var (
 		// initialize parameters with default values
 
+		formatDefault           = string("zip-deflate")
 		overrideFileNameDefault = string("")
 
 		previewDefault = bool(false)
 	)
 
 	return DownloadObjectParams{
+		Format: &formatDefault,
+
 		OverrideFileName: &overrideFileNameDefault,
 
 		Preview: &previewDefault,
@@ -66,6 +69,11 @@ type DownloadObjectParams struct {
 	  In: path
 	*/
 	BucketName string
+	/*archive format used when downloading multiple objects or a folder
+	  In: query
+	  Default: "zip-deflate"
+	*/
+	Format *string
 	/*
 	  In: query
 	  Default: ""
@@ -103,6 +111,11 @@ func (o *DownloadObjectParams) BindRequest(r *http.Request, route *middleware.Ma
 		res = append(res, err)
 	}
 
+	qFormat, qhkFormat, _ := qs.GetOK("format")
+	if err := o.bindFormat(qFormat, qhkFormat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOverrideFileName, qhkOverrideFileName, _ := qs.GetOK("override_file_name")
 	if err := o.bindOverrideFileName(qOverrideFileName, qhkOverrideFileName, route.Formats); err != nil {
 		res = append(res, err)
@@ -142,6 +155,39 @@ func (o *DownloadObjectParams) bindBucketName(rawData []string, hasKey bool, for
 	return nil
 }
 
+// bindFormat binds and validates parameter Format from query.
+func (o *DownloadObjectParams) bindFormat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewDownloadObjectParams()
+		return nil
+	}
+	o.Format = &raw
+
+	if err := o.validateFormat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFormat carries on validations for parameter Format
+func (o *DownloadObjectParams) validateFormat(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("format", "query", *o.Format, []interface{}{"zip-deflate", "zip-store", "tar", "tar.gz", "tar.zst"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindOverrideFileName binds and validates parameter OverrideFileName from query.
 func (o *DownloadObjectParams) bindOverrideFileName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -35,6 +35,7 @@ import (
 type DownloadObjectURL struct {
 	BucketName string
 
+	Format           *string
 	OverrideFileName *string
 	Prefix           string
 	Preview          *bool
@@ -81,6 +82,14 @@ func (o *DownloadObjectURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var formatQ string
+	if o.Format != nil {
+		formatQ = *o.Format
+	}
+	if formatQ != "" {
+		qs.Set("format", formatQ)
+	}
+
 	var overrideFileNameQ string
 	if o.OverrideFileName != nil {
 		overrideFileNameQ = *o.OverrideFileName
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -164,6 +165,7 @@ func getCreateServiceAccountResponse(session *models.Principal, params saApi.Cre
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, saCreds.AccessKey, nil, params.Body)
 	return saCreds, nil
 }
 
@@ -214,6 +216,7 @@ func getCreateAUserServiceAccountResponse(session *models.Principal, params user
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, saCreds.AccessKey, nil, params.Body)
 	return saCreds, nil
 }
 
@@ -259,6 +262,7 @@ func getCreateAUserServiceAccountCredsResponse(session *models.Principal, params
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, saCreds.AccessKey, nil, serviceAccount)
 	return saCreds, nil
 }
 
@@ -301,6 +305,7 @@ func getCreateServiceAccountCredsResponse(session *models.Principal, params saAp
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, saCreds.AccessKey, nil, serviceAccount)
 	return saCreds, nil
 }
 
@@ -328,11 +333,43 @@ func getUserServiceAccounts(ctx context.Context, userClient MinioAdmin, user str
 			Expiration:    expiry,
 			Name:          aInfo.Name,
 			AccessKey:     acc.AccessKey,
+			Usage:         accessKeyUsage(globalAccessKeyUsage, acc.AccessKey),
 		})
 	}
 	return saList, nil
 }
 
+// serviceAccountDetails - a service account along with its details
+type serviceAccountDetails struct {
+	AccessKey string
+	madmin.InfoServiceAccountResp
+}
+
+// listAllServiceAccounts returns the service accounts of the users and of the requester, it may not be
+// an internal user, each one once. Service accounts whose details can't be read are left out.
+func listAllServiceAccounts(ctx context.Context, client MinioAdmin, users []string) ([]serviceAccountDetails, error) {
+	var accounts []serviceAccountDetails
+	seen := map[string]bool{}
+	for _, owner := range append(append([]string{}, users...), "") {
+		list, err := client.listServiceAccounts(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		for _, acc := range list.Accounts {
+			if seen[acc.AccessKey] {
+				continue
+			}
+			seen[acc.AccessKey] = true
+			info, err := client.infoServiceAccount(ctx, acc.AccessKey)
+			if err != nil {
+				continue
+			}
+			accounts = append(accounts, serviceAccountDetails{AccessKey: acc.AccessKey, InfoServiceAccountResp: info})
+		}
+	}
+	return accounts, nil
+}
+
 // getUserServiceAccountsResponse authenticates the user and calls
 // getUserServiceAccounts to list the user's service accounts
 func getUserServiceAccountsResponse(ctx context.Context, session *models.Principal, user string) (models.ServiceAccounts, *CodedAPIError) {
@@ -374,9 +411,11 @@ func getDeleteServiceAccountResponse(session *models.Principal, params saApi.Del
 	// create a MinIO user Admin Client interface implementation
 	// defining the client to be used
 	userAdminClient := AdminClient{Client: userAdmin}
+	before, _ := getServiceAccountDetails(ctx, userAdminClient, accessKey)
 	if err := deleteServiceAccount(ctx, userAdminClient, accessKey); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, accessKey, before, nil)
 	return nil
 }
 
@@ -478,10 +517,13 @@ func updateSetServiceAccountResponse(session *models.Principal, params saApi.Upd
 			return ErrorWithContext(ctx, err)
 		}
 	}
+	before, _ := getServiceAccountDetails(ctx, userAdminClient, accessKey)
 	err = updateServiceAccountDetails(ctx, userAdminClient, accessKey, policy, parsedExpiry, params.Body.Name, params.Body.Description, params.Body.Status, params.Body.SecretKey)
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	after, _ := getServiceAccountDetails(ctx, userAdminClient, accessKey)
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, accessKey, before, after)
 	return nil
 }
 
@@ -502,5 +544,6 @@ func getDeleteMultipleServiceAccountsResponse(session *models.Principal, params
 			return ErrorWithContext(ctx, err)
 		}
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, strings.Join(selectedSAs, ","), selectedSAs, nil)
 	return nil
 }
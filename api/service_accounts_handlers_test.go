@@ -114,6 +114,47 @@ func TestListServiceAccounts(t *testing.T) {
 	}
 }
 
+func TestListAllServiceAccounts(t *testing.T) {
+	assert := assert.New(t)
+	client := AdminClientMock{}
+	ctx := context.Background()
+
+	// the requester's service accounts are listed as well, accounts show up once
+	accounts := map[string][]string{"alice": {"sa-alice", "sa-gone"}, "bob": {"sa-bob"}, "": {"sa-alice", "sa-root"}}
+	var owners []string
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		owners = append(owners, user)
+		var resp madmin.ListServiceAccountsResp
+		for _, accessKey := range accounts[user] {
+			resp.Accounts = append(resp.Accounts, madmin.ServiceAccountInfo{AccessKey: accessKey})
+		}
+		return resp, nil
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, accessKey string) (madmin.InfoServiceAccountResp, error) {
+		if accessKey == "sa-gone" {
+			return madmin.InfoServiceAccountResp{}, errors.New("the specified service account is not found")
+		}
+		return madmin.InfoServiceAccountResp{ParentUser: accessKey[3:]}, nil
+	}
+	users := []string{"alice", "bob"}
+	list, err := listAllServiceAccounts(ctx, client, users)
+	assert.Nil(err)
+	assert.Equal([]string{"alice", "bob", ""}, owners)
+	assert.Equal([]string{"alice", "bob"}, users)
+	var accessKeys []string
+	for _, acc := range list {
+		accessKeys = append(accessKeys, acc.AccessKey)
+		assert.Equal(acc.AccessKey[3:], acc.ParentUser)
+	}
+	assert.Equal([]string{"sa-alice", "sa-bob", "sa-root"}, accessKeys)
+
+	minioListServiceAccountsMock = func(_ context.Context, _ string) (madmin.ListServiceAccountsResp, error) {
+		return madmin.ListServiceAccountsResp{}, errors.New("access denied")
+	}
+	_, err = listAllServiceAccounts(ctx, client, users)
+	assert.EqualError(err, "access denied")
+}
+
 func TestDeleteServiceAccount(t *testing.T) {
 	assert := assert.New(t)
 	// mock minIO client
@@ -0,0 +1,366 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	saApi "github.com/minio/console/api/operations/service_account"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/madmin-go/v3"
+	iampolicy "github.com/minio/pkg/v2/policy"
+	"github.com/minio/pkg/v2/wildcard"
+)
+
+const (
+	serviceAccountFindingExpired           = "expired"
+	serviceAccountFindingExpiringSoon      = "expiringSoon"
+	serviceAccountFindingNoExpiry          = "noExpiry"
+	serviceAccountFindingBroaderThanParent = "broaderThanParent"
+
+	defaultRotationGracePeriod = 24 * time.Hour
+)
+
+func registerServiceAccountHygieneHandlers(api *operations.ConsoleAPI) {
+	// Service accounts hygiene report
+	api.ServiceAccountGetServiceAccountHygieneReportHandler = saApi.GetServiceAccountHygieneReportHandlerFunc(func(params saApi.GetServiceAccountHygieneReportParams, session *models.Principal) middleware.Responder {
+		resp, err := getServiceAccountHygieneReportResponse(session, params)
+		if err != nil {
+			return saApi.NewGetServiceAccountHygieneReportDefault(err.Code).WithPayload(err.APIError)
+		}
+		return saApi.NewGetServiceAccountHygieneReportOK().WithPayload(resp)
+	})
+	// Rotate service account
+	api.ServiceAccountRotateServiceAccountHandler = saApi.RotateServiceAccountHandlerFunc(func(params saApi.RotateServiceAccountParams, session *models.Principal) middleware.Responder {
+		resp, err := getRotateServiceAccountResponse(session, params)
+		if err != nil {
+			return saApi.NewRotateServiceAccountDefault(err.Code).WithPayload(err.APIError)
+		}
+		return saApi.NewRotateServiceAccountCreated().WithPayload(resp)
+	})
+}
+
+// serviceAccountExpires tells whether the service account has an expiration, MinIO reports
+// service accounts that never expire with a zero unix time
+func serviceAccountExpires(expiration *time.Time) bool {
+	return expiration != nil && expiration.After(time.Unix(0, 0))
+}
+
+// policyGrants tells whether any of the allow statements grants action on resource, patterns
+// are compared as wildcards so s3:* covers s3:Get* but not the other way around
+func policyGrants(statements []iampolicy.Statement, action, resource string) bool {
+	for _, statement := range statements {
+		if statement.Effect != iampolicy.Allow {
+			continue
+		}
+		for a := range statement.Actions {
+			if !wildcard.Match(string(a), action) {
+				continue
+			}
+			for _, r := range statementResources(statement) {
+				if wildcard.Match(r, resource) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// statementResources returns the resource patterns of a statement, statements on admin actions
+// have no resources
+func statementResources(statement iampolicy.Statement) []string {
+	if len(statement.Resources) == 0 {
+		return []string{""}
+	}
+	var resources []string
+	for r := range statement.Resources {
+		resources = append(resources, r.Pattern)
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+// broaderThanParent returns the actions of an inline policy not granted by the parent policies,
+// conditions are not compared
+func broaderThanParent(policy *iampolicy.Policy, parent []iampolicy.Statement) []string {
+	var broader []string
+	for _, statement := range policy.Statements {
+		if statement.Effect != iampolicy.Allow {
+			continue
+		}
+		var actions []string
+		for a := range statement.Actions {
+			actions = append(actions, string(a))
+		}
+		sort.Strings(actions)
+		for _, action := range actions {
+			for _, resource := range statementResources(statement) {
+				if policyGrants(parent, action, resource) {
+					continue
+				}
+				if resource == "" {
+					broader = append(broader, action)
+				} else {
+					broader = append(broader, fmt.Sprintf("%s on %s", action, resource))
+				}
+			}
+		}
+	}
+	return UniqueKeys(broader)
+}
+
+// parentPolicies resolves the statements an internal user gets from its own and its groups' policies
+type parentPolicies struct {
+	client   MinioAdmin
+	users    map[string]madmin.UserInfo
+	policies map[string]*iampolicy.Policy
+	groups   map[string]string
+	resolved map[string][]iampolicy.Statement
+}
+
+// statements returns the statements granted to parent, false when the parent isn't an internal user
+// like the root user or an LDAP/OpenID identity
+func (p *parentPolicies) statements(ctx context.Context, parent string) ([]iampolicy.Statement, bool, error) {
+	userInfo, ok := p.users[parent]
+	if !ok {
+		return nil, false, nil
+	}
+	if statements, ok := p.resolved[parent]; ok {
+		return statements, true, nil
+	}
+	if p.policies == nil {
+		policies, err := p.client.listPolicies(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		p.policies = policies
+	}
+	names := strings.Split(userInfo.PolicyName, ",")
+	for _, group := range userInfo.MemberOf {
+		policy, ok := p.groups[group]
+		if !ok {
+			desc, err := p.client.getGroupDescription(ctx, group)
+			if err != nil {
+				return nil, false, err
+			}
+			policy = desc.Policy
+			p.groups[group] = policy
+		}
+		names = append(names, strings.Split(policy, ",")...)
+	}
+	var statements []iampolicy.Statement
+	for _, name := range names {
+		if policy, ok := p.policies[strings.TrimSpace(name)]; ok && policy != nil {
+			statements = append(statements, policy.Statements...)
+		}
+	}
+	p.resolved[parent] = statements
+	return statements, true, nil
+}
+
+// getServiceAccountHygieneReport goes through the service accounts of every user and of the requester
+// and reports the ones that are expired, expire within expiringWithin, never expire or have an
+// inline policy granting more than their parent has
+func getServiceAccountHygieneReport(ctx context.Context, client MinioAdmin, now time.Time, expiringWithin time.Duration) (*models.ServiceAccountHygieneReport, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var owners []string
+	for user := range users {
+		owners = append(owners, user)
+	}
+	sort.Strings(owners)
+
+	parents := &parentPolicies{
+		client:   client,
+		users:    users,
+		groups:   map[string]string{},
+		resolved: map[string][]iampolicy.Statement{},
+	}
+	report := &models.ServiceAccountHygieneReport{
+		ExpiringWithinDays: int32(expiringWithin / (24 * time.Hour)),
+		Accounts:           []*models.ServiceAccountHygieneItem{},
+	}
+	accounts, err := listAllServiceAccounts(ctx, client, owners)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range accounts {
+		report.Total++
+
+		item := &models.ServiceAccountHygieneItem{
+			AccessKey:     info.AccessKey,
+			ParentUser:    info.ParentUser,
+			Name:          info.Name,
+			AccountStatus: info.AccountStatus,
+			Findings:      []string{},
+			Details:       []string{},
+		}
+		switch {
+		case !serviceAccountExpires(info.Expiration):
+			item.Findings = append(item.Findings, serviceAccountFindingNoExpiry)
+		case !info.Expiration.After(now):
+			item.Expiration = info.Expiration.Format(time.RFC3339)
+			item.Findings = append(item.Findings, serviceAccountFindingExpired)
+		case info.Expiration.Before(now.Add(expiringWithin)):
+			item.Expiration = info.Expiration.Format(time.RFC3339)
+			item.Findings = append(item.Findings, serviceAccountFindingExpiringSoon)
+		default:
+			item.Expiration = info.Expiration.Format(time.RFC3339)
+		}
+
+		if !info.ImpliedPolicy && info.Policy != "" {
+			parent, ok, err := parents.statements(ctx, info.ParentUser)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				policy, err := iampolicy.ParseConfig(strings.NewReader(info.Policy))
+				if err != nil {
+					return nil, fmt.Errorf("service account %s has an invalid policy: %v", info.AccessKey, err)
+				}
+				if broader := broaderThanParent(policy, parent); len(broader) > 0 {
+					item.Findings = append(item.Findings, serviceAccountFindingBroaderThanParent)
+					item.Details = append(item.Details, broader...)
+				}
+			}
+		}
+
+		if len(item.Findings) > 0 {
+			report.Flagged++
+			report.Accounts = append(report.Accounts, item)
+		}
+	}
+	return report, nil
+}
+
+func getServiceAccountHygieneReportResponse(session *models.Principal, params saApi.GetServiceAccountHygieneReportParams) (*models.ServiceAccountHygieneReport, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	days := int32(7)
+	if params.ExpiringWithinDays != nil {
+		days = *params.ExpiringWithinDays
+	}
+	report, err := getServiceAccountHygieneReport(ctx, adminClient, time.Now().UTC(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return report, nil
+}
+
+// rotateServiceAccount creates a replacement for a service account with the same parent, policy, name
+// and description, the old credential is expired by MinIO once the grace period is over, or deleted
+// right away without one. The replacement is removed if the old credential can't be scheduled.
+func rotateServiceAccount(ctx context.Context, client MinioAdmin, accessKey string, now time.Time, gracePeriod time.Duration, expiry time.Time) (*models.RotateServiceAccountResponse, error) {
+	info, err := client.infoServiceAccount(ctx, accessKey)
+	if err != nil {
+		return nil, err
+	}
+	policy := ""
+	if !info.ImpliedPolicy {
+		policy = info.Policy
+	}
+	creds, err := createAUserServiceAccountCreds(ctx, client, policy, info.ParentUser, "", "", info.Name, info.Description, &expiry, "")
+	if err != nil {
+		return nil, err
+	}
+	var undo iamUndoLog
+	undo.add(func(ctx context.Context) error {
+		return deleteServiceAccount(ctx, client, creds.AccessKey)
+	})
+
+	deadline := now.Add(gracePeriod)
+	switch {
+	case gracePeriod == 0:
+		err = deleteServiceAccount(ctx, client, accessKey)
+	case serviceAccountExpires(info.Expiration) && info.Expiration.Before(deadline):
+		// it already expires within the grace period
+		deadline = *info.Expiration
+	default:
+		err = client.updateServiceAccount(ctx, accessKey, madmin.UpdateServiceAccountReq{NewExpiration: &deadline})
+	}
+	if err = undo.rollbackOnError(ctx, err); err != nil {
+		return nil, err
+	}
+	return &models.RotateServiceAccountResponse{
+		Credentials:        creds,
+		PreviousAccessKey:  accessKey,
+		PreviousExpiration: deadline.Format(time.RFC3339),
+	}, nil
+}
+
+func getRotateServiceAccountResponse(session *models.Principal, params saApi.RotateServiceAccountParams) (*models.RotateServiceAccountResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	accessKey, err := utils.DecodeBase64(params.AccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	gracePeriod := defaultRotationGracePeriod
+	if params.Body.GracePeriod != "" {
+		gracePeriod, err = time.ParseDuration(params.Body.GracePeriod)
+		if err != nil {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+		}
+		if gracePeriod < 0 {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("grace period can't be negative"))
+		}
+	}
+	var expiry time.Time
+	if params.Body.Expiry != "" {
+		expiry, err = time.Parse(time.RFC3339, params.Body.Expiry)
+		if err != nil {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+		}
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	before, _ := getServiceAccountDetails(ctx, adminClient, accessKey)
+	resp, err := rotateServiceAccount(ctx, adminClient, accessKey, time.Now().UTC(), gracePeriod, expiry)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	auditEvent(params.HTTPRequest, session, auditTargetServiceAccount, accessKey, before, map[string]string{
+		"accessKey":          resp.Credentials.AccessKey,
+		"previousExpiration": resp.PreviousExpiration,
+	})
+	return resp, nil
+}
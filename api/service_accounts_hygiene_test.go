@@ -0,0 +1,176 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	iampolicy "github.com/minio/pkg/v2/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	readOnlyPhotosPolicy  = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:Get*","s3:ListBucket"],"Resource":["arn:aws:s3:::photos","arn:aws:s3:::photos/*"]}]}`
+	getPhotosPolicy       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/2024/*"]}]}`
+	writeEverywherePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::*"]},{"Effect":"Allow","Action":["admin:ServerInfo"]}]}`
+)
+
+func mustParsePolicy(t *testing.T, policy string) *iampolicy.Policy {
+	p, err := iampolicy.ParseConfig(strings.NewReader(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func Test_broaderThanParent(t *testing.T) {
+	parent := mustParsePolicy(t, readOnlyPhotosPolicy).Statements
+	assert.Empty(t, broaderThanParent(mustParsePolicy(t, getPhotosPolicy), parent))
+	assert.Equal(t, []string{
+		"s3:GetObject on *",
+		"s3:PutObject on *",
+		"admin:ServerInfo",
+	}, broaderThanParent(mustParsePolicy(t, writeEverywherePolicy), parent))
+}
+
+func Test_getServiceAccountHygieneReport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Hour)
+	soon := now.Add(48 * time.Hour)
+	later := now.Add(30 * 24 * time.Hour)
+	never := time.Unix(0, 0).UTC()
+
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {MemberOf: []string{"photographers"}},
+			"bob":   {PolicyName: "readwrite"},
+		}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: "photos-read"}, nil
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"photos-read": mustParsePolicy(t, readOnlyPhotosPolicy)}, nil
+	}
+	accounts := map[string][]string{"alice": {"sa-1", "sa-2", "sa-3"}, "bob": {"sa-4"}, "": {"sa-5", "sa-1"}}
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		var resp madmin.ListServiceAccountsResp
+		for _, accessKey := range accounts[user] {
+			resp.Accounts = append(resp.Accounts, madmin.ServiceAccountInfo{AccessKey: accessKey})
+		}
+		return resp, nil
+	}
+	infos := map[string]madmin.InfoServiceAccountResp{
+		"sa-1": {ParentUser: "alice", ImpliedPolicy: true, Expiration: &later},
+		"sa-2": {ParentUser: "alice", Policy: writeEverywherePolicy, Expiration: &soon},
+		"sa-3": {ParentUser: "alice", Policy: getPhotosPolicy, Expiration: &expired},
+		"sa-4": {ParentUser: "bob", ImpliedPolicy: true, Expiration: &never},
+		// the root user's, its parent's policies are unknown
+		"sa-5": {ParentUser: "minioadmin", Policy: writeEverywherePolicy},
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, accessKey string) (madmin.InfoServiceAccountResp, error) {
+		return infos[accessKey], nil
+	}
+
+	report, err := getServiceAccountHygieneReport(ctx, adminClient, now, 7*24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), report.ExpiringWithinDays)
+	assert.Equal(t, int64(5), report.Total)
+	assert.Equal(t, int64(4), report.Flagged)
+	findings := map[string][]string{}
+	for _, item := range report.Accounts {
+		findings[item.AccessKey] = item.Findings
+	}
+	assert.Equal(t, map[string][]string{
+		"sa-2": {serviceAccountFindingExpiringSoon, serviceAccountFindingBroaderThanParent},
+		"sa-3": {serviceAccountFindingExpired},
+		"sa-4": {serviceAccountFindingNoExpiry},
+		"sa-5": {serviceAccountFindingNoExpiry},
+	}, findings)
+	assert.Equal(t, soon.Format(time.RFC3339), report.Accounts[0].Expiration)
+	assert.Contains(t, report.Accounts[0].Details, "s3:PutObject on *")
+
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return nil, errors.New("access denied")
+	}
+	_, err = getServiceAccountHygieneReport(ctx, adminClient, now, 7*24*time.Hour)
+	assert.EqualError(t, err, "access denied")
+}
+
+func Test_rotateServiceAccount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	mocks := setIAMChangeSetMocks()
+	var added []string
+	minioAddServiceAccountMock = func(_ context.Context, policy string, user string, _ string, _ string, name string, description string, _ *time.Time, _ string) (madmin.Credentials, error) {
+		added = append(added, strings.Join([]string{user, policy, name, description}, "|"))
+		return madmin.Credentials{AccessKey: "sa-new", SecretKey: "sa-new-secret"}, nil
+	}
+	var updated map[string]time.Time
+	minioUpdateServiceAccountMock = func(_ context.Context, serviceAccount string, opts madmin.UpdateServiceAccountReq) error {
+		updated = map[string]time.Time{serviceAccount: *opts.NewExpiration}
+		return nil
+	}
+	expiration := now.Add(time.Hour)
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", Policy: getPhotosPolicy, Name: "backup", Description: "nightly backup", Expiration: &expiration}, nil
+	}
+
+	// the old key expires once the grace period is over
+	resp, err := rotateServiceAccount(ctx, adminClient, "sa-old", now, 24*time.Hour, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "sa-new", resp.Credentials.AccessKey)
+	assert.Equal(t, "sa-old", resp.PreviousAccessKey)
+	assert.Equal(t, []string{"alice|" + getPhotosPolicy + "|backup|nightly backup"}, added)
+	assert.Nil(t, updated)
+	// it already expired earlier
+	assert.Equal(t, expiration.Format(time.RFC3339), resp.PreviousExpiration)
+
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", ImpliedPolicy: true, Policy: readOnlyPhotosPolicy}, nil
+	}
+	added = nil
+	resp, err = rotateServiceAccount(ctx, adminClient, "sa-old", now, 24*time.Hour, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice|||"}, added)
+	assert.Equal(t, map[string]time.Time{"sa-old": now.Add(24 * time.Hour)}, updated)
+	assert.Equal(t, now.Add(24*time.Hour).Format(time.RFC3339), resp.PreviousExpiration)
+
+	// without a grace period the old key is deleted
+	_, err = rotateServiceAccount(ctx, adminClient, "sa-old", now, 0, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"deleteServiceAccount sa-old"}, mocks.calls)
+
+	// the replacement is removed when the old key can't be expired
+	mocks.calls = nil
+	minioUpdateServiceAccountMock = func(_ context.Context, _ string, _ madmin.UpdateServiceAccountReq) error {
+		return errors.New("invalid expiration")
+	}
+	_, err = rotateServiceAccount(ctx, adminClient, "sa-old", now, 24*time.Hour, time.Time{})
+	assert.EqualError(t, err, "invalid expiration")
+	assert.Equal(t, []string{"deleteServiceAccount sa-new"}, mocks.calls)
+}
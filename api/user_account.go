@@ -77,6 +77,7 @@ func getChangePasswordResponse(session *models.Principal, params accountApi.Acco
 	if err := changePassword(ctx, userClient, session, newSecretKey); err != nil {
 		return nil, ErrorWithContext(ctx, ErrChangePassword, nil, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetUser, accessKey, nil, params.Body)
 	// user credentials are updated at this point, we need to generate a new admin client and authenticate using
 	// the new credentials
 	credentials, err := getConsoleCredentials(accessKey, newSecretKey, clientIP)
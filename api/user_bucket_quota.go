@@ -60,9 +60,12 @@ func setBucketQuotaResponse(session *models.Principal, params bucektApi.SetBucke
 	// create a minioClient interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
+	before, _ := getBucketQuota(ctx, &adminClient, &params.Name)
 	if err := setBucketQuota(ctx, &adminClient, &params.Name, params.Body); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	after, _ := getBucketQuota(ctx, &adminClient, &params.Name)
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.Name, before, after)
 	return nil
 }
 
@@ -236,6 +236,7 @@ func setBucketVersioningResponse(session *models.Principal, params bucketApi.Set
 	if err := doSetVersioning(ctx, amcClient, versioningState, excludePrefixes, excludeFolders); err != nil {
 		return ErrorWithContext(ctx, fmt.Errorf("error setting versioning for bucket: %s", err))
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, bucketName, nil, params.Body)
 	return nil
 }
 
@@ -557,6 +558,7 @@ func getMakeBucketResponse(session *models.Principal, params bucketApi.MakeBucke
 			return nil, ErrorWithContext(ctx, err)
 		}
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, *br.Name, nil, br)
 	return &models.MakeBucketsResponse{BucketName: *br.Name}, nil
 }
 
@@ -615,9 +617,11 @@ func getBucketSetPolicyResponse(session *models.Principal, params bucketApi.Buck
 	adminClient := AdminClient{Client: mAdmin}
 	bucketName := params.Name
 	req := params.Body
+	before := auditBucketPolicy(ctx, minioClient, bucketName)
 	if err := setBucketAccessPolicy(ctx, minioClient, bucketName, *req.Access, req.Definition); err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, bucketName, before, auditBucketPolicy(ctx, minioClient, bucketName))
 	// set bucket access policy
 	bucket, err := getBucketInfo(ctx, minioClient, adminClient, bucketName)
 	if err != nil {
@@ -647,10 +651,15 @@ func getPutBucketTagsResponse(session *models.Principal, params bucketApi.PutBuc
 		return ErrorWithContext(ctx, err)
 	}
 
+	var before map[string]string
+	if oldTagSet, err := minioClient.GetBucketTagging(ctx, bucketName); err == nil {
+		before = oldTagSet.ToMap()
+	}
 	err = minioClient.SetBucketTagging(ctx, bucketName, newTagSet)
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, bucketName, before, req.Tags)
 	return nil
 }
 
@@ -683,9 +692,20 @@ func getDeleteBucketResponse(session *models.Principal, params bucketApi.DeleteB
 		}
 		return resp
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, bucketName, nil, nil)
 	return nil
 }
 
+// auditBucketPolicy returns the access policy of the bucket as recorded in audit events, nil if the
+// bucket has no policy or it can't be fetched
+func auditBucketPolicy(ctx context.Context, client MinioClient, bucketName string) interface{} {
+	policyStr, err := client.getBucketPolicy(ctx, bucketName)
+	if err != nil || policyStr == "" {
+		return nil
+	}
+	return json.RawMessage(policyStr)
+}
+
 // getBucketInfo return bucket information including name, policy access, size and creation date
 func getBucketInfo(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string) (*models.Bucket, error) {
 	var bucketAccess models.BucketAccess
@@ -819,9 +839,12 @@ func enableBucketEncryptionResponse(session *models.Principal, params bucketApi.
 	// create a minioClient interface implementation
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
+	before, _ := getBucketEncryptionInfo(ctx, minioClient, params.BucketName)
 	if err := enableBucketEncryption(ctx, minioClient, params.BucketName, *params.Body.EncType, params.Body.KmsKeyID); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	after, _ := getBucketEncryptionInfo(ctx, minioClient, params.BucketName)
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, before, after)
 	return nil
 }
 
@@ -841,9 +864,11 @@ func disableBucketEncryptionResponse(session *models.Principal, params bucketApi
 	// create a minioClient interface implementation
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
+	before, _ := getBucketEncryptionInfo(ctx, minioClient, params.BucketName)
 	if err := disableBucketEncryption(ctx, minioClient, params.BucketName); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, before, nil)
 	return nil
 }
 
@@ -915,10 +940,13 @@ func getSetBucketRetentionConfigResponse(session *models.Principal, params bucke
 	// create a minioClient interface implementation
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
+	before, _ := getBucketRetentionConfig(ctx, minioClient, params.BucketName)
 	err = setBucketRetentionConfig(ctx, minioClient, params.BucketName, *params.Body.Mode, *params.Body.Unit, params.Body.Validity)
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	after, _ := getBucketRetentionConfig(ctx, minioClient, params.BucketName)
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, before, after)
 	return nil
 }
 
@@ -207,6 +207,7 @@ func getCreateBucketEventsResponse(session *models.Principal, params bucketApi.C
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, bucketName, nil, eventReq.Configuration)
 	return nil
 }
 
@@ -248,5 +249,6 @@ func getDeleteBucketEventsResponse(session *models.Principal, params bucketApi.D
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, bucketName, params.Body, nil)
 	return nil
 }
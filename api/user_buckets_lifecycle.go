@@ -251,6 +251,15 @@ func addBucketLifecycle(ctx context.Context, client MinioClient, params bucketAp
 	return client.setBucketLifecycle(ctx, params.BucketName, lfcCfg)
 }
 
+// auditBucketLifecycle returns the lifecycle rules of the bucket as recorded in audit events
+func auditBucketLifecycle(ctx context.Context, client MinioClient, bucketName string) []lifecycle.Rule {
+	lfcCfg, err := client.getLifecycleRules(ctx, bucketName)
+	if err != nil || lfcCfg == nil {
+		return nil
+	}
+	return lfcCfg.Rules
+}
+
 // getAddBucketLifecycleResponse returns the response of adding a bucket lifecycle response
 func getAddBucketLifecycleResponse(session *models.Principal, params bucketApi.AddBucketLifecycleParams) *CodedAPIError {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
@@ -263,10 +272,12 @@ func getAddBucketLifecycleResponse(session *models.Principal, params bucketApi.A
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
 
+	before := auditBucketLifecycle(ctx, minioClient, params.BucketName)
 	err = addBucketLifecycle(ctx, minioClient, params)
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, before, auditBucketLifecycle(ctx, minioClient, params.BucketName))
 
 	return nil
 }
@@ -378,10 +389,12 @@ func getEditBucketLifecycleRule(session *models.Principal, params bucketApi.Upda
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
 
+	before := auditBucketLifecycle(ctx, minioClient, params.BucketName)
 	err = editBucketLifecycle(ctx, minioClient, params)
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, before, auditBucketLifecycle(ctx, minioClient, params.BucketName))
 
 	return nil
 }
@@ -432,10 +445,12 @@ func getDeleteBucketLifecycleRule(session *models.Principal, params bucketApi.De
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
 
+	before := auditBucketLifecycle(ctx, minioClient, params.BucketName)
 	err = deleteBucketLifecycle(ctx, minioClient, params)
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, params.BucketName, before, auditBucketLifecycle(ctx, minioClient, params.BucketName))
 
 	return nil
 }
@@ -533,6 +548,7 @@ func getAddMultiBucketLifecycleResponse(session *models.Principal, params bucket
 	}
 
 	finalResult := models.MultiLifecycleResult{Results: returnList}
+	auditEvent(params.HTTPRequest, session, auditTargetBucket, strings.Join(params.Body.Buckets, ","), nil, params.Body)
 
 	return &finalResult, nil
 }
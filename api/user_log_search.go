@@ -40,7 +40,7 @@ func registerLogSearchHandlers(api *operations.ConsoleAPI) {
 	})
 }
 
-// getLogSearchResponse performs a query to Log Search if Enabled
+// getLogSearchResponse performs a query to Log Search or to the local index if Enabled
 func getLogSearchResponse(session *models.Principal, params logApi.LogSearchParams) (*models.LogSearchResponse, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
@@ -68,6 +68,19 @@ func getLogSearchResponse(session *models.Principal, params logApi.LogSearchPara
 		}
 	}
 
+	// without an external log search service the local index is queried
+	if getLogSearchURL() == "" && globalLogSearchIndex != nil {
+		query, errQuery := logSearchQuery(params)
+		if errQuery != nil {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, errQuery)
+		}
+		result, errLogSearch := globalLogSearchIndex.Search(query)
+		if errLogSearch != nil {
+			return nil, ErrorWithContext(ctx, errLogSearch)
+		}
+		return &models.LogSearchResponse{Results: result.Records, Truncated: result.Truncated}, nil
+	}
+
 	token := getLogSearchAPIToken()
 	endpoint := fmt.Sprintf("%s/api/query?token=%s&q=reqinfo", getLogSearchURL(), token)
 	for _, fp := range params.Fp {
@@ -110,7 +110,7 @@ func TestLogSearch(t *testing.T) {
 				t.Errorf("logSearch() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if !reflect.DeepEqual(resp, tt.expectedResponse) {
-				t.Errorf("\ngot: %d \nwant: %d", resp, tt.expectedResponse)
+				t.Errorf("\ngot: %v \nwant: %v", resp, tt.expectedResponse)
 			}
 			// if tt.wantErr {
 			//	assert.Equal(tt.expectedError.Code, err.Code, fmt.Sprintf("logSearch() error code: `%v`, wantErr: `%v`", err.Code, tt.expectedError))
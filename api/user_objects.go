@@ -36,7 +36,6 @@ import (
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/klauspost/compress/zip"
 	"github.com/minio/console/api/operations"
 	objectApi "github.com/minio/console/api/operations/object"
 	"github.com/minio/console/models"
@@ -108,6 +107,14 @@ func registerObjectsHandlers(api *operations.ConsoleAPI) {
 		}
 		return resp
 	})
+	// download objects as an archive, selecting the version of each one
+	api.ObjectDownloadObjectsArchiveHandler = objectApi.DownloadObjectsArchiveHandlerFunc(func(params objectApi.DownloadObjectsArchiveParams, session *models.Principal) middleware.Responder {
+		resp, err := getDownloadObjectsArchiveResponse(session, params)
+		if err != nil {
+			return objectApi.NewDownloadObjectsArchiveDefault(err.Code).WithPayload(err.APIError)
+		}
+		return resp
+	})
 	// download multiple objects
 	api.ObjectDownloadMultipleObjectsHandler = objectApi.DownloadMultipleObjectsHandlerFunc(func(params objectApi.DownloadMultipleObjectsParams, session *models.Principal) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
@@ -537,7 +544,6 @@ func getDownloadObjectResponse(session *models.Principal, params objectApi.Downl
 func getDownloadFolderResponse(session *models.Principal, params objectApi.DownloadObjectParams) (middleware.Responder, *CodedAPIError) {
 	ctx := params.HTTPRequest.Context()
 	var prefix string
-	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
 	if params.Prefix != "" {
 		encodedPrefix := SanitizeEncodedPrefix(params.Prefix)
 		decodedPrefix, err := base64.StdEncoding.DecodeString(encodedPrefix)
@@ -546,103 +552,18 @@ func getDownloadFolderResponse(session *models.Principal, params objectApi.Downl
 		}
 		prefix = string(decodedPrefix)
 	}
-
-	folders := strings.Split(prefix, "/")
-
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
 	minioClient := minioClient{client: mClient}
-	objects, err := listBucketObjects(ListObjectsOpts{
-		ctx:          ctx,
-		client:       minioClient,
-		bucketName:   params.BucketName,
-		prefix:       prefix,
-		recursive:    true,
-		withVersions: false,
-		withMetadata: false,
-	})
-	if err != nil {
-		return nil, ErrorWithContext(ctx, err)
-	}
-
-	resp, pw := io.Pipe()
-	// Create file async
-	go func() {
-		defer pw.Close()
-		zipw := zip.NewWriter(pw)
-		var folder string
-		if len(folders) > 1 {
-			folder = folders[len(folders)-2]
-		}
-		defer zipw.Close()
-
-		for i, obj := range objects {
-			name := folder + objects[i].Name[len(prefix)-1:]
-			object, err := mClient.GetObject(ctx, params.BucketName, obj.Name, minio.GetObjectOptions{})
-			if err != nil {
-				// Ignore errors, move to next
-				continue
-			}
-			modified, _ := time.Parse(time.RFC3339, obj.LastModified)
-			f, err := zipw.CreateHeader(&zip.FileHeader{
-				Name:     name,
-				NonUTF8:  false,
-				Method:   zip.Deflate,
-				Modified: modified,
-			})
-			if err != nil {
-				// Ignore errors, move to next
-				continue
-			}
-			_, err = io.Copy(f, object)
-			if err != nil {
-				// We have a partial object, report error.
-				pw.CloseWithError(err)
-				return
-			}
-		}
-	}()
-
-	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
-		defer resp.Close()
-
-		// indicate it's a download / inline content to the browser, and the size of the object
-		var prefixPath string
-		var filename string
-		if params.Prefix != "" {
-			encodedPrefix := SanitizeEncodedPrefix(params.Prefix)
-			decodedPrefix, err := base64.StdEncoding.DecodeString(encodedPrefix)
-			if err != nil {
-				fmtError := ErrorWithContext(ctx, fmt.Errorf("unable to parse encoded prefix %s: %v", encodedPrefix, err))
-				http.Error(rw, fmtError.APIError.DetailedMessage, http.StatusInternalServerError)
-				return
-			}
-
-			prefixPath = string(decodedPrefix)
-		}
-		prefixElements := strings.Split(prefixPath, "/")
-		if len(prefixElements) > 0 {
-			if prefixElements[len(prefixElements)-1] == "" {
-				filename = prefixElements[len(prefixElements)-2]
-			} else {
-				filename = prefixElements[len(prefixElements)-1]
-			}
-		}
-		escapedName := url.PathEscape(filename)
-
-		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.zip\"", escapedName))
-		rw.Header().Set("Content-Type", "application/zip")
 
-		// Copy the stream
-		_, err := io.Copy(rw, resp)
-		if err != nil {
-			ErrorWithContext(ctx, fmt.Errorf("unable to write all the requested data: %v", err))
-			// You can't change headers after you already started writing the body.
-			// Handle incomplete write in client.
-			return
-		}
-	}), nil
+	format := archiveFormatZipDeflate
+	if params.Format != nil {
+		format = *params.Format
+	}
+	return newArchiveResponder(ctx, minioClient, params.BucketName, format, folderArchiveName(params.BucketName, prefix),
+		folderArchiveEntries(ctx, minioClient, params.BucketName, prefix)), nil
 }
 
 func getMultipleFilesDownloadResponse(session *models.Principal, params objectApi.DownloadMultipleObjectsParams) (middleware.Responder, *CodedAPIError) {
@@ -653,121 +574,17 @@ func getMultipleFilesDownloadResponse(session *models.Principal, params objectAp
 	}
 	minioClient := minioClient{client: mClient}
 
-	resp, pw := io.Pipe()
-	// Create file async
-	go func() {
-		defer pw.Close()
-		zipw := zip.NewWriter(pw)
-		defer zipw.Close()
-
-		addToZip := func(name string, modified time.Time) (io.Writer, error) {
-			f, err := zipw.CreateHeader(&zip.FileHeader{
-				Name:     name,
-				NonUTF8:  false,
-				Method:   zip.Deflate,
-				Modified: modified,
-			})
-			return f, err
-		}
-
-		for _, dObj := range params.ObjectList {
-			// if a prefix is selected, list and add objects recursively
-			// the prefixes are not base64 encoded.
-			if strings.HasSuffix(dObj, "/") {
-				prefix := dObj
-
-				folders := strings.Split(prefix, "/")
-
-				var folder string
-				if len(folders) > 1 {
-					folder = folders[len(folders)-2]
-				}
-
-				objects, err := listBucketObjects(ListObjectsOpts{
-					ctx:          ctx,
-					client:       minioClient,
-					bucketName:   params.BucketName,
-					prefix:       prefix,
-					recursive:    true,
-					withVersions: false,
-					withMetadata: false,
-				})
-				if err != nil {
-					pw.CloseWithError(err)
-				}
-
-				for i, obj := range objects {
-					name := folder + objects[i].Name[len(prefix)-1:]
-
-					object, err := mClient.GetObject(ctx, params.BucketName, obj.Name, minio.GetObjectOptions{})
-					if err != nil {
-						// Ignore errors, move to next
-						continue
-					}
-					modified, _ := time.Parse(time.RFC3339, obj.LastModified)
-
-					f, err := addToZip(name, modified)
-					if err != nil {
-						// Ignore errors, move to next
-						continue
-					}
-					_, err = io.Copy(f, object)
-					if err != nil {
-						// We have a partial object, report error.
-						pw.CloseWithError(err)
-						return
-					}
-				}
-
-			} else {
-				// add selected individual object
-				objectData, err := mClient.StatObject(ctx, params.BucketName, dObj, minio.StatObjectOptions{})
-				if err != nil {
-					// Ignore errors, move to next
-					continue
-				}
-				object, err := mClient.GetObject(ctx, params.BucketName, dObj, minio.GetObjectOptions{})
-				if err != nil {
-					// Ignore errors, move to next
-					continue
-				}
-
-				prefixes := strings.Split(dObj, "/")
-				// truncate upper level prefixes to make the download as flat at the current level.
-				objectName := prefixes[len(prefixes)-1]
-				f, err := addToZip(objectName, objectData.LastModified)
-				if err != nil {
-					// Ignore errors, move to next
-					continue
-				}
-				_, err = io.Copy(f, object)
-				if err != nil {
-					// We have a partial object, report error.
-					pw.CloseWithError(err)
-					return
-				}
-			}
-		}
-	}()
-
-	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
-		defer resp.Close()
-
-		// indicate it's a download / inline content to the browser, and the size of the object
-		fileName := "selected_files_" + strings.ReplaceAll(strings.ReplaceAll(time.Now().UTC().Format(time.RFC3339), ":", ""), "-", "")
-
-		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.zip\"", fileName))
-		rw.Header().Set("Content-Type", "application/zip")
-
-		// Copy the stream
-		_, err := io.Copy(rw, resp)
-		if err != nil {
-			ErrorWithContext(ctx, fmt.Errorf("unable to write all the requested data: %v", err))
-			// You can't change headers after you already started writing the body.
-			// Handle incomplete write in client.
-			return
-		}
-	}), nil
+	// the prefixes are not base64 encoded.
+	objects := make([]*models.DownloadArchiveObject, 0, len(params.ObjectList))
+	for i := range params.ObjectList {
+		objects = append(objects, &models.DownloadArchiveObject{Name: &params.ObjectList[i]})
+	}
+	format := archiveFormatZipDeflate
+	if params.Format != nil {
+		format = *params.Format
+	}
+	return newArchiveResponder(ctx, minioClient, params.BucketName, format, selectedFilesArchiveName(),
+		buildArchiveEntries(ctx, minioClient, params.BucketName, objects)), nil
 }
 
 // getDeleteObjectResponse returns whether there was an error on deletion of object
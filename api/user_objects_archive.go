@@ -0,0 +1,416 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
+	objectApi "github.com/minio/console/api/operations/object"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	archiveFormatZipDeflate = models.DownloadArchiveRequestFormatZipDashDeflate
+	archiveFormatZipStore   = models.DownloadArchiveRequestFormatZipDashStore
+	archiveFormatTar        = models.DownloadArchiveRequestFormatTar
+	archiveFormatTarGz      = models.DownloadArchiveRequestFormatTarDotGz
+	archiveFormatTarZst     = models.DownloadArchiveRequestFormatTarDotZst
+)
+
+// archiveManifestName is the name of the manifest written at the end of every archive
+const archiveManifestName = "download-manifest.json"
+
+// archiveEntries sends the entries of an archive to add as the objects are found, so the archive is
+// streamed while the objects are still being listed. Errors returned by add abort the archive.
+type archiveEntries func(add func(entry archiveEntry) error) error
+
+// archiveEntry is an object to be added to an archive
+type archiveEntry struct {
+	// Name is the path of the object inside the archive
+	Name      string
+	Object    string
+	VersionID string
+	Size      int64
+	Modified  time.Time
+	// Error is set when the object couldn't be found while building the entries
+	Error string
+}
+
+type archiveManifestEntry struct {
+	Name      string `json:"name"`
+	Object    string `json:"object"`
+	VersionID string `json:"version_id,omitempty"`
+	Size      int64  `json:"size"`
+	Error     string `json:"error,omitempty"`
+}
+
+// archiveManifest lists the objects of an archive, objects failing mid-stream are kept in the archive
+// with their missing content zeroed or truncated, so the manifest is the only way to tell them apart
+type archiveManifest struct {
+	Format  string                 `json:"format"`
+	Created string                 `json:"created"`
+	Objects int                    `json:"objects"`
+	Failed  int                    `json:"failed"`
+	Entries []archiveManifestEntry `json:"entries"`
+}
+
+type archiveWriter interface {
+	create(name string, size int64, modified time.Time) (io.Writer, error)
+	// pad completes an entry that couldn't be fully written, only needed by formats declaring the size upfront
+	pad(n int64) error
+	Close() error
+}
+
+type zipArchiveWriter struct {
+	zw     *zip.Writer
+	method uint16
+}
+
+func (z *zipArchiveWriter) create(name string, _ int64, modified time.Time) (io.Writer, error) {
+	return z.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		NonUTF8:  false,
+		Method:   z.method,
+		Modified: modified,
+	})
+}
+
+func (z *zipArchiveWriter) pad(_ int64) error {
+	return nil
+}
+
+func (z *zipArchiveWriter) Close() error {
+	return z.zw.Close()
+}
+
+type tarArchiveWriter struct {
+	tw         *tar.Writer
+	compressor io.WriteCloser
+}
+
+func (t *tarArchiveWriter) create(name string, size int64, modified time.Time) (io.Writer, error) {
+	err := t.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  modified,
+		Format:   tar.FormatPAX,
+	})
+	return t.tw, err
+}
+
+func (t *tarArchiveWriter) pad(n int64) error {
+	_, err := io.CopyN(t.tw, zeroReader{}, n)
+	return err
+}
+
+func (t *tarArchiveWriter) Close() error {
+	err := t.tw.Close()
+	if t.compressor != nil {
+		if cErr := t.compressor.Close(); err == nil {
+			err = cErr
+		}
+	}
+	return err
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func newArchiveWriter(w io.Writer, format string) (archiveWriter, error) {
+	switch format {
+	case "", archiveFormatZipDeflate:
+		return &zipArchiveWriter{zw: zip.NewWriter(w), method: zip.Deflate}, nil
+	case archiveFormatZipStore:
+		return &zipArchiveWriter{zw: zip.NewWriter(w), method: zip.Store}, nil
+	case archiveFormatTar:
+		return &tarArchiveWriter{tw: tar.NewWriter(w)}, nil
+	case archiveFormatTarGz:
+		gzw := gzip.NewWriter(w)
+		return &tarArchiveWriter{tw: tar.NewWriter(gzw), compressor: gzw}, nil
+	case archiveFormatTarZst:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, err
+		}
+		return &tarArchiveWriter{tw: tar.NewWriter(zw), compressor: zw}, nil
+	}
+	return nil, fmt.Errorf("unsupported archive format %q", format)
+}
+
+// archiveFileInfo returns the file extension and content type of the archive format
+func archiveFileInfo(format string) (string, string) {
+	switch format {
+	case archiveFormatTar:
+		return ".tar", "application/x-tar"
+	case archiveFormatTarGz:
+		return ".tar.gz", "application/gzip"
+	case archiveFormatTarZst:
+		return ".tar.zst", "application/zstd"
+	}
+	return ".zip", "application/zip"
+}
+
+// archiveSourceReader keeps the error reading the object, to tell it apart from errors writing the archive
+type archiveSourceReader struct {
+	r   io.Reader
+	err error
+}
+
+func (a *archiveSourceReader) Read(p []byte) (int, error) {
+	n, err := a.r.Read(p)
+	if err != nil && err != io.EOF {
+		a.err = err
+	}
+	return n, err
+}
+
+// writeArchiveEntry adds a single object to the archive, errors reading the object are returned as the
+// entry error while errors writing the archive abort it
+func writeArchiveEntry(ctx context.Context, client MinioClient, bucketName string, aw archiveWriter, entry archiveEntry) (string, error) {
+	object, err := client.getObject(ctx, bucketName, entry.Object, minio.GetObjectOptions{VersionID: entry.VersionID})
+	if err != nil {
+		return err.Error(), nil
+	}
+	defer object.Close()
+
+	f, err := aw.create(entry.Name, entry.Size, entry.Modified)
+	if err != nil {
+		return "", err
+	}
+	src := &archiveSourceReader{r: object}
+	n, err := io.CopyN(f, src, entry.Size)
+	if err == nil {
+		return "", nil
+	}
+	if src.err == nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return "", ctxErr
+	}
+	entryErr := fmt.Sprintf("object ended after %d of %d bytes", n, entry.Size)
+	if src.err != nil {
+		entryErr = fmt.Sprintf("%s after %d of %d bytes", src.err.Error(), n, entry.Size)
+	}
+	if err = aw.pad(entry.Size - n); err != nil {
+		return "", err
+	}
+	return entryErr, nil
+}
+
+// writeArchive streams the objects to w in the requested format, followed by the manifest
+func writeArchive(ctx context.Context, client MinioClient, bucketName string, w io.Writer, format string, entries archiveEntries) error {
+	aw, err := newArchiveWriter(w, format)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = archiveFormatZipDeflate
+	}
+	manifest := archiveManifest{
+		Format:  format,
+		Created: time.Now().UTC().Format(time.RFC3339),
+		Entries: []archiveManifestEntry{},
+	}
+
+	err = entries(func(entry archiveEntry) error {
+		entryErr := entry.Error
+		if entryErr == "" {
+			var err error
+			if entryErr, err = writeArchiveEntry(ctx, client, bucketName, aw, entry); err != nil {
+				return err
+			}
+		}
+		manifest.Objects++
+		if entryErr != "" {
+			manifest.Failed++
+		}
+		manifest.Entries = append(manifest.Entries, archiveManifestEntry{
+			Name:      entry.Name,
+			Object:    entry.Object,
+			VersionID: entry.VersionID,
+			Size:      entry.Size,
+			Error:     entryErr,
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := aw.create(archiveManifestName, int64(len(data)), time.Now())
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		return err
+	}
+	return aw.Close()
+}
+
+// folderArchiveName returns the name of the archive of a folder, the last folder of the prefix with or
+// without a trailing slash
+func folderArchiveName(bucketName, prefix string) string {
+	folder := strings.TrimSuffix(prefix, "/")
+	if folder == "" {
+		return bucketName
+	}
+	return path.Base(folder)
+}
+
+// folderArchiveEntries lists the objects under the prefix, named after the last folder of the prefix. A
+// listing error is added as a failed entry and ends the folder.
+func folderArchiveEntries(ctx context.Context, client MinioClient, bucketName, prefix string) archiveEntries {
+	return func(add func(entry archiveEntry) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		// names keep the last folder of the prefix
+		parent := prefix[:strings.LastIndex(strings.TrimSuffix(prefix, "/"), "/")+1]
+		for obj := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if obj.Err != nil {
+				return add(archiveEntry{Name: prefix, Object: prefix, Error: obj.Err.Error()})
+			}
+			err := add(archiveEntry{
+				Name:     obj.Key[len(parent):],
+				Object:   obj.Key,
+				Size:     obj.Size,
+				Modified: obj.LastModified,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// buildArchiveEntries returns the archive entries of the selected objects, prefixes are added recursively
+// while objects are added at the top level of the archive
+func buildArchiveEntries(ctx context.Context, client MinioClient, bucketName string, objects []*models.DownloadArchiveObject) archiveEntries {
+	return func(add func(entry archiveEntry) error) error {
+		for _, obj := range objects {
+			if obj == nil || obj.Name == nil {
+				continue
+			}
+			name := *obj.Name
+			if strings.HasSuffix(name, "/") {
+				if err := folderArchiveEntries(ctx, client, bucketName, name)(add); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// truncate upper level prefixes to make the download as flat at the current level.
+			prefixes := strings.Split(name, "/")
+			entry := archiveEntry{
+				Name:      prefixes[len(prefixes)-1],
+				Object:    name,
+				VersionID: obj.VersionID,
+			}
+			stat, err := client.statObject(ctx, bucketName, name, minio.GetObjectOptions{VersionID: obj.VersionID})
+			if err != nil {
+				entry.Error = err.Error()
+			} else {
+				entry.Size = stat.Size
+				entry.Modified = stat.LastModified
+			}
+			if err = add(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// newArchiveResponder streams the archive as a file download, objects are listed while the archive is
+// written since finding the selected objects may take a while
+func newArchiveResponder(ctx context.Context, client MinioClient, bucketName, format, fileName string, entries archiveEntries) middleware.Responder {
+	resp, pw := io.Pipe()
+	// Create file async
+	go func() {
+		if err := writeArchive(ctx, client, bucketName, pw, format, entries); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.Close()
+	}()
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		defer resp.Close()
+
+		extension, contentType := archiveFileInfo(format)
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s%s\"", url.PathEscape(fileName), extension))
+		rw.Header().Set("Content-Type", contentType)
+
+		// Copy the stream
+		_, err := io.Copy(rw, resp)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("unable to write all the requested data: %v", err))
+			// You can't change headers after you already started writing the body.
+			// Handle incomplete write in client.
+			return
+		}
+	})
+}
+
+func selectedFilesArchiveName() string {
+	return "selected_files_" + strings.ReplaceAll(strings.ReplaceAll(time.Now().UTC().Format(time.RFC3339), ":", ""), "-", "")
+}
+
+func getDownloadObjectsArchiveResponse(session *models.Principal, params objectApi.DownloadObjectsArchiveParams) (middleware.Responder, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	format := archiveFormatZipDeflate
+	if params.Body.Format != nil {
+		format = *params.Body.Format
+	}
+	return newArchiveResponder(ctx, minioClient, params.BucketName, format, selectedFilesArchiveName(),
+		buildArchiveEntries(ctx, minioClient, params.BucketName, params.Body.Objects)), nil
+}
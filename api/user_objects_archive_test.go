@@ -0,0 +1,237 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingReader returns the content followed by an error, as a connection dropping mid-stream
+type failingReader struct {
+	r io.Reader
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	n, err := f.r.Read(p)
+	if err == io.EOF {
+		return n, errors.New("connection reset")
+	}
+	return n, err
+}
+
+// readArchive returns the content of every file in the archive
+func readArchive(t *testing.T, format string, data []byte) map[string]string {
+	files := map[string]string{}
+	if strings.HasPrefix(format, "zip") {
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		assert.Nil(t, err)
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			assert.Nil(t, err)
+			content, _ := io.ReadAll(rc)
+			rc.Close()
+			files[f.Name] = string(content)
+		}
+		return files
+	}
+
+	var r io.Reader = bytes.NewReader(data)
+	switch format {
+	case archiveFormatTarGz:
+		gzr, err := gzip.NewReader(r)
+		assert.Nil(t, err)
+		r = gzr
+	case archiveFormatTarZst:
+		zr, err := zstd.NewReader(r)
+		assert.Nil(t, err)
+		r = zr
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		content, _ := io.ReadAll(tr)
+		files[hdr.Name] = string(content)
+	}
+	return files
+}
+
+// archiveEntryList sends the entries in order
+func archiveEntryList(entries []archiveEntry) archiveEntries {
+	return func(add func(entry archiveEntry) error) error {
+		for _, entry := range entries {
+			if err := add(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// collectArchiveEntries returns every entry sent
+func collectArchiveEntries(t *testing.T, entries archiveEntries) []archiveEntry {
+	var collected []archiveEntry
+	assert.Nil(t, entries(func(entry archiveEntry) error {
+		collected = append(collected, entry)
+		return nil
+	}))
+	return collected
+}
+
+func TestWriteArchive(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+
+	minioGetObjectMock = func(_ context.Context, _, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+		switch objectName {
+		case "docs/a.txt":
+			assert.Equal("v1", opts.VersionID)
+			return io.NopCloser(strings.NewReader("hello")), nil
+		case "docs/b.txt":
+			return io.NopCloser(&failingReader{r: strings.NewReader("par")}), nil
+		}
+		return nil, errors.New("object not found")
+	}
+	entries := []archiveEntry{
+		{Name: "docs/a.txt", Object: "docs/a.txt", VersionID: "v1", Size: 5, Modified: time.Now()},
+		{Name: "docs/b.txt", Object: "docs/b.txt", Size: 7, Modified: time.Now()},
+		{Name: "c.txt", Object: "c.txt", Error: "The specified key does not exist."},
+	}
+
+	for _, format := range []string{archiveFormatZipDeflate, archiveFormatZipStore, archiveFormatTar, archiveFormatTarGz, archiveFormatTarZst} {
+		var buf bytes.Buffer
+		assert.Nil(writeArchive(ctx, client, "bucket", &buf, format, archiveEntryList(entries)), format)
+
+		files := readArchive(t, format, buf.Bytes())
+		assert.Equal("hello", files["docs/a.txt"], format)
+		assert.NotContains(files, "c.txt", format)
+
+		var manifest archiveManifest
+		assert.Nil(json.Unmarshal([]byte(files[archiveManifestName]), &manifest), format)
+		assert.Equal(format, manifest.Format)
+		assert.Equal(3, manifest.Objects)
+		assert.Equal(2, manifest.Failed)
+		assert.Equal("", manifest.Entries[0].Error)
+		assert.Equal("connection reset after 3 of 7 bytes", manifest.Entries[1].Error, format)
+		assert.Equal("The specified key does not exist.", manifest.Entries[2].Error)
+	}
+
+	_, err := newArchiveWriter(io.Discard, "rar")
+	assert.NotNil(err)
+}
+
+func TestBuildArchiveEntries(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	modified := time.Now()
+
+	minioListObjectsMock = func(_ context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.Equal("photos/2024/", opts.Prefix)
+		ch := make(chan minio.ObjectInfo, 2)
+		ch <- minio.ObjectInfo{Key: "photos/2024/a.jpg", Size: 10, LastModified: modified}
+		ch <- minio.ObjectInfo{Key: "photos/2024/trip/b.jpg", Size: 20, LastModified: modified}
+		close(ch)
+		return ch
+	}
+	minioStatObjectMock = func(_ context.Context, _, prefix string, opts minio.GetObjectOptions) (minio.ObjectInfo, error) {
+		if prefix == "missing.txt" {
+			return minio.ObjectInfo{}, errors.New("The specified key does not exist.")
+		}
+		assert.Equal("v2", opts.VersionID)
+		return minio.ObjectInfo{Size: 30, LastModified: modified}, nil
+	}
+
+	folder, notes, missing := "photos/2024/", "docs/notes.txt", "missing.txt"
+	entries := collectArchiveEntries(t, buildArchiveEntries(ctx, client, "bucket", []*models.DownloadArchiveObject{
+		{Name: &folder},
+		{Name: &notes, VersionID: "v2"},
+		{Name: &missing, VersionID: "v2"},
+	}))
+	assert.Len(entries, 4)
+	assert.Equal("2024/a.jpg", entries[0].Name)
+	assert.Equal("2024/trip/b.jpg", entries[1].Name)
+	assert.Equal(archiveEntry{Name: "notes.txt", Object: "docs/notes.txt", VersionID: "v2", Size: 30, Modified: modified}, entries[2])
+	assert.NotEmpty(entries[3].Error)
+}
+
+func TestFolderArchiveEntries(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	modified := time.Now()
+
+	assert.Equal("2024", folderArchiveName("bucket", "photos/2024/"))
+	assert.Equal("2024", folderArchiveName("bucket", "photos/2024"))
+	assert.Equal("photos", folderArchiveName("bucket", "photos"))
+	assert.Equal("bucket", folderArchiveName("bucket", ""))
+
+	// entries are sent while the objects are listed
+	listing := make(chan minio.ObjectInfo)
+	minioListObjectsMock = func(_ context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		return listing
+	}
+	go func(listing chan<- minio.ObjectInfo) {
+		listing <- minio.ObjectInfo{Key: "photos/2024/a.jpg", Size: 10, LastModified: modified}
+		listing <- minio.ObjectInfo{Err: errors.New("listing interrupted")}
+		close(listing)
+	}(listing)
+	var entries []archiveEntry
+	err := folderArchiveEntries(ctx, client, "bucket", "photos/2024")(func(entry archiveEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	assert.Nil(err)
+	assert.Len(entries, 2)
+	assert.Equal("2024/a.jpg", entries[0].Name)
+	assert.Equal("listing interrupted", entries[1].Error)
+
+	// errors writing the archive stop the listing
+	listed := make(chan minio.ObjectInfo, 2)
+	listed <- minio.ObjectInfo{Key: "photos/a.jpg"}
+	listed <- minio.ObjectInfo{Key: "photos/b.jpg"}
+	close(listed)
+	minioListObjectsMock = func(_ context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		return listed
+	}
+	calls := 0
+	err = folderArchiveEntries(ctx, client, "bucket", "photos/")(func(_ archiveEntry) error {
+		calls++
+		return io.ErrClosedPipe
+	})
+	assert.ErrorIs(err, io.ErrClosedPipe)
+	assert.Equal(1, calls)
+}
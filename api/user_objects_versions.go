@@ -0,0 +1,368 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	objectApi "github.com/minio/console/api/operations/object"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// maxVersionDiffSize is the largest object version a diff is computed for
+const maxVersionDiffSize = 1 << 20
+
+// maxVersionPurgeEntries is the number of purged versions listed on the response
+const maxVersionPurgeEntries = 1000
+
+var errVersionNotText = errors.New("object version is not a text file")
+
+func registerObjectVersionsHandlers(api *operations.ConsoleAPI) {
+	api.ObjectGetObjectVersionHistoryHandler = objectApi.GetObjectVersionHistoryHandlerFunc(func(params objectApi.GetObjectVersionHistoryParams, session *models.Principal) middleware.Responder {
+		resp, err := getObjectVersionHistoryResponse(session, params)
+		if err != nil {
+			return objectApi.NewGetObjectVersionHistoryDefault(err.Code).WithPayload(err.APIError)
+		}
+		return objectApi.NewGetObjectVersionHistoryOK().WithPayload(resp)
+	})
+	api.ObjectGetObjectVersionDiffHandler = objectApi.GetObjectVersionDiffHandlerFunc(func(params objectApi.GetObjectVersionDiffParams, session *models.Principal) middleware.Responder {
+		resp, err := getObjectVersionDiffResponse(session, params)
+		if err != nil {
+			return objectApi.NewGetObjectVersionDiffDefault(err.Code).WithPayload(err.APIError)
+		}
+		return objectApi.NewGetObjectVersionDiffOK().WithPayload(resp)
+	})
+	api.ObjectPurgeObjectVersionsHandler = objectApi.PurgeObjectVersionsHandlerFunc(func(params objectApi.PurgeObjectVersionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getPurgeObjectVersionsResponse(session, params)
+		if err != nil {
+			return objectApi.NewPurgeObjectVersionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return objectApi.NewPurgeObjectVersionsOK().WithPayload(resp)
+	})
+}
+
+func decodeObjectPrefix(prefix string) (string, error) {
+	decodedPrefix, err := base64.StdEncoding.DecodeString(SanitizeEncodedPrefix(prefix))
+	if err != nil {
+		return "", err
+	}
+	return string(decodedPrefix), nil
+}
+
+// listObjectVersions returns the versions of a single object, newest first
+func listObjectVersions(ctx context.Context, client MinioClient, bucketName, objectName string) ([]minio.ObjectInfo, error) {
+	var versions []minio.ObjectInfo
+	for obj := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: objectName, WithVersions: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		// the prefix also matches objects with a longer name
+		if obj.Key != objectName {
+			continue
+		}
+		versions = append(versions, obj)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].LastModified.After(versions[j].LastModified)
+	})
+	return versions, nil
+}
+
+func getObjectVersionHistory(ctx context.Context, client MinioClient, bucketName, objectName string) (*models.ObjectVersionHistory, error) {
+	versions, err := listObjectVersions(ctx, client, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+
+	history := &models.ObjectVersionHistory{Name: objectName}
+	for i, v := range versions {
+		entry := &models.ObjectVersionHistoryEntry{
+			VersionID:      v.VersionID,
+			LastModified:   v.LastModified.Format(time.RFC3339),
+			Size:           v.Size,
+			Etag:           v.ETag,
+			IsLatest:       v.IsLatest,
+			IsDeleteMarker: v.IsDeleteMarker,
+		}
+		if !v.IsDeleteMarker {
+			// compare with the closest older version holding data
+			entry.SizeDelta = v.Size
+			for _, older := range versions[i+1:] {
+				if !older.IsDeleteMarker {
+					entry.SizeDelta = v.Size - older.Size
+					break
+				}
+			}
+		}
+		history.Versions = append(history.Versions, entry)
+	}
+	return history, nil
+}
+
+func getObjectVersionHistoryResponse(session *models.Principal, params objectApi.GetObjectVersionHistoryParams) (*models.ObjectVersionHistory, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	prefix, err := decodeObjectPrefix(params.Prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	history, err := getObjectVersionHistory(ctx, minioClient, params.BucketName, prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return history, nil
+}
+
+// isTextContent returns whether data can be shown as text, binary content usually holds NUL bytes
+// or invalid UTF-8 sequences
+func isTextContent(data []byte) bool {
+	return utf8.Valid(data) && !bytes.ContainsRune(data, 0)
+}
+
+func readObjectVersion(ctx context.Context, client MinioClient, bucketName, objectName, versionID string) (string, error) {
+	stat, err := client.statObject(ctx, bucketName, objectName, minio.GetObjectOptions{VersionID: versionID})
+	if err != nil {
+		return "", err
+	}
+	if stat.Size > maxVersionDiffSize {
+		return "", fmt.Errorf("object version %s is larger than %d bytes", versionID, maxVersionDiffSize)
+	}
+	object, err := client.getObject(ctx, bucketName, objectName, minio.GetObjectOptions{VersionID: versionID})
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+	data, err := io.ReadAll(io.LimitReader(object, maxVersionDiffSize+1))
+	if err != nil {
+		return "", err
+	}
+	if !isTextContent(data) {
+		return "", errVersionNotText
+	}
+	return string(data), nil
+}
+
+// getObjectVersionDiff returns a unified diff between two versions of the object, toVersion defaults to the latest one
+func getObjectVersionDiff(ctx context.Context, client MinioClient, bucketName, objectName, fromVersion, toVersion string) (*models.ObjectVersionDiff, error) {
+	if toVersion == "" {
+		versions, err := listObjectVersions(ctx, client, bucketName, objectName)
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) == 0 {
+			return nil, ErrNotFound
+		}
+		toVersion = versions[0].VersionID
+	}
+	from, err := readObjectVersion(ctx, client, bucketName, objectName, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := readObjectVersion(ctx, client, bucketName, objectName, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fmt.Sprintf("%s@%s", objectName, fromVersion),
+		ToFile:   fmt.Sprintf("%s@%s", objectName, toVersion),
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.ObjectVersionDiff{
+		Name:        objectName,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Identical:   diff == "",
+		Diff:        diff,
+	}, nil
+}
+
+func getObjectVersionDiffResponse(session *models.Principal, params objectApi.GetObjectVersionDiffParams) (*models.ObjectVersionDiff, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	prefix, err := decodeObjectPrefix(params.Prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	toVersion := ""
+	if params.ToVersion != nil {
+		toVersion = *params.ToVersion
+	}
+	diff, err := getObjectVersionDiff(ctx, minioClient, params.BucketName, prefix, params.FromVersion, toVersion)
+	if errors.Is(err, errVersionNotText) {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return diff, nil
+}
+
+type objectVersionPurgeOpts struct {
+	Prefix     string
+	KeepLatest int64
+	NewerThan  time.Time
+	DryRun     bool
+}
+
+func getObjectVersionPurgeOpts(req *models.ObjectVersionPurgeRequest) (*objectVersionPurgeOpts, error) {
+	opts := &objectVersionPurgeOpts{
+		Prefix:     req.Prefix,
+		KeepLatest: req.KeepLatest,
+		DryRun:     req.DryRun,
+	}
+	if opts.KeepLatest < 0 {
+		return nil, errors.New("keep_latest can't be negative")
+	}
+	if req.NewerThan != "" {
+		newerThan, err := time.Parse(time.RFC3339, req.NewerThan)
+		if err != nil {
+			return nil, err
+		}
+		opts.NewerThan = newerThan
+	}
+	if opts.KeepLatest == 0 && opts.NewerThan.IsZero() {
+		return nil, errors.New("either keep_latest or newer_than is required")
+	}
+	return opts, nil
+}
+
+// purgedVersions returns the versions of an object that aren't kept, a version is kept when it is
+// one of the newest KeepLatest versions or was modified after NewerThan. The latest version is always kept.
+func purgedVersions(versions []minio.ObjectInfo, opts *objectVersionPurgeOpts) []minio.ObjectInfo {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].LastModified.After(versions[j].LastModified)
+	})
+	var purged []minio.ObjectInfo
+	for i, v := range versions {
+		if i == 0 || v.IsLatest {
+			continue
+		}
+		if opts.KeepLatest > 0 && int64(i) < opts.KeepLatest {
+			continue
+		}
+		if !opts.NewerThan.IsZero() && v.LastModified.After(opts.NewerThan) {
+			continue
+		}
+		purged = append(purged, v)
+	}
+	return purged
+}
+
+// purgeObjectVersions removes the versions not kept by the purge options for every object under the prefix
+func purgeObjectVersions(ctx context.Context, client MinioClient, bucketName string, opts *objectVersionPurgeOpts) (*models.ObjectVersionPurgeResponse, error) {
+	resp := &models.ObjectVersionPurgeResponse{DryRun: opts.DryRun}
+
+	purge := func(versions []minio.ObjectInfo) {
+		for _, v := range purgedVersions(versions, opts) {
+			entry := &models.ObjectVersionPurgeEntry{
+				Name:           v.Key,
+				VersionID:      v.VersionID,
+				LastModified:   v.LastModified.Format(time.RFC3339),
+				Size:           v.Size,
+				IsDeleteMarker: v.IsDeleteMarker,
+			}
+			if !opts.DryRun {
+				if err := client.removeObject(ctx, bucketName, v.Key, minio.RemoveObjectOptions{VersionID: v.VersionID}); err != nil {
+					entry.Error = err.Error()
+				}
+			}
+			if entry.Error != "" {
+				resp.Failed++
+			} else {
+				resp.Purged++
+				resp.BytesFreed += v.Size
+			}
+			if len(resp.Versions) < maxVersionPurgeEntries {
+				resp.Versions = append(resp.Versions, entry)
+			} else {
+				resp.Truncated = true
+			}
+		}
+	}
+
+	// versions of the same object are listed together
+	var versions []minio.ObjectInfo
+	for obj := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: opts.Prefix, Recursive: true, WithVersions: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		if len(versions) > 0 && versions[0].Key != obj.Key {
+			purge(versions)
+			versions = nil
+		}
+		versions = append(versions, obj)
+	}
+	if len(versions) > 0 {
+		purge(versions)
+	}
+	return resp, nil
+}
+
+func getPurgeObjectVersionsResponse(session *models.Principal, params objectApi.PurgeObjectVersionsParams) (*models.ObjectVersionPurgeResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	opts, err := getObjectVersionPurgeOpts(params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	opts.Prefix = strings.TrimPrefix(opts.Prefix, "/")
+	resp, err := purgeObjectVersions(ctx, minioClient, params.BucketName, opts)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
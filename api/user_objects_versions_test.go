@@ -0,0 +1,167 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+// assigning mock at runtime instead of compile time
+var minioGetObjectMock func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error)
+
+// mock function of getObject()
+func (ac minioClientMock) getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	return minioGetObjectMock(ctx, bucketName, objectName, opts)
+}
+
+func TestGetObjectVersionHistory(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	now := time.Now()
+
+	minioListObjectsMock = func(_ context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.True(opts.WithVersions)
+		ch := make(chan minio.ObjectInfo, 5)
+		ch <- minio.ObjectInfo{Key: "report.txt", VersionID: "v3", Size: 150, IsLatest: true, LastModified: now}
+		ch <- minio.ObjectInfo{Key: "report.txt", VersionID: "dm", IsDeleteMarker: true, LastModified: now.Add(-time.Hour)}
+		ch <- minio.ObjectInfo{Key: "report.txt", VersionID: "v2", Size: 200, LastModified: now.Add(-2 * time.Hour)}
+		ch <- minio.ObjectInfo{Key: "report.txt", VersionID: "v1", Size: 100, LastModified: now.Add(-3 * time.Hour)}
+		ch <- minio.ObjectInfo{Key: "report.txt.bak", VersionID: "b1", Size: 100, LastModified: now}
+		close(ch)
+		return ch
+	}
+
+	history, err := getObjectVersionHistory(ctx, client, "docs", "report.txt")
+	assert.Nil(err)
+	assert.Len(history.Versions, 4)
+	assert.Equal(int64(-50), history.Versions[0].SizeDelta)
+	assert.True(history.Versions[1].IsDeleteMarker)
+	assert.Equal(int64(0), history.Versions[1].SizeDelta)
+	assert.Equal(int64(100), history.Versions[2].SizeDelta)
+	assert.Equal(int64(100), history.Versions[3].SizeDelta)
+
+	_, err = getObjectVersionHistory(ctx, client, "docs", "missing.txt")
+	assert.Equal(ErrNotFound, err)
+}
+
+func TestGetObjectVersionDiff(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	contents := map[string]string{
+		"v1":  "line 1\nline 2\nline 3\n",
+		"v2":  "line 1\nline two\nline 3\n",
+		"bin": "\x00\x01\x02",
+	}
+
+	minioListObjectsMock = func(_ context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Key: "notes.txt", VersionID: "v2", IsLatest: true}
+		close(ch)
+		return ch
+	}
+	minioStatObjectMock = func(_ context.Context, _, _ string, opts minio.GetObjectOptions) (minio.ObjectInfo, error) {
+		if opts.VersionID == "big" {
+			return minio.ObjectInfo{Size: maxVersionDiffSize + 1}, nil
+		}
+		return minio.ObjectInfo{Size: int64(len(contents[opts.VersionID]))}, nil
+	}
+	minioGetObjectMock = func(_ context.Context, _, _ string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(contents[opts.VersionID])), nil
+	}
+
+	diff, err := getObjectVersionDiff(ctx, client, "docs", "notes.txt", "v1", "")
+	assert.Nil(err)
+	assert.Equal("v2", diff.ToVersion)
+	assert.False(diff.Identical)
+	assert.Contains(diff.Diff, "--- notes.txt@v1")
+	assert.Contains(diff.Diff, "-line 2\n+line two\n")
+
+	diff, err = getObjectVersionDiff(ctx, client, "docs", "notes.txt", "v1", "v1")
+	assert.Nil(err)
+	assert.True(diff.Identical)
+
+	_, err = getObjectVersionDiff(ctx, client, "docs", "notes.txt", "bin", "v2")
+	assert.Equal(errVersionNotText, err)
+	_, err = getObjectVersionDiff(ctx, client, "docs", "notes.txt", "big", "v2")
+	assert.NotNil(err)
+}
+
+func TestPurgeObjectVersions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	now := time.Now()
+
+	_, err := getObjectVersionPurgeOpts(&models.ObjectVersionPurgeRequest{})
+	assert.NotNil(err)
+	_, err = getObjectVersionPurgeOpts(&models.ObjectVersionPurgeRequest{KeepLatest: -1})
+	assert.NotNil(err)
+	_, err = getObjectVersionPurgeOpts(&models.ObjectVersionPurgeRequest{NewerThan: "last week"})
+	assert.NotNil(err)
+
+	minioListObjectsMock = func(_ context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.True(opts.Recursive)
+		ch := make(chan minio.ObjectInfo, 5)
+		ch <- minio.ObjectInfo{Key: "a", VersionID: "a3", Size: 10, IsLatest: true, LastModified: now}
+		ch <- minio.ObjectInfo{Key: "a", VersionID: "a2", Size: 20, LastModified: now.Add(-48 * time.Hour)}
+		ch <- minio.ObjectInfo{Key: "a", VersionID: "a1", Size: 30, LastModified: now.Add(-72 * time.Hour)}
+		ch <- minio.ObjectInfo{Key: "b", VersionID: "b2", Size: 5, IsLatest: true, LastModified: now.Add(-time.Hour)}
+		ch <- minio.ObjectInfo{Key: "b", VersionID: "b1", Size: 5, LastModified: now.Add(-96 * time.Hour)}
+		close(ch)
+		return ch
+	}
+	var removed []string
+	minioRemoveObjectMock = func(_ context.Context, _, objectName string, opts minio.RemoveObjectOptions) error {
+		if opts.VersionID == "a1" {
+			return errors.New("object is WORM protected")
+		}
+		removed = append(removed, objectName+"@"+opts.VersionID)
+		return nil
+	}
+
+	opts, err := getObjectVersionPurgeOpts(&models.ObjectVersionPurgeRequest{KeepLatest: 1, DryRun: true})
+	assert.Nil(err)
+	resp, err := purgeObjectVersions(ctx, client, "data", opts)
+	assert.Nil(err)
+	assert.Empty(removed)
+	assert.Equal(int64(3), resp.Purged)
+	assert.Equal(int64(55), resp.BytesFreed)
+
+	// versions newer than the date are kept, even when not among the newest ones
+	opts, err = getObjectVersionPurgeOpts(&models.ObjectVersionPurgeRequest{
+		KeepLatest: 1,
+		NewerThan:  now.Add(-24 * time.Hour).Format(time.RFC3339),
+	})
+	assert.Nil(err)
+	resp, err = purgeObjectVersions(ctx, client, "data", opts)
+	assert.Nil(err)
+	assert.Equal([]string{"a@a2", "b@b1"}, removed)
+	assert.Equal(int64(2), resp.Purged)
+	assert.Equal(int64(1), resp.Failed)
+	assert.Equal("object is WORM protected", resp.Versions[1].Error)
+}
@@ -266,7 +266,7 @@ func getListOfEnabledFeatures(ctx context.Context, minioClient MinioAdmin, sessi
 	oidcEnabled := oauth2.IsIDPEnabled()
 	ldapEnabled := ldap.GetLDAPEnabled()
 
-	if logSearchURL != "" {
+	if logSearchURL != "" || globalLogSearchIndex != nil {
 		features = append(features, "log-search")
 	}
 	if oidcEnabled {
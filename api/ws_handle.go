@@ -27,8 +27,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/minio/madmin-go/v3"
-
 	"github.com/minio/console/pkg/utils"
 
 	errorsApi "github.com/go-openapi/errors"
@@ -243,7 +241,11 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			closeWsConn(conn)
 			return
 		}
-		go wsAdminClient.healthInfo(ctx, deadline)
+		if getHealthInfoOfflineFromReq(req) {
+			go wsAdminClient.offlineHealthInfo(ctx, deadline)
+		} else {
+			go wsAdminClient.healthInfo(ctx, deadline)
+		}
 	case strings.HasPrefix(wsPath, `/watch`):
 		wOptions, err := getWatchOptionsFromReq(req)
 		if err != nil {
@@ -286,6 +288,20 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 		go wsAdminClient.profile(ctx, pOptions)
+	case strings.HasPrefix(wsPath, `/replication-diff`):
+		diffOptions, err := getReplicationDiffOptionsFromReq(req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting replication diff options: %v", err))
+			closeWsConn(conn)
+			return
+		}
+		wsAdminClient, err := newWebSocketAdminClient(conn, session)
+		if err != nil {
+			ErrorWithContext(ctx, err)
+			closeWsConn(conn)
+			return
+		}
+		go wsAdminClient.replicationDiff(ctx, diffOptions)
 
 	case strings.HasPrefix(wsPath, `/objectManager`):
 		wsMinioClient, err := newWebSocketMinioClient(conn, session)
@@ -505,7 +521,21 @@ func (wsc *wsAdminClient) healthInfo(ctx context.Context, deadline *time.Duratio
 	sendWsCloseMessage(wsc.conn, err)
 }
 
-func (wsc *wsAdminClient) speedtest(ctx context.Context, opts *madmin.SpeedtestOpts) {
+func (wsc *wsAdminClient) offlineHealthInfo(ctx context.Context, deadline *time.Duration) {
+	defer func() {
+		LogInfo("offline health info stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfo("offline health info started")
+
+	ctx = wsReadClientCtx(ctx, wsc.conn)
+	err := startOfflineHealthInfo(ctx, wsc.conn, wsc.client, deadline)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
+func (wsc *wsAdminClient) speedtest(ctx context.Context, opts *speedtestOptions) {
 	defer func() {
 		LogInfo("speedtest stopped")
 		// close connection after return
@@ -515,7 +545,7 @@ func (wsc *wsAdminClient) speedtest(ctx context.Context, opts *madmin.SpeedtestO
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
-	err := startSpeedtest(ctx, wsc.conn, wsc.client, opts)
+	err := startSpeedtest(ctx, wsc.conn, wsc.client, getConsoleStore(), opts)
 
 	sendWsCloseMessage(wsc.conn, err)
 }
@@ -535,6 +565,21 @@ func (wsc *wsAdminClient) profile(ctx context.Context, opts *profileOptions) {
 	sendWsCloseMessage(wsc.conn, err)
 }
 
+func (wsc *wsAdminClient) replicationDiff(ctx context.Context, options *replicationDiffOptions) {
+	defer func() {
+		LogInfo("replication diff stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfo("replication diff started")
+
+	ctx = wsReadClientCtx(ctx, wsc.conn)
+
+	err := startReplicationDiff(ctx, wsc.conn, wsc.client, options)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
 // sendWsCloseMessage sends Websocket Connection Close Message indicating the Status Code
 // see https://tools.ietf.org/html/rfc6455#page-45
 func sendWsCloseMessage(conn WSConn, err error) {
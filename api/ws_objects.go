@@ -19,8 +19,10 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/minio/console/models"
@@ -28,16 +30,26 @@ import (
 )
 
 func (wsc *wsMinioClient) objectManager(session *models.Principal) {
-	// Storage of Cancel Contexts for this connection
+	// Storage of Cancel Contexts for this connection, requests remove theirs when they end
+	var cancelMu sync.Mutex
 	cancelContexts := make(map[int64]context.CancelFunc)
+	removeCancelContext := func(requestID int64) {
+		cancelMu.Lock()
+		delete(cancelContexts, requestID)
+		cancelMu.Unlock()
+	}
+	// Cancel Contexts of the listings, a new listing replaces the previous ones
+	listingContexts := make(map[int64]context.CancelFunc)
 	// Initial goroutine
 	defer func() {
 		// We close socket at the end of requests
 		wsc.conn.close()
+		cancelMu.Lock()
 		for _, c := range cancelContexts {
 			// invoke cancel
 			c()
 		}
+		cancelMu.Unlock()
 	}()
 
 	writeChannel := make(chan WSResponse)
@@ -65,31 +77,41 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 					return
 				}
 
+				// a cancel request carries the id of the request to cancel, if we have it, cancel it
+				if messageRequest.Mode == "cancel" {
+					cancelMu.Lock()
+					if cancelFunc, ok := cancelContexts[messageRequest.RequestID]; ok {
+						cancelFunc()
+						delete(cancelContexts, messageRequest.RequestID)
+					}
+					cancelMu.Unlock()
+					continue
+				}
+
 				// new message, new context
 				ctx, cancel := context.WithCancel(context.Background())
 
 				// We store the cancel func associated with this request
+				cancelMu.Lock()
 				cancelContexts[messageRequest.RequestID] = cancel
+				cancelMu.Unlock()
 
 				const itemsPerBatch = 1000
 				switch messageRequest.Mode {
 				case "close":
 					close(done)
 					return
-				case "cancel":
-					// if we have that request id, cancel it
-					if cancelFunc, ok := cancelContexts[messageRequest.RequestID]; ok {
-						cancelFunc()
-						delete(cancelContexts, messageRequest.RequestID)
-					}
 				case "objects":
-					// cancel all previous open objects requests for listing
-					for rid, c := range cancelContexts {
+					// cancel all previous open objects requests for listing, searches, bulk edits and
+					// restores keep running until they end or are cancelled explicitly
+					for rid, c := range listingContexts {
 						if rid < messageRequest.RequestID {
 							// invoke cancel
 							c()
+							delete(listingContexts, rid)
 						}
 					}
+					listingContexts[messageRequest.RequestID] = cancel
 
 					// start listing and writing to web socket
 					go func() {
@@ -108,7 +130,7 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 						}
 						var buffer []ObjectResponse
 						for lsObj := range startObjectsListing(ctx, wsc.client, objectRqConfigs) {
-							if cancelContexts[messageRequest.RequestID] == nil {
+							if ctx.Err() != nil {
 								return
 							}
 							if lsObj.Err != nil {
@@ -152,16 +174,219 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 						}
 
 						// remove the cancellation context
-						delete(cancelContexts, messageRequest.RequestID)
+						removeCancelContext(messageRequest.RequestID)
+					}()
+				case "search":
+					// searches walk the whole bucket, they run alongside listings until done or cancelled
+					go func() {
+						searchOpts, err := getObjectsSearchOptionsFromReq(messageRequest)
+						if err != nil {
+							LogInfo(fmt.Sprintf("Error during Objects Search OptionsParse %s", err.Error()))
+
+							writeChannel <- WSResponse{
+								RequestID:  messageRequest.RequestID,
+								Error:      ErrorWithContext(ctx, ErrBadRequest, err),
+								Prefix:     messageRequest.Prefix,
+								BucketName: messageRequest.BucketName,
+							}
+
+							return
+						}
+						var buffer []ObjectResponse
+						for obj := range startObjectsSearch(ctx, wsc.client, searchOpts) {
+							if ctx.Err() != nil {
+								return
+							}
+							if obj.Err != nil {
+								writeChannel <- WSResponse{
+									RequestID:  messageRequest.RequestID,
+									Error:      ErrorWithContext(ctx, obj.Err),
+									Prefix:     messageRequest.Prefix,
+									BucketName: messageRequest.BucketName,
+								}
+
+								continue
+							}
+							buffer = append(buffer, newObjectSearchResponse(obj))
+
+							if len(buffer) >= itemsPerBatch {
+								writeChannel <- WSResponse{
+									RequestID: messageRequest.RequestID,
+									Data:      buffer,
+								}
+								buffer = nil
+							}
+						}
+						if len(buffer) > 0 {
+							writeChannel <- WSResponse{
+								RequestID: messageRequest.RequestID,
+								Data:      buffer,
+							}
+						}
+
+						writeChannel <- WSResponse{
+							RequestID:  messageRequest.RequestID,
+							RequestEnd: true,
+						}
+
+						// remove the cancellation context
+						removeCancelContext(messageRequest.RequestID)
+					}()
+				case "bulk-edit":
+					go func() {
+						bulkOpts, err := getObjectsBulkEditOptionsFromReq(messageRequest)
+						if err != nil {
+							LogInfo(fmt.Sprintf("Error during Objects Bulk Edit OptionsParse %s", err.Error()))
+
+							writeChannel <- WSResponse{
+								RequestID:  messageRequest.RequestID,
+								Error:      ErrorWithContext(ctx, ErrBadRequest, err),
+								Prefix:     messageRequest.Prefix,
+								BucketName: messageRequest.BucketName,
+							}
+
+							return
+						}
+
+						if messageRequest.DryRun {
+							matched, err := countObjectsBulkEdit(ctx, wsc.client, bulkOpts)
+							if err != nil {
+								writeChannel <- WSResponse{
+									RequestID:  messageRequest.RequestID,
+									Error:      ErrorWithContext(ctx, err),
+									Prefix:     messageRequest.Prefix,
+									BucketName: messageRequest.BucketName,
+								}
+							}
+							writeChannel <- WSResponse{
+								RequestID:  messageRequest.RequestID,
+								RequestEnd: true,
+								Matched:    matched,
+							}
+
+							removeCancelContext(messageRequest.RequestID)
+							return
+						}
+
+						var buffer []ObjectBulkEditResult
+						var matched, failed int64
+						for result := range startObjectsBulkEdit(ctx, wsc.client, bulkOpts, messageRequest.Edit) {
+							if ctx.Err() != nil {
+								return
+							}
+							matched++
+							if result.Error != "" {
+								failed++
+							}
+							buffer = append(buffer, result)
+
+							if len(buffer) >= bulkEditResultsPerBatch {
+								writeChannel <- WSResponse{
+									RequestID:   messageRequest.RequestID,
+									BulkResults: buffer,
+								}
+								buffer = nil
+							}
+						}
+						if len(buffer) > 0 {
+							writeChannel <- WSResponse{
+								RequestID:   messageRequest.RequestID,
+								BulkResults: buffer,
+							}
+						}
+
+						writeChannel <- WSResponse{
+							RequestID:  messageRequest.RequestID,
+							RequestEnd: true,
+							Matched:    matched,
+							Failed:     failed,
+						}
+
+						// remove the cancellation context
+						removeCancelContext(messageRequest.RequestID)
+					}()
+				case "restore":
+					go func() {
+						objectRqConfigs, err := getObjectsOptionsFromReq(messageRequest)
+						if err != nil {
+							LogInfo(fmt.Sprintf("Error during Objects Restore OptionsParse %s", err.Error()))
+
+							writeChannel <- WSResponse{
+								RequestID:  messageRequest.RequestID,
+								Error:      ErrorWithContext(ctx, ErrBadRequest, err),
+								Prefix:     messageRequest.Prefix,
+								BucketName: messageRequest.BucketName,
+							}
+
+							return
+						}
+
+						report := &ObjectsRestoreReport{
+							Date:   objectRqConfigs.Date.Format(time.RFC3339),
+							DryRun: messageRequest.DryRun,
+						}
+						restoreCh, err := startObjectsRestore(ctx, wsc.client, objectRqConfigs, messageRequest.DryRun)
+						if err != nil {
+							restoreErr := ErrorWithContext(ctx, err)
+							if errors.Is(err, errRestoreVersioningNotEnabled) {
+								restoreErr = ErrorWithContext(ctx, ErrBadRequest, err)
+							}
+							writeChannel <- WSResponse{
+								RequestID:  messageRequest.RequestID,
+								Error:      restoreErr,
+								Prefix:     messageRequest.Prefix,
+								BucketName: messageRequest.BucketName,
+							}
+
+							return
+						}
+						var buffer []ObjectRestoreResult
+						for result := range restoreCh {
+							if ctx.Err() != nil {
+								return
+							}
+							report.add(result)
+							// objects already matching the date are only reported in the totals
+							if result.Action == restoreActionUnchanged && result.Error == "" {
+								continue
+							}
+							buffer = append(buffer, result)
+
+							if len(buffer) >= restoreResultsPerBatch {
+								writeChannel <- WSResponse{
+									RequestID:      messageRequest.RequestID,
+									RestoreResults: buffer,
+								}
+								buffer = nil
+							}
+						}
+						if len(buffer) > 0 {
+							writeChannel <- WSResponse{
+								RequestID:      messageRequest.RequestID,
+								RestoreResults: buffer,
+							}
+						}
+
+						writeChannel <- WSResponse{
+							RequestID:     messageRequest.RequestID,
+							RequestEnd:    true,
+							RestoreReport: report,
+						}
+
+						// remove the cancellation context
+						removeCancelContext(messageRequest.RequestID)
 					}()
 				case "rewind":
-					// cancel all previous open objects requests for listing
-					for rid, c := range cancelContexts {
+					// cancel all previous open objects requests for listing, searches, bulk edits and
+					// restores keep running until they end or are cancelled explicitly
+					for rid, c := range listingContexts {
 						if rid < messageRequest.RequestID {
 							// invoke cancel
 							c()
+							delete(listingContexts, rid)
 						}
 					}
+					listingContexts[messageRequest.RequestID] = cancel
 
 					// start listing and writing to web socket
 					go func() {
@@ -243,7 +468,7 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 						}
 
 						// remove the cancellation context
-						delete(cancelContexts, messageRequest.RequestID)
+						removeCancelContext(messageRequest.RequestID)
 					}()
 				}
 			}
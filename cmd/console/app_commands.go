@@ -57,6 +57,10 @@ func StartServer(ctx *cli.Context) error {
 		api.LogError("argument validation failed: %v", err)
 		return err
 	}
+	// the local log search index is opened while building the server
+	if rctx.LogSearchRetention > 0 {
+		api.LogSearchRetention = rctx.LogSearchRetention
+	}
 
 	server, err := buildServer()
 	if err != nil {
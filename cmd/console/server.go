@@ -17,6 +17,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -71,6 +72,11 @@ var serverCmd = cli.Command{
 			Value: api.GetTLSRedirect(),
 			Usage: "toggle HTTP->HTTPS redirect",
 		},
+		cli.DurationFlag{
+			Name:  "log-search-retention",
+			Value: api.GetLogSearchRetention(),
+			Usage: "keep entries of the local log search index for DURATION",
+		},
 		cli.StringFlag{
 			Name:   "tls-certificate",
 			Value:  "",
@@ -139,8 +145,8 @@ func loadAllCerts(ctx *cli.Context) error {
 		return fmt.Errorf("unable to create certs CA directory at %s: failed with %w", certs.GlobalCertsCADir.Get(), err)
 	}
 
-	// load the certificates and the CAs
-	api.GlobalRootCAs, api.GlobalPublicCerts, api.GlobalTLSCertsManager, err = certs.GetAllCertificatesAndCAs()
+	// load the certificates and the CAs, certificates are watched for changes for the lifetime of the process
+	api.GlobalRootCAs, api.GlobalPublicCerts, api.GlobalTLSCertsManager, err = certs.GetAllCertificatesAndCAs(context.Background())
 	if err != nil {
 		return fmt.Errorf("unable to load certificates at %s: failed with %w", certs.GlobalCertsDir.Get(), err)
 	}
@@ -36,6 +36,9 @@ import (
 // swagger:model addPolicyRequest
 type AddPolicyRequest struct {
 
+	// kept with the policy revision when policy history is enabled
+	Comment string `json:"comment,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
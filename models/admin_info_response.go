@@ -39,7 +39,7 @@ import (
 type AdminInfoResponse struct {
 
 	// advanced metrics status
-	// Enum: [not configured available unavailable]
+	// Enum: [not configured available unavailable realtime]
 	AdvancedMetricsStatus string `json:"advancedMetricsStatus,omitempty"`
 
 	// backend
@@ -91,7 +91,7 @@ var adminInfoResponseTypeAdvancedMetricsStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["not configured","available","unavailable"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["not configured","available","unavailable","realtime"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -109,6 +109,9 @@ const (
 
 	// AdminInfoResponseAdvancedMetricsStatusUnavailable captures enum value "unavailable"
 	AdminInfoResponseAdvancedMetricsStatusUnavailable string = "unavailable"
+
+	// AdminInfoResponseAdvancedMetricsStatusRealtime captures enum value "realtime"
+	AdminInfoResponseAdvancedMetricsStatusRealtime string = "realtime"
 )
 
 // prop value enum
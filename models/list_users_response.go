@@ -36,6 +36,9 @@ import (
 // swagger:model listUsersResponse
 type ListUsersResponse struct {
 
+	// start of the period covered by the usage of the users, empty when usage isn't tracked
+	UsageTrackedSince string `json:"usageTrackedSince,omitempty"`
+
 	// list of resulting users
 	Users []*User `json:"users"`
 }
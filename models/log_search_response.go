@@ -36,6 +36,9 @@ type LogSearchResponse struct {
 
 	// list of log search responses
 	Results interface{} `json:"results,omitempty"`
+
+	// the page is past the records a search of the local index reads
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 // Validate validates this log search response
@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/minio/cli"
@@ -215,10 +216,9 @@ func LoadX509KeyPair(certFile, keyFile string) (tls.Certificate, error) {
 	return tls.X509KeyPair(certPEMBlock, keyPEMBlock)
 }
 
-func GetTLSConfig() (x509Certs []*x509.Certificate, manager *xcerts.Manager, err error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
+// GetTLSConfig loads the certificates found in the certs directory. The returned manager keeps
+// watching the certificate files, and reloads them on demand, until ctx is canceled.
+func GetTLSConfig(ctx context.Context) (x509Certs []*x509.Certificate, manager *xcerts.Manager, err error) {
 	if !(isFile(getPublicCertFile()) && isFile(getPrivateKeyFile())) {
 		return nil, nil, nil
 	}
@@ -232,6 +232,26 @@ func GetTLSConfig() (x509Certs []*x509.Certificate, manager *xcerts.Manager, err
 		return nil, nil, err
 	}
 
+	pairs, err := getDomainCertKeyPairs()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, pair := range pairs {
+		if err = manager.AddCertificate(pair.certFile, pair.keyFile); err != nil {
+			return nil, nil, fmt.Errorf("unable to load TLS certificate '%s,%s': %w", pair.certFile, pair.keyFile, err)
+		}
+	}
+	return x509Certs, manager, nil
+}
+
+// certKeyPair is a public certificate and its private key
+type certKeyPair struct {
+	certFile string
+	keyFile  string
+}
+
+// getDomainCertKeyPairs returns the additional certificates found in the certs directory
+func getDomainCertKeyPairs() ([]certKeyPair, error) {
 	// Console has support for multiple certificates. It expects the following structure:
 	// certs/
 	//  │
@@ -250,17 +270,17 @@ func GetTLSConfig() (x509Certs []*x509.Certificate, manager *xcerts.Manager, err
 	//
 	// Therefore, we read all filenames in the cert directory and check
 	// for each directory whether it contains a public.crt and private.key.
-	// If so, we try to add it to certificate manager.
 	root, err := os.Open(GlobalCertsDir.Get())
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	defer root.Close()
 
 	files, err := root.Readdir(-1)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	var pairs []certKeyPair
 	for _, file := range files {
 		// Ignore all
 		// - regular files
@@ -287,21 +307,59 @@ func GetTLSConfig() (x509Certs []*x509.Certificate, manager *xcerts.Manager, err
 		if !isFile(certFile) || !isFile(keyFile) {
 			continue
 		}
-		if err = manager.AddCertificate(certFile, keyFile); err != nil {
-			return nil, nil, fmt.Errorf("unable to load TLS certificate '%s,%s': %w", certFile, keyFile, err)
+		pairs = append(pairs, certKeyPair{certFile: certFile, keyFile: keyFile})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].certFile < pairs[j].certFile
+	})
+	return pairs, nil
+}
+
+// GetPublicCertFiles returns the public certificate files Console serves, the files are read
+// again on every call so the result reflects certificates reloaded from disk.
+func GetPublicCertFiles() ([]string, error) {
+	if !(isFile(getPublicCertFile()) && isFile(getPrivateKeyFile())) {
+		return nil, nil
+	}
+	files := []string{getPublicCertFile()}
+	pairs, err := getDomainCertKeyPairs()
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		files = append(files, pair.certFile)
+	}
+	return files, nil
+}
+
+// GetCACertFiles returns the files in the CAs directory
+func GetCACertFiles() ([]string, error) {
+	entries, err := os.ReadDir(GlobalCertsCADir.Get())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
 		}
+		return nil, err
 	}
-	return x509Certs, manager, nil
+	var files []string
+	for _, entry := range entries {
+		file := filepath.Join(GlobalCertsCADir.Get(), entry.Name())
+		if isFile(file) {
+			files = append(files, file)
+		}
+	}
+	return files, nil
 }
 
-func GetAllCertificatesAndCAs() (*x509.CertPool, []*x509.Certificate, *xcerts.Manager, error) {
+// GetAllCertificatesAndCAs loads the CAs and the certificates Console serves, see GetTLSConfig
+func GetAllCertificatesAndCAs(ctx context.Context) (*x509.CertPool, []*x509.Certificate, *xcerts.Manager, error) {
 	// load all CAs from ~/.console/certs/CAs
 	rootCAs, err := xcerts.GetRootCAs(GlobalCertsCADir.Get())
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	// load all certs from ~/.console/certs
-	publicCerts, certsManager, err := GetTLSConfig()
+	publicCerts, certsManager, err := GetTLSConfig(ctx)
 	if err != nil {
 		return nil, nil, nil, err
 	}
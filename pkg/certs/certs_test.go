@@ -0,0 +1,165 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestCertificate writes a self signed certificate and its key to dir
+func writeTestCertificate(t *testing.T, dir, commonName string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err = os.WriteFile(filepath.Join(dir, PrivateKeyFile), keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, PublicCertFile), certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func setTestCertsDir(t *testing.T) string {
+	dir := t.TempDir()
+	previousDir, previousCADir := GlobalCertsDir, GlobalCertsCADir
+	GlobalCertsDir = &ConfigDir{Path: dir}
+	GlobalCertsCADir = &ConfigDir{Path: filepath.Join(dir, CertsCADir)}
+	t.Cleanup(func() {
+		GlobalCertsDir, GlobalCertsCADir = previousDir, previousCADir
+	})
+	return dir
+}
+
+func servedCommonName(manager interface {
+	GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
+},
+) string {
+	cert, err := manager.GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil || cert == nil || cert.Leaf == nil {
+		return ""
+	}
+	return cert.Leaf.Subject.CommonName
+}
+
+func TestGetPublicCertFiles(t *testing.T) {
+	assert := assert.New(t)
+	dir := setTestCertsDir(t)
+
+	files, err := GetPublicCertFiles()
+	assert.Nil(err)
+	assert.Empty(files)
+
+	writeTestCertificate(t, dir, "console.local")
+	writeTestCertificate(t, filepath.Join(dir, "example.com"), "example.com")
+	// directories without a key pair are ignored
+	assert.Nil(os.MkdirAll(filepath.Join(dir, "empty.com"), 0o700))
+
+	files, err = GetPublicCertFiles()
+	assert.Nil(err)
+	assert.Equal([]string{
+		filepath.Join(dir, PublicCertFile),
+		filepath.Join(dir, "example.com", PublicCertFile),
+	}, files)
+
+	files, err = GetCACertFiles()
+	assert.Nil(err)
+	assert.Empty(files)
+	writeTestCertificate(t, filepath.Join(dir, CertsCADir), "ca.local")
+	files, err = GetCACertFiles()
+	assert.Nil(err)
+	assert.Len(files, 2)
+}
+
+func TestGetTLSConfigReloadsOnFileChange(t *testing.T) {
+	assert := assert.New(t)
+	dir := setTestCertsDir(t)
+	writeTestCertificate(t, dir, "original.local")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	certs, manager, err := GetTLSConfig(ctx)
+	assert.Nil(err)
+	assert.Len(certs, 1)
+	assert.Equal("original.local", servedCommonName(manager))
+
+	// the manager keeps watching the files after GetTLSConfig returns
+	writeTestCertificate(t, dir, "rotated.local")
+	assert.Eventually(func() bool {
+		return servedCommonName(manager) == "rotated.local"
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestGetTLSConfigReloadsOnSignal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("SIGHUP is not supported on windows")
+	}
+	assert := assert.New(t)
+	dir := setTestCertsDir(t)
+	writeTestCertificate(t, dir, "original.local")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, manager, err := GetTLSConfig(ctx)
+	assert.Nil(err)
+	manager.ReloadOnSignal(syscall.SIGHUP)
+
+	writeTestCertificate(t, dir, "signaled.local")
+	process, err := os.FindProcess(os.Getpid())
+	assert.Nil(err)
+	assert.Nil(process.Signal(syscall.SIGHUP))
+	assert.Eventually(func() bool {
+		return servedCommonName(manager) == "signaled.local"
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -153,6 +153,17 @@ func GetAuditEntry(ctx context.Context) *audit.Entry {
 	return nil
 }
 
+// SetAuditEvent attaches the event of a console action to the audit entry set in the context,
+// the event is dropped when the request is not being audited.
+func SetAuditEvent(ctx context.Context, event *audit.Event) {
+	if ctx == nil {
+		return
+	}
+	if entry, ok := ctx.Value(utils.ContextAuditKey).(*audit.Entry); ok {
+		entry.Event = event
+	}
+}
+
 // AuditLog - logs audit logs to all audit targets.
 func AuditLog(ctx context.Context, w *ResponseWriter, r *http.Request, reqClaims map[string]interface{}, filterKeys ...string) {
 	// Fast exit if there is not audit target configured
@@ -193,6 +204,9 @@ func AuditLog(ctx context.Context, w *ResponseWriter, r *http.Request, reqClaims
 		}
 
 		entry.API.Path = r.URL.Path
+		if auditEntry, ok := ctx.Value(utils.ContextAuditKey).(*audit.Entry); ok {
+			entry.Event = auditEntry.Event
+		}
 
 		entry.API.Status = http.StatusText(statusCode)
 		entry.API.StatusCode = statusCode
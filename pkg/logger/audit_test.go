@@ -0,0 +1,77 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/pkg/logger/message/audit"
+	"github.com/minio/console/pkg/logger/target/file"
+)
+
+func TestAuditLogEvent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	if err := UpdateAuditFileTarget(Config{AuditFile: file.Config{Enabled: true, Path: path}}); err != nil {
+		t.Fatal(err)
+	}
+	defer UpdateAuditFileTarget(NewConfig())
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/policy", nil)
+	req = req.WithContext(SetAuditEntry(req.Context(), &audit.Entry{}))
+	rw := NewResponseWriter(httptest.NewRecorder())
+
+	// a handler attaches the event to the entry set by the middleware
+	event := audit.NewEvent("SetPolicy", "user", "alice", nil, map[string]string{"policy": "readwrite"})
+	event.AccessKey = "admin"
+	SetAuditEvent(req.Context(), event)
+	rw.WriteHeader(http.StatusNoContent)
+	AuditLog(req.Context(), rw, req, map[string]interface{}{})
+
+	// events are dropped when the request isn't audited
+	SetAuditEvent(httptest.NewRequest(http.MethodGet, "/", nil).Context(), event)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 audit entry, got %d", len(lines))
+	}
+	var entry audit.Entry
+	if err = json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.API.StatusCode != http.StatusNoContent || entry.API.Path != "/api/v1/policy" {
+		t.Errorf("unexpected api info %+v", entry.API)
+	}
+	if entry.Event == nil {
+		t.Fatal("expected the event in the audit entry")
+	}
+	if entry.Event.OperationID != "SetPolicy" || entry.Event.AccessKey != "admin" || entry.Event.Target.Name != "alice" {
+		t.Errorf("unexpected event %+v", entry.Event)
+	}
+	if entry.Event.Diff["policy"].After != "readwrite" {
+		t.Errorf("unexpected diff %v", entry.Event.Diff)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/minio/console/pkg/logger/config"
+	"github.com/minio/console/pkg/logger/target/file"
 	"github.com/minio/console/pkg/logger/target/http"
 	"github.com/minio/pkg/v2/env"
 )
@@ -179,6 +180,17 @@ func lookupAuditWebhookConfig() (Config, error) {
 	return cfg, nil
 }
 
+func lookupAuditFileConfig() Config {
+	cfg := NewConfig()
+	if path := env.Get(EnvAuditFilePath, ""); path != "" {
+		cfg.AuditFile = file.Config{
+			Enabled: true,
+			Path:    path,
+		}
+	}
+	return cfg
+}
+
 // LookupConfigForSubSys - lookup logger config, override with ENVs if set, for the given sub-system
 func LookupConfigForSubSys(subSys string) (cfg Config, err error) {
 	switch subSys {
@@ -190,6 +202,8 @@ func LookupConfigForSubSys(subSys string) (cfg Config, err error) {
 		if cfg, err = lookupAuditWebhookConfig(); err != nil {
 			return cfg, err
 		}
+	case config.AuditFileSubSys:
+		cfg = lookupAuditFileConfig()
 	}
 	return cfg, nil
 }
@@ -29,4 +29,5 @@ const (
 const (
 	LoggerWebhookSubSys = "logger_webhook"
 	AuditWebhookSubSys  = "audit_webhook"
+	AuditFileSubSys     = "audit_file"
 )
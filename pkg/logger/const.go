@@ -19,6 +19,7 @@ package logger
 import (
 	"context"
 
+	"github.com/minio/console/pkg/logger/target/file"
 	"github.com/minio/console/pkg/logger/target/http"
 )
 
@@ -42,12 +43,15 @@ const (
 	EnvAuditWebhookClientCert = "CONSOLE_AUDIT_WEBHOOK_CLIENT_CERT"
 	EnvAuditWebhookClientKey  = "CONSOLE_AUDIT_WEBHOOK_CLIENT_KEY"
 	EnvAuditWebhookQueueSize  = "CONSOLE_AUDIT_WEBHOOK_QUEUE_SIZE"
+
+	EnvAuditFilePath = "CONSOLE_AUDIT_FILE_PATH"
 )
 
 // Config console and http logger targets
 type Config struct {
 	HTTP         map[string]http.Config `json:"http"`
 	AuditWebhook map[string]http.Config `json:"audit"`
+	AuditFile    file.Config            `json:"auditFile"`
 }
 
 var (
@@ -409,6 +409,17 @@ func applyDynamicConfigForSubSys(ctx context.Context, transport *http.Transport,
 			LogIf(ctx, fmt.Errorf("Unable to update audit webhook targets: %w", err))
 			return err
 		}
+	case config.AuditFileSubSys:
+		loggerCfg, err := LookupConfigForSubSys(config.AuditFileSubSys)
+		if err != nil {
+			LogIf(ctx, fmt.Errorf("unable to load audit file config: %w", err))
+			return err
+		}
+		err = UpdateAuditFileTarget(loggerCfg)
+		if err != nil {
+			LogIf(ctx, fmt.Errorf("Unable to update audit file target: %w", err))
+			return err
+		}
 	}
 	return nil
 }
@@ -423,6 +434,10 @@ func InitializeLogger(ctx context.Context, transport *http.Transport) error {
 	if err != nil {
 		return err
 	}
+	err = applyDynamicConfigForSubSys(ctx, transport, config.AuditFileSubSys)
+	if err != nil {
+		return err
+	}
 
 	if enable, _ := config.ParseBool(env.Get(EnvLoggerJSONEnable, "")); enable {
 		EnableJSON()
@@ -65,6 +65,7 @@ type Entry struct {
 	ReqHeader  map[string]string      `json:"requestHeader,omitempty"`
 	RespHeader map[string]string      `json:"responseHeader,omitempty"`
 	Tags       map[string]interface{} `json:"tags,omitempty"`
+	Event      *Event                 `json:"event,omitempty"`
 }
 
 // NewEntry - constructs an audit entry object with some fields filled
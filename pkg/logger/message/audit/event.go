@@ -0,0 +1,183 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package audit
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// RedactedValue replaces the value of sensitive fields in audit events
+const RedactedValue = "*REDACTED*"
+
+// sensitiveKeys are matched against field names lowercased and stripped of '_' and '-'
+var sensitiveKeys = []string{
+	"secret", "password", "token", "privatekey", "accountkey", "cred", "apikey", "authorization",
+	// database connection strings of notification targets embed the password
+	"connectionstring", "dsnstring",
+}
+
+// Target - entity a console action was applied to
+type Target struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+// Change - value of a field before and after a console action
+type Change struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// Event - describes the console action behind an audit entry
+type Event struct {
+	OperationID string            `json:"operationID"`
+	AccessKey   string            `json:"accessKey,omitempty"`
+	ParentUser  string            `json:"parentUser,omitempty"`
+	Target      Target            `json:"target"`
+	Diff        map[string]Change `json:"diff,omitempty"`
+}
+
+// NewEvent - constructs an event with the redacted diff between before and after
+func NewEvent(operationID, targetType, targetName string, before, after interface{}) *Event {
+	return &Event{
+		OperationID: operationID,
+		Target:      Target{Type: targetType, Name: targetName},
+		Diff:        Diff(before, after),
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// toGeneric - returns the JSON representation of v as maps, lists and scalar values
+func toGeneric(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var generic interface{}
+	if err = json.Unmarshal(data, &generic); err != nil {
+		return nil
+	}
+	return generic
+}
+
+// redactURL removes the user information of URL values, such as the credentials of an amqp target
+func redactURL(value string) string {
+	if !strings.Contains(value, "://") || !strings.Contains(value, "@") {
+		return value
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return RedactedValue
+	}
+	if u.User == nil {
+		return value
+	}
+	u.User = nil
+	return u.String()
+}
+
+// redact replaces the values of sensitive fields and the user information of URLs, key/value pairs
+// such as configuration entries are redacted based on their key
+func redact(v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		return redactURL(value)
+	case map[string]interface{}:
+		key, _ := value["key"].(string)
+		for k, field := range value {
+			if (isSensitiveKey(k) || (k == "value" && isSensitiveKey(key))) && field != nil && field != "" {
+				value[k] = RedactedValue
+				continue
+			}
+			value[k] = redact(field)
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = redact(value[i])
+		}
+	}
+	return v
+}
+
+// flatten maps the nested fields of v to dotted paths, lists and sensitive fields are kept as a single value
+func flatten(prefix string, v interface{}, out map[string]interface{}) {
+	fields, ok := v.(map[string]interface{})
+	if !ok || len(fields) == 0 {
+		if prefix == "" {
+			prefix = "value"
+		}
+		if v != nil {
+			out[prefix] = v
+		}
+		return
+	}
+	for k, field := range fields {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if isSensitiveKey(k) {
+			if field != nil {
+				out[path] = field
+			}
+			continue
+		}
+		flatten(path, field, out)
+	}
+}
+
+// Diff - returns the fields that differ between before and after keyed by their dotted path, fields are
+// compared on their actual value but the values of sensitive fields such as secret keys are redacted
+func Diff(before, after interface{}) map[string]Change {
+	beforeFields, afterFields := map[string]interface{}{}, map[string]interface{}{}
+	flatten("", toGeneric(before), beforeFields)
+	flatten("", toGeneric(after), afterFields)
+	redactedBefore, redactedAfter := map[string]interface{}{}, map[string]interface{}{}
+	flatten("", redact(toGeneric(before)), redactedBefore)
+	flatten("", redact(toGeneric(after)), redactedAfter)
+
+	diff := map[string]Change{}
+	for path, b := range beforeFields {
+		if a := afterFields[path]; !reflect.DeepEqual(b, a) {
+			diff[path] = Change{Before: redactedBefore[path], After: redactedAfter[path]}
+		}
+	}
+	for path := range afterFields {
+		if _, ok := beforeFields[path]; !ok {
+			diff[path] = Change{After: redactedAfter[path]}
+		}
+	}
+	if len(diff) == 0 {
+		return nil
+	}
+	return diff
+}
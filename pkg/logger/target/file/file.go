@@ -0,0 +1,102 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package file
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/minio/console/pkg/logger/target/types"
+)
+
+// Config file logger target
+type Config struct {
+	Enabled bool   `json:"enabled"`
+	Path    string `json:"path"`
+}
+
+// Target implements logger.Target and appends the json
+// format of a log entry to the configured file, one
+// entry per line.
+type Target struct {
+	mu     sync.Mutex
+	file   *os.File
+	config Config
+}
+
+// Endpoint returns the path of the file
+func (f *Target) Endpoint() string {
+	return f.config.Path
+}
+
+func (f *Target) String() string {
+	return f.config.Path
+}
+
+// Init opens the file, entries are appended to existing content
+func (f *Target) Init() error {
+	if f.config.Path == "" {
+		return errors.New("file path is empty")
+	}
+	file, err := os.OpenFile(f.config.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	f.file = file
+	f.mu.Unlock()
+	return nil
+}
+
+// Send log message 'e' to file target.
+func (f *Target) Send(entry interface{}, _ string) error {
+	logJSON, err := json.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		// File was closed or used before init.
+		return nil
+	}
+	_, err = f.file.Write(append(logJSON, '\n'))
+	return err
+}
+
+// Cancel - closes the file
+func (f *Target) Cancel() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file != nil {
+		f.file.Close()
+		f.file = nil
+	}
+}
+
+// Type - returns type of the target
+func (f *Target) Type() types.TargetType {
+	return types.TargetFile
+}
+
+// New initializes a new logger target which
+// appends logs to the file at the configured path
+func New(config Config) *Target {
+	return &Target{config: config}
+}
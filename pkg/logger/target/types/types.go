@@ -24,4 +24,6 @@ const (
 	_ TargetType = iota
 	TargetConsole
 	TargetHTTP
+	TargetFile
+	TargetLogSearch
 )
@@ -20,6 +20,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/minio/console/pkg/logger/target/file"
 	"github.com/minio/console/pkg/logger/target/http"
 	"github.com/minio/console/pkg/logger/target/types"
 )
@@ -143,9 +144,56 @@ func UpdateAuditWebhookTargets(cfg Config) error {
 	}
 
 	swapMu.Lock()
+	updated = append(updated, auditTargetsExcept(types.TargetHTTP)...)
 	atomic.StoreInt32(&nAuditTargets, int32(len(updated)))
 	cancelAuditTargetType(types.TargetHTTP) // cancel running targets
 	auditTargets = updated
 	swapMu.Unlock()
 	return nil
 }
+
+// auditTargetsExcept returns the audit targets not of type t, must be called holding swapMu
+func auditTargetsExcept(t types.TargetType) []Target {
+	var tgts []Target
+	for _, tgt := range auditTargets {
+		if tgt.Type() != t {
+			tgts = append(tgts, tgt)
+		}
+	}
+	return tgts
+}
+
+// UpdateAuditFileTarget swaps the audit file target with the one loaded from the cfg
+func UpdateAuditFileTarget(cfg Config) error {
+	var updated []Target
+	if cfg.AuditFile.Enabled {
+		t := file.New(cfg.AuditFile)
+		if err := t.Init(); err != nil {
+			return err
+		}
+		updated = append(updated, t)
+	}
+
+	swapMu.Lock()
+	updated = append(updated, auditTargetsExcept(types.TargetFile)...)
+	atomic.StoreInt32(&nAuditTargets, int32(len(updated)))
+	cancelAuditTargetType(types.TargetFile) // cancel running targets
+	auditTargets = updated
+	swapMu.Unlock()
+	return nil
+}
+
+// AddAuditTarget initializes t and adds it to the audit targets, a target of the same type is replaced
+func AddAuditTarget(t Target) error {
+	if err := t.Init(); err != nil {
+		return err
+	}
+
+	swapMu.Lock()
+	updated := append(auditTargetsExcept(t.Type()), t)
+	atomic.StoreInt32(&nAuditTargets, int32(len(updated)))
+	cancelAuditTargetType(t.Type()) // cancel running targets
+	auditTargets = updated
+	swapMu.Unlock()
+	return nil
+}
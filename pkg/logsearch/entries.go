@@ -0,0 +1,134 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/minio/console/pkg/logger/message/audit"
+	minioaudit "github.com/minio/pkg/v2/logger/message/audit"
+)
+
+const bucketsAPIPrefix = "/api/v1/buckets/"
+
+// FromConsoleEntry maps an audit entry of a console request to a record, console
+// actions are named after their API operation and requests without one after the route
+func FromConsoleEntry(entry audit.Entry) Record {
+	record := Record{
+		Time:                 entry.Time,
+		Source:               SourceConsole,
+		APIName:              entry.API.Method + " " + entry.API.Path,
+		RemoteHost:           hostOnly(entry.RemoteHost),
+		RequestID:            entry.RequestID,
+		UserAgent:            entry.UserAgent,
+		ResponseStatus:       entry.API.Status,
+		ResponseStatusCode:   entry.API.StatusCode,
+		TimeToResponseNs:     nanoseconds(entry.API.TimeToResponse),
+		RequestContentLength: entry.API.InputBytes,
+	}
+	if entry.API.OutputBytes > 0 {
+		record.ResponseContentLength = entry.API.OutputBytes
+	}
+	if accessKey, ok := entry.ReqClaims["accessKey"].(string); ok {
+		record.AccessKey = accessKey
+	}
+	if strings.HasPrefix(entry.API.Path, bucketsAPIPrefix) {
+		record.Bucket, _, _ = strings.Cut(strings.TrimPrefix(entry.API.Path, bucketsAPIPrefix), "/")
+	}
+	if event := entry.Event; event != nil {
+		if event.OperationID != "" {
+			record.APIName = event.OperationID
+		}
+		if event.AccessKey != "" {
+			record.AccessKey = event.AccessKey
+		}
+		record.ParentUser = event.ParentUser
+		if event.Target.Type == "bucket" {
+			record.Bucket = event.Target.Name
+		}
+	}
+	return record
+}
+
+// FromMinIOEntry maps an entry delivered by the MinIO audit webhook to a record
+func FromMinIOEntry(entry minioaudit.Entry) Record {
+	record := Record{
+		Time:                  entry.Time,
+		Source:                SourceMinIO,
+		APIName:               entry.API.Name,
+		Bucket:                entry.API.Bucket,
+		Object:                entry.API.Object,
+		AccessKey:             entry.AccessKey,
+		ParentUser:            entry.ParentUser,
+		RemoteHost:            hostOnly(entry.RemoteHost),
+		RequestID:             entry.RequestID,
+		UserAgent:             entry.UserAgent,
+		ResponseStatus:        entry.API.Status,
+		ResponseStatusCode:    entry.API.StatusCode,
+		TimeToResponseNs:      nanoseconds(entry.API.TimeToResponse),
+		RequestContentLength:  entry.API.InputBytes,
+		ResponseContentLength: entry.API.OutputBytes,
+		Error:                 entry.Error,
+	}
+	// older MinIO releases only report the access key in the claims
+	if record.AccessKey == "" {
+		record.AccessKey, _ = entry.ReqClaims["accessKey"].(string)
+	}
+	if record.ParentUser == "" {
+		record.ParentUser, _ = entry.ReqClaims["parent"].(string)
+	}
+	return record
+}
+
+// DecodeMinIOEntries reads the entries of a MinIO audit webhook delivery, batched
+// deliveries hold several entries one after the other
+func DecodeMinIOEntries(r io.Reader) ([]Record, error) {
+	var records []Record
+	decoder := json.NewDecoder(r)
+	for {
+		var entry minioaudit.Entry
+		err := decoder.Decode(&entry)
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, FromMinIOEntry(entry))
+	}
+}
+
+func hostOnly(remoteHost string) string {
+	if host, _, err := net.SplitHostPort(remoteHost); err == nil {
+		return host
+	}
+	return remoteHost
+}
+
+// nanoseconds parses durations such as "452546530ns", unknown durations are reported as zero
+func nanoseconds(duration string) int64 {
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return 0
+	}
+	return d.Nanoseconds()
+}
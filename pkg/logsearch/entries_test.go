@@ -0,0 +1,79 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logsearch
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/logger/message/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeMinIOEntries(t *testing.T) {
+	// a batched delivery, the second entry comes from a release reporting the access key in the claims
+	delivery := `{"version":"1","time":"2024-03-10T12:00:00Z","api":{"name":"PutObject","bucket":"photos","object":"a.png","status":"OK","statusCode":200,"rx":512,"tx":0,"timeToResponse":"1500000ns"},"remotehost":"10.0.0.1","requestID":"17B","userAgent":"mc","accessKey":"alice","parentUser":"admin"}
+{"version":"1","time":"2024-03-10T12:00:01Z","api":{"name":"GetObject","bucket":"photos","status":"Not Found","statusCode":404,"rx":0,"tx":10},"remotehost":"10.0.0.2:5000","requestClaims":{"accessKey":"bob","parent":"admin"},"error":"object not found"}`
+
+	records, err := DecodeMinIOEntries(strings.NewReader(delivery))
+	assert.NoError(t, err)
+	assert.Equal(t, []Record{
+		{
+			Time: time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC), Source: SourceMinIO, APIName: "PutObject", Bucket: "photos", Object: "a.png",
+			AccessKey: "alice", ParentUser: "admin", RemoteHost: "10.0.0.1", RequestID: "17B", UserAgent: "mc",
+			ResponseStatus: "OK", ResponseStatusCode: 200, TimeToResponseNs: 1500000, RequestContentLength: 512,
+		},
+		{
+			Time: time.Date(2024, 3, 10, 12, 0, 1, 0, time.UTC), Source: SourceMinIO, APIName: "GetObject", Bucket: "photos",
+			AccessKey: "bob", ParentUser: "admin", RemoteHost: "10.0.0.2", ResponseStatus: "Not Found", ResponseStatusCode: 404,
+			ResponseContentLength: 10, Error: "object not found",
+		},
+	}, records)
+
+	_, err = DecodeMinIOEntries(strings.NewReader(`{"version":`))
+	assert.Error(t, err)
+}
+
+func TestFromConsoleEntry(t *testing.T) {
+	entry := audit.NewEntry("")
+	entry.API.Method = "PUT"
+	entry.API.Path = "/api/v1/buckets/photos/quota"
+	entry.API.Status = "OK"
+	entry.API.StatusCode = 200
+	entry.API.TimeToResponse = "2ms"
+	entry.API.OutputBytes = -1
+	entry.RemoteHost = "127.0.0.1:41234"
+	entry.ReqClaims = map[string]interface{}{"accessKey": "alice"}
+
+	record := FromConsoleEntry(entry)
+	assert.Equal(t, SourceConsole, record.Source)
+	assert.Equal(t, "PUT /api/v1/buckets/photos/quota", record.APIName)
+	assert.Equal(t, "photos", record.Bucket)
+	assert.Equal(t, "alice", record.AccessKey)
+	assert.Equal(t, "127.0.0.1", record.RemoteHost)
+	assert.Equal(t, int64(2000000), record.TimeToResponseNs)
+	assert.Equal(t, int64(0), record.ResponseContentLength)
+
+	entry.Event = audit.NewEvent("SetBucketQuota", "bucket", "photos", nil, nil)
+	entry.Event.AccessKey = "sa-1"
+	entry.Event.ParentUser = "alice"
+	record = FromConsoleEntry(entry)
+	assert.Equal(t, "SetBucketQuota", record.APIName)
+	assert.Equal(t, "sa-1", record.AccessKey)
+	assert.Equal(t, "alice", record.ParentUser)
+}
@@ -0,0 +1,427 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logsearch
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	segmentExt    = ".jsonl"
+	segmentLayout = "2006-01-02"
+	// maxRecordSize is the longest line accepted when reading back a segment
+	maxRecordSize = 1 << 20
+	// MaxQueryRecords is the most records a search keeps in memory, pages past it aren't served
+	MaxQueryRecords = 10000
+)
+
+// Sources of the indexed records
+const (
+	SourceConsole = "console"
+	SourceMinIO   = "minio"
+)
+
+// Record - a single request in the index, field names match the results of the
+// external log search API so both can be rendered the same way
+type Record struct {
+	Time                  time.Time `json:"time"`
+	Source                string    `json:"source"`
+	APIName               string    `json:"api_name"`
+	Bucket                string    `json:"bucket"`
+	Object                string    `json:"object"`
+	AccessKey             string    `json:"access_key"`
+	ParentUser            string    `json:"parent_user,omitempty"`
+	RemoteHost            string    `json:"remote_host"`
+	RequestID             string    `json:"request_id"`
+	UserAgent             string    `json:"user_agent"`
+	ResponseStatus        string    `json:"response_status"`
+	ResponseStatusCode    int       `json:"response_status_code"`
+	TimeToResponseNs      int64     `json:"time_to_response_ns"`
+	RequestContentLength  int64     `json:"request_content_length"`
+	ResponseContentLength int64     `json:"response_content_length"`
+	Error                 string    `json:"error,omitempty"`
+}
+
+// Query - selects records from the index, zero values don't filter
+type Query struct {
+	Start      time.Time
+	End        time.Time
+	Source     string
+	AccessKey  string
+	Bucket     string
+	Object     string
+	APIName    string
+	RemoteHost string
+	RequestID  string
+	UserAgent  string
+	// Status matches either the status text or the status code of the response
+	Status    string
+	Ascending bool
+	PageSize  int
+	PageNo    int
+}
+
+// Index keeps records on the local disk in one append only segment per day,
+// every segment lives in `<path>/<yyyy-mm-dd>.jsonl` and segments older than
+// the retention are removed as new ones are created
+type Index struct {
+	path      string
+	retention time.Duration
+
+	mu      sync.Mutex
+	day     string
+	segment *os.File
+}
+
+// New returns an Index rooted at path keeping records for the retention period,
+// a retention of zero keeps records forever
+func New(path string, retention time.Duration) *Index {
+	return &Index{path: path, retention: retention}
+}
+
+// Path returns the root directory of the index
+func (i *Index) Path() string {
+	return i.path
+}
+
+// Retention returns for how long records are kept
+func (i *Index) Retention() time.Duration {
+	return i.retention
+}
+
+// Add appends records to the segments of the day they happened
+func (i *Index) Add(records ...Record) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, record := range records {
+		if record.Time.IsZero() {
+			record.Time = time.Now().UTC()
+		}
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		segment, err := i.segmentFor(record.Time.UTC().Format(segmentLayout))
+		if err != nil {
+			return err
+		}
+		if _, err = segment.Write(append(data, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// segmentFor returns the open segment of the day, must be called holding mu
+func (i *Index) segmentFor(day string) (*os.File, error) {
+	if i.segment != nil && i.day == day {
+		return i.segment, nil
+	}
+	if err := os.MkdirAll(i.path, 0o700); err != nil {
+		return nil, err
+	}
+	segment, err := os.OpenFile(filepath.Join(i.path, day+segmentExt), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if i.segment != nil {
+		i.segment.Close()
+	}
+	i.day, i.segment = day, segment
+	// a new day started, drop what fell out of the retention window
+	if err = i.prune(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+// Prune removes the segments older than the retention period
+func (i *Index) Prune(now time.Time) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.prune(now)
+}
+
+func (i *Index) prune(now time.Time) error {
+	if i.retention <= 0 {
+		return nil
+	}
+	days, err := i.days()
+	if err != nil {
+		return err
+	}
+	cutoff := now.Add(-i.retention)
+	for _, day := range days {
+		t, _ := time.Parse(segmentLayout, day)
+		// a segment is removed once its last record would be past the retention
+		if !t.AddDate(0, 0, 1).Before(cutoff) || day == i.day {
+			continue
+		}
+		if err = os.Remove(filepath.Join(i.path, day+segmentExt)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// days returns the sorted days with a segment on disk
+func (i *Index) days() ([]string, error) {
+	entries, err := os.ReadDir(i.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var days []string
+	for _, entry := range entries {
+		day := strings.TrimSuffix(entry.Name(), segmentExt)
+		if entry.IsDir() || day == entry.Name() {
+			continue
+		}
+		if _, err = time.Parse(segmentLayout, day); err != nil {
+			continue
+		}
+		days = append(days, day)
+	}
+	sort.Strings(days)
+	return days, nil
+}
+
+// SearchResult - a page of records
+type SearchResult struct {
+	Records []Record
+	// Truncated is set when the page is past the first MaxQueryRecords records
+	Truncated bool
+}
+
+// Search returns the page of records matching the query ordered by time, segments are streamed
+// keeping only the records up to the requested page in memory
+func (i *Index) Search(q Query) (SearchResult, error) {
+	result := SearchResult{Records: []Record{}}
+	days, err := i.days()
+	if err != nil {
+		return result, err
+	}
+	if !q.Ascending {
+		sort.Sort(sort.Reverse(sort.StringSlice(days)))
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = 10
+	}
+	if q.PageSize > MaxQueryRecords {
+		q.PageSize = MaxQueryRecords
+		result.Truncated = true
+	}
+	skip := q.PageNo * q.PageSize
+	if skip+q.PageSize > MaxQueryRecords {
+		result.Truncated = true
+		return result, nil
+	}
+	for _, day := range days {
+		if !q.includesDay(day) {
+			continue
+		}
+		records, matched, err := i.readSegment(day, q, skip+q.PageSize-len(result.Records))
+		if err != nil {
+			return result, err
+		}
+		if skip >= matched {
+			skip -= matched
+			continue
+		}
+		records = records[skip:]
+		skip = 0
+		result.Records = append(result.Records, records...)
+		if len(result.Records) == q.PageSize {
+			break
+		}
+	}
+	return result, nil
+}
+
+// Each calls fn with every record matching the query, segments are read from the oldest to the
+// newest and records in the order they were delivered, paging parameters are ignored
+func (i *Index) Each(q Query, fn func(Record)) error {
+	days, err := i.days()
+	if err != nil {
+		return err
+	}
+	for _, day := range days {
+		if !q.includesDay(day) {
+			continue
+		}
+		if err = i.scanSegment(day, func(record Record) {
+			if q.Matches(record) {
+				fn(record)
+			}
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanSegment calls fn with every record of the day in the order they were delivered, segments are
+// only appended to so they are read without blocking writers
+func (i *Index) scanSegment(day string, fn func(Record)) error {
+	segment, err := os.Open(filepath.Join(i.path, day+segmentExt))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// removed by the retention while searching
+			return nil
+		}
+		return err
+	}
+	defer segment.Close()
+
+	scanner := bufio.NewScanner(segment)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		var record Record
+		// a partially written line is skipped, the rest of the segment is still readable
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		fn(record)
+	}
+	return scanner.Err()
+}
+
+// readSegment returns the first limit records of the day matching the query in the query order and
+// the number of records matching, only limit records are held while the segment is read
+func (i *Index) readSegment(day string, q Query, limit int) ([]Record, int, error) {
+	kept := &recordHeap{ascending: q.Ascending}
+	matched := 0
+	err := i.scanSegment(day, func(record Record) {
+		if !q.Matches(record) {
+			return
+		}
+		// deliveries can arrive late, order by time rather than by arrival
+		r := sequencedRecord{Record: record, seq: matched}
+		matched++
+		if kept.Len() < limit {
+			heap.Push(kept, r)
+		} else if kept.Len() > 0 && kept.before(r, kept.records[0]) {
+			kept.records[0] = r
+			heap.Fix(kept, 0)
+		}
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.Slice(kept.records, func(a, b int) bool {
+		return kept.before(kept.records[a], kept.records[b])
+	})
+	records := make([]Record, len(kept.records))
+	for n, r := range kept.records {
+		records[n] = r.Record
+	}
+	return records, matched, nil
+}
+
+// sequencedRecord keeps the delivery order of records with the same time
+type sequencedRecord struct {
+	Record
+	seq int
+}
+
+// recordHeap keeps the records coming first in the query order, the last of them at the root
+type recordHeap struct {
+	records   []sequencedRecord
+	ascending bool
+}
+
+// before returns whether a comes before b in the query order
+func (h *recordHeap) before(a, b sequencedRecord) bool {
+	if !a.Time.Equal(b.Time) {
+		return a.Time.Before(b.Time) == h.ascending
+	}
+	return a.seq < b.seq
+}
+
+func (h *recordHeap) Len() int           { return len(h.records) }
+func (h *recordHeap) Less(a, b int) bool { return h.before(h.records[b], h.records[a]) }
+func (h *recordHeap) Swap(a, b int)      { h.records[a], h.records[b] = h.records[b], h.records[a] }
+func (h *recordHeap) Push(x interface{}) { h.records = append(h.records, x.(sequencedRecord)) }
+func (h *recordHeap) Pop() interface{} {
+	last := h.records[len(h.records)-1]
+	h.records = h.records[:len(h.records)-1]
+	return last
+}
+
+// Close closes the segment being written
+func (i *Index) Close() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.segment == nil {
+		return nil
+	}
+	err := i.segment.Close()
+	i.day, i.segment = "", nil
+	return err
+}
+
+// includesDay returns whether the segment of the day can hold records of the time range
+func (q Query) includesDay(day string) bool {
+	start, err := time.Parse(segmentLayout, day)
+	if err != nil {
+		return false
+	}
+	if !q.Start.IsZero() && !start.AddDate(0, 0, 1).After(q.Start) {
+		return false
+	}
+	if !q.End.IsZero() && start.After(q.End) {
+		return false
+	}
+	return true
+}
+
+// Matches returns whether the record satisfies every filter of the query, the object
+// filter matches prefixes and the user agent filter substrings, the rest match exactly
+func (q Query) Matches(r Record) bool {
+	if !q.Start.IsZero() && r.Time.Before(q.Start) {
+		return false
+	}
+	if !q.End.IsZero() && r.Time.After(q.End) {
+		return false
+	}
+	if q.Status != "" && !strings.EqualFold(q.Status, r.ResponseStatus) && q.Status != strconv.Itoa(r.ResponseStatusCode) {
+		return false
+	}
+	return matchExact(q.Source, r.Source) &&
+		(matchExact(q.AccessKey, r.AccessKey) || matchExact(q.AccessKey, r.ParentUser)) &&
+		matchExact(q.Bucket, r.Bucket) &&
+		strings.HasPrefix(r.Object, q.Object) &&
+		(q.APIName == "" || strings.EqualFold(q.APIName, r.APIName)) &&
+		matchExact(q.RemoteHost, r.RemoteHost) &&
+		matchExact(q.RequestID, r.RequestID) &&
+		strings.Contains(r.UserAgent, q.UserAgent)
+}
+
+func matchExact(filter, value string) bool {
+	return filter == "" || filter == value
+}
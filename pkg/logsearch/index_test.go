@@ -0,0 +1,183 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logsearch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func requestIDs(records []Record) []string {
+	ids := []string{}
+	for _, r := range records {
+		ids = append(ids, r.RequestID)
+	}
+	return ids
+}
+
+func TestIndexSearch(t *testing.T) {
+	index := New(t.TempDir(), 0)
+	defer index.Close()
+
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	records := []Record{
+		{Time: day, RequestID: "1", Source: SourceMinIO, APIName: "PutObject", Bucket: "photos", Object: "2024/a.png", AccessKey: "alice", RemoteHost: "10.0.0.1", ResponseStatus: "OK", ResponseStatusCode: 200},
+		{Time: day.Add(time.Hour), RequestID: "2", Source: SourceMinIO, APIName: "GetObject", Bucket: "photos", Object: "2023/b.png", AccessKey: "bob", RemoteHost: "10.0.0.2", ResponseStatus: "Not Found", ResponseStatusCode: 404},
+		// delivered late, ordered by time within its day
+		{Time: day.Add(-time.Hour), RequestID: "3", Source: SourceConsole, APIName: "AddUser", AccessKey: "sa-1", ParentUser: "alice", RemoteHost: "10.0.0.1", ResponseStatus: "Created", ResponseStatusCode: 201},
+		{Time: day.AddDate(0, 0, 1), RequestID: "4", Source: SourceMinIO, APIName: "PutObject", Bucket: "docs", AccessKey: "alice", RemoteHost: "10.0.0.3", ResponseStatus: "OK", ResponseStatusCode: 200},
+	}
+	assert.NoError(t, index.Add(records...))
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{name: "newest first", query: Query{PageSize: 10}, want: []string{"4", "2", "1", "3"}},
+		{name: "oldest first", query: Query{PageSize: 10, Ascending: true}, want: []string{"3", "1", "2", "4"}},
+		{name: "pages span segments", query: Query{PageSize: 2, PageNo: 1}, want: []string{"1", "3"}},
+		{name: "page past the end", query: Query{PageSize: 2, PageNo: 2}, want: []string{}},
+		{name: "time range", query: Query{Start: day, End: day.Add(2 * time.Hour), PageSize: 10}, want: []string{"2", "1"}},
+		{name: "by user or parent user", query: Query{AccessKey: "alice", PageSize: 10}, want: []string{"4", "1", "3"}},
+		{name: "by bucket and object prefix", query: Query{Bucket: "photos", Object: "2024/", PageSize: 10}, want: []string{"1"}},
+		{name: "by api name", query: Query{APIName: "putobject", PageSize: 10}, want: []string{"4", "1"}},
+		{name: "by status code", query: Query{Status: "404", PageSize: 10}, want: []string{"2"}},
+		{name: "by status text", query: Query{Status: "created", PageSize: 10}, want: []string{"3"}},
+		{name: "by remote ip and source", query: Query{RemoteHost: "10.0.0.1", Source: SourceMinIO, PageSize: 10}, want: []string{"1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := index.Search(tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, requestIDs(got.Records))
+		})
+	}
+}
+
+func TestIndexSearchPages(t *testing.T) {
+	index := New(t.TempDir(), 0)
+	defer index.Close()
+
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, index.Add(
+		Record{Time: day.Add(2 * time.Hour), RequestID: "4"},
+		Record{Time: day, RequestID: "2"},
+		Record{Time: day.AddDate(0, 0, -1), RequestID: "1"},
+		Record{Time: day, RequestID: "3"},
+		Record{Time: day.AddDate(0, 0, 1), RequestID: "5"},
+	))
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{name: "newest first", query: Query{PageSize: 2}, want: []string{"5", "4"}},
+		{name: "across days", query: Query{PageSize: 2, PageNo: 1}, want: []string{"2", "3"}},
+		{name: "oldest first", query: Query{PageSize: 3, Ascending: true}, want: []string{"1", "2", "3"}},
+		{name: "last page", query: Query{PageSize: 3, PageNo: 1, Ascending: true}, want: []string{"4", "5"}},
+		{name: "past the records", query: Query{PageSize: 3, PageNo: 2}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := index.Search(tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, requestIDs(got.Records))
+			assert.False(t, got.Truncated)
+		})
+	}
+
+	got, err := index.Search(Query{PageSize: 10, PageNo: MaxQueryRecords / 10})
+	assert.NoError(t, err)
+	assert.Empty(t, got.Records)
+	assert.True(t, got.Truncated)
+
+	got, err = index.Search(Query{PageSize: MaxQueryRecords + 1})
+	assert.NoError(t, err)
+	assert.Len(t, got.Records, 5)
+	assert.True(t, got.Truncated)
+}
+
+func TestIndexEach(t *testing.T) {
+	index := New(t.TempDir(), 0)
+	defer index.Close()
+
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, index.Add(
+		Record{Time: day.AddDate(0, 0, 1), RequestID: "3"},
+		Record{Time: day, RequestID: "2"},
+		Record{Time: day.Add(-time.Hour), RequestID: "1"},
+	))
+	var all []Record
+	assert.NoError(t, index.Each(Query{PageSize: 1}, func(r Record) { all = append(all, r) }))
+	// days are read in order, records of a day as they were delivered
+	assert.Equal(t, []string{"2", "1", "3"}, requestIDs(all))
+
+	var recent []Record
+	assert.NoError(t, index.Each(Query{Start: day.Add(time.Second)}, func(r Record) { recent = append(recent, r) }))
+	assert.Equal(t, []string{"3"}, requestIDs(recent))
+}
+
+func TestIndexRetention(t *testing.T) {
+	dir := t.TempDir()
+	index := New(dir, 48*time.Hour)
+	defer index.Close()
+
+	now := time.Now().UTC()
+	assert.NoError(t, index.Add(
+		Record{Time: now.AddDate(0, 0, -5), RequestID: "old"},
+		Record{Time: now.AddDate(0, 0, -1), RequestID: "recent"},
+	))
+	// writing the recent segment dropped the old one
+	_, err := os.Stat(filepath.Join(dir, now.AddDate(0, 0, -5).Format(segmentLayout)+segmentExt))
+	assert.True(t, os.IsNotExist(err))
+
+	assert.NoError(t, index.Prune(now.AddDate(0, 0, 3)))
+	got, err := index.Search(Query{PageSize: 10})
+	assert.NoError(t, err)
+	// the segment being written is kept
+	assert.Equal(t, []string{"recent"}, requestIDs(got.Records))
+	index.Close()
+	assert.NoError(t, index.Prune(now.AddDate(0, 0, 3)))
+	got, err = index.Search(Query{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, got.Records)
+}
+
+func TestIndexSkipsPartialRecords(t *testing.T) {
+	dir := t.TempDir()
+	index := New(dir, 0)
+	now := time.Now().UTC()
+	assert.NoError(t, index.Add(Record{Time: now, RequestID: "1"}))
+	assert.NoError(t, index.Close())
+
+	segment, err := os.OpenFile(filepath.Join(dir, now.Format(segmentLayout)+segmentExt), os.O_APPEND|os.O_WRONLY, 0o600)
+	assert.NoError(t, err)
+	_, err = segment.WriteString(`{"time":"` + "\n")
+	assert.NoError(t, err)
+	segment.Close()
+
+	assert.NoError(t, index.Add(Record{Time: now.Add(time.Second), RequestID: "2"}))
+	got, err := index.Search(Query{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "1"}, requestIDs(got.Records))
+}
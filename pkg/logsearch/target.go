@@ -0,0 +1,68 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logsearch
+
+import (
+	"github.com/minio/console/pkg/logger/message/audit"
+	"github.com/minio/console/pkg/logger/target/types"
+)
+
+// Target implements logger.Target and adds the audit
+// entries of console requests to the index
+type Target struct {
+	index *Index
+}
+
+// NewTarget returns an audit target writing to the index
+func NewTarget(index *Index) *Target {
+	return &Target{index: index}
+}
+
+// Endpoint returns the path of the index
+func (t *Target) Endpoint() string {
+	return t.index.Path()
+}
+
+func (t *Target) String() string {
+	return t.index.Path()
+}
+
+// Init - the index creates its directory on first write
+func (t *Target) Init() error {
+	return nil
+}
+
+// Send adds the audit entry to the index, other log entries are ignored
+func (t *Target) Send(entry interface{}, _ string) error {
+	switch e := entry.(type) {
+	case audit.Entry:
+		return t.index.Add(FromConsoleEntry(e))
+	case *audit.Entry:
+		return t.index.Add(FromConsoleEntry(*e))
+	}
+	return nil
+}
+
+// Cancel - closes the segment being written
+func (t *Target) Cancel() {
+	t.index.Close()
+}
+
+// Type - returns type of the target
+func (t *Target) Type() types.TargetType {
+	return types.TargetLogSearch
+}
@@ -0,0 +1,220 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logsearch
+
+import (
+	"sync"
+	"time"
+)
+
+// maxUsageSourceIPs limits the source addresses remembered per access key
+const maxUsageSourceIPs = 10
+
+// Usage - what is known about the requests signed with an access key
+type Usage struct {
+	AccessKey  string    `json:"accessKey"`
+	ParentUser string    `json:"parentUser,omitempty"`
+	LastUsed   time.Time `json:"lastUsed"`
+	// SourceIPs are the latest addresses the access key was used from, most recent first
+	SourceIPs []string         `json:"sourceIPs"`
+	Requests  int64            `json:"requests"`
+	APICalls  map[string]int64 `json:"apiCalls"`
+}
+
+// UsageState - the persisted form of a UsageTracker
+type UsageState struct {
+	// Since is when tracking started, access keys unused since then have no usage
+	Since time.Time `json:"since"`
+	// Until is the time of the newest tracked record
+	Until time.Time         `json:"until"`
+	Keys  map[string]*Usage `json:"keys"`
+	// FirstSeen is when each access key first appeared in a listing or a record, keys seen after
+	// tracking started may have been created right before
+	FirstSeen map[string]time.Time `json:"firstSeen,omitempty"`
+}
+
+// UsageTracker keeps the usage of every access key seen in the MinIO audit records,
+// requests of console itself are left out since MinIO audits them as well
+type UsageTracker struct {
+	mu      sync.RWMutex
+	state   UsageState
+	changed bool
+}
+
+// NewUsageTracker returns a tracker resuming from state, a zero state starts tracking at now
+func NewUsageTracker(state UsageState, now time.Time) *UsageTracker {
+	if state.Since.IsZero() {
+		state.Since = now
+	}
+	if state.Keys == nil {
+		state.Keys = map[string]*Usage{}
+	}
+	if state.FirstSeen == nil {
+		state.FirstSeen = map[string]time.Time{}
+	}
+	return &UsageTracker{state: state}
+}
+
+// Add counts the requests of the records
+func (t *UsageTracker) Add(records ...Record) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, record := range records {
+		if record.Source != SourceMinIO || record.AccessKey == "" {
+			continue
+		}
+		usage, ok := t.state.Keys[record.AccessKey]
+		if !ok {
+			usage = &Usage{AccessKey: record.AccessKey, APICalls: map[string]int64{}}
+			t.state.Keys[record.AccessKey] = usage
+		}
+		if record.ParentUser != "" {
+			usage.ParentUser = record.ParentUser
+		}
+		usage.Requests++
+		if record.APIName != "" {
+			if usage.APICalls == nil {
+				usage.APICalls = map[string]int64{}
+			}
+			usage.APICalls[record.APIName]++
+		}
+		// deliveries can arrive late, only newer records move the last use
+		if record.Time.After(usage.LastUsed) {
+			usage.LastUsed = record.Time
+			if record.RemoteHost != "" {
+				usage.SourceIPs = prependSourceIP(usage.SourceIPs, record.RemoteHost)
+			}
+		}
+		t.seen(record.AccessKey, record.Time)
+		if record.Time.After(t.state.Until) {
+			t.state.Until = record.Time
+		}
+		t.changed = true
+	}
+}
+
+// Seen records the access key was listed at now and returns when it was first seen
+func (t *UsageTracker) Seen(accessKey string, now time.Time) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.seen(accessKey, now)
+}
+
+// seen moves the first time the access key was seen back to at, the lock must be held
+func (t *UsageTracker) seen(accessKey string, at time.Time) time.Time {
+	firstSeen, ok := t.state.FirstSeen[accessKey]
+	if !ok || at.Before(firstSeen) {
+		firstSeen = at
+		t.state.FirstSeen[accessKey] = at
+		t.changed = true
+	}
+	return firstSeen
+}
+
+// prependSourceIP moves ip to the front of the addresses keeping at most maxUsageSourceIPs
+func prependSourceIP(ips []string, ip string) []string {
+	updated := []string{ip}
+	for _, existing := range ips {
+		if existing != ip && len(updated) < maxUsageSourceIPs {
+			updated = append(updated, existing)
+		}
+	}
+	return updated
+}
+
+func (u *Usage) clone() *Usage {
+	c := *u
+	c.SourceIPs = append([]string{}, u.SourceIPs...)
+	c.APICalls = make(map[string]int64, len(u.APICalls))
+	for name, count := range u.APICalls {
+		c.APICalls[name] = count
+	}
+	return &c
+}
+
+// Get returns a copy of the usage of the access key
+func (t *UsageTracker) Get(accessKey string) (Usage, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	usage, ok := t.state.Keys[accessKey]
+	if !ok {
+		return Usage{}, false
+	}
+	return *usage.clone(), true
+}
+
+// LastUsedBy returns the latest use of the access key or of any credential derived from it,
+// such as its service accounts and temporary credentials
+func (t *UsageTracker) LastUsedBy(accessKey string) time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var lastUsed time.Time
+	for _, usage := range t.state.Keys {
+		if (usage.AccessKey == accessKey || usage.ParentUser == accessKey) && usage.LastUsed.After(lastUsed) {
+			lastUsed = usage.LastUsed
+		}
+	}
+	return lastUsed
+}
+
+// Forget drops the usage of an access key that no longer exists
+func (t *UsageTracker) Forget(accessKey string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, used := t.state.Keys[accessKey]
+	_, seen := t.state.FirstSeen[accessKey]
+	if used || seen {
+		delete(t.state.Keys, accessKey)
+		delete(t.state.FirstSeen, accessKey)
+		t.changed = true
+	}
+}
+
+// Since returns when tracking started
+func (t *UsageTracker) Since() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.state.Since
+}
+
+// Until returns the time of the newest tracked record
+func (t *UsageTracker) Until() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.state.Until
+}
+
+// Snapshot returns a copy of the state to persist and whether it changed since the previous snapshot
+func (t *UsageTracker) Snapshot() (UsageState, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	changed := t.changed
+	t.changed = false
+	state := UsageState{
+		Since:     t.state.Since,
+		Until:     t.state.Until,
+		Keys:      make(map[string]*Usage, len(t.state.Keys)),
+		FirstSeen: make(map[string]time.Time, len(t.state.FirstSeen)),
+	}
+	for accessKey, usage := range t.state.Keys {
+		state.Keys[accessKey] = usage.clone()
+	}
+	for accessKey, firstSeen := range t.state.FirstSeen {
+		state.FirstSeen[accessKey] = firstSeen
+	}
+	return state, changed
+}
@@ -0,0 +1,119 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logsearch
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageTracker(t *testing.T) {
+	start := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tracker := NewUsageTracker(UsageState{}, start)
+	assert.Equal(t, start, tracker.Since())
+
+	tracker.Add(
+		Record{Time: start.Add(time.Hour), Source: SourceMinIO, APIName: "PutObject", AccessKey: "sa-1", ParentUser: "alice", RemoteHost: "10.0.0.1"},
+		Record{Time: start.Add(2 * time.Hour), Source: SourceMinIO, APIName: "GetObject", AccessKey: "sa-1", RemoteHost: "10.0.0.2"},
+		// delivered late, counted without moving the last use
+		Record{Time: start.Add(30 * time.Minute), Source: SourceMinIO, APIName: "PutObject", AccessKey: "sa-1", RemoteHost: "10.0.0.3"},
+		// console requests and anonymous requests aren't tracked
+		Record{Time: start.Add(time.Hour), Source: SourceConsole, APIName: "ListUsers", AccessKey: "sa-1"},
+		Record{Time: start.Add(time.Hour), Source: SourceMinIO, APIName: "GetObject"},
+		// records older than the start don't extend the tracked period
+		Record{Time: start.Add(-time.Hour), Source: SourceMinIO, APIName: "ListBuckets", AccessKey: "bob", RemoteHost: "10.0.0.4"},
+	)
+	usage, ok := tracker.Get("sa-1")
+	assert.True(t, ok)
+	assert.Equal(t, Usage{
+		AccessKey:  "sa-1",
+		ParentUser: "alice",
+		LastUsed:   start.Add(2 * time.Hour),
+		SourceIPs:  []string{"10.0.0.2", "10.0.0.1"},
+		Requests:   3,
+		APICalls:   map[string]int64{"PutObject": 2, "GetObject": 1},
+	}, usage)
+	assert.Equal(t, start, tracker.Since())
+	assert.Equal(t, start.Add(2*time.Hour), tracker.Until())
+
+	// keys are first seen in the oldest record or listing
+	assert.Equal(t, start.Add(30*time.Minute), tracker.Seen("sa-1", start.AddDate(0, 0, 1)))
+	assert.Equal(t, start.AddDate(0, 0, 1), tracker.Seen("carol", start.AddDate(0, 0, 1)))
+	assert.Equal(t, start.AddDate(0, 0, 1), tracker.Seen("carol", start.AddDate(0, 0, 2)))
+
+	assert.Equal(t, start.Add(2*time.Hour), tracker.LastUsedBy("alice"))
+	assert.Equal(t, start.Add(-time.Hour), tracker.LastUsedBy("bob"))
+	assert.True(t, tracker.LastUsedBy("carol").IsZero())
+
+	// copies are returned
+	usage.APICalls["PutObject"] = 10
+	usage, _ = tracker.Get("sa-1")
+	assert.Equal(t, int64(2), usage.APICalls["PutObject"])
+
+	state, changed := tracker.Snapshot()
+	assert.True(t, changed)
+	assert.Len(t, state.Keys, 2)
+	assert.Len(t, state.FirstSeen, 3)
+	_, changed = tracker.Snapshot()
+	assert.False(t, changed)
+	tracker.Seen("carol", start.AddDate(0, 0, 3))
+	_, changed = tracker.Snapshot()
+	assert.False(t, changed)
+
+	// resumed trackers keep the period, the usage and when keys were first seen
+	resumed := NewUsageTracker(state, start.AddDate(0, 0, 1))
+	assert.Equal(t, start, resumed.Since())
+	assert.Equal(t, start.AddDate(0, 0, 1), resumed.Seen("carol", start.AddDate(0, 0, 3)))
+	resumed.Forget("bob")
+	_, ok = resumed.Get("bob")
+	assert.False(t, ok)
+	assert.Equal(t, start.AddDate(0, 0, 3), resumed.Seen("bob", start.AddDate(0, 0, 3)))
+	_, changed = resumed.Snapshot()
+	assert.True(t, changed)
+}
+
+func TestUsageTrackerSourceIPs(t *testing.T) {
+	start := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tracker := NewUsageTracker(UsageState{}, start)
+	for i := 0; i < maxUsageSourceIPs+5; i++ {
+		tracker.Add(Record{Time: start.Add(time.Duration(i) * time.Second), Source: SourceMinIO, AccessKey: "alice", RemoteHost: fmt.Sprintf("10.0.0.%d", i)})
+	}
+	tracker.Add(Record{Time: start.Add(time.Hour), Source: SourceMinIO, AccessKey: "alice", RemoteHost: "10.0.0.10"})
+	usage, _ := tracker.Get("alice")
+	assert.Len(t, usage.SourceIPs, maxUsageSourceIPs)
+	assert.Equal(t, []string{"10.0.0.10", "10.0.0.14", "10.0.0.13"}, usage.SourceIPs[:3])
+	assert.Empty(t, usage.APICalls)
+}
+
+func TestUsageTrackerOutOfOrder(t *testing.T) {
+	start := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tracker := NewUsageTracker(UsageState{}, start)
+	tracker.Add(Record{Time: start.Add(time.Hour), Source: SourceMinIO, AccessKey: "alice", RemoteHost: "10.0.0.1"})
+	// a late delivery older than the tracker
+	tracker.Add(Record{Time: start.AddDate(0, 0, -3), Source: SourceMinIO, AccessKey: "alice", RemoteHost: "10.0.0.2"})
+
+	assert.Equal(t, start, tracker.Since())
+	assert.Equal(t, start.Add(time.Hour), tracker.Until())
+	usage, _ := tracker.Get("alice")
+	assert.Equal(t, start.Add(time.Hour), usage.LastUsed)
+	assert.Equal(t, []string{"10.0.0.1"}, usage.SourceIPs)
+	assert.Equal(t, int64(2), usage.Requests)
+	assert.Equal(t, start.AddDate(0, 0, -3), tracker.Seen("alice", start.Add(2*time.Hour)))
+}
@@ -0,0 +1,143 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNotFound is returned when the requested document doesn't exist
+var ErrNotFound = errors.New("document not found")
+
+const documentExt = ".json"
+
+// Store keeps JSON documents on the local disk grouped by collection,
+// every document lives in `<path>/<collection>/<id>.json`
+type Store struct {
+	path string
+	mu   sync.RWMutex
+}
+
+// New returns a Store rooted at path, the directory is created on first write
+func New(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the root directory of the store
+func (s *Store) Path() string {
+	return s.path
+}
+
+func (s *Store) documentPath(collection, id string) (string, error) {
+	if collection == "" || id == "" {
+		return "", errors.New("collection and id cannot be empty")
+	}
+	if strings.ContainsAny(collection, `/\`) || strings.ContainsAny(id, `/\`) || strings.HasPrefix(id, ".") {
+		return "", fmt.Errorf("invalid document id: %s", id)
+	}
+	return filepath.Join(s.path, collection, id+documentExt), nil
+}
+
+// Put stores v as JSON under collection/id, replacing any previous document
+func (s *Store) Put(collection, id string, v interface{}) error {
+	docPath, err := s.documentPath(collection, id)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err = os.MkdirAll(filepath.Dir(docPath), 0o700); err != nil {
+		return err
+	}
+	// write to a temporary file first so readers never see a partial document
+	tmpPath := docPath + ".tmp"
+	if err = os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, docPath)
+}
+
+// Get decodes the document stored under collection/id into v
+func (s *Store) Get(collection, id string, v interface{}) error {
+	docPath, err := s.documentPath(collection, id)
+	if err != nil {
+		return err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, err := os.ReadFile(docPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Delete removes the document stored under collection/id
+func (s *Store) Delete(collection, id string) error {
+	docPath, err := s.documentPath(collection, id)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err = os.Remove(docPath); err != nil {
+		if os.IsNotExist(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// List returns the sorted ids of all the documents in a collection
+func (s *Store) List(collection string) ([]string, error) {
+	if collection == "" || strings.ContainsAny(collection, `/\`) {
+		return nil, fmt.Errorf("invalid collection: %s", collection)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries, err := os.ReadDir(filepath.Join(s.path, collection))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	ids := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), documentExt) {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(entry.Name(), documentExt))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
@@ -0,0 +1,64 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testDocument struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestStore(t *testing.T) {
+	assert := assert.New(t)
+	s := New(t.TempDir())
+
+	ids, err := s.List("docs")
+	assert.Nil(err)
+	assert.Empty(ids)
+
+	assert.Nil(s.Put("docs", "b", testDocument{Name: "b", Count: 2}))
+	assert.Nil(s.Put("docs", "a", testDocument{Name: "a", Count: 1}))
+
+	var doc testDocument
+	assert.Nil(s.Get("docs", "a", &doc))
+	assert.Equal(testDocument{Name: "a", Count: 1}, doc)
+
+	ids, err = s.List("docs")
+	assert.Nil(err)
+	assert.Equal([]string{"a", "b"}, ids)
+
+	assert.Nil(s.Delete("docs", "a"))
+	assert.True(errors.Is(s.Get("docs", "a", &doc), ErrNotFound))
+	assert.True(errors.Is(s.Delete("docs", "a"), ErrNotFound))
+}
+
+func TestStoreInvalidIDs(t *testing.T) {
+	assert := assert.New(t)
+	s := New(t.TempDir())
+
+	assert.NotNil(s.Put("docs", "../escape", testDocument{}))
+	assert.NotNil(s.Put("docs", "", testDocument{}))
+	assert.NotNil(s.Put("../docs", "a", testDocument{}))
+	_, err := s.List("a/b")
+	assert.NotNil(err)
+}
@@ -142,11 +142,14 @@ export interface User {
   memberOf?: string[];
   status?: string;
   hasPolicy?: boolean;
+  usage?: AccessKeyUsage;
 }
 
 export interface ListUsersResponse {
   /** list of resulting users */
   users?: User[];
+  /** start of the period covered by the usage of the users, empty when usage isn't tracked */
+  usageTrackedSince?: string;
 }
 
 export type SelectedUsers = string[];
@@ -183,6 +186,8 @@ export interface ListGroupsResponse {
 export interface Policy {
   name?: string;
   policy?: string;
+  /** lint findings, only set when the policy is saved */
+  findings?: PolicyLintFinding[];
 }
 
 /** @default "user" */
@@ -214,6 +219,8 @@ export type IamEntity = string;
 export interface AddPolicyRequest {
   name: string;
   policy: string;
+  /** kept with the policy revision when policy history is enabled */
+  comment?: string;
 }
 
 export interface UpdateServiceAccountRequest {
@@ -356,6 +363,103 @@ export interface BucketReplicationRuleList {
   rules?: string[];
 }
 
+export interface ReplicationMetricsPoint {
+  timestamp?: string;
+  /** @format int64 */
+  queuedCount?: number;
+  /** @format int64 */
+  queuedSize?: number;
+  /** @format int64 */
+  replicatedSize?: number;
+  /** @format int64 */
+  failedCount?: number;
+  /** @format double */
+  currentBandwidth?: number;
+}
+
+export interface ReplicationTargetMetrics {
+  type?: "bucket" | "site";
+  bucket?: string;
+  arn?: string;
+  targetBucket?: string;
+  deploymentId?: string;
+  endpoint?: string;
+  online?: boolean;
+  /** @format int64 */
+  replicatedSize?: number;
+  /** @format int64 */
+  replicatedCount?: number;
+  /** @format int64 */
+  failedSize?: number;
+  /** @format int64 */
+  failedCount?: number;
+  /** @format int64 */
+  failedLastHourCount?: number;
+  /**
+   * objects waiting in the replication queue of the source bucket, or of the site for peers
+   * @format int64
+   */
+  queuedCount?: number;
+  /** @format int64 */
+  queuedSize?: number;
+  /** @format double */
+  latencyCurrentMs?: number;
+  /** @format double */
+  latencyAverageMs?: number;
+  /** @format double */
+  latencyMaxMs?: number;
+  /**
+   * bytes per second, 0 when unlimited
+   * @format int64
+   */
+  bandwidthLimit?: number;
+  /**
+   * bytes per second
+   * @format double
+   */
+  currentBandwidth?: number;
+  backlogTrend?: "unknown" | "draining" | "growing" | "steady";
+  history?: ReplicationMetricsPoint[];
+}
+
+export interface ReplicationMetricsResponse {
+  /**
+   * seconds between history points
+   * @format int64
+   */
+  sampleInterval?: number;
+  targets?: ReplicationTargetMetrics[];
+  siteReplicationError?: string;
+}
+
+export interface ReplicationResyncRequest {
+  arn: string;
+  /** only resync objects older than this duration, e.g. 72h */
+  olderThan?: string;
+}
+
+export interface ReplicationResyncTarget {
+  arn?: string;
+  resetId?: string;
+  status?: string;
+  startTime?: string;
+  endTime?: string;
+  /** @format int64 */
+  replicatedSize?: number;
+  /** @format int64 */
+  replicatedCount?: number;
+  /** @format int64 */
+  failedSize?: number;
+  /** @format int64 */
+  failedCount?: number;
+  /** last object replicated by the resync */
+  lastObject?: string;
+}
+
+export interface ReplicationResyncResponse {
+  targets?: ReplicationResyncTarget[];
+}
+
 export interface BucketReplicationResponse {
   rules?: BucketReplicationRule[];
 }
@@ -583,12 +687,185 @@ export interface AdminInfoResponse {
   buckets?: number;
   objects?: number;
   usage?: number;
-  advancedMetricsStatus?: "not configured" | "available" | "unavailable";
+  advancedMetricsStatus?:
+    | "not configured"
+    | "available"
+    | "unavailable"
+    | "realtime";
   widgets?: Widget[];
   servers?: ServerProperties[];
   backend?: BackendProperties;
 }
 
+export interface DashboardWidgetTarget {
+  expr: string;
+  legendFormat?: string;
+  /** @format int32 */
+  step?: number;
+  /** @format int64 */
+  initialTime?: number;
+}
+
+export interface DashboardWidgetGridPos {
+  /** @format int32 */
+  h?: number;
+  /** @format int32 */
+  w?: number;
+  /** @format int32 */
+  x?: number;
+  /** @format int32 */
+  y?: number;
+}
+
+export interface DashboardWidget {
+  /** @format int32 */
+  id?: number;
+  title: string;
+  type: string;
+  /** @format int32 */
+  maxDataPoints?: number;
+  options?: {
+    reduceOptions?: {
+      calcs?: string[];
+    };
+  };
+  gridPos?: DashboardWidgetGridPos;
+  targets: DashboardWidgetTarget[];
+}
+
+export interface DashboardRequest {
+  name: string;
+  description?: string;
+  shared?: boolean;
+  widgets?: DashboardWidget[];
+}
+
+export interface Dashboard {
+  id?: string;
+  name?: string;
+  description?: string;
+  owner?: string;
+  shared?: boolean;
+  createdAt?: string;
+  updatedAt?: string;
+  widgets?: DashboardWidget[];
+}
+
+export interface DashboardListResponse {
+  dashboards?: Dashboard[];
+}
+
+export interface AlertRule {
+  id?: string;
+  name: string;
+  type:
+    | "drives_offline"
+    | "bucket_usage"
+    | "replication_backlog"
+    | "kms_status"
+    | "tier_verification"
+    | "certificate_expiry";
+  /** @default "warning" */
+  severity?: "info" | "warning" | "critical";
+  enabled?: boolean;
+  /** @format double */
+  threshold?: number;
+  bucket?: string;
+  channels?: string[];
+  /**
+   * seconds to wait before notifying again about an alert that is still firing
+   * @format int64
+   */
+  repeatInterval?: number;
+}
+
+export interface AlertRuleListResponse {
+  rules?: AlertRule[];
+}
+
+export interface AlertChannel {
+  id?: string;
+  name: string;
+  type: "webhook" | "smtp" | "slack";
+  url?: string;
+  authToken?: string;
+  smtpServer?: string;
+  smtpUsername?: string;
+  smtpPassword?: string;
+  smtpFrom?: string;
+  smtpTo?: string[];
+}
+
+export interface AlertChannelListResponse {
+  channels?: AlertChannel[];
+}
+
+export interface AlertSilenceRequest {
+  ruleId?: string;
+  subject?: string;
+  comment?: string;
+  /**
+   * seconds the silence stays active
+   * @format int64
+   */
+  duration: number;
+}
+
+export interface AlertSilence {
+  id?: string;
+  ruleId?: string;
+  subject?: string;
+  comment?: string;
+  createdBy?: string;
+  createdAt?: string;
+  expiresAt?: string;
+}
+
+export interface AlertSilenceListResponse {
+  silences?: AlertSilence[];
+}
+
+export interface AlertEvent {
+  id?: string;
+  ruleId?: string;
+  ruleName?: string;
+  type?: string;
+  severity?: string;
+  subject?: string;
+  message?: string;
+  status?: "firing" | "resolved";
+  silenced?: boolean;
+  notified?: string[];
+  notificationErrors?: string[];
+  timestamp?: string;
+}
+
+export interface AlertHistoryResponse {
+  events?: AlertEvent[];
+}
+
+export interface CertificateInfo {
+  source?: "console" | "ca" | "minio";
+  path?: string;
+  subject?: string;
+  issuer?: string;
+  serialNumber?: string;
+  sans?: string[];
+  isCA?: boolean;
+  notBefore?: string;
+  notAfter?: string;
+  /** @format int64 */
+  daysRemaining?: number;
+  status?: "valid" | "expiring" | "expired";
+}
+
+export interface CertificatesResponse {
+  /** @format int64 */
+  warningDays?: number;
+  certificates?: CertificateInfo[];
+  minioEndpointError?: string;
+}
+
 export interface ServerProperties {
   state?: string;
   endpoint?: string;
@@ -724,130 +1001,544 @@ export interface SiteReplicationStatusResponse {
   groupStats?: object;
 }
 
-export interface UpdateUser {
-  status: string;
-  groups: string[];
+export interface SiteReplicationResyncRequest {
+  deploymentId: string;
+  op: "start" | "cancel";
 }
 
-export interface BulkUserGroups {
-  users: string[];
-  groups: string[];
+export interface SiteReplicationResyncBucketStatus {
+  bucket?: string;
+  status?: string;
+  errorDetail?: string;
 }
 
-export interface ServiceAccount {
-  parentUser?: string;
-  accountStatus?: string;
-  impliedPolicy?: boolean;
-  policy?: string;
-  name?: string;
-  description?: string;
-  expiration?: string;
+export interface SiteReplicationResyncResponse {
+  op?: string;
+  resyncId?: string;
+  status?: string;
+  errorDetail?: string;
+  buckets?: SiteReplicationResyncBucketStatus[];
 }
 
-export type ServiceAccounts = {
-  accountStatus?: string;
+export interface SiteReplicationPeerHealth {
+  deploymentId?: string;
   name?: string;
-  description?: string;
-  expiration?: string;
-  accessKey?: string;
-}[];
+  endpoint?: string;
+  /** peer state as seen by MinIO */
+  online?: boolean;
+  lastOnline?: string;
+  /**
+   * seconds
+   * @format int64
+   */
+  totalDowntime?: number;
+  /** @format double */
+  latencyCurrentMs?: number;
+  /** @format double */
+  latencyAverageMs?: number;
+  /** @format double */
+  latencyMaxMs?: number;
+  /** whether Console could reach the peer liveness endpoint */
+  reachable?: boolean;
+  /** @format double */
+  checkLatencyMs?: number;
+  error?: string;
+}
 
-export interface ServiceAccountRequest {
-  /** policy to be applied to the Service Account if any */
-  policy?: string;
+export interface SiteReplicationPeerHealthResponse {
+  peers?: SiteReplicationPeerHealth[];
+}
+
+export interface SiteReplicationDiffSite {
+  deploymentId?: string;
   name?: string;
-  description?: string;
-  expiry?: string;
-  comment?: string;
+  missing?: boolean;
+  /** settings that differ from the other sites */
+  fields?: string[];
 }
 
-export interface ServiceAccountRequestCreds {
-  /** policy to be applied to the Service Account if any */
-  policy?: string;
-  accessKey?: string;
-  secretKey?: string;
+export interface SiteReplicationDiffEntry {
+  entityType?: "bucket" | "policy" | "user" | "group";
   name?: string;
-  description?: string;
-  expiry?: string;
-  comment?: string;
+  sites?: SiteReplicationDiffSite[];
 }
 
-export interface ServiceAccountCreds {
-  accessKey?: string;
-  secretKey?: string;
-  url?: string;
+export interface SiteReplicationDiffResponse {
+  entries?: SiteReplicationDiffEntry[];
 }
 
-export interface RemoteBucket {
-  /** @minLength 3 */
-  accessKey: string;
-  /** @minLength 8 */
-  secretKey?: string;
-  sourceBucket: string;
-  targetURL?: string;
-  targetBucket?: string;
-  remoteARN: string;
-  status?: string;
-  service?: "replication";
-  syncMode?: string;
-  /** @format int64 */
-  bandwidth?: number;
-  healthCheckPeriod?: number;
+export interface SpeedtestMetric {
+  node?: string;
+  drive?: string;
+  name?: string;
+  /** @format double */
+  value?: number;
 }
 
-export interface CreateRemoteBucket {
-  /** @minLength 3 */
-  accessKey: string;
-  /** @minLength 8 */
-  secretKey: string;
-  targetURL: string;
-  sourceBucket: string;
-  targetBucket: string;
-  region?: string;
-  /** @default "async" */
-  syncMode?: "async" | "sync";
-  /** @format int64 */
-  bandwidth?: number;
-  /** @format int32 */
-  healthCheckPeriod?: number;
+export interface SpeedtestResult {
+  id?: string;
+  type?: "object" | "drive" | "net" | "site";
+  startTime?: string;
+  endTime?: string;
+  parameters?: Record<string, string>;
+  error?: string;
+  /** errors reported by individual nodes or drives */
+  errors?: string[];
+  metrics?: SpeedtestMetric[];
+  /** final result as returned by MinIO, only included when requesting a single run */
+  result?: object;
 }
 
-export interface ListRemoteBucketsResponse {
-  /** list of remote buckets */
-  buckets?: RemoteBucket[];
+export interface SpeedtestResultsResponse {
+  results?: SpeedtestResult[];
+}
+
+export interface ObjectVersionHistoryEntry {
+  version_id?: string;
+  last_modified?: string;
+  /** @format int64 */
+  size?: number;
   /**
-   * number of remote buckets accessible to user
+   * size difference with the previous version
    * @format int64
    */
-  total?: number;
+  size_delta?: number;
+  etag?: string;
+  is_latest?: boolean;
+  is_delete_marker?: boolean;
 }
 
-export interface BucketVersioningResponse {
-  status?: string;
-  MFADelete?: string;
-  excludedPrefixes?: {
-    prefix?: string;
-  }[];
-  excludeFolders?: boolean;
+export interface ObjectVersionHistory {
+  name?: string;
+  versions?: ObjectVersionHistoryEntry[];
 }
 
-export interface SetBucketVersioning {
-  enabled?: boolean;
-  /** @maxLength 10 */
-  excludePrefixes?: string[];
-  excludeFolders?: boolean;
+export interface ObjectVersionDiff {
+  name?: string;
+  from_version?: string;
+  to_version?: string;
+  identical?: boolean;
+  diff?: string;
 }
 
-export interface BucketObLockingResponse {
-  object_locking_enabled?: boolean;
+export interface ObjectVersionPurgeRequest {
+  prefix?: string;
+  /**
+   * number of newest versions kept for each object
+   * @format int64
+   */
+  keep_latest?: number;
+  /** versions modified after this date (RFC3339) are kept */
+  newer_than?: string;
+  dry_run?: boolean;
 }
 
-export interface LogSearchResponse {
-  /** list of log search responses */
-  results?: object;
+export interface ObjectVersionPurgeEntry {
+  name?: string;
+  version_id?: string;
+  last_modified?: string;
+  /** @format int64 */
+  size?: number;
+  is_delete_marker?: boolean;
+  error?: string;
 }
 
-export enum ObjectLegalHoldStatus {
+export interface ObjectVersionPurgeResponse {
+  dry_run?: boolean;
+  /** @format int64 */
+  purged?: number;
+  /** @format int64 */
+  failed?: number;
+  /** @format int64 */
+  bytes_freed?: number;
+  /** only the first versions are listed, totals include all of them */
+  truncated?: boolean;
+  versions?: ObjectVersionPurgeEntry[];
+}
+
+export interface DownloadArchiveObject {
+  /** object name, names ending with `/` include every object under the prefix */
+  name: string;
+  version_id?: string;
+}
+
+export interface DownloadArchiveRequest {
+  /** @default "zip-deflate" */
+  format?: "zip-deflate" | "zip-store" | "tar" | "tar.gz" | "tar.zst";
+  objects: DownloadArchiveObject[];
+}
+
+export interface IamChangeSet {
+  operations: IamChangeSetOperation[];
+}
+
+export interface IamChangeSetOperation {
+  type:
+    | "createUser"
+    | "setUserGroups"
+    | "setGroupMembers"
+    | "attachPolicies"
+    | "createServiceAccount";
+  user?: string;
+  secretKey?: string;
+  group?: string;
+  groups?: string[];
+  members?: string[];
+  policies?: string[];
+  serviceAccount?: ServiceAccountRequestCreds;
+}
+
+export interface IamChangeSetStep {
+  index?: number;
+  type?: string;
+  target?: string;
+  status?: "applied" | "failed" | "skipped" | "rolledBack" | "rollbackFailed";
+  error?: string;
+  serviceAccount?: ServiceAccountCreds;
+}
+
+export interface IamChangeSetResponse {
+  applied?: boolean;
+  rolledBack?: boolean;
+  steps?: IamChangeSetStep[];
+}
+
+export interface ImportUsersRow {
+  row?: number;
+  accessKey?: string;
+  status?: "created" | "invalid" | "failed";
+  errors?: string[];
+  secretKey?: string;
+}
+
+export interface ImportUsersResponse {
+  created?: number;
+  invalid?: number;
+  failed?: number;
+  rows?: ImportUsersRow[];
+}
+
+export interface ServiceAccountHygieneItem {
+  accessKey?: string;
+  parentUser?: string;
+  name?: string;
+  accountStatus?: string;
+  expiration?: string;
+  findings?: ("expired" | "expiringSoon" | "noExpiry" | "broaderThanParent")[];
+  details?: string[];
+}
+
+export interface ServiceAccountHygieneReport {
+  /** @format int32 */
+  expiringWithinDays?: number;
+  /** @format int64 */
+  total?: number;
+  /** @format int64 */
+  flagged?: number;
+  accounts?: ServiceAccountHygieneItem[];
+}
+
+export interface RotateServiceAccountRequest {
+  /** how long the old credential keeps working, as a duration like 24h, defaults to 24h */
+  gracePeriod?: string;
+  /** expiration of the new credential, RFC3339 */
+  expiry?: string;
+}
+
+export interface RotateServiceAccountResponse {
+  credentials?: ServiceAccountCreds;
+  previousAccessKey?: string;
+  previousExpiration?: string;
+}
+
+export interface PolicyRevision {
+  revision?: string;
+  name?: string;
+  author?: string;
+  time?: string;
+  comment?: string;
+  policy?: string;
+}
+
+export interface ListPolicyRevisionsResponse {
+  revisions?: PolicyRevision[];
+}
+
+export interface PolicyStatementChange {
+  change?: "added" | "removed" | "modified" | "unchanged";
+  sid?: string;
+  before?: string;
+  after?: string;
+}
+
+export interface PolicyRevisionDiff {
+  name?: string;
+  from?: PolicyRevision;
+  to?: PolicyRevision;
+  statements?: PolicyStatementChange[];
+}
+
+export interface RollbackPolicyRequest {
+  comment?: string;
+}
+
+export interface PolicyLintFinding {
+  policy?: string;
+  check?:
+    | "invalidPolicy"
+    | "wildcardAccess"
+    | "adminActionsBroadGroup"
+    | "shadowedDeny"
+    | "nonExistentBucket"
+    | "unusedPolicy"
+    | "unsupportedConditionKey";
+  severity?: "high" | "medium" | "low";
+  /**
+   * 1-based position of the statement, 0 when the finding is about the whole policy
+   * @format int32
+   */
+  statement?: number;
+  message?: string;
+  remediation?: string;
+}
+
+export interface PolicyLintReport {
+  /** @format int64 */
+  policies?: number;
+  findings?: PolicyLintFinding[];
+}
+
+export interface BucketAccessMatrixEntry {
+  principal?: string;
+  type?:
+    | "user"
+    | "group"
+    | "serviceAccount"
+    | "ldapUser"
+    | "ldapGroup"
+    | "anonymous";
+  /** prefix of the bucket the capabilities apply to, empty for the whole bucket */
+  prefix?: string;
+  read?: BucketAccessCapability;
+  write?: BucketAccessCapability;
+  delete?: BucketAccessCapability;
+  list?: BucketAccessCapability;
+  admin?: BucketAccessCapability;
+  /** policies, groups, parents and rules the capabilities come from */
+  via?: string[];
+}
+
+export enum BucketAccessCapability {
+  None = "none",
+  Conditional = "conditional",
+  Granted = "granted",
+}
+
+export interface BucketAccessMatrix {
+  bucket?: string;
+  prefixes?: string[];
+  entries?: BucketAccessMatrixEntry[];
+}
+
+export interface LdapDirectoryEntry {
+  dn?: string;
+  name?: string;
+  /** name the user logs in with, only set for users */
+  username?: string;
+  email?: string;
+}
+
+export interface LdapDirectorySearchResponse {
+  entries?: LdapDirectoryEntry[];
+  /** more entries match than the limit */
+  truncated?: boolean;
+}
+
+export interface LdapUserGroupsResponse {
+  dn?: string;
+  username?: string;
+  /** policies mapped to the user itself */
+  policies?: string[];
+  groups?: LdapGroupPolicyEntity[];
+}
+
+export interface LdapPolicyMappingRequest {
+  type: "user" | "group";
+  dn: string;
+  policies: string[];
+}
+
+export interface LdapPolicyMappingResponse {
+  policiesAttached?: string[];
+  updatedAt?: string;
+}
+
+export interface AccessKeyUsage {
+  lastUsed?: string;
+  /** latest addresses the access key was used from, most recent first */
+  sourceIPs?: string[];
+  /** @format int64 */
+  requests?: number;
+  /** number of requests per API */
+  apiCalls?: Record<string, number>;
+}
+
+export interface InactiveAccessKeysCleanupRequest {
+  /** @format int32 */
+  inactiveDays: number;
+  action: "disable" | "delete";
+  /** @default "all" */
+  credentialType?: "all" | "user" | "serviceAccount";
+  dryRun?: boolean;
+}
+
+export interface InactiveAccessKey {
+  accessKey?: string;
+  type?: "user" | "serviceAccount";
+  parentUser?: string;
+  status?: string;
+  /** empty when the access key wasn't used since usage is tracked */
+  lastUsed?: string;
+  error?: string;
+}
+
+export interface InactiveAccessKeysCleanupResponse {
+  action?: string;
+  dryRun?: boolean;
+  cutoff?: string;
+  usageTrackedSince?: string;
+  accessKeys?: InactiveAccessKey[];
+  /** @format int64 */
+  failed?: number;
+}
+
+export interface UpdateUser {
+  status: string;
+  groups: string[];
+}
+
+export interface BulkUserGroups {
+  users: string[];
+  groups: string[];
+}
+
+export interface ServiceAccount {
+  parentUser?: string;
+  accountStatus?: string;
+  impliedPolicy?: boolean;
+  policy?: string;
+  name?: string;
+  description?: string;
+  expiration?: string;
+}
+
+export type ServiceAccounts = {
+  accountStatus?: string;
+  name?: string;
+  description?: string;
+  expiration?: string;
+  accessKey?: string;
+  usage?: AccessKeyUsage;
+}[];
+
+export interface ServiceAccountRequest {
+  /** policy to be applied to the Service Account if any */
+  policy?: string;
+  name?: string;
+  description?: string;
+  expiry?: string;
+  comment?: string;
+}
+
+export interface ServiceAccountRequestCreds {
+  /** policy to be applied to the Service Account if any */
+  policy?: string;
+  accessKey?: string;
+  secretKey?: string;
+  name?: string;
+  description?: string;
+  expiry?: string;
+  comment?: string;
+}
+
+export interface ServiceAccountCreds {
+  accessKey?: string;
+  secretKey?: string;
+  url?: string;
+}
+
+export interface RemoteBucket {
+  /** @minLength 3 */
+  accessKey: string;
+  /** @minLength 8 */
+  secretKey?: string;
+  sourceBucket: string;
+  targetURL?: string;
+  targetBucket?: string;
+  remoteARN: string;
+  status?: string;
+  service?: "replication";
+  syncMode?: string;
+  /** @format int64 */
+  bandwidth?: number;
+  healthCheckPeriod?: number;
+}
+
+export interface CreateRemoteBucket {
+  /** @minLength 3 */
+  accessKey: string;
+  /** @minLength 8 */
+  secretKey: string;
+  targetURL: string;
+  sourceBucket: string;
+  targetBucket: string;
+  region?: string;
+  /** @default "async" */
+  syncMode?: "async" | "sync";
+  /** @format int64 */
+  bandwidth?: number;
+  /** @format int32 */
+  healthCheckPeriod?: number;
+}
+
+export interface ListRemoteBucketsResponse {
+  /** list of remote buckets */
+  buckets?: RemoteBucket[];
+  /**
+   * number of remote buckets accessible to user
+   * @format int64
+   */
+  total?: number;
+}
+
+export interface BucketVersioningResponse {
+  status?: string;
+  MFADelete?: string;
+  excludedPrefixes?: {
+    prefix?: string;
+  }[];
+  excludeFolders?: boolean;
+}
+
+export interface SetBucketVersioning {
+  enabled?: boolean;
+  /** @maxLength 10 */
+  excludePrefixes?: string[];
+  excludeFolders?: boolean;
+}
+
+export interface BucketObLockingResponse {
+  object_locking_enabled?: boolean;
+}
+
+export interface LogSearchResponse {
+  /** list of log search responses */
+  results?: object;
+  /** the page is past the records a search of the local index reads */
+  truncated?: boolean;
+}
+
+export enum ObjectLegalHoldStatus {
   Enabled = "enabled",
   Disabled = "disabled",
 }
@@ -1194,15 +1885,68 @@ export interface TierListResponse {
   items?: Tier[];
 }
 
-export interface TierCredentialsRequest {
-  access_key?: string;
-  secret_key?: string;
-  /** a base64 encoded value */
-  creds?: string;
+export interface TierVerifyResponse {
+  name?: string;
+  status?: boolean;
+  error?: string;
 }
 
-export interface RewindItem {
-  last_modified?: string;
+export interface TierLifecycleRule {
+  id?: string;
+  prefix?: string;
+  status?: string;
+  /** the rule transitions noncurrent versions */
+  noncurrent?: boolean;
+}
+
+export interface TierBucketUsage {
+  bucket?: string;
+  rules?: TierLifecycleRule[];
+  /** the tier usage is attributed to the bucket, only possible when it's the only bucket transitioning to the tier */
+  attributed?: boolean;
+  /** @format int64 */
+  size?: number;
+  /** @format int64 */
+  objects?: number;
+  /** @format int64 */
+  versions?: number;
+}
+
+export interface TierUsage {
+  name?: string;
+  type?: string;
+  /** @format int64 */
+  size?: number;
+  /** @format int64 */
+  objects?: number;
+  /** @format int64 */
+  versions?: number;
+  /**
+   * bytes transitioned during the last 24 hours
+   * @format int64
+   */
+  dailySize?: number;
+  /**
+   * objects transitioned during the last 24 hours
+   * @format int64
+   */
+  dailyObjects?: number;
+  buckets?: TierBucketUsage[];
+}
+
+export interface TierUsageResponse {
+  tiers?: TierUsage[];
+}
+
+export interface TierCredentialsRequest {
+  access_key?: string;
+  secret_key?: string;
+  /** a base64 encoded value */
+  creds?: string;
+}
+
+export interface RewindItem {
+  last_modified?: string;
   /** @format int64 */
   size?: number;
   version_id?: string;
@@ -2183,17 +2927,48 @@ export class Api<
     downloadMultipleObjects: (
       bucketName: string,
       objectList: SelectedUsers,
+      query?: {
+        /**
+         * archive format used when downloading multiple objects or a folder
+         * @default "zip-deflate"
+         */
+        format?: "zip-deflate" | "zip-store" | "tar" | "tar.gz" | "tar.zst";
+      },
       params: RequestParams = {},
     ) =>
       this.request<File, ApiError>({
         path: `/buckets/${bucketName}/objects/download-multiple`,
         method: "POST",
+        query: query,
         body: objectList,
         secure: true,
         type: ContentType.Json,
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name DownloadObjectsArchive
+     * @summary Download objects, optionally selecting their version, as a single archive
+     * @request POST:/buckets/{bucket_name}/objects/download-archive
+     * @secure
+     */
+    downloadObjectsArchive: (
+      bucketName: string,
+      body: DownloadArchiveRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<File, ApiError>({
+        path: `/buckets/${bucketName}/objects/download-archive`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -2212,6 +2987,11 @@ export class Api<
         preview?: boolean;
         /** @default "" */
         override_file_name?: string;
+        /**
+         * archive format used when downloading multiple objects or a folder
+         * @default "zip-deflate"
+         */
+        format?: "zip-deflate" | "zip-store" | "tar" | "tar.gz" | "tar.zst";
       },
       params: RequestParams = {},
     ) =>
@@ -2409,6 +3189,83 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name GetObjectVersionHistory
+     * @summary Lists the version history of an object
+     * @request GET:/buckets/{bucket_name}/objects/versions
+     * @secure
+     */
+    getObjectVersionHistory: (
+      bucketName: string,
+      query: {
+        prefix: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ObjectVersionHistory, ApiError>({
+        path: `/buckets/${bucketName}/objects/versions`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name GetObjectVersionDiff
+     * @summary Returns a unified diff between two versions of a text object
+     * @request GET:/buckets/{bucket_name}/objects/versions/diff
+     * @secure
+     */
+    getObjectVersionDiff: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        from_version: string;
+        /** defaults to the latest version */
+        to_version?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ObjectVersionDiff, ApiError>({
+        path: `/buckets/${bucketName}/objects/versions/diff`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name PurgeObjectVersions
+     * @summary Purges old object versions under a prefix
+     * @request POST:/buckets/{bucket_name}/objects/versions/purge
+     * @secure
+     */
+    purgeObjectVersions: (
+      bucketName: string,
+      body: ObjectVersionPurgeRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<ObjectVersionPurgeResponse, ApiError>({
+        path: `/buckets/${bucketName}/objects/versions/purge`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -2707,6 +3564,55 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketReplicationResyncStatus
+     * @summary Returns the replication resync progress to every remote target of a bucket
+     * @request GET:/buckets/{bucket_name}/replication-resync
+     * @secure
+     */
+    getBucketReplicationResyncStatus: (
+      bucketName: string,
+      query?: {
+        arn?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ReplicationResyncResponse, ApiError>({
+        path: `/buckets/${bucketName}/replication-resync`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * @description A started resync runs until it completes, canceling a bucket replication resync is not supported.
+     *
+     * @tags Bucket
+     * @name StartBucketReplicationResync
+     * @summary Starts replicating again the existing objects of a bucket to a remote target
+     * @request POST:/buckets/{bucket_name}/replication-resync
+     * @secure
+     */
+    startBucketReplicationResync: (
+      bucketName: string,
+      body: ReplicationResyncRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<ReplicationResyncResponse, ApiError>({
+        path: `/buckets/${bucketName}/replication-resync`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -3163,6 +4069,58 @@ export class Api<
         secure: true,
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name GetServiceAccountHygieneReport
+     * @summary Report service accounts that are expired, about to expire, never expire or have a policy broader than their parent
+     * @request GET:/service-accounts/hygiene
+     * @secure
+     */
+    getServiceAccountHygieneReport: (
+      query?: {
+        /**
+         * @format int32
+         * @default 7
+         */
+        expiringWithinDays?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ServiceAccountHygieneReport, ApiError>({
+        path: `/service-accounts/hygiene`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name RotateServiceAccount
+     * @summary Replace a service account with a new credential and expire the old one after a grace period
+     * @request POST:/service-accounts/{access_key}/rotate
+     * @secure
+     */
+    rotateServiceAccount: (
+      accessKey: string,
+      body: RotateServiceAccountRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<RotateServiceAccountResponse, ApiError>({
+        path: `/service-accounts/${accessKey}/rotate`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
   };
   serviceAccountCredentials = {
     /**
@@ -3187,6 +4145,30 @@ export class Api<
         ...params,
       }),
   };
+  accessKeys = {
+    /**
+     * No description
+     *
+     * @tags User
+     * @name CleanupInactiveAccessKeys
+     * @summary Disable or delete the users and service accounts unused for a number of days
+     * @request POST:/access-keys/cleanup-inactive
+     * @secure
+     */
+    cleanupInactiveAccessKeys: (
+      body: InactiveAccessKeysCleanupRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<InactiveAccessKeysCleanupResponse, ApiError>({
+        path: `/access-keys/cleanup-inactive`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+  };
   users = {
     /**
      * No description
@@ -3241,6 +4223,60 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags User
+     * @name ImportUsers
+     * @summary Create users in bulk from a CSV or JSON file
+     * @request POST:/users/import
+     * @secure
+     */
+    importUsers: (
+      data: {
+        /** @format binary */
+        file: File;
+      },
+      query?: {
+        format?: "json" | "csv";
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ImportUsersResponse, ApiError>({
+        path: `/users/import`,
+        method: "POST",
+        query: query,
+        body: data,
+        secure: true,
+        type: ContentType.FormData,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name ExportUsers
+     * @summary Export all users with their groups, policies and status as JSON or CSV
+     * @request GET:/users/export
+     * @secure
+     */
+    exportUsers: (
+      query?: {
+        /** @default "json" */
+        format?: "json" | "csv";
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<File, ApiError>({
+        path: `/users/export`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -3448,6 +4484,27 @@ export class Api<
         ...params,
       }),
   };
+  iam = {
+    /**
+     * No description
+     *
+     * @tags User
+     * @name ApplyIAMChangeSet
+     * @summary Apply a list of IAM operations as a single unit, rolling back the applied ones on failure
+     * @request POST:/iam/change-set
+     * @secure
+     */
+    applyIAMChangeSet: (body: IamChangeSet, params: RequestParams = {}) =>
+      this.request<IamChangeSetResponse, ApiError>({
+        path: `/iam/change-set`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+  };
   usersGroupsBulk = {
     /**
      * No description
@@ -3586,9 +4643,47 @@ export class Api<
      * No description
      *
      * @tags Policy
-     * @name ListPolicies
-     * @summary List Policies
-     * @request GET:/policies
+     * @name LintPolicies
+     * @summary Lint every policy for security issues
+     * @request GET:/policies/lint
+     * @secure
+     */
+    lintPolicies: (params: RequestParams = {}) =>
+      this.request<PolicyLintReport, ApiError>({
+        path: `/policies/lint`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name LintPolicy
+     * @summary Lint a policy document before saving it
+     * @request POST:/policies/lint
+     * @secure
+     */
+    lintPolicy: (body: AddPolicyRequest, params: RequestParams = {}) =>
+      this.request<PolicyLintReport, ApiError>({
+        path: `/policies/lint`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name ListPolicies
+     * @summary List Policies
+     * @request GET:/policies
      * @secure
      */
     listPolicies: (
@@ -3707,6 +4802,41 @@ export class Api<
       }),
   };
   bucket = {
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketAccessMatrix
+     * @summary Effective permissions of every user, group and service account on a bucket, per prefix
+     * @request GET:/bucket/{bucket}/access-matrix
+     * @secure
+     */
+    getBucketAccessMatrix: (bucket: string, params: RequestParams = {}) =>
+      this.request<BucketAccessMatrix, ApiError>({
+        path: `/bucket/${bucket}/access-matrix`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ExportBucketAccessMatrix
+     * @summary Export the effective permissions on a bucket as CSV
+     * @request GET:/bucket/{bucket}/access-matrix/export
+     * @secure
+     */
+    exportBucketAccessMatrix: (bucket: string, params: RequestParams = {}) =>
+      this.request<File, ApiError>({
+        path: `/bucket/${bucket}/access-matrix/export`,
+        method: "GET",
+        secure: true,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -3859,6 +4989,75 @@ export class Api<
         secure: true,
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name ListPolicyRevisions
+     * @summary List the revisions kept for a policy, newest first
+     * @request GET:/policy/{name}/revisions
+     * @secure
+     */
+    listPolicyRevisions: (name: string, params: RequestParams = {}) =>
+      this.request<ListPolicyRevisionsResponse, ApiError>({
+        path: `/policy/${name}/revisions`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name DiffPolicyRevisions
+     * @summary Compare two revisions of a policy statement by statement
+     * @request GET:/policy/{name}/revisions/diff
+     * @secure
+     */
+    diffPolicyRevisions: (
+      name: string,
+      query: {
+        from: string;
+        to: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<PolicyRevisionDiff, ApiError>({
+        path: `/policy/${name}/revisions/diff`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name RollbackPolicy
+     * @summary Restore a policy to one of its revisions
+     * @request POST:/policy/{name}/revisions/{revision}/rollback
+     * @secure
+     */
+    rollbackPolicy: (
+      name: string,
+      revision: string,
+      body: RollbackPolicyRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<Policy, ApiError>({
+        path: `/policy/${name}/revisions/${revision}/rollback`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
   };
   configs = {
     /**
@@ -4226,15 +5425,506 @@ export class Api<
      * @request GET:/admin/info
      * @secure
      */
-    adminInfo: (
+    adminInfo: (
+      query?: {
+        /** @default false */
+        defaultOnly?: boolean;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<AdminInfoResponse, ApiError>({
+        path: `/admin/info`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name DashboardWidgetDetails
+     * @summary Returns information about the deployment
+     * @request GET:/admin/info/widgets/{widgetId}
+     * @secure
+     */
+    dashboardWidgetDetails: (
+      widgetId: number,
+      query?: {
+        start?: number;
+        end?: number;
+        /** @format int32 */
+        step?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WidgetDetails, ApiError>({
+        path: `/admin/info/widgets/${widgetId}`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ListDashboards
+     * @summary Returns the dashboards owned by or shared with the current user
+     * @request GET:/admin/dashboards
+     * @secure
+     */
+    listDashboards: (params: RequestParams = {}) =>
+      this.request<DashboardListResponse, ApiError>({
+        path: `/admin/dashboards`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name CreateDashboard
+     * @summary Creates a new dashboard owned by the current user
+     * @request POST:/admin/dashboards
+     * @secure
+     */
+    createDashboard: (body: DashboardRequest, params: RequestParams = {}) =>
+      this.request<Dashboard, ApiError>({
+        path: `/admin/dashboards`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ImportDashboard
+     * @summary Imports a previously exported dashboard as a new dashboard owned by the current user
+     * @request POST:/admin/dashboards/import
+     * @secure
+     */
+    importDashboard: (body: Dashboard, params: RequestParams = {}) =>
+      this.request<Dashboard, ApiError>({
+        path: `/admin/dashboards/import`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name GetDashboard
+     * @summary Returns a dashboard
+     * @request GET:/admin/dashboards/{id}
+     * @secure
+     */
+    getDashboard: (id: string, params: RequestParams = {}) =>
+      this.request<Dashboard, ApiError>({
+        path: `/admin/dashboards/${id}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name UpdateDashboard
+     * @summary Updates a dashboard owned by the current user
+     * @request PUT:/admin/dashboards/{id}
+     * @secure
+     */
+    updateDashboard: (
+      id: string,
+      body: DashboardRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<Dashboard, ApiError>({
+        path: `/admin/dashboards/${id}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name DeleteDashboard
+     * @summary Deletes a dashboard owned by the current user
+     * @request DELETE:/admin/dashboards/{id}
+     * @secure
+     */
+    deleteDashboard: (id: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/dashboards/${id}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ExportDashboard
+     * @summary Exports a dashboard so it can be imported on another deployment
+     * @request GET:/admin/dashboards/{id}/export
+     * @secure
+     */
+    exportDashboard: (id: string, params: RequestParams = {}) =>
+      this.request<Dashboard, ApiError>({
+        path: `/admin/dashboards/${id}/export`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name DashboardCustomWidgetDetails
+     * @summary Returns the results of a dashboard widget
+     * @request GET:/admin/dashboards/{id}/widgets/{widgetId}
+     * @secure
+     */
+    dashboardCustomWidgetDetails: (
+      id: string,
+      widgetId: number,
+      query?: {
+        start?: number;
+        end?: number;
+        /** @format int32 */
+        step?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WidgetDetails, ApiError>({
+        path: `/admin/dashboards/${id}/widgets/${widgetId}`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ListCertificates
+     * @summary Returns the certificates known to Console with their expiration
+     * @request GET:/admin/certificates
+     * @secure
+     */
+    listCertificates: (params: RequestParams = {}) =>
+      this.request<CertificatesResponse, ApiError>({
+        path: `/admin/certificates`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name ListAlertRules
+     * @summary Returns the configured alert rules
+     * @request GET:/admin/alerts/rules
+     * @secure
+     */
+    listAlertRules: (params: RequestParams = {}) =>
+      this.request<AlertRuleListResponse, ApiError>({
+        path: `/admin/alerts/rules`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name CreateAlertRule
+     * @summary Creates a new alert rule
+     * @request POST:/admin/alerts/rules
+     * @secure
+     */
+    createAlertRule: (body: AlertRule, params: RequestParams = {}) =>
+      this.request<AlertRule, ApiError>({
+        path: `/admin/alerts/rules`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name GetAlertRule
+     * @summary Returns an alert rule
+     * @request GET:/admin/alerts/rules/{id}
+     * @secure
+     */
+    getAlertRule: (id: string, params: RequestParams = {}) =>
+      this.request<AlertRule, ApiError>({
+        path: `/admin/alerts/rules/${id}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name UpdateAlertRule
+     * @summary Updates an alert rule
+     * @request PUT:/admin/alerts/rules/{id}
+     * @secure
+     */
+    updateAlertRule: (
+      id: string,
+      body: AlertRule,
+      params: RequestParams = {},
+    ) =>
+      this.request<AlertRule, ApiError>({
+        path: `/admin/alerts/rules/${id}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name DeleteAlertRule
+     * @summary Deletes an alert rule
+     * @request DELETE:/admin/alerts/rules/{id}
+     * @secure
+     */
+    deleteAlertRule: (id: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/alerts/rules/${id}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name ListAlertChannels
+     * @summary Returns the configured notification channels
+     * @request GET:/admin/alerts/channels
+     * @secure
+     */
+    listAlertChannels: (params: RequestParams = {}) =>
+      this.request<AlertChannelListResponse, ApiError>({
+        path: `/admin/alerts/channels`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name CreateAlertChannel
+     * @summary Creates a new notification channel
+     * @request POST:/admin/alerts/channels
+     * @secure
+     */
+    createAlertChannel: (body: AlertChannel, params: RequestParams = {}) =>
+      this.request<AlertChannel, ApiError>({
+        path: `/admin/alerts/channels`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name UpdateAlertChannel
+     * @summary Updates a notification channel
+     * @request PUT:/admin/alerts/channels/{id}
+     * @secure
+     */
+    updateAlertChannel: (
+      id: string,
+      body: AlertChannel,
+      params: RequestParams = {},
+    ) =>
+      this.request<AlertChannel, ApiError>({
+        path: `/admin/alerts/channels/${id}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name DeleteAlertChannel
+     * @summary Deletes a notification channel
+     * @request DELETE:/admin/alerts/channels/{id}
+     * @secure
+     */
+    deleteAlertChannel: (id: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/alerts/channels/${id}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name TestAlertChannel
+     * @summary Sends a test notification through a notification channel
+     * @request POST:/admin/alerts/channels/{id}/test
+     * @secure
+     */
+    testAlertChannel: (id: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/alerts/channels/${id}/test`,
+        method: "POST",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name ListAlertSilences
+     * @summary Returns the active alert silences
+     * @request GET:/admin/alerts/silences
+     * @secure
+     */
+    listAlertSilences: (params: RequestParams = {}) =>
+      this.request<AlertSilenceListResponse, ApiError>({
+        path: `/admin/alerts/silences`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name CreateAlertSilence
+     * @summary Silences notifications for a rule or a subject for a period of time
+     * @request POST:/admin/alerts/silences
+     * @secure
+     */
+    createAlertSilence: (
+      body: AlertSilenceRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<AlertSilence, ApiError>({
+        path: `/admin/alerts/silences`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name DeleteAlertSilence
+     * @summary Removes an alert silence
+     * @request DELETE:/admin/alerts/silences/{id}
+     * @secure
+     */
+    deleteAlertSilence: (id: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/alerts/silences/${id}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Alerts
+     * @name ListAlertHistory
+     * @summary Returns the history of fired and resolved alerts, newest first
+     * @request GET:/admin/alerts/history
+     * @secure
+     */
+    listAlertHistory: (
       query?: {
-        /** @default false */
-        defaultOnly?: boolean;
+        ruleId?: string;
+        status?: "firing" | "resolved";
+        /**
+         * @format int32
+         * @default 100
+         */
+        limit?: number;
       },
       params: RequestParams = {},
     ) =>
-      this.request<AdminInfoResponse, ApiError>({
-        path: `/admin/info`,
+      this.request<AlertHistoryResponse, ApiError>({
+        path: `/admin/alerts/history`,
         method: "GET",
         query: query,
         secure: true,
@@ -4245,26 +5935,16 @@ export class Api<
     /**
      * No description
      *
-     * @tags System
-     * @name DashboardWidgetDetails
-     * @summary Returns information about the deployment
-     * @request GET:/admin/info/widgets/{widgetId}
+     * @tags Alerts
+     * @name EvaluateAlertRules
+     * @summary Evaluates all the enabled alert rules and returns the alerts that changed state
+     * @request POST:/admin/alerts/evaluate
      * @secure
      */
-    dashboardWidgetDetails: (
-      widgetId: number,
-      query?: {
-        start?: number;
-        end?: number;
-        /** @format int32 */
-        step?: number;
-      },
-      params: RequestParams = {},
-    ) =>
-      this.request<WidgetDetails, ApiError>({
-        path: `/admin/info/widgets/${widgetId}`,
-        method: "GET",
-        query: query,
+    evaluateAlertRules: (params: RequestParams = {}) =>
+      this.request<AlertHistoryResponse, ApiError>({
+        path: `/admin/alerts/evaluate`,
+        method: "POST",
         secure: true,
         format: "json",
         ...params,
@@ -4329,6 +6009,30 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Replication
+     * @name GetReplicationMetrics
+     * @summary Returns replication metrics and backlog history for every bucket remote target and site replication peer
+     * @request GET:/admin/replication/metrics
+     * @secure
+     */
+    getReplicationMetrics: (
+      query?: {
+        bucket?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ReplicationMetricsResponse, ApiError>({
+        path: `/admin/replication/metrics`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -4457,6 +6161,74 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags SiteReplication
+     * @name SiteReplicationResync
+     * @summary Start or cancel a site replication resync to a peer site
+     * @request POST:/admin/site-replication/resync
+     * @secure
+     */
+    siteReplicationResync: (
+      body: SiteReplicationResyncRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<SiteReplicationResyncResponse, ApiError>({
+        path: `/admin/site-replication/resync`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags SiteReplication
+     * @name GetSiteReplicationPeerHealth
+     * @summary Check reachability and latency of every site replication peer
+     * @request GET:/admin/site-replication/health
+     * @secure
+     */
+    getSiteReplicationPeerHealth: (params: RequestParams = {}) =>
+      this.request<SiteReplicationPeerHealthResponse, ApiError>({
+        path: `/admin/site-replication/health`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags SiteReplication
+     * @name GetSiteReplicationDiff
+     * @summary List the buckets, policies, users and groups out of sync across sites
+     * @request GET:/admin/site-replication/diff
+     * @secure
+     */
+    getSiteReplicationDiff: (
+      query?: {
+        /** Only report entities of this type */
+        entityType?: "bucket" | "policy" | "user" | "group";
+        /** Only report this entity, requires entityType */
+        entityValue?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<SiteReplicationDiffResponse, ApiError>({
+        path: `/admin/site-replication/diff`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -4494,6 +6266,59 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Tiering
+     * @name TiersUsage
+     * @summary Returns the data transitioned to each tier and the bucket lifecycle rules targeting it
+     * @request GET:/admin/tiers/usage
+     * @secure
+     */
+    tiersUsage: (params: RequestParams = {}) =>
+      this.request<TierUsageResponse, ApiError>({
+        path: `/admin/tiers/usage`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Tiering
+     * @name RemoveTier
+     * @summary Remove Tier
+     * @request DELETE:/admin/tiers/{name}
+     * @secure
+     */
+    removeTier: (name: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/tiers/${name}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Tiering
+     * @name VerifyTier
+     * @summary Verify Tier
+     * @request POST:/admin/tiers/{name}/verify
+     * @secure
+     */
+    verifyTier: (name: string, params: RequestParams = {}) =>
+      this.request<TierVerifyResponse, ApiError>({
+        path: `/admin/tiers/${name}/verify`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -4540,6 +6365,90 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Speedtest
+     * @name ListSpeedtestResults
+     * @summary List previous speedtest runs, newest first
+     * @request GET:/admin/speedtest/results
+     * @secure
+     */
+    listSpeedtestResults: (
+      query?: {
+        type?: "object" | "drive" | "net" | "site";
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<SpeedtestResultsResponse, ApiError>({
+        path: `/admin/speedtest/results`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Speedtest
+     * @name GetSpeedtestResult
+     * @summary Returns a speedtest run including the raw result
+     * @request GET:/admin/speedtest/results/{id}
+     * @secure
+     */
+    getSpeedtestResult: (id: string, params: RequestParams = {}) =>
+      this.request<SpeedtestResult, ApiError>({
+        path: `/admin/speedtest/results/${id}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Speedtest
+     * @name DeleteSpeedtestResult
+     * @summary Removes a speedtest run from the history
+     * @request DELETE:/admin/speedtest/results/{id}
+     * @secure
+     */
+    deleteSpeedtestResult: (id: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/speedtest/results/${id}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Speedtest
+     * @name ExportSpeedtestResults
+     * @summary Export the speedtest history as JSON or CSV
+     * @request GET:/admin/speedtest/export
+     * @secure
+     */
+    exportSpeedtestResults: (
+      query?: {
+        /** @default "json" */
+        format?: "json" | "csv";
+        type?: "object" | "drive" | "net" | "site";
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<File, ApiError>({
+        path: `/admin/speedtest/export`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -5207,6 +7116,114 @@ export class Api<
         ...params,
       }),
   };
+  ldapDirectory = {
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name SearchLdapUsers
+     * @summary Search the LDAP directory for users
+     * @request GET:/ldap-directory/users
+     * @secure
+     */
+    searchLdapUsers: (
+      query?: {
+        /** part of the name to look for, everything when empty */
+        query?: string;
+        /**
+         * @format int32
+         * @default 50
+         */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<LdapDirectorySearchResponse, ApiError>({
+        path: `/ldap-directory/users`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name SearchLdapGroups
+     * @summary Search the LDAP directory for groups
+     * @request GET:/ldap-directory/groups
+     * @secure
+     */
+    searchLdapGroups: (
+      query?: {
+        /** part of the name to look for, everything when empty */
+        query?: string;
+        /**
+         * @format int32
+         * @default 50
+         */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<LdapDirectorySearchResponse, ApiError>({
+        path: `/ldap-directory/groups`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name GetLdapUserGroups
+     * @summary Groups an LDAP user is a member of and the policies mapped to them
+     * @request GET:/ldap-directory/user-groups
+     * @secure
+     */
+    getLdapUserGroups: (
+      query: {
+        dn: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<LdapUserGroupsResponse, ApiError>({
+        path: `/ldap-directory/user-groups`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name AttachLdapPolicies
+     * @summary Attach policies to an LDAP user or group
+     * @request POST:/ldap-directory/policies
+     * @secure
+     */
+    attachLdapPolicies: (
+      body: LdapPolicyMappingRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<LdapPolicyMappingResponse, ApiError>({
+        path: `/ldap-directory/policies`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+  };
   releases = {
     /**
      * No description
@@ -29,11 +29,68 @@ export interface BucketObjectItem {
 }
 
 export interface WebsocketRequest {
-  mode: "objects" | "rewind" | "close" | "cancel";
+  mode: "objects" | "rewind" | "search" | "bulk-edit" | "restore" | "close" | "cancel";
   bucket_name?: string;
   prefix?: string;
   date?: string;
   request_id: number;
+  search?: WebsocketSearchRequest;
+  edit?: WebsocketBulkEditRequest;
+  dry_run?: boolean;
+}
+
+export interface WebsocketSearchRequest {
+  name?: string;
+  regex?: string;
+  min_size?: number;
+  max_size?: number;
+  modified_after?: string;
+  modified_before?: string;
+  content_type?: string;
+  metadata?: Record<string, string>;
+  tags?: Record<string, string>;
+  versions?: boolean;
+}
+
+export interface WebsocketBulkEditRequest {
+  tags?: {
+    mode: "add" | "remove" | "replace";
+    tags: Record<string, string>;
+  };
+  content_type?: string;
+  cache_control?: string;
+  metadata?: Record<string, string>;
+  retention?: {
+    mode: "governance" | "compliance";
+    expires: string;
+    governance_bypass?: boolean;
+  };
+  legal_hold?: "enabled" | "disabled";
+}
+
+export interface WebsocketBulkEditResult {
+  name: string;
+  version_id?: string;
+  new_version_id?: string;
+  error?: string;
+}
+
+export interface WebsocketRestoreResult {
+  name: string;
+  action: "restore" | "delete" | "unchanged";
+  version_id?: string;
+  last_modified?: string;
+  new_version_id?: string;
+  error?: string;
+}
+
+export interface WebsocketRestoreReport {
+  date: string;
+  dry_run?: boolean;
+  restored: number;
+  deleted: number;
+  unchanged: number;
+  failed: number;
 }
 
 export interface WebsocketResponse {
@@ -43,6 +100,11 @@ export interface WebsocketResponse {
   data?: ObjectResponse[];
   prefix?: string;
   bucketName?: string;
+  bulk_results?: WebsocketBulkEditResult[];
+  matched?: number;
+  failed?: number;
+  restore_results?: WebsocketRestoreResult[];
+  restore_report?: WebsocketRestoreReport;
 }
 
 export interface WebsocketErrorResponse {
@@ -57,6 +119,9 @@ export interface ObjectResponse {
   version_id: string;
   delete_flag: boolean;
   is_latest: boolean;
+  content_type?: string;
+  user_metadata?: Record<string, string>;
+  tags?: Record<string, string>;
 }
 
 export interface IRestoreLocalObjectList {
@@ -43,6 +43,7 @@ export const downloadSelectedAsZip = async (
     const resp = await api.buckets.downloadMultipleObjects(
       bucketName,
       objectList,
+      undefined,
       {
         type: ContentType.Json,
         headers: anonymousMode